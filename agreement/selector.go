@@ -20,6 +20,7 @@ import (
 	"fmt"
 
 	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/committee"
 	"github.com/algorand/go-algorand/protocol"
@@ -87,3 +88,39 @@ func membership(l LedgerReader, addr basics.Address, r basics.Round, p period, s
 	m.TotalMoney = total
 	return m, nil
 }
+
+// ExpectedParticipation reports whether the given account, using the given selection keys, is selected to propose a
+// block, and to vote in the soft or cert steps, during the first period of round r. The selection is evaluated the
+// same way it is when the proposals and votes are made, so the caller may compare it with the actual participation.
+func ExpectedParticipation(l LedgerReader, addr basics.Address, selection *crypto.VRFSecrets, r basics.Round) (proposer bool, voter bool, err error) {
+	proto, err := l.ConsensusParams(ParamsRound(r))
+	if err != nil {
+		return false, false, fmt.Errorf("ExpectedParticipation: could not get consensus params for round %d: %w", ParamsRound(r), err)
+	}
+
+	selected := func(s step) (bool, error) {
+		m, err := membership(l, addr, r, 0, s)
+		if err != nil {
+			return false, err
+		}
+		if m.Record.VotingStake().IsZero() {
+			return false, nil
+		}
+		cred := committee.MakeCredential(&selection.SK, m.Selector)
+		// the credential fails to verify when it has no weight, or when the account is online using other keys.
+		_, err = cred.Verify(proto, m)
+		return err == nil, nil
+	}
+
+	proposer, err = selected(propose)
+	if err != nil {
+		return false, false, err
+	}
+	for _, s := range []step{soft, cert} {
+		voter, err = selected(s)
+		if err != nil || voter {
+			return proposer, voter, err
+		}
+	}
+	return proposer, false, nil
+}
@@ -0,0 +1,72 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package agreement
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/committee"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestExpectedParticipation(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	ledger, addresses, vrfSecrets, _ := readOnlyFixture100()
+	round := ledger.NextRound()
+	proto := config.Consensus[protocol.ConsensusCurrentVersion]
+
+	isSelected := func(i int, s step) bool {
+		m, err := membership(ledger, addresses[i], round, 0, s)
+		require.NoError(t, err)
+		_, err = committee.MakeCredential(&vrfSecrets[i].SK, m.Selector).Verify(proto, m)
+		return err == nil
+	}
+
+	proposers := 0
+	for i, address := range addresses {
+		proposer, voter, err := ExpectedParticipation(ledger, address, vrfSecrets[i], round)
+		require.NoError(t, err)
+		require.Equal(t, isSelected(i, propose), proposer)
+		require.Equal(t, isSelected(i, soft) || isSelected(i, cert), voter)
+		if proposer {
+			proposers++
+		}
+	}
+	require.NotZero(t, proposers)
+
+	// an account is never selected using keys other than its registered ones.
+	otherKeys := crypto.GenerateVRFSecrets()
+	for _, address := range addresses {
+		proposer, voter, err := ExpectedParticipation(ledger, address, otherKeys, round)
+		require.NoError(t, err)
+		require.False(t, proposer)
+		require.False(t, voter)
+	}
+
+	// neither is an account which isn't online.
+	proposer, voter, err := ExpectedParticipation(ledger, basics.Address{}, vrfSecrets[0], round)
+	require.NoError(t, err)
+	require.False(t, proposer)
+	require.False(t, voter)
+}
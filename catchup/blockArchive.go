@@ -0,0 +1,207 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package catchup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/network"
+	"github.com/algorand/go-algorand/util/s3"
+)
+
+// peersBlockArchives is the peer class of the block archives configured for catchup. The block archives aren't network
+// peers, so the peer selector retrieves them from the catchup service rather than from the network.
+const peersBlockArchives network.PeerOption = -1
+
+// blockArchiveMinFileNameLength is the minimal length of the block file names in the catchupsrv directory format,
+// which are the base-36 representation of the round, padded with zeros.
+const blockArchiveMinFileNameLength = 6
+
+// blockArchive is a static archive of blocks and certificates, laid out in the catchupsrv directory format.
+type blockArchive interface {
+	// getObject returns the content of the object at the given slash-separated path, relative to the archive root.
+	// errNoBlockForRound is returned if the object doesn't exist.
+	getObject(ctx context.Context, objectPath string) ([]byte, error)
+}
+
+// blockArchivePeer is a block archive used by the catchup service as a peer.
+type blockArchivePeer struct {
+	archive blockArchive
+	// archiveURL is the configured archive URL, which identifies the peer.
+	archiveURL string
+}
+
+// blockArchiveBlockPath returns the path of the given round's block and certificate in the catchupsrv directory
+// format, e.g. the path of round 0bcdef in base 36 is v1/<genesisID>/block/0b/cd/0bcdef.
+func blockArchiveBlockPath(genesisID string, round basics.Round) string {
+	s := strconv.FormatUint(uint64(round), 36)
+	if len(s) < blockArchiveMinFileNameLength {
+		s = strings.Repeat("0", blockArchiveMinFileNameLength-len(s)) + s
+	}
+	return path.Join("v1", genesisID, "block", s[:len(s)-4], s[len(s)-4:len(s)-2], s)
+}
+
+// fileBlockArchive is a block archive stored in a local directory.
+type fileBlockArchive struct {
+	root string
+}
+
+// getObject implements blockArchive
+func (fa *fileBlockArchive) getObject(ctx context.Context, objectPath string) ([]byte, error) {
+	filename := filepath.Join(fa.root, filepath.FromSlash(objectPath))
+	stat, err := os.Stat(filename)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, errNoBlockForRound
+		}
+		return nil, err
+	}
+	if stat.Size() > fetcherMaxBlockBytes {
+		return nil, fmt.Errorf("fileBlockArchive: %s exceeds the maximal size of %d bytes", filename, fetcherMaxBlockBytes)
+	}
+	return os.ReadFile(filename)
+}
+
+// s3BlockArchive is a block archive stored in an S3-compatible bucket.
+type s3BlockArchive struct {
+	helper s3.Helper
+	prefix string
+}
+
+// getObject implements blockArchive
+func (sa *s3BlockArchive) getObject(ctx context.Context, objectPath string) ([]byte, error) {
+	data, err := sa.helper.GetObject(ctx, path.Join(sa.prefix, objectPath), fetcherMaxBlockBytes)
+	if errors.Is(err, s3.ErrObjectNotFound) {
+		return nil, errNoBlockForRound
+	}
+	return data, err
+}
+
+// makeBlockArchivePeer creates a block archive peer out of an archive URL, which is either a local directory path,
+// a file:// URL, or an s3://bucket/prefix URL whose endpoint and region can be given as query parameters.
+func makeBlockArchivePeer(archiveURL string) (*blockArchivePeer, error) {
+	if !strings.Contains(archiveURL, "://") {
+		return &blockArchivePeer{archive: &fileBlockArchive{root: archiveURL}, archiveURL: archiveURL}, nil
+	}
+	parsedURL, err := url.Parse(archiveURL)
+	if err != nil {
+		return nil, err
+	}
+	switch parsedURL.Scheme {
+	case "file":
+		return &blockArchivePeer{archive: &fileBlockArchive{root: parsedURL.Path}, archiveURL: archiveURL}, nil
+	case "s3":
+		query := parsedURL.Query()
+		helper, err := s3.MakeS3SessionForDownloadWithEndpoint(parsedURL.Host, query.Get("endpoint"), query.Get("region"))
+		if err != nil {
+			return nil, err
+		}
+		archive := &s3BlockArchive{helper: helper, prefix: strings.Trim(parsedURL.Path, "/")}
+		return &blockArchivePeer{archive: archive, archiveURL: archiveURL}, nil
+	default:
+		return nil, fmt.Errorf("makeBlockArchivePeer: unsupported block archive scheme %s", parsedURL.Scheme)
+	}
+}
+
+// makeBlockArchivePeers creates the block archive peers out of a comma delimited list of archive URLs. Invalid archive
+// URLs are logged and skipped.
+func makeBlockArchivePeers(log logging.Logger, archiveURLs string) (peers []network.Peer) {
+	for _, archiveURL := range strings.Split(archiveURLs, ",") {
+		archiveURL = strings.TrimSpace(archiveURL)
+		if archiveURL == "" {
+			continue
+		}
+		peer, err := makeBlockArchivePeer(archiveURL)
+		if err != nil {
+			log.Warnf("unable to use block archive %s : %v", archiveURL, err)
+			continue
+		}
+		peers = append(peers, peer)
+	}
+	return
+}
+
+// blockArchivesPeersRetriever adds the block archives to the peers retrieved from the network.
+type blockArchivesPeersRetriever struct {
+	peersRetriever
+	archives []network.Peer
+}
+
+// GetPeers implements peersRetriever
+func (br blockArchivesPeersRetriever) GetPeers(options ...network.PeerOption) (peers []network.Peer) {
+	netOptions := make([]network.PeerOption, 0, len(options))
+	for _, option := range options {
+		if option == peersBlockArchives {
+			peers = append(peers, br.archives...)
+		} else {
+			netOptions = append(netOptions, option)
+		}
+	}
+	if len(netOptions) > 0 {
+		peers = append(peers, br.peersRetriever.GetPeers(netOptions...)...)
+	}
+	return
+}
+
+// preferBlockArchives returns the given peer classes, preceded by the block archives peer class. The block archives
+// take the first priority, and each of the other peer classes is demoted by one priority.
+func preferBlockArchives(peerClasses []peerClass) []peerClass {
+	priorities := []int{peerRankInitialFirstPriority, peerRankInitialSecondPriority, peerRankInitialThirdPriority, peerRankInitialFourthPriority}
+	result := []peerClass{{initialRank: peerRankInitialFirstPriority, peerClass: peersBlockArchives}}
+	for _, class := range peerClasses {
+		for i := 0; i < len(priorities)-1; i++ {
+			if class.initialRank == priorities[i] {
+				class.initialRank = priorities[i+1]
+				break
+			}
+		}
+		result = append(result, class)
+	}
+	return result
+}
+
+// blockArchiveFetcher fetches blocks from a block archive.
+type blockArchiveFetcher struct {
+	peer   *blockArchivePeer
+	net    network.GossipNode
+	config *config.Local
+}
+
+// getBlockBytes gets a block.
+func (bf *blockArchiveFetcher) getBlockBytes(ctx context.Context, r basics.Round) ([]byte, error) {
+	genesisID := bf.net.SubstituteGenesisID("{genesisID}")
+	requestCtx, requestCancel := context.WithTimeout(ctx, time.Duration(bf.config.CatchupHTTPBlockFetchTimeoutSec)*time.Second)
+	defer requestCancel()
+	return bf.peer.archive.getObject(requestCtx, blockArchiveBlockPath(genesisID, r))
+}
+
+// address returns the archive URL.
+func (bf *blockArchiveFetcher) address() string {
+	return fmt.Sprintf("[archive] (%s)", bf.peer.archiveURL)
+}
@@ -0,0 +1,151 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package catchup
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/agreement"
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/network"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/rpcs"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestBlockArchiveBlockPath(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	require.Equal(t, "v1/test-v1/block/00/00/000000", blockArchiveBlockPath("test-v1", 0))
+	require.Equal(t, "v1/test-v1/block/0b/cd/0bcdef", blockArchiveBlockPath("test-v1", 19053015))
+	require.Equal(t, "v1/test-v1/block/abc/de/abcdefg", blockArchiveBlockPath("test-v1", 22453731916))
+}
+
+// s3StandIn serves the objects of a single bucket the way an S3-compatible service does, using path-style requests.
+type s3StandIn struct {
+	bucket  string
+	objects map[string][]byte
+}
+
+func (s *s3StandIn) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	key := strings.TrimPrefix(r.URL.Path, "/"+s.bucket+"/")
+	data, ok := s.objects[key]
+	if r.Method != http.MethodGet || !strings.HasPrefix(r.URL.Path, "/"+s.bucket+"/") || !ok {
+		w.Header().Set("Content-Type", "application/xml")
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`<?xml version="1.0" encoding="UTF-8"?><Error><Code>NoSuchKey</Code><Message>The specified key does not exist.</Message></Error>`))
+		return
+	}
+	w.Header().Set("Content-Type", "application/octet-stream")
+	w.Write(data)
+}
+
+func TestBlockArchiveFetcher(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	cfg := config.GetDefaultLocal()
+	_, next, b, err := buildTestLedger(t, bookkeeping.Block{})
+	require.NoError(t, err)
+	blockCert := protocol.Encode(&rpcs.EncodedBlockCert{Block: b, Certificate: agreement.Certificate{Round: next}})
+	blockPath := blockArchiveBlockPath("test genesisID", next)
+
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Dir(filepath.Join(dir, blockPath)), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, blockPath), blockCert, 0644))
+
+	standIn := &s3StandIn{bucket: "blocks", objects: map[string][]byte{"archive/" + blockPath: blockCert}}
+	server := httptest.NewServer(standIn)
+	defer server.Close()
+
+	archives := makeBlockArchivePeers(logging.TestingLog(t), strings.Join([]string{
+		dir,
+		"file://" + dir,
+		"s3://blocks/archive?endpoint=" + server.URL,
+		"ftp://unsupported",
+		"",
+	}, ", "))
+	require.Len(t, archives, 3)
+
+	net := &httpTestPeerSource{}
+	fetcher := makeUniversalBlockFetcher(logging.TestingLog(t), net, cfg)
+	for _, archive := range archives {
+		block, cert, _, err := fetcher.fetchBlock(context.Background(), next, archive)
+		require.NoError(t, err)
+		require.Equal(t, &b, block)
+		require.Equal(t, next, cert.Round)
+
+		block, cert, _, err = fetcher.fetchBlock(context.Background(), next+1, archive)
+		require.ErrorIs(t, err, errNoBlockForRound)
+		require.Nil(t, block)
+		require.Nil(t, cert)
+	}
+}
+
+func TestPreferBlockArchives(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	archive, err := makeBlockArchivePeer(t.TempDir())
+	require.NoError(t, err)
+	relay := &mockHTTPPeer{address: "relay"}
+	net := &httpTestPeerSource{peers: []network.Peer{relay}}
+
+	cfg := config.GetDefaultLocal()
+	cfg.EnableCatchupFromArchiveServers = false
+	cfg.NetAddress = ""
+	ps := createPeerSelector(net, cfg, true, []network.Peer{archive})
+	require.Equal(t, []peerClass{
+		{initialRank: peerRankInitialFirstPriority, peerClass: peersBlockArchives},
+		{initialRank: peerRankInitialSecondPriority, peerClass: network.PeersConnectedOut},
+		{initialRank: peerRankInitialThirdPriority, peerClass: network.PeersPhonebookRelays},
+	}, ps.peerClasses)
+
+	// the archive is selected first, and the network peers are used once it keeps failing.
+	psp, err := ps.getNextPeer()
+	require.NoError(t, err)
+	require.Equal(t, archive, psp.Peer)
+	require.Equal(t, peersBlockArchives, psp.peerClass)
+	for i := 0; i < 10 && psp.Peer == archive; i++ {
+		ps.rankPeer(psp, peerRankDownloadFailed)
+		psp, err = ps.getNextPeer()
+		require.NoError(t, err)
+	}
+	require.Equal(t, relay, psp.Peer)
+
+	// the peer classes aren't demoted beyond the last priority.
+	cfg.EnableCatchupFromArchiveServers = true
+	cfg.NetAddress = ":0"
+	ps = createPeerSelector(net, cfg, true, []network.Peer{archive})
+	require.Equal(t, []peerClass{
+		{initialRank: peerRankInitialFirstPriority, peerClass: peersBlockArchives},
+		{initialRank: peerRankInitialSecondPriority, peerClass: network.PeersConnectedOut},
+		{initialRank: peerRankInitialThirdPriority, peerClass: network.PeersPhonebookArchivers},
+		{initialRank: peerRankInitialFourthPriority, peerClass: network.PeersPhonebookRelays},
+		{initialRank: peerRankInitialFourthPriority, peerClass: network.PeersConnectedIn},
+	}, ps.peerClasses)
+	ps = createPeerSelector(net, cfg, true, nil)
+	require.Len(t, ps.peerClasses, 4)
+}
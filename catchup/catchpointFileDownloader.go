@@ -0,0 +1,420 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package catchup
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/network"
+	"github.com/algorand/go-algorand/rpcs"
+)
+
+// catchpointFileSegmentSize is the size of the catchpoint file segments, each downloaded from a single peer using a
+// range request.
+var catchpointFileSegmentSize int64 = 64 * 1024 * 1024
+
+const (
+	// catchpointFileDownloadPeerSelectionAttempts is the number of attempts made to select a peer which isn't used by
+	// any of the other segment downloads.
+	catchpointFileDownloadPeerSelectionAttempts = 4
+	// catchpointFileDownloadProgressSuffix is the suffix of the file storing the download progress, beside the
+	// downloaded catchpoint file.
+	catchpointFileDownloadProgressSuffix = ".progress"
+)
+
+var errRangeRequestsNotSupported = errors.New("catchpoint file download : peer doesn't support range requests")
+
+// catchpointFileDownloadProgress is the progress of a catchpoint file download, stored beside the downloaded file so
+// that an interrupted download could be resumed.
+type catchpointFileDownloadProgress struct {
+	// Size is the size of the compressed catchpoint file, or zero if it isn't known yet.
+	Size int64 `json:"size"`
+	// Segments marks the segments of the catchpoint file which were already downloaded.
+	Segments []bool `json:"segments"`
+}
+
+// catchpointFileDownloader downloads the compressed catchpoint file into a local file, in segments. The segments are
+// downloaded in parallel from several peers, and the download resumes from the last downloaded segment if it's
+// interrupted. The segments of different peers are only required to add up to a file of the same size; the content of
+// the downloaded file is verified once it's loaded into the ledger.
+type catchpointFileDownloader struct {
+	net          network.GossipNode
+	log          logging.Logger
+	config       config.Local
+	peerSelector *peerSelector
+	round        basics.Round
+	filename     string
+
+	// mu synchronizes the access to the fields below, which are shared by the segment downloads.
+	mu       deadlock.Mutex
+	file     *os.File
+	progress catchpointFileDownloadProgress
+	// pending are the segments waiting to be downloaded
+	pending []int
+	// failures counts the failed segment downloads
+	failures int
+	// activePeers counts the segment downloads of each of the peers
+	activePeers map[network.Peer]int
+}
+
+func makeCatchpointFileDownloader(net network.GossipNode, log logging.Logger, cfg config.Local, peerSelector *peerSelector, round basics.Round, filename string) *catchpointFileDownloader {
+	return &catchpointFileDownloader{
+		net:          net,
+		log:          log,
+		config:       cfg,
+		peerSelector: peerSelector,
+		round:        round,
+		filename:     filename,
+		activePeers:  make(map[network.Peer]int),
+	}
+}
+
+// download downloads the catchpoint file, resuming a previously interrupted download of the same file.
+func (fd *catchpointFileDownloader) download(ctx context.Context) (err error) {
+	fd.file, err = os.OpenFile(fd.filename, os.O_RDWR|os.O_CREATE, 0600)
+	if err != nil {
+		return err
+	}
+	defer fd.file.Close()
+
+	if !fd.loadProgress() {
+		fd.progress = catchpointFileDownloadProgress{}
+		if err = fd.file.Truncate(0); err != nil {
+			return err
+		}
+	}
+	if fd.progress.Size == 0 {
+		// the size of the catchpoint file is unknown until the first segment is downloaded.
+		fd.pending = []int{0}
+		if err = fd.downloadSegments(ctx, 1); err != nil {
+			return err
+		}
+	}
+	fd.pending = nil
+	for segment, downloaded := range fd.progress.Segments {
+		if !downloaded {
+			fd.pending = append(fd.pending, segment)
+		}
+	}
+	if len(fd.pending) == 0 {
+		return nil
+	}
+	fd.log.Infof("downloading %d of %d catchpoint file segments", len(fd.pending), len(fd.progress.Segments))
+	downloads := len(fd.pending)
+	if fd.config.CatchpointFileDownloadPeers < uint64(downloads) {
+		downloads = int(fd.config.CatchpointFileDownloadPeers)
+	}
+	return fd.downloadSegments(ctx, downloads)
+}
+
+// remove removes the downloaded catchpoint file and its download progress.
+func (fd *catchpointFileDownloader) remove() {
+	for _, filename := range []string{fd.filename, fd.filename + catchpointFileDownloadProgressSuffix} {
+		if err := os.Remove(filename); err != nil && !os.IsNotExist(err) {
+			fd.log.Warnf("unable to remove %s : %v", filename, err)
+		}
+	}
+}
+
+// loadProgress loads the progress of a previous download, and returns false if there isn't a valid one.
+func (fd *catchpointFileDownloader) loadProgress() bool {
+	progressBytes, err := os.ReadFile(fd.filename + catchpointFileDownloadProgressSuffix)
+	if err != nil {
+		return false
+	}
+	var progress catchpointFileDownloadProgress
+	if err = json.Unmarshal(progressBytes, &progress); err != nil {
+		fd.log.Warnf("unable to parse the catchpoint file download progress : %v", err)
+		return false
+	}
+	if progress.Size <= 0 || len(progress.Segments) != catchpointFileSegments(progress.Size) {
+		return false
+	}
+	fd.progress = progress
+	return true
+}
+
+// saveProgress stores the download progress, once the downloaded segments are synced to disk.
+func (fd *catchpointFileDownloader) saveProgress() error {
+	if err := fd.file.Sync(); err != nil {
+		return err
+	}
+	progressBytes, err := json.Marshal(&fd.progress)
+	if err != nil {
+		return err
+	}
+	progressFilename := fd.filename + catchpointFileDownloadProgressSuffix
+	if err = os.WriteFile(progressFilename+".tmp", progressBytes, 0600); err != nil {
+		return err
+	}
+	return os.Rename(progressFilename+".tmp", progressFilename)
+}
+
+// catchpointFileSegments returns the number of segments of a catchpoint file of the given size.
+func catchpointFileSegments(size int64) int {
+	return int((size + catchpointFileSegmentSize - 1) / catchpointFileSegmentSize)
+}
+
+// downloadSegments downloads the pending segments, running the given number of downloads in parallel.
+func (fd *catchpointFileDownloader) downloadSegments(ctx context.Context, downloads int) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	var wg sync.WaitGroup
+	errs := make(chan error, downloads)
+	for i := 0; i < downloads; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := fd.downloadPendingSegments(ctx); err != nil {
+				errs <- err
+				cancel()
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	return <-errs
+}
+
+// downloadPendingSegments downloads the pending segments one by one, until none are left.
+func (fd *catchpointFileDownloader) downloadPendingSegments(ctx context.Context) error {
+	for {
+		segment, ok := fd.nextSegment()
+		if !ok {
+			return nil
+		}
+		psp, err := fd.selectPeer()
+		if err != nil {
+			fd.requeueSegment(segment)
+			return fmt.Errorf("catchpoint file download : unable to obtain a peer to retrieve the catchpoint file from : %v", err)
+		}
+		start := time.Now()
+		err = fd.downloadSegment(ctx, psp.Peer, segment)
+		fd.releasePeer(psp.Peer)
+		if err == nil {
+			fd.log.Debugf("downloaded catchpoint file segment %d from %s in %v", segment, peerAddress(psp.Peer), time.Since(start))
+			continue
+		}
+		fd.requeueSegment(segment)
+		// the http client library sometimes wraps the context canceled error, so we check on the context itself.
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		fd.peerSelector.rankPeer(psp, peerRankDownloadFailed)
+		fd.log.Infof("unable to download catchpoint file segment %d from %s : %v", segment, peerAddress(psp.Peer), err)
+		if fd.failed() >= fd.config.CatchupLedgerDownloadRetryAttempts {
+			return fmt.Errorf("catchpoint file download : exceeded number of attempts to retrieve the catchpoint file segments")
+		}
+	}
+}
+
+func (fd *catchpointFileDownloader) nextSegment() (int, bool) {
+	fd.mu.Lock()
+	defer fd.mu.Unlock()
+	if len(fd.pending) == 0 {
+		return 0, false
+	}
+	segment := fd.pending[0]
+	fd.pending = fd.pending[1:]
+	return segment, true
+}
+
+func (fd *catchpointFileDownloader) requeueSegment(segment int) {
+	fd.mu.Lock()
+	defer fd.mu.Unlock()
+	fd.pending = append(fd.pending, segment)
+}
+
+func (fd *catchpointFileDownloader) failed() int {
+	fd.mu.Lock()
+	defer fd.mu.Unlock()
+	fd.failures++
+	return fd.failures
+}
+
+// selectPeer selects the peer to download a segment from, preferring the peers which aren't used by any of the other
+// segment downloads.
+func (fd *catchpointFileDownloader) selectPeer() (psp *peerSelectorPeer, err error) {
+	fd.mu.Lock()
+	defer fd.mu.Unlock()
+	for i := 0; i < catchpointFileDownloadPeerSelectionAttempts; i++ {
+		psp, err = fd.peerSelector.getNextPeer()
+		if err != nil || fd.activePeers[psp.Peer] == 0 {
+			break
+		}
+	}
+	if err == nil {
+		fd.activePeers[psp.Peer]++
+	}
+	return
+}
+
+func (fd *catchpointFileDownloader) releasePeer(peer network.Peer) {
+	fd.mu.Lock()
+	defer fd.mu.Unlock()
+	fd.activePeers[peer]--
+	if fd.activePeers[peer] == 0 {
+		delete(fd.activePeers, peer)
+	}
+}
+
+// downloadSegment downloads a single segment of the catchpoint file from the given peer, and writes it to the file.
+func (fd *catchpointFileDownloader) downloadSegment(ctx context.Context, peer network.Peer, segment int) error {
+	httpPeer, ok := peer.(network.HTTPPeer)
+	if !ok {
+		return errNonHTTPPeer
+	}
+	ledgerURL, err := peerLedgerURL(fd.net, httpPeer, fd.round)
+	if err != nil {
+		return err
+	}
+	request, err := http.NewRequest(http.MethodGet, ledgerURL, nil)
+	if err != nil {
+		return err
+	}
+
+	// maxSegmentDownloadDuration is the maximum amount of time we would wait to download a single segment
+	maxSegmentDownloadDuration := 2 * time.Minute
+	if fd.config.MinCatchpointFileDownloadBytesPerSecond > 0 {
+		maxSegmentDownloadDuration += time.Duration(catchpointFileSegmentSize) * time.Second / time.Duration(fd.config.MinCatchpointFileDownloadBytesPerSecond)
+	} else {
+		maxSegmentDownloadDuration += time.Duration(catchpointFileSegmentSize) * time.Second / defaultMinCatchpointFileDownloadBytesPerSecond
+	}
+	timeoutContext, timeoutContextCancel := context.WithTimeout(ctx, maxSegmentDownloadDuration)
+	defer timeoutContextCancel()
+	request = request.WithContext(timeoutContext)
+	network.SetUserAgentHeader(request.Header)
+	// the ranges apply to the compressed catchpoint file, which the http client would otherwise decompress.
+	request.Header.Set("Accept-Encoding", "gzip")
+	first := int64(segment) * catchpointFileSegmentSize
+	request.Header.Set("Range", fmt.Sprintf("bytes=%d-%d", first, first+catchpointFileSegmentSize-1))
+	response, err := httpPeer.GetHTTPClient().Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	switch response.StatusCode {
+	case http.StatusPartialContent:
+	case http.StatusOK:
+		return errRangeRequestsNotSupported
+	case http.StatusNotFound:
+		return errNoLedgerForRound
+	default:
+		return fmt.Errorf("catchpoint file download : error response status code %d", response.StatusCode)
+	}
+	if contentType := response.Header.Get("Content-Type"); contentType != rpcs.LedgerResponseContentType {
+		return fmt.Errorf("catchpoint file download : response has an invalid content type : %s", contentType)
+	}
+	if contentEncoding := response.Header.Get("Content-Encoding"); contentEncoding != "gzip" {
+		return fmt.Errorf("catchpoint file download : response has an invalid content encoding : %s", contentEncoding)
+	}
+	rangeFirst, rangeLast, size, err := parseContentRange(response.Header.Get("Content-Range"))
+	if err != nil {
+		return err
+	}
+	if rangeFirst != first || (rangeLast+1 != first+catchpointFileSegmentSize && rangeLast+1 != size) {
+		return fmt.Errorf("catchpoint file download : response range %d-%d doesn't match the requested segment %d", rangeFirst, rangeLast, segment)
+	}
+	if err = fd.setSize(size); err != nil {
+		return err
+	}
+
+	written, err := io.Copy(&offsetWriter{file: fd.file, offset: first}, io.LimitReader(response.Body, rangeLast-rangeFirst+1))
+	if err != nil {
+		return err
+	}
+	if written != rangeLast-rangeFirst+1 {
+		return fmt.Errorf("catchpoint file download : received %d bytes of segment %d, rather than %d", written, segment, rangeLast-rangeFirst+1)
+	}
+	return fd.segmentDownloaded(segment)
+}
+
+// setSize sets the size of the catchpoint file, or verifies that it matches the size reported by the other peers.
+func (fd *catchpointFileDownloader) setSize(size int64) error {
+	fd.mu.Lock()
+	defer fd.mu.Unlock()
+	if fd.progress.Size == 0 {
+		fd.progress.Size = size
+		fd.progress.Segments = make([]bool, catchpointFileSegments(size))
+		return fd.file.Truncate(size)
+	}
+	if fd.progress.Size != size {
+		return fmt.Errorf("catchpoint file download : catchpoint file size %d doesn't match the previously downloaded segments size %d", size, fd.progress.Size)
+	}
+	return nil
+}
+
+func (fd *catchpointFileDownloader) segmentDownloaded(segment int) error {
+	fd.mu.Lock()
+	defer fd.mu.Unlock()
+	fd.progress.Segments[segment] = true
+	return fd.saveProgress()
+}
+
+// parseContentRange parses a Content-Range header, such as "bytes 100-199/1000", returning the first and last offsets of
+// the range, inclusive, and the size of the content.
+func parseContentRange(contentRange string) (first int64, last int64, size int64, err error) {
+	const prefix = "bytes "
+	err = fmt.Errorf("catchpoint file download : invalid content range '%s'", contentRange)
+	if !strings.HasPrefix(contentRange, prefix) {
+		return
+	}
+	bounds := strings.SplitN(contentRange[len(prefix):], "/", 2)
+	if len(bounds) != 2 {
+		return
+	}
+	offsets := strings.SplitN(bounds[0], "-", 2)
+	if len(offsets) != 2 {
+		return
+	}
+	var err0, err1, err2 error
+	first, err0 = strconv.ParseInt(offsets[0], 10, 64)
+	last, err1 = strconv.ParseInt(offsets[1], 10, 64)
+	size, err2 = strconv.ParseInt(bounds[1], 10, 64)
+	if err0 != nil || err1 != nil || err2 != nil || first < 0 || last < first || last >= size {
+		return 0, 0, 0, err
+	}
+	return first, last, size, nil
+}
+
+// offsetWriter writes to the file sequentially, starting at the given offset.
+type offsetWriter struct {
+	file   *os.File
+	offset int64
+}
+
+func (w *offsetWriter) Write(p []byte) (n int, err error) {
+	n, err = w.file.WriteAt(p, w.offset)
+	w.offset += int64(n)
+	return
+}
@@ -0,0 +1,224 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package catchup
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/components/mocks"
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/ledger"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/network"
+	"github.com/algorand/go-algorand/rpcs"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// catchpointFileServer serves a catchpoint file the way the ledger service does.
+type catchpointFileServer struct {
+	content []byte
+	// noRanges makes the server ignore the range requests
+	noRanges bool
+
+	mu sync.Mutex
+	// failures is the number of requests which would fail before the catchpoint file is served
+	failures int
+	ranges   []string
+}
+
+func (s *catchpointFileServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	s.ranges = append(s.ranges, r.Header.Get("Range"))
+	fail := s.failures > 0
+	if fail {
+		s.failures--
+	}
+	s.mu.Unlock()
+	if fail {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", rpcs.LedgerResponseContentType)
+	w.Header().Set("Content-Encoding", "gzip")
+	if s.noRanges {
+		r.Header.Del("Range")
+	}
+	http.ServeContent(w, r, "", time.Time{}, bytes.NewReader(s.content))
+}
+
+func makeCatchpointFileDownloadTest(t *testing.T, servers ...*catchpointFileServer) (*httpTestPeerSource, *peerSelector) {
+	net := &httpTestPeerSource{}
+	for _, s := range servers {
+		server := httptest.NewServer(s)
+		t.Cleanup(server.Close)
+		net.addPeer(server.URL)
+	}
+	return net, makePeerSelector(net, []peerClass{{initialRank: peerRankInitialFirstPriority, peerClass: network.PeersPhonebookRelays}})
+}
+
+func TestCatchpointFileDownloader(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	defer func(segmentSize int64) {
+		catchpointFileSegmentSize = segmentSize
+	}(catchpointFileSegmentSize)
+	catchpointFileSegmentSize = 1000
+
+	content := make([]byte, 4500)
+	rand.Read(content)
+	good := &catchpointFileServer{content: content}
+	noRanges := &catchpointFileServer{content: content, noRanges: true}
+	flaky := &catchpointFileServer{content: content, failures: 2}
+	net, peerSelector := makeCatchpointFileDownloadTest(t, good, noRanges, flaky)
+
+	cfg := config.GetDefaultLocal()
+	cfg.CatchpointFileDownloadPeers = 3
+	filename := filepath.Join(t.TempDir(), "catchpoint.download")
+	fd := makeCatchpointFileDownloader(net, logging.TestingLog(t), cfg, peerSelector, 1, filename)
+	require.NoError(t, fd.download(context.Background()))
+
+	downloaded, err := os.ReadFile(filename)
+	require.NoError(t, err)
+	require.Equal(t, content, downloaded)
+	require.NotEmpty(t, good.ranges)
+	require.FileExists(t, filename+catchpointFileDownloadProgressSuffix)
+
+	fd.remove()
+	require.NoFileExists(t, filename)
+	require.NoFileExists(t, filename+catchpointFileDownloadProgressSuffix)
+
+	// peers which don't support range requests can't be used.
+	net, peerSelector = makeCatchpointFileDownloadTest(t, noRanges)
+	cfg.CatchupLedgerDownloadRetryAttempts = 3
+	fd = makeCatchpointFileDownloader(net, logging.TestingLog(t), cfg, peerSelector, 1, filename)
+	require.Error(t, fd.download(context.Background()))
+}
+
+func TestCatchpointFileDownloaderResume(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	defer func(segmentSize int64) {
+		catchpointFileSegmentSize = segmentSize
+	}(catchpointFileSegmentSize)
+	catchpointFileSegmentSize = 1000
+
+	content := make([]byte, 4500)
+	rand.Read(content)
+	good := &catchpointFileServer{content: content}
+	net, peerSelector := makeCatchpointFileDownloadTest(t, good)
+
+	// the first two segments were downloaded before the download was interrupted.
+	filename := filepath.Join(t.TempDir(), "catchpoint.download")
+	partial := make([]byte, len(content))
+	copy(partial, content[:2000])
+	require.NoError(t, os.WriteFile(filename, partial, 0600))
+	progress, err := json.Marshal(&catchpointFileDownloadProgress{Size: int64(len(content)), Segments: []bool{true, true, false, false, false}})
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filename+catchpointFileDownloadProgressSuffix, progress, 0600))
+
+	cfg := config.GetDefaultLocal()
+	cfg.CatchpointFileDownloadPeers = 1
+	fd := makeCatchpointFileDownloader(net, logging.TestingLog(t), cfg, peerSelector, 1, filename)
+	require.NoError(t, fd.download(context.Background()))
+	downloaded, err := os.ReadFile(filename)
+	require.NoError(t, err)
+	require.Equal(t, content, downloaded)
+	require.Equal(t, []string{"bytes=2000-2999", "bytes=3000-3999", "bytes=4000-4999"}, good.ranges)
+
+	// a download of a catchpoint file of a different size is restarted.
+	require.NoError(t, os.WriteFile(filename+catchpointFileDownloadProgressSuffix, progress, 0600))
+	good.content = content[:2500]
+	good.ranges = nil
+	fd = makeCatchpointFileDownloader(net, logging.TestingLog(t), cfg, peerSelector, 1, filename)
+	require.Error(t, fd.download(context.Background()))
+	require.NoError(t, os.Remove(filename+catchpointFileDownloadProgressSuffix))
+	good.ranges = nil
+	fd = makeCatchpointFileDownloader(net, logging.TestingLog(t), cfg, peerSelector, 1, filename)
+	require.NoError(t, fd.download(context.Background()))
+	downloaded, err = os.ReadFile(filename)
+	require.NoError(t, err)
+	require.Equal(t, content[:2500], downloaded)
+	require.Equal(t, []string{"bytes=0-999", "bytes=1000-1999", "bytes=2000-2999"}, good.ranges)
+}
+
+func TestParseContentRange(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	first, last, size, err := parseContentRange("bytes 100-199/1000")
+	require.NoError(t, err)
+	require.Equal(t, []int64{100, 199, 1000}, []int64{first, last, size})
+
+	for _, contentRange := range []string{"", "bytes */1000", "bytes 100-199", "bytes 100-1000/1000", "bytes 200-199/1000", "items 0-1/2"} {
+		_, _, _, err = parseContentRange(contentRange)
+		require.Error(t, err, contentRange)
+	}
+}
+
+// stagingBalancesRecorder records the catchpoint file chunks stored in the staging tables.
+type stagingBalancesRecorder struct {
+	mocks.MockCatchpointCatchupAccessor
+	sections []string
+}
+
+func (r *stagingBalancesRecorder) ProcessStagingBalances(ctx context.Context, sectionName string, bytes []byte, progress *ledger.CatchpointCatchupAccessorProgress) (err error) {
+	r.sections = append(r.sections, sectionName)
+	return nil
+}
+
+func TestLoadLedgerFile(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	var tarFile bytes.Buffer
+	tarWriter := tar.NewWriter(&tarFile)
+	for _, name := range []string{"content.msgpack", "balances.1.msgpack", "balances.2.msgpack"} {
+		require.NoError(t, tarWriter.WriteHeader(&tar.Header{Name: name, Mode: 0600, Size: 4}))
+		_, err := tarWriter.Write([]byte("data"))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tarWriter.Close())
+	var compressedFile bytes.Buffer
+	gzipWriter := gzip.NewWriter(&compressedFile)
+	_, err := gzipWriter.Write(tarFile.Bytes())
+	require.NoError(t, err)
+	require.NoError(t, gzipWriter.Close())
+
+	dir := t.TempDir()
+	for filename, data := range map[string][]byte{"catchpoint.tar": tarFile.Bytes(), "catchpoint.catchpoint": compressedFile.Bytes()} {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, filename), data, 0600))
+		recorder := &stagingBalancesRecorder{}
+		lf := makeLedgerFetcher(&mocks.MockNetwork{}, recorder, logging.TestingLog(t), &dummyLedgerFetcherReporter{}, config.GetDefaultLocal())
+		require.NoError(t, lf.loadLedgerFile(context.Background(), filepath.Join(dir, filename)))
+		require.Equal(t, []string{"content.msgpack", "balances.1.msgpack", "balances.2.msgpack"}, recorder.sections, filename)
+	}
+
+	lf := makeLedgerFetcher(&mocks.MockNetwork{}, &stagingBalancesRecorder{}, logging.TestingLog(t), &dummyLedgerFetcherReporter{}, config.GetDefaultLocal())
+	require.Error(t, lf.loadLedgerFile(context.Background(), filepath.Join(dir, "missing")))
+}
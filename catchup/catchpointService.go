@@ -99,6 +99,9 @@ type CatchpointCatchupService struct {
 	abortCtxFunc context.CancelFunc
 	// blocksDownloadPeerSelector is the peer selector used for downloading blocks.
 	blocksDownloadPeerSelector *peerSelector
+	// catchpointFile is the path of a catchpoint file on disk, which is loaded instead of downloading the catchpoint
+	// file from the peers. It isn't persisted, so a catchup resumed after the node restarts downloads the catchpoint file.
+	catchpointFile string
 }
 
 // MakeResumedCatchpointCatchupService creates a catchpoint catchup service for a node that is already in catchpoint catchup mode
@@ -128,8 +131,9 @@ func MakeResumedCatchpointCatchupService(ctx context.Context, node CatchpointCat
 	return service, nil
 }
 
-// MakeNewCatchpointCatchupService creates a new catchpoint catchup service for a node that is not in catchpoint catchup mode
-func MakeNewCatchpointCatchupService(catchpoint string, node CatchpointCatchupNodeServices, log logging.Logger, net network.GossipNode, accessor ledger.CatchpointCatchupAccessor, cfg config.Local) (service *CatchpointCatchupService, err error) {
+// MakeNewCatchpointCatchupService creates a new catchpoint catchup service for a node that is not in catchpoint catchup mode.
+// If catchpointFile isn't empty, the catchpoint file stored at that path is used rather than downloading it.
+func MakeNewCatchpointCatchupService(catchpoint string, catchpointFile string, node CatchpointCatchupNodeServices, log logging.Logger, net network.GossipNode, accessor ledger.CatchpointCatchupAccessor, cfg config.Local) (service *CatchpointCatchupService, err error) {
 	if catchpoint == "" {
 		return nil, fmt.Errorf("MakeNewCatchpointCatchupService: catchpoint is invalid")
 	}
@@ -146,6 +150,7 @@ func MakeNewCatchpointCatchupService(catchpoint string, node CatchpointCatchupNo
 		net:            net,
 		ledger:         accessor.Ledger(),
 		config:         cfg,
+		catchpointFile: catchpointFile,
 	}
 	l := accessor.Ledger()
 	service.lastBlockHeader, err = l.BlockHdr(l.Latest())
@@ -281,6 +286,20 @@ func (cs *CatchpointCatchupService) processStageLedgerDownload() (err error) {
 		return cs.abort(fmt.Errorf("processStageLedgerDownload failed to parse label : %v", err0))
 	}
 
+	if cs.catchpointFile != "" {
+		err = cs.loadLedgerFile(cs.catchpointFile)
+		if err != nil {
+			if cs.ctx.Err() != nil {
+				return cs.stopOrAbort()
+			}
+			return cs.abort(fmt.Errorf("processStageLedgerDownload failed to load the catchpoint file %s : %v", cs.catchpointFile, err))
+		}
+		return cs.completeStageLedgerDownload()
+	}
+	if cs.config.CatchpointFileDownloadPeers > 0 {
+		return cs.downloadLedgerFile(round)
+	}
+
 	// download balances file.
 	peerSelector := makePeerSelector(cs.net, []peerClass{{initialRank: peerRankInitialFirstPriority, peerClass: network.PeersPhonebookRelays}})
 	ledgerFetcher := makeLedgerFetcher(cs.net, cs.ledgerAccessor, cs.log, cs, cs.config)
@@ -332,6 +351,77 @@ func (cs *CatchpointCatchupService) processStageLedgerDownload() (err error) {
 		cs.log.Warnf("unable to download ledger : %v", err)
 	}
 
+	return cs.completeStageLedgerDownload()
+}
+
+// downloadLedgerFile downloads the catchpoint file to disk, in segments which are downloaded from several peers in
+// parallel, and loads it into the ledger once it's downloaded. The download is resumed if it was interrupted before.
+func (cs *CatchpointCatchupService) downloadLedgerFile(round basics.Round) (err error) {
+	peerSelector := makePeerSelector(cs.net, []peerClass{{initialRank: peerRankInitialFirstPriority, peerClass: network.PeersPhonebookRelays}})
+	filename := cs.ledgerAccessor.CatchpointFileDownloadPath(round)
+	attemptsCount := 0
+
+	for {
+		attemptsCount++
+
+		downloader := makeCatchpointFileDownloader(cs.net, cs.log, cs.config, peerSelector, round, filename)
+		start := time.Now()
+		err = downloader.download(cs.ctx)
+		if err != nil {
+			if cs.ctx.Err() != nil {
+				return cs.stopOrAbort()
+			}
+			// the downloaded segments are kept, so that a subsequent catchup would resume the download.
+			return cs.abort(fmt.Errorf("processStageLedgerDownload failed to download the catchpoint file : %v", err))
+		}
+		cs.log.Infof("catchpoint file downloaded in %d seconds", time.Since(start)/time.Second)
+
+		err = cs.loadLedgerFile(filename)
+		if err == nil {
+			downloader.remove()
+			break
+		}
+		if cs.ctx.Err() != nil {
+			return cs.stopOrAbort()
+		}
+		// the downloaded catchpoint file is invalid, so it's downloaded again.
+		downloader.remove()
+
+		if attemptsCount >= cs.config.CatchupLedgerDownloadRetryAttempts {
+			err = fmt.Errorf("processStageLedgerDownload: catchpoint catchup exceeded number of attempts to retrieve ledger")
+			return cs.abort(err)
+		}
+		cs.log.Warnf("unable to load the downloaded catchpoint file : %v", err)
+	}
+
+	return cs.completeStageLedgerDownload()
+}
+
+// loadLedgerFile loads the catchpoint file stored at the given path into the staging tables, and builds the merkle
+// trie out of it.
+func (cs *CatchpointCatchupService) loadLedgerFile(filename string) (err error) {
+	err = cs.ledgerAccessor.ResetStagingBalances(cs.ctx, true)
+	if err != nil {
+		return fmt.Errorf("failed to reset staging balances : %v", err)
+	}
+	ledgerFetcher := makeLedgerFetcher(cs.net, cs.ledgerAccessor, cs.log, cs, cs.config)
+	start := time.Now()
+	err = ledgerFetcher.loadLedgerFile(cs.ctx, filename)
+	if err != nil {
+		return err
+	}
+	cs.log.Infof("ledger loaded in %d seconds", time.Since(start)/time.Second)
+	start = time.Now()
+	err = cs.ledgerAccessor.BuildMerkleTrie(cs.ctx, cs.updateVerifiedCounts)
+	if err != nil {
+		return err
+	}
+	cs.log.Infof("built merkle trie in %d seconds", time.Since(start)/time.Second)
+	return nil
+}
+
+// completeStageLedgerDownload moves on from the ledger download stage, once the ledger was downloaded.
+func (cs *CatchpointCatchupService) completeStageLedgerDownload() (err error) {
 	err = cs.updateStage(ledger.CatchpointCatchupStateLatestBlockDownload)
 	if err != nil {
 		return cs.abort(fmt.Errorf("processStageLedgerDownload failed to update stage to CatchpointCatchupStateLatestBlockDownload : %v", err))
@@ -18,11 +18,14 @@ package catchup
 
 import (
 	"archive/tar"
+	"bufio"
+	"compress/gzip"
 	"context"
 	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"os"
 	"path"
 	"strconv"
 	"time"
@@ -80,14 +83,21 @@ func (lf *ledgerFetcher) downloadLedger(ctx context.Context, peer network.Peer,
 	return lf.getPeerLedger(ctx, httpPeer, round)
 }
 
-func (lf *ledgerFetcher) getPeerLedger(ctx context.Context, peer network.HTTPPeer, round basics.Round) error {
+// peerLedgerURL returns the URL of the given round's catchpoint file on the given peer.
+func peerLedgerURL(net network.GossipNode, peer network.HTTPPeer, round basics.Round) (string, error) {
 	parsedURL, err := network.ParseHostOrURL(peer.GetAddress())
 	if err != nil {
-		return err
+		return "", err
 	}
+	parsedURL.Path = net.SubstituteGenesisID(path.Join(parsedURL.Path, "/v1/{genesisID}/ledger/"+strconv.FormatUint(uint64(round), 36)))
+	return parsedURL.String(), nil
+}
 
-	parsedURL.Path = lf.net.SubstituteGenesisID(path.Join(parsedURL.Path, "/v1/{genesisID}/ledger/"+strconv.FormatUint(uint64(round), 36)))
-	ledgerURL := parsedURL.String()
+func (lf *ledgerFetcher) getPeerLedger(ctx context.Context, peer network.HTTPPeer, round basics.Round) error {
+	ledgerURL, err := peerLedgerURL(lf.net, peer, round)
+	if err != nil {
+		return err
+	}
 	lf.log.Debugf("ledger GET %#v peer %#v %T", ledgerURL, peer, peer)
 	request, err := http.NewRequest(http.MethodGet, ledgerURL, nil)
 	if err != nil {
@@ -137,7 +147,43 @@ func (lf *ledgerFetcher) getPeerLedger(ctx context.Context, peer network.HTTPPee
 
 	watchdogReader := util.MakeWatchdogStreamReader(response.Body, catchpointFileStreamReadSize, 2*maxCatchpointFileChunkSize, maxCatchpointFileChunkDownloadDuration)
 	defer watchdogReader.Close()
-	tarReader := tar.NewReader(watchdogReader)
+	return lf.processCatchpointStream(ctx, tar.NewReader(watchdogReader), func() error {
+		err := watchdogReader.Reset()
+		if err != nil && err != io.EOF {
+			err = fmt.Errorf("getPeerLedger received the following error while reading the catchpoint file : %v", err)
+		}
+		return err
+	})
+}
+
+// loadLedgerFile loads the catchpoint file stored at the given path into the staging tables. The catchpoint file
+// could be either compressed, as served by the ledger service, or a plain tar file.
+func (lf *ledgerFetcher) loadLedgerFile(ctx context.Context, filename string) error {
+	file, err := os.Open(filename)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	reader := bufio.NewReader(file)
+	var stream io.Reader = reader
+	magic, err := reader.Peek(2)
+	if err == nil && magic[0] == 0x1f && magic[1] == 0x8b {
+		gzipReader, err := gzip.NewReader(reader)
+		if err != nil {
+			return err
+		}
+		defer gzipReader.Close()
+		stream = gzipReader
+	}
+	return lf.processCatchpointStream(ctx, tar.NewReader(stream), func() error {
+		return ctx.Err()
+	})
+}
+
+// processCatchpointStream stores the chunks of the catchpoint file read from the given tar stream in the staging
+// tables. nextChunk is called after each chunk is stored, and returns io.EOF if the stream has ended.
+func (lf *ledgerFetcher) processCatchpointStream(ctx context.Context, tarReader *tar.Reader, nextChunk func() error) error {
 	var downloadProgress ledger.CatchpointCatchupAccessorProgress
 	var writeDuration time.Duration
 
@@ -181,12 +227,11 @@ func (lf *ledgerFetcher) getPeerLedger(ctx context.Context, peer network.HTTPPee
 		if lf.reporter != nil {
 			lf.reporter.updateLedgerFetcherProgress(&downloadProgress)
 		}
-		if err = watchdogReader.Reset(); err != nil {
+		if err = nextChunk(); err != nil {
 			if err == io.EOF {
 				printLogsFunc()
 				return nil
 			}
-			err = fmt.Errorf("getPeerLedger received the following error while reading the catchpoint file : %v", err)
 			return err
 		}
 	}
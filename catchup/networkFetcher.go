@@ -45,7 +45,7 @@ func MakeNetworkFetcher(log logging.Logger, net network.GossipNode, cfg config.L
 		log:          log,
 		cfg:          cfg,
 		auth:         auth,
-		peerSelector: createPeerSelector(net, cfg, pipelineFetch),
+		peerSelector: createPeerSelector(net, cfg, pipelineFetch, nil),
 		fetcher:      makeUniversalBlockFetcher(log, net, cfg),
 	}
 	return netFetcher
@@ -413,12 +413,14 @@ func (ps *peerSelector) sort() {
 
 // peerAddress returns the peer's underlying address. The network.Peer object cannot be compared
 // to itself, since the network package dynamically creating a new instance on every network.GetPeers() call.
-// The method retrun the peer address or an empty string if the peer is not one of HTTPPeer/UnicastPeer
+// The method retrun the peer address or an empty string if the peer is not one of HTTPPeer/UnicastPeer/blockArchivePeer
 func peerAddress(peer network.Peer) string {
 	if httpPeer, ok := peer.(network.HTTPPeer); ok {
 		return httpPeer.GetAddress()
 	} else if unicastPeer, ok := peer.(network.UnicastPeer); ok {
 		return unicastPeer.GetAddress()
+	} else if archivePeer, ok := peer.(*blockArchivePeer); ok {
+		return archivePeer.archiveURL
 	}
 	return ""
 }
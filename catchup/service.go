@@ -82,6 +82,8 @@ type Service struct {
 	parallelBlocks      uint64
 	deadlineTimeout     time.Duration
 	blockValidationPool execpool.BacklogPool
+	// blockArchives are the configured block archives, which are preferred over the network peers.
+	blockArchives []network.Peer
 
 	// suspendForCatchpointWriting defines whether we've ran into a state where the ledger is currently busy writing the
 	// catchpoint file. If so, we want to suspend the catchup process until the catchpoint file writing is complete,
@@ -122,6 +124,7 @@ func MakeService(log logging.Logger, config config.Local, net network.GossipNode
 	s.parallelBlocks = config.CatchupParallelBlocks
 	s.deadlineTimeout = agreement.DeadlineTimeout()
 	s.blockValidationPool = blockValidationPool
+	s.blockArchives = makeBlockArchivePeers(s.log, config.CatchupBlockArchives)
 
 	return s
 }
@@ -456,7 +459,7 @@ func (s *Service) pipelinedFetch(seedLookback uint64) {
 		close(completed)
 	}()
 
-	peerSelector := createPeerSelector(s.net, s.cfg, true)
+	peerSelector := createPeerSelector(s.net, s.cfg, true, s.blockArchives)
 
 	if _, err := peerSelector.getNextPeer(); err == errPeerSelectorNoPeerPoolsAvailable {
 		s.log.Debugf("pipelinedFetch: was unable to obtain a peer to retrieve the block from")
@@ -684,7 +687,7 @@ func (s *Service) fetchRound(cert agreement.Certificate, verifier *agreement.Asy
 	}
 
 	blockHash := bookkeeping.BlockHash(cert.Proposal.BlockDigest) // semantic digest (i.e., hash of the block header), not byte-for-byte digest
-	peerSelector := createPeerSelector(s.net, s.cfg, false)
+	peerSelector := createPeerSelector(s.net, s.cfg, false, s.blockArchives)
 	for s.ledger.LastRound() < cert.Round {
 		psp, getPeerErr := peerSelector.getNextPeer()
 		if getPeerErr != nil {
@@ -786,7 +789,7 @@ func (s *Service) handleUnsupportedRound(nextUnsupportedRound basics.Round) {
 	}
 }
 
-func createPeerSelector(net network.GossipNode, cfg config.Local, pipelineFetch bool) *peerSelector {
+func createPeerSelector(net network.GossipNode, cfg config.Local, pipelineFetch bool, blockArchives []network.Peer) *peerSelector {
 	var peerClasses []peerClass
 	if cfg.EnableCatchupFromArchiveServers {
 		if pipelineFetch {
@@ -849,5 +852,8 @@ func createPeerSelector(net network.GossipNode, cfg config.Local, pipelineFetch
 			}
 		}
 	}
+	if len(blockArchives) > 0 {
+		return makePeerSelector(blockArchivesPeersRetriever{peersRetriever: net, archives: blockArchives}, preferBlockArchives(peerClasses))
+	}
 	return makePeerSelector(net, peerClasses)
 }
@@ -36,14 +36,14 @@ import (
 	"github.com/algorand/go-algorand/rpcs"
 )
 
-// UniversalFetcher fetches blocks either from an http peer or ws peer.
+// UniversalFetcher fetches blocks either from an http peer, a ws peer or a block archive.
 type universalBlockFetcher struct {
 	config config.Local
 	net    network.GossipNode
 	log    logging.Logger
 }
 
-// makeUniversalFetcher returns a fetcher for http and ws peers.
+// makeUniversalFetcher returns a fetcher for http and ws peers and block archives.
 func makeUniversalBlockFetcher(log logging.Logger, net network.GossipNode, config config.Local) *universalBlockFetcher {
 	return &universalBlockFetcher{
 		config: config,
@@ -51,14 +51,25 @@ func makeUniversalBlockFetcher(log logging.Logger, net network.GossipNode, confi
 		log:    log}
 }
 
-// fetchBlock returns a block from the peer. The peer can be either an http or ws peer.
+// fetchBlock returns a block from the peer. The peer can be either an http or ws peer, or a block archive.
 func (uf *universalBlockFetcher) fetchBlock(ctx context.Context, round basics.Round, peer network.Peer) (blk *bookkeeping.Block,
 	cert *agreement.Certificate, downloadDuration time.Duration, err error) {
 
 	var fetchedBuf []byte
 	var address string
 	blockDownloadStartTime := time.Now()
-	if wsPeer, validWSPeer := peer.(network.UnicastPeer); validWSPeer {
+	if archivePeer, validArchivePeer := peer.(*blockArchivePeer); validArchivePeer {
+		fetcherClient := &blockArchiveFetcher{
+			peer:   archivePeer,
+			net:    uf.net,
+			config: &uf.config,
+		}
+		fetchedBuf, err = fetcherClient.getBlockBytes(ctx, round)
+		if err != nil {
+			return nil, nil, time.Duration(0), err
+		}
+		address = fetcherClient.address()
+	} else if wsPeer, validWSPeer := peer.(network.UnicastPeer); validWSPeer {
 		fetcherClient := &wsFetcherClient{
 			target: wsPeer,
 			config: &uf.config,
@@ -0,0 +1,214 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/gen"
+	"github.com/algorand/go-algorand/ledger"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/ledger/store"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/util/db"
+)
+
+var forkStateFile string
+
+// forkAccountsWarnThreshold is the number of forked accounts above which the genesis of a forked network becomes
+// too large for its nodes to load comfortably: they keep the whole genesis in memory when they start.
+const forkAccountsWarnThreshold = 1000000
+
+func init() {
+	forkCmd.Flags().StringVarP(&catchpointFile, "tar", "t", "", "Specify the catchpoint file (either .tar or .tar.gz) to fork")
+	forkCmd.Flags().StringVarP(&ledgerTrackerFilename, "tracker", "d", "", "Specify the ledger tracker file name to fork ( i.e. ./ledger.tracker.sqlite )")
+	forkCmd.Flags().BoolVarP(&ledgerTrackerStaging, "staging", "s", false, "Specify whether to fork the catchpoint staging or regular tables of the ledger tracker. (default false)")
+	forkCmd.Flags().StringVarP(&forkStateFile, "output", "o", "", "Specify the fork state file to write ( i.e. mainnet.fork )")
+}
+
+var forkCmd = &cobra.Command{
+	Use:   "fork",
+	Short: "Snapshot the accounts and boxes of a catchpoint file or a ledger tracker database into a fork state file",
+	Long: `Snapshot the accounts and boxes of a catchpoint file or a ledger tracker database into a fork state file.
+A private network is forked from the snapshot by setting "ForkState" to the fork state file in the Genesis section of its network template:
+the forked accounts are taken offline, and the network's own wallets hold all the online stake.
+
+The forked accounts and boxes are written into the genesis.json of the private network, which every node reads,
+decodes and hashes in memory when it starts; no pre-seeded ledger database is produced. Each account takes a few
+hundred bytes of genesis, and more with its assets, applications and boxes, so forking up to about a million
+accounts is practical, while the full state of MainNet, with tens of millions of accounts, is not.`,
+	Example: `catchpointdump fork -t 21000000.catchpoint -o mainnet.fork
+goal network create -r ./net -n fork -t template.json`,
+	Args: validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, args []string) {
+		if forkStateFile == "" || (catchpointFile == "") == (ledgerTrackerFilename == "") {
+			cmd.HelpFunc()(cmd, args)
+			return
+		}
+
+		var fork gen.ForkState
+		var err error
+		if catchpointFile != "" {
+			fork, err = forkCatchpointFile(catchpointFile)
+		} else {
+			fork, err = loadForkState(ledgerTrackerFilename, ledgerTrackerStaging)
+		}
+		if err != nil {
+			reportErrorf("Unable to load fork state : %v", err)
+		}
+
+		err = gen.SaveForkState(forkStateFile, fork)
+		if err != nil {
+			reportErrorf("Unable to write fork state file '%s' : %v", forkStateFile, err)
+		}
+		reportInfof("Wrote %d accounts and %d boxes of round %d to %s", len(fork.Accounts), len(fork.Boxes), fork.Round, forkStateFile)
+		if len(fork.Accounts) > forkAccountsWarnThreshold {
+			reportWarnf("The genesis of a network forked from %d accounts is too large for its nodes to load comfortably: they keep the whole genesis in memory", len(fork.Accounts))
+		}
+	},
+}
+
+// forkCatchpointFile loads a catchpoint file into the staging tables of a temporary ledger, and snapshots them
+func forkCatchpointFile(filename string) (fork gen.ForkState, err error) {
+	stats, err := os.Stat(filename)
+	if err != nil {
+		return
+	}
+
+	tempDir, err := os.MkdirTemp("", "catchpointfork")
+	if err != nil {
+		return
+	}
+	defer os.RemoveAll(tempDir)
+
+	// the protocol of the temporary ledger does not matter, as only its staging tables are used.
+	genesisInitState := ledgercore.InitState{
+		Block: bookkeeping.Block{BlockHeader: bookkeeping.BlockHeader{
+			UpgradeState: bookkeeping.UpgradeState{
+				CurrentProtocol: protocol.ConsensusCurrentVersion,
+			},
+		}},
+	}
+	ledgerPrefix := filepath.Join(tempDir, "ledger")
+	l, err := ledger.OpenLedger(logging.Base(), ledgerPrefix, false, genesisInitState, config.GetDefaultLocal())
+	if err != nil {
+		return
+	}
+	defer l.Close()
+
+	catchupAccessor := ledger.MakeCatchpointCatchupAccessor(l, logging.Base())
+	err = catchupAccessor.ResetStagingBalances(context.Background(), true)
+	if err != nil {
+		return
+	}
+
+	reader, err := os.Open(filename)
+	if err != nil {
+		return
+	}
+	defer reader.Close()
+
+	fileHeader, err := loadCatchpointIntoDatabase(context.Background(), catchupAccessor, reader, stats.Size())
+	if err != nil {
+		return
+	}
+
+	fork, err = loadForkState(ledgerPrefix+".tracker.sqlite", true)
+	fork.Round = fileHeader.BalancesRound
+	return
+}
+
+// loadForkState snapshots the accounts and boxes of a ledger tracker database
+func loadForkState(databaseName string, stagingTables bool) (fork gen.ForkState, err error) {
+	dbAccessor, err := db.MakeAccessor(databaseName, true, false)
+	if err != nil {
+		return
+	}
+	defer dbAccessor.Close()
+
+	balancesTable, resourcesTable, kvTable := "accountbase", "resources", "kvstore"
+	if stagingTables {
+		balancesTable, resourcesTable, kvTable = "catchpointbalances", "catchpointresources", "catchpointkvstore"
+	}
+
+	err = dbAccessor.Atomic(func(ctx context.Context, tx *sql.Tx) (err error) {
+		// loading a large database takes a while; disable the transaction deadline warning.
+		_, _ = db.ResetTransactionWarnDeadline(ctx, tx, time.Now().Add(time.Hour))
+
+		arw := store.NewAccountsSQLReaderWriter(tx)
+		totals, err := arw.AccountsTotals(ctx, stagingTables)
+		if err != nil {
+			return err
+		}
+		fork.RewardsLevel = totals.RewardsLevel
+		if stagingTables {
+			var rnd uint64
+			rnd, err = store.NewCatchpointSQLReaderWriter(tx).ReadCatchpointStateUint64(ctx, store.CatchpointStateCatchupBalancesRound)
+			fork.Round = basics.Round(rnd)
+		} else {
+			fork.Round, err = arw.AccountsRound()
+		}
+		if err != nil {
+			return err
+		}
+
+		fork.Accounts = make(map[basics.Address]basics.AccountData)
+		_, err = arw.LoadAllFullAccounts(ctx, balancesTable, resourcesTable, func(addr basics.Address, ad basics.AccountData) {
+			fork.Accounts[addr] = ad
+		})
+		if err != nil {
+			return err
+		}
+
+		rows, err := tx.QueryContext(ctx, fmt.Sprintf("SELECT key, value FROM %s ORDER BY key", kvTable))
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var key, value []byte
+			err = rows.Scan(&key, &value)
+			if err != nil {
+				return err
+			}
+			if value == nil {
+				continue
+			}
+			app, name, err := logic.SplitBoxKey(string(key))
+			if err != nil {
+				// only boxes are kept in the key/value store for now
+				reportWarnf("Skipping key %v : %v", key, err)
+				continue
+			}
+			fork.Boxes = append(fork.Boxes, bookkeeping.GenesisBox{App: app, Name: []byte(name), Value: value})
+		}
+		return rows.Err()
+	})
+	return
+}
@@ -377,22 +377,29 @@ var appCmd = &cobra.Command{
 }
 
 func mustParseOnCompletion(ocString string) (oc transactions.OnCompletion) {
+	oc, err := parseOnCompletion(ocString)
+	if err != nil {
+		reportErrorf("unknown value for --on-completion: %s (possible values: {NoOp, OptIn, CloseOut, ClearState, UpdateApplication, DeleteApplication})", ocString)
+	}
+	return
+}
+
+func parseOnCompletion(ocString string) (transactions.OnCompletion, error) {
 	switch strings.ToLower(ocString) {
 	case "noop":
-		return transactions.NoOpOC
+		return transactions.NoOpOC, nil
 	case "optin":
-		return transactions.OptInOC
+		return transactions.OptInOC, nil
 	case "closeout":
-		return transactions.CloseOutOC
+		return transactions.CloseOutOC, nil
 	case "clearstate":
-		return transactions.ClearStateOC
+		return transactions.ClearStateOC, nil
 	case "updateapplication":
-		return transactions.UpdateApplicationOC
+		return transactions.UpdateApplicationOC, nil
 	case "deleteapplication":
-		return transactions.DeleteApplicationOC
+		return transactions.DeleteApplicationOC, nil
 	default:
-		reportErrorf("unknown value for --on-completion: %s (possible values: {NoOp, OptIn, CloseOut, ClearState, UpdateApplication, DeleteApplication})", ocString)
-		return
+		return transactions.NoOpOC, fmt.Errorf("unknown on-completion %s", ocString)
 	}
 }
 
@@ -50,10 +50,14 @@ var (
 	closeToAddress  string
 	noProgramOutput bool
 	writeSourceMap  bool
+	optimizeProgram bool
+	analyzeProgram  bool
 	signProgram     bool
 	programSource   string
 	argB64Strings   []string
 	disassemble     bool
+	annotate        bool
+	abiMethods      []string
 	verbose         bool
 	progByteFile    string
 	msigParams      string
@@ -123,8 +127,12 @@ func init() {
 	splitCmd.MarkFlagRequired("outfile")
 
 	compileCmd.Flags().BoolVarP(&disassemble, "disassemble", "D", false, "disassemble a compiled program")
+	compileCmd.Flags().BoolVar(&annotate, "annotate", false, "with -D, name labels after the subroutines, ABI methods and OnCompletion they dispatch to, and write a source map with -m")
+	compileCmd.Flags().StringArrayVar(&abiMethods, "method", nil, "with --annotate, ABI method signature to name the labels of its selector")
 	compileCmd.Flags().BoolVarP(&noProgramOutput, "no-out", "n", false, "don't write contract program binary")
 	compileCmd.Flags().BoolVarP(&writeSourceMap, "map", "m", false, "write out source map")
+	compileCmd.Flags().BoolVar(&optimizeProgram, "optimize", false, "optimize the compiled program with peephole rewrites, and report the savings")
+	compileCmd.Flags().BoolVar(&analyzeProgram, "analyze", false, "report the worst-case cost and stack depth of the compiled program, and its unreachable, failing and looping code")
 	compileCmd.Flags().BoolVarP(&signProgram, "sign", "s", false, "sign program, output is a binary signed LogicSig record")
 	compileCmd.Flags().StringVarP(&outFilename, "outfile", "o", "", "Filename to write program bytes or signed LogicSig to")
 	compileCmd.Flags().StringVarP(&account, "account", "a", "", "Account address to sign the program (If not specified, uses default account)")
@@ -960,11 +968,26 @@ func assembleFileImpl(fname string, printWarnings bool) *logic.OpStream {
 	if err != nil {
 		reportErrorf("%s: %s", fname, err)
 	}
-	ops, err := logic.AssembleString(string(text))
+	ops, err := logic.AssembleFile(fname, string(text), os.ReadFile)
 	if err != nil {
 		ops.ReportProblems(fname, os.Stderr)
 		reportErrorf("%s: %s", fname, err)
 	}
+	if optimizeProgram {
+		report, err := ops.Optimize()
+		if err != nil {
+			reportErrorf("%s: %s", fname, err)
+		}
+		// the program may be written to stdout, so the report goes to stderr
+		fmt.Fprintf(os.Stderr, "%s: %s\n", fname, report)
+	}
+	if analyzeProgram {
+		analysis, err := ops.Analyze()
+		if err != nil {
+			reportErrorf("%s: %s", fname, err)
+		}
+		ops.ReportAnalysis(fname, analysis, os.Stderr)
+	}
 	_, params := getProto(protoVersion)
 	if ops.HasStatefulOps {
 		if len(ops.Program) > config.MaxAvailableAppProgramLen {
@@ -997,7 +1020,7 @@ func assembleFile(fname string, printWarnings bool) (program []byte) {
 
 func assembleFileWithMap(fname string, printWarnings bool) ([]byte, logic.SourceMap) {
 	ops := assembleFileImpl(fname, printWarnings)
-	return ops.Program, logic.GetSourceMap([]string{fname}, ops.OffsetToLine)
+	return ops.Program, logic.GetMultiSourceMap(ops.Sources, ops.OffsetToLine, ops.OffsetToSource)
 }
 
 func disassembleFile(fname, outname string) {
@@ -1019,7 +1042,13 @@ func disassembleFile(fname, outname string) {
 			extra = "LogicSig: " + string(protocol.EncodeJSON(ilsig))
 		}
 	}
-	text, err := logic.Disassemble(program)
+	var text string
+	var offsetToLine map[int]int
+	if annotate {
+		text, offsetToLine, err = logic.DisassembleAnnotated(program, abiMethods)
+	} else {
+		text, err = logic.Disassemble(program)
+	}
 	if err != nil {
 		reportErrorf("%s: %s", fname, err)
 	}
@@ -1034,6 +1063,20 @@ func disassembleFile(fname, outname string) {
 			reportErrorf("%s: %s", outname, err)
 		}
 	}
+	if annotate && writeSourceMap {
+		if outname == "" {
+			reportErrorf("%s: %s", fname, "cannot print map to stdout")
+		}
+		mapname := outname + ".map"
+		pcblob, err := json.Marshal(logic.GetSourceMap([]string{outname}, offsetToLine))
+		if err != nil {
+			reportErrorf("%s: %s", mapname, err)
+		}
+		err = writeFile(mapname, pcblob, 0666)
+		if err != nil {
+			reportErrorf("%s: %s", mapname, err)
+		}
+	}
 }
 
 var compileCmd = &cobra.Command{
@@ -49,6 +49,8 @@ var verboseVersionPrint bool
 
 var kmdDataDirFlag string
 
+var tlsCAFile string
+
 var versionCheck bool
 
 func init() {
@@ -97,6 +99,7 @@ func init() {
 	defaultDataDirValue := []string{""}
 	rootCmd.PersistentFlags().StringArrayVarP(&dataDirs, "datadir", "d", defaultDataDirValue, "Data directory for the node")
 	rootCmd.PersistentFlags().StringVarP(&kmdDataDirFlag, "kmddir", "k", "", "Data directory for kmd")
+	rootCmd.PersistentFlags().StringVar(&tlsCAFile, "tls-ca", "", "File of the certificate authorities to trust when algod or kmd serve their API over TLS")
 }
 
 var rootCmd = &cobra.Command{
@@ -374,6 +377,7 @@ func getGoalClient(dataDir string, clientType libgoal.ClientType) (client libgoa
 		AlgodDataDir: dataDir,
 		KMDDataDir:   resolveKmdDataDir(dataDir),
 		CacheDir:     ensureCacheDir(dataDir),
+		TLSCAFile:    tlsCAFile,
 	}
 	client, err = libgoal.MakeClientFromConfig(clientConfig, clientType)
 	if err != nil {
@@ -43,6 +43,7 @@ var kmdCmd = &cobra.Command{
 
 func startKMDForDataDir(binDir, algodDataDir, kmdDataDir string) {
 	nc := nodecontrol.MakeNodeController(binDir, algodDataDir)
+	nc.SetTLSCAFile(tlsCAFile)
 	nc.SetKMDDataDir(kmdDataDir)
 	nc.StopKMD()
 	kmdArgs := nodecontrol.KMDStartArgs{
@@ -88,6 +89,7 @@ var stopKMDCmd = &cobra.Command{
 
 		onDataDirs(func(dataDir string) {
 			nc := nodecontrol.MakeNodeController(binDir, dataDir)
+			nc.SetTLSCAFile(tlsCAFile)
 			kdd := resolveKmdDataDir(dataDir)
 			nc.SetKMDDataDir(kdd)
 
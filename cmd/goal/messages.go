@@ -88,6 +88,8 @@ const (
 	errorCatchpointLabelMissing        = "A catchpoint argument is needed: %s"
 	errorUnableToLookupCatchpointLabel = "Unable to fetch catchpoint label"
 	errorTooManyCatchpointLabels       = "The catchup command expect a single catchpoint"
+	errorNodeRollback                  = "Unable to roll back the ledger: %s"
+	infoNodeRolledBack                 = "Rolled back the ledger to round %d"
 
 	// Asset
 	malformedMetadataHash = "Cannot base64-decode metadata hash %s: %s"
@@ -59,6 +59,8 @@ var newNodeRelay string
 var newNodeFullConfig bool
 var watchMillisecond uint64
 var abortCatchup bool
+var catchpointFile string
+var rollbackRound uint64
 
 const catchpointURL = "https://algorand-catchpoints.s3.us-east-2.amazonaws.com/channel/%s/latest.catchpoint"
 
@@ -74,6 +76,7 @@ func init() {
 	nodeCmd.AddCommand(waitCmd)
 	nodeCmd.AddCommand(createCmd)
 	nodeCmd.AddCommand(catchupCmd)
+	nodeCmd.AddCommand(rollbackCmd)
 	// Once the server-side implementation of the shutdown command is ready, we should enable this one.
 	//nodeCmd.AddCommand(shutdownCmd)
 
@@ -107,6 +110,10 @@ func init() {
 	statusCmd.Flags().Uint64VarP(&watchMillisecond, "watch", "w", 0, "Time (in milliseconds) between two successive status updates")
 
 	catchupCmd.Flags().BoolVarP(&abortCatchup, "abort", "x", false, "Aborts the current catchup process")
+	catchupCmd.Flags().StringVar(&catchpointFile, "file", "", "Catchpoint file to load the ledger from, rather than downloading it from the network. The file needs to be accessible to the node")
+
+	rollbackCmd.Flags().Uint64VarP(&rollbackRound, "round", "r", 0, "The round to roll the ledger back to")
+	rollbackCmd.MarkFlagRequired("round")
 
 }
 
@@ -149,7 +156,7 @@ var catchupCmd = &cobra.Command{
 	Use:     "catchup",
 	Short:   "Catchup the Algorand node to a specific catchpoint",
 	Long:    "Catchup allows making large jumps over round ranges without the need to incrementally validate each individual round. If no catchpoint is provided, this command attempts to lookup the latest catchpoint from algorand-catchpoints.s3.us-east-2.amazonaws.com.",
-	Example: "goal node catchup 6500000#1234567890ABCDEF01234567890ABCDEF0\tStart catching up to round 6500000 with the provided catchpoint\ngoal node catchup --abort\t\t\t\t\tAbort the current catchup",
+	Example: "goal node catchup 6500000#1234567890ABCDEF01234567890ABCDEF0\tStart catching up to round 6500000 with the provided catchpoint\ngoal node catchup 6500000#1234567890ABCDEF01234567890ABCDEF0 --file 6500000.catchpoint\tCatch up using a catchpoint file on disk\ngoal node catchup --abort\t\t\t\t\tAbort the current catchup",
 	Args:    catchpointCmdArgument,
 	Run: func(cmd *cobra.Command, args []string) {
 		onDataDirs(func(dataDir string) {
@@ -172,6 +179,24 @@ var catchupCmd = &cobra.Command{
 	},
 }
 
+var rollbackCmd = &cobra.Command{
+	Use:     "rollback",
+	Short:   "Roll the ledger of the Algorand node back to an earlier round",
+	Long:    "Rollback discards all the blocks that follow the given round, and restores the ledger state as of that round. It's refused on the public networks, and on other networks all the nodes need to be rolled back to the same round. The round is bounded by the state deltas the node keeps in memory, which cover at least the last MaxAcctLookback rounds, except in developer mode, where rolling back beyond them replays the ledger from genesis, which requires an archival node.",
+	Example: "goal node rollback --round 1000\tRoll the ledger back to round 1000",
+	Args:    validateNoPosArgsFn,
+	Run: func(cmd *cobra.Command, args []string) {
+		onDataDirs(func(dataDir string) {
+			client := ensureAlgodClient(dataDir)
+			rnd, err := client.RollbackLedger(rollbackRound)
+			if err != nil {
+				reportErrorf(errorNodeRollback, err)
+			}
+			reportInfof(infoNodeRolledBack, rnd)
+		})
+	},
+}
+
 func catchpointCmdArgument(cmd *cobra.Command, args []string) error {
 	catchpointsCount := 0
 	for _, arg := range args {
@@ -212,6 +237,7 @@ var startCmd = &cobra.Command{
 			}
 
 			nc := nodecontrol.MakeNodeController(binDir, dataDir)
+			nc.SetTLSCAFile(tlsCAFile)
 			nodeArgs := nodecontrol.AlgodStartArgs{
 				PeerAddress:       peerDial,
 				ListenIP:          listenIP,
@@ -249,6 +275,7 @@ var shutdownCmd = &cobra.Command{
 		}
 		onDataDirs(func(dataDir string) {
 			nc := nodecontrol.MakeNodeController(binDir, dataDir)
+			nc.SetTLSCAFile(tlsCAFile)
 			err := nc.Shutdown()
 
 			if err == nil {
@@ -284,6 +311,7 @@ var stopCmd = &cobra.Command{
 			}
 
 			nc := nodecontrol.MakeNodeController(binDir, dataDir)
+			nc.SetTLSCAFile(tlsCAFile)
 
 			log.Info(infoTryingToStopNode)
 
@@ -315,6 +343,7 @@ var restartCmd = &cobra.Command{
 			}
 
 			nc := nodecontrol.MakeNodeController(binDir, dataDir)
+			nc.SetTLSCAFile(tlsCAFile)
 
 			_, err = nc.GetAlgodPID()
 
@@ -372,6 +401,7 @@ var generateTokenCmd = &cobra.Command{
 				AlgodDataDir: dataDir,
 				KMDDataDir:   resolveKmdDataDir(dataDir),
 				CacheDir:     ensureCacheDir(dataDir),
+				TLSCAFile:    tlsCAFile,
 			}
 			client, err := libgoal.MakeClientFromConfig(clientConfig, libgoal.AlgodClient)
 			if err == nil {
@@ -674,6 +704,18 @@ func catchup(dataDir string, args []string) {
 		}
 		return
 	}
+	if catchpointFile != "" {
+		// the node resolves the path relative to its own working directory, so it's passed as an absolute path.
+		filename, err := filepath.Abs(catchpointFile)
+		if err != nil {
+			reportErrorf(errorNodeStatus, err)
+		}
+		err = client.CatchupFromFile(args[0], filename)
+		if err != nil {
+			reportErrorf(errorNodeStatus, err)
+		}
+		return
+	}
 	err := client.Catchup(args[0])
 	if err != nil {
 		reportErrorf(errorNodeStatus, err)
@@ -0,0 +1,1013 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+package main
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/algorand/go-codec/codec"
+	"github.com/spf13/cobra"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/ledger"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+var testRunPattern string
+
+func init() {
+	clerkCmd.AddCommand(testCmd)
+
+	testCmd.Flags().StringVarP(&testRunPattern, "run", "r", "", "run only the cases whose names match this regular expression")
+}
+
+var testCmd = &cobra.Command{
+	Use:   "test [spec file]...",
+	Short: "Run the test cases of TEAL contracts",
+	Long: `Run declarative test cases of TEAL contracts against an in-memory ledger, without a running node.
+
+Each spec file is a JSON object with the prior state of the ledger and a list of cases. Every case applies a transaction group to that state, extended with the state of the case, using the block evaluator of the node, and checks whether the group is rejected, the logs of its transactions, and its changes to global and local application state and to boxes. Program files are relative to the spec file; .teal files are assembled, other files hold compiled programs. Keys, byte values, arguments and logs use the encoding:value format of application call arguments, as in str:hello or b64:AA==. Signatures are not checked.`,
+	Args: cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		var filter *regexp.Regexp
+		if testRunPattern != "" {
+			var err error
+			filter, err = regexp.Compile(testRunPattern)
+			if err != nil {
+				reportErrorf("invalid --run pattern: %v", err)
+			}
+		}
+
+		passed, failed := 0, 0
+		for _, fname := range args {
+			spec, err := readTestSpec(fname)
+			if err != nil {
+				reportErrorf("%s: %v", fname, err)
+			}
+			runner, err := makeTestRunner(spec, filepath.Dir(fname))
+			if err != nil {
+				reportErrorf("%s: %v", fname, err)
+			}
+			for i := range spec.Cases {
+				tc := &spec.Cases[i]
+				if filter != nil && !filter.MatchString(tc.Name) {
+					continue
+				}
+				diffs, err := runner.run(tc)
+				if err != nil {
+					reportErrorf("%s: case %s: %v", fname, tc.Name, err)
+				}
+				if len(diffs) == 0 {
+					passed++
+					fmt.Printf("PASS %s\n", tc.Name)
+					continue
+				}
+				failed++
+				fmt.Printf("FAIL %s\n", tc.Name)
+				for _, diff := range diffs {
+					fmt.Printf("    %s\n", diff)
+				}
+			}
+		}
+		fmt.Printf("%d passed, %d failed\n", passed, failed)
+		if failed > 0 {
+			os.Exit(1)
+		}
+	},
+}
+
+// testSpec is a spec file of `goal clerk test`
+type testSpec struct {
+	// Protocol is the consensus protocol, the current one by default.
+	Protocol string `codec:"protocol"`
+
+	// Round and Timestamp are the round and the timestamp of the block
+	// that the transactions are evaluated in.
+	Round     uint64 `codec:"round"`
+	Timestamp int64  `codec:"timestamp"`
+
+	// State is the state of the ledger shared by all cases.
+	State testState  `codec:"state"`
+	Cases []testCase `codec:"cases"`
+}
+
+// testState is the state of the ledger before the transactions of a case.
+// Applications and assets that are created later get IDs after the largest
+// one of the state.
+type testState struct {
+	Accounts []testAccount `codec:"accounts"`
+	Apps     []testApp     `codec:"apps"`
+	Assets   []testAsset   `codec:"assets"`
+
+	// Boxes are the boxes with their App, Key and Bytes.
+	Boxes []testValue `codec:"boxes"`
+}
+
+// testAccount is an account of a testState. It is identified by its Address,
+// or by the App that it is the account of.
+type testAccount struct {
+	Address  basics.Address   `codec:"address"`
+	App      basics.AppIndex  `codec:"app"`
+	Balance  uint64           `codec:"balance"`
+	Local    []testLocalState `codec:"local"`
+	Holdings []testHolding    `codec:"holdings"`
+}
+
+type testLocalState struct {
+	App    basics.AppIndex `codec:"app"`
+	Values []testValue     `codec:"values"`
+}
+
+type testHolding struct {
+	Asset  basics.AssetIndex `codec:"asset"`
+	Amount uint64            `codec:"amount"`
+	Frozen bool              `codec:"frozen"`
+}
+
+type testApp struct {
+	ID           basics.AppIndex `codec:"id"`
+	Creator      basics.Address  `codec:"creator"`
+	Approval     string          `codec:"approval"`
+	Clear        string          `codec:"clear"`
+	GlobalSchema testSchema      `codec:"global-schema"`
+	LocalSchema  testSchema      `codec:"local-schema"`
+	ExtraPages   uint32          `codec:"extra-pages"`
+	Global       []testValue     `codec:"global"`
+}
+
+type testSchema struct {
+	Uints uint64 `codec:"uints"`
+	Bytes uint64 `codec:"bytes"`
+}
+
+func (s testSchema) stateSchema() basics.StateSchema {
+	return basics.StateSchema{NumUint: s.Uints, NumByteSlice: s.Bytes}
+}
+
+type testAsset struct {
+	ID            basics.AssetIndex `codec:"id"`
+	Creator       basics.Address    `codec:"creator"`
+	Total         uint64            `codec:"total"`
+	Decimals      uint32            `codec:"decimals"`
+	DefaultFrozen bool              `codec:"default-frozen"`
+	UnitName      string            `codec:"unit-name"`
+	Name          string            `codec:"name"`
+	URL           string            `codec:"url"`
+	Manager       basics.Address    `codec:"manager"`
+	Reserve       basics.Address    `codec:"reserve"`
+	Freeze        basics.Address    `codec:"freeze"`
+	Clawback      basics.Address    `codec:"clawback"`
+}
+
+func (a *testAsset) assetParams() basics.AssetParams {
+	return basics.AssetParams{
+		Total:         a.Total,
+		Decimals:      a.Decimals,
+		DefaultFrozen: a.DefaultFrozen,
+		UnitName:      a.UnitName,
+		AssetName:     a.Name,
+		URL:           a.URL,
+		Manager:       a.Manager,
+		Reserve:       a.Reserve,
+		Freeze:        a.Freeze,
+		Clawback:      a.Clawback,
+	}
+}
+
+// testValue is a key of application state or the name of a box, with its
+// value. The value is Bytes if it is set, and Uint otherwise. App and
+// Account tell where the key is when that is not implied by its place, and
+// Deleted marks a deleted key in expected changes.
+type testValue struct {
+	App     basics.AppIndex `codec:"app"`
+	Account basics.Address  `codec:"account"`
+	Key     string          `codec:"key"`
+	Uint    uint64          `codec:"uint"`
+	Bytes   *string         `codec:"bytes"`
+	Deleted bool            `codec:"deleted"`
+}
+
+// testCase is a transaction group to evaluate with the state of its spec and
+// its own State, and what is expected of it.
+type testCase struct {
+	Name   string     `codec:"name"`
+	State  testState  `codec:"state"`
+	Txns   []testTxn  `codec:"txns"`
+	Expect testExpect `codec:"expect"`
+}
+
+// testExpect is what is expected of a case. The changes of the group are
+// only checked for the kinds of state that are listed, and then they must be
+// exactly the listed ones.
+type testExpect struct {
+	// Reject expects the group to be rejected, with an error that contains
+	// Error if it is set.
+	Reject bool   `codec:"reject"`
+	Error  string `codec:"error"`
+
+	Global []testValue `codec:"global"`
+	Local  []testValue `codec:"local"`
+	Boxes  []testValue `codec:"boxes"`
+}
+
+// testTxn is a transaction of a case, with the logs it is expected to emit if
+// Logs is set. The fee is the minimum fee unless it is set.
+type testTxn struct {
+	Type         protocol.TxType `codec:"type"`
+	Sender       basics.Address  `codec:"sender"`
+	Fee          *uint64         `codec:"fee"`
+	Note         string          `codec:"note"`
+	Lease        string          `codec:"lease"`
+	RekeyTo      basics.Address  `codec:"rekey-to"`
+	LogicSig     string          `codec:"lsig"`
+	LogicSigArgs []string        `codec:"lsig-args"`
+
+	Receiver basics.Address `codec:"receiver"`
+	Amount   uint64         `codec:"amount"`
+	CloseTo  basics.Address `codec:"close-to"`
+
+	Asset         basics.AssetIndex `codec:"asset"`
+	AssetParams   *testAsset        `codec:"asset-params"`
+	AssetAmount   uint64            `codec:"asset-amount"`
+	AssetSender   basics.Address    `codec:"asset-sender"`
+	AssetReceiver basics.Address    `codec:"asset-receiver"`
+	AssetCloseTo  basics.Address    `codec:"asset-close-to"`
+	FreezeAccount basics.Address    `codec:"freeze-account"`
+	Frozen        bool              `codec:"frozen"`
+
+	App           basics.AppIndex     `codec:"app"`
+	OnCompletion  string              `codec:"on-completion"`
+	Args          []string            `codec:"args"`
+	Accounts      []basics.Address    `codec:"accounts"`
+	ForeignApps   []basics.AppIndex   `codec:"foreign-apps"`
+	ForeignAssets []basics.AssetIndex `codec:"foreign-assets"`
+	Boxes         []testValue         `codec:"boxes"`
+	Approval      string              `codec:"approval"`
+	Clear         string              `codec:"clear"`
+	GlobalSchema  testSchema          `codec:"global-schema"`
+	LocalSchema   testSchema          `codec:"local-schema"`
+	ExtraPages    uint32              `codec:"extra-pages"`
+
+	Logs []string `codec:"logs"`
+}
+
+func readTestSpec(fname string) (*testSpec, error) {
+	data, err := readFile(fname)
+	if err != nil {
+		return nil, err
+	}
+	var spec testSpec
+	dec := codec.NewDecoderBytes(data, protocol.JSONStrictHandle)
+	err = dec.Decode(&spec)
+	if err != nil {
+		return nil, err
+	}
+	return &spec, nil
+}
+
+// testRunner runs the cases of a spec
+type testRunner struct {
+	spec     *testSpec
+	dir      string
+	proto    config.ConsensusParams
+	specials transactions.SpecialAddresses
+
+	// programs are the programs read so far, by file name
+	programs map[string][]byte
+}
+
+func makeTestRunner(spec *testSpec, dir string) (*testRunner, error) {
+	r := &testRunner{
+		spec:     spec,
+		dir:      dir,
+		programs: make(map[string][]byte),
+		specials: transactions.SpecialAddresses{
+			FeeSink:     basics.Address(crypto.Hash([]byte("goal clerk test fee sink"))),
+			RewardsPool: basics.Address(crypto.Hash([]byte("goal clerk test rewards pool"))),
+		},
+	}
+	if spec.Protocol == "" {
+		spec.Protocol = string(protocol.ConsensusCurrentVersion)
+	}
+	var ok bool
+	r.proto, ok = config.Consensus[protocol.ConsensusVersion(spec.Protocol)]
+	if !ok {
+		return nil, fmt.Errorf("unknown protocol %s", spec.Protocol)
+	}
+	if spec.Round == 0 {
+		spec.Round = 1
+	}
+	return r, nil
+}
+
+// program returns the program of a file relative to the spec
+func (r *testRunner) program(fname string) ([]byte, error) {
+	if program, ok := r.programs[fname]; ok {
+		return program, nil
+	}
+	path := filepath.Join(r.dir, fname)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if strings.HasSuffix(fname, ".teal") {
+		ops, err := logic.AssembleFile(path, string(data), os.ReadFile)
+		if err != nil {
+			ops.ReportProblems(path, os.Stderr)
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		data = ops.Program
+	}
+	r.programs[fname] = data
+	return data, nil
+}
+
+// clearProgram returns the clear state program of a file, or a program that
+// approves, in the version of the approval program, if there is no file
+func (r *testRunner) clearProgram(fname string, approval []byte) ([]byte, error) {
+	if fname != "" {
+		return r.program(fname)
+	}
+	version := uint64(1)
+	if len(approval) > 0 {
+		version = uint64(approval[0])
+	}
+	ops, err := logic.AssembleStringWithVersion("int 1", version)
+	if err != nil {
+		return nil, err
+	}
+	return ops.Program, nil
+}
+
+// testBytes decodes a byte string in the encoding:value format
+func testBytes(arg string) ([]byte, error) {
+	acb, err := logic.NewAppCallBytes(arg)
+	if err != nil {
+		return nil, err
+	}
+	return acb.Raw()
+}
+
+// testBytesString formats bytes for diffs, as text if they are printable
+func testBytesString(b []byte) string {
+	for _, c := range b {
+		if c < 0x20 || c > 0x7e {
+			return "b64:" + base64.StdEncoding.EncodeToString(b)
+		}
+	}
+	return "str:" + string(b)
+}
+
+func testTealValue(v *testValue) (basics.TealValue, error) {
+	if v.Bytes == nil {
+		return basics.TealValue{Type: basics.TealUintType, Uint: v.Uint}, nil
+	}
+	b, err := testBytes(*v.Bytes)
+	if err != nil {
+		return basics.TealValue{}, err
+	}
+	return basics.TealValue{Type: basics.TealBytesType, Bytes: string(b)}, nil
+}
+
+func testTealValueString(tv basics.TealValue) string {
+	if tv.Type == basics.TealBytesType {
+		return "bytes " + testBytesString([]byte(tv.Bytes))
+	}
+	return fmt.Sprintf("uint %d", tv.Uint)
+}
+
+func testKeyValue(values []testValue) (basics.TealKeyValue, error) {
+	if len(values) == 0 {
+		return nil, nil
+	}
+	tkv := make(basics.TealKeyValue, len(values))
+	for i := range values {
+		key, err := testBytes(values[i].Key)
+		if err != nil {
+			return nil, err
+		}
+		tkv[string(key)], err = testTealValue(&values[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return tkv, nil
+}
+
+// replayState makes the state of the ledger of a case
+func (r *testRunner) replayState(state *testState) (ledgercore.ReplayState, error) {
+	rs := ledgercore.ReplayState{
+		Header: bookkeeping.BlockHeader{
+			Round:     basics.Round(r.spec.Round),
+			TimeStamp: r.spec.Timestamp,
+			RewardsState: bookkeeping.RewardsState{
+				FeeSink:     r.specials.FeeSink,
+				RewardsPool: r.specials.RewardsPool,
+			},
+			UpgradeState: bookkeeping.UpgradeState{
+				CurrentProtocol: protocol.ConsensusVersion(r.spec.Protocol),
+			},
+		},
+		PrevTimestamp: r.spec.Timestamp,
+		Boxes:         make(map[string][]byte),
+	}
+
+	var order []basics.Address
+	accounts := make(map[basics.Address]*basics.AccountData)
+	account := func(addr basics.Address) *basics.AccountData {
+		ad, ok := accounts[addr]
+		if !ok {
+			ad = &basics.AccountData{Status: basics.NotParticipating}
+			accounts[addr] = ad
+			order = append(order, addr)
+		}
+		return ad
+	}
+	counter := func(id uint64) {
+		if id > rs.TxnCounter {
+			rs.TxnCounter = id
+		}
+	}
+
+	apps := make(map[basics.AppIndex]*testApp)
+	for i := range state.Apps {
+		app := &state.Apps[i]
+		if app.ID == 0 {
+			return rs, fmt.Errorf("app %d has no id", i)
+		}
+		apps[app.ID] = app
+		counter(uint64(app.ID))
+
+		approval, err := r.program(app.Approval)
+		if err != nil {
+			return rs, err
+		}
+		clearProg, err := r.clearProgram(app.Clear, approval)
+		if err != nil {
+			return rs, err
+		}
+		global, err := testKeyValue(app.Global)
+		if err != nil {
+			return rs, fmt.Errorf("app %d: %w", app.ID, err)
+		}
+		ad := account(app.Creator)
+		if ad.AppParams == nil {
+			ad.AppParams = make(map[basics.AppIndex]basics.AppParams)
+		}
+		ad.AppParams[app.ID] = basics.AppParams{
+			ApprovalProgram:   approval,
+			ClearStateProgram: clearProg,
+			GlobalState:       global,
+			StateSchemas: basics.StateSchemas{
+				LocalStateSchema:  app.LocalSchema.stateSchema(),
+				GlobalStateSchema: app.GlobalSchema.stateSchema(),
+			},
+			ExtraProgramPages: app.ExtraPages,
+		}
+		ad.TotalAppSchema = ad.TotalAppSchema.AddSchema(app.GlobalSchema.stateSchema())
+		ad.TotalExtraAppPages += app.ExtraPages
+	}
+
+	for i := range state.Assets {
+		asset := &state.Assets[i]
+		if asset.ID == 0 {
+			return rs, fmt.Errorf("asset %d has no id", i)
+		}
+		counter(uint64(asset.ID))
+		ad := account(asset.Creator)
+		if ad.AssetParams == nil {
+			ad.AssetParams = make(map[basics.AssetIndex]basics.AssetParams)
+		}
+		ad.AssetParams[asset.ID] = asset.assetParams()
+	}
+
+	for i := range state.Accounts {
+		acct := &state.Accounts[i]
+		addr := acct.Address
+		if acct.App != 0 {
+			addr = acct.App.Address()
+		}
+		if addr.IsZero() {
+			return rs, fmt.Errorf("account %d has no address", i)
+		}
+		ad := account(addr)
+		ad.MicroAlgos.Raw = acct.Balance
+		for _, local := range acct.Local {
+			app, ok := apps[local.App]
+			if !ok {
+				return rs, fmt.Errorf("account %s: local state of unknown app %d", addr, local.App)
+			}
+			tkv, err := testKeyValue(local.Values)
+			if err != nil {
+				return rs, fmt.Errorf("account %s: %w", addr, err)
+			}
+			if ad.AppLocalStates == nil {
+				ad.AppLocalStates = make(map[basics.AppIndex]basics.AppLocalState)
+			}
+			ad.AppLocalStates[local.App] = basics.AppLocalState{
+				Schema:   app.LocalSchema.stateSchema(),
+				KeyValue: tkv,
+			}
+			ad.TotalAppSchema = ad.TotalAppSchema.AddSchema(app.LocalSchema.stateSchema())
+		}
+		for _, holding := range acct.Holdings {
+			if ad.Assets == nil {
+				ad.Assets = make(map[basics.AssetIndex]basics.AssetHolding)
+			}
+			ad.Assets[holding.Asset] = basics.AssetHolding{Amount: holding.Amount, Frozen: holding.Frozen}
+		}
+	}
+
+	for i := range state.Boxes {
+		box := &state.Boxes[i]
+		name, err := testBytes(box.Key)
+		if err != nil {
+			return rs, fmt.Errorf("box %d: %w", i, err)
+		}
+		var value []byte
+		if box.Bytes != nil {
+			value, err = testBytes(*box.Bytes)
+			if err != nil {
+				return rs, fmt.Errorf("box %d: %w", i, err)
+			}
+		}
+		rs.Boxes[logic.MakeBoxKey(box.App, string(name))] = value
+		ad := account(box.App.Address())
+		ad.TotalBoxes++
+		ad.TotalBoxBytes += uint64(len(name) + len(value))
+	}
+
+	for _, addr := range order {
+		ad := accounts[addr]
+		rs.Accounts = append(rs.Accounts, ledgercore.ReplayAccount{
+			Addr:           addr,
+			AccountData:    ledgercore.ToAccountData(*ad),
+			AppParams:      ad.AppParams,
+			AppLocalStates: ad.AppLocalStates,
+			AssetParams:    ad.AssetParams,
+			Assets:         ad.Assets,
+		})
+	}
+	return rs, nil
+}
+
+// txn makes the signed transaction of a testTxn
+func (r *testRunner) txn(tt *testTxn) (stxn transactions.SignedTxn, err error) {
+	txn := transactions.Transaction{
+		Type: tt.Type,
+		Header: transactions.Header{
+			Sender:     tt.Sender,
+			Fee:        basics.MicroAlgos{Raw: r.proto.MinTxnFee},
+			FirstValid: basics.Round(r.spec.Round),
+			LastValid:  basics.Round(r.spec.Round + r.proto.MaxTxnLife),
+			RekeyTo:    tt.RekeyTo,
+		},
+	}
+	if tt.Fee != nil {
+		txn.Fee.Raw = *tt.Fee
+	}
+	if tt.Note != "" {
+		txn.Note, err = testBytes(tt.Note)
+		if err != nil {
+			return
+		}
+	}
+	if tt.Lease != "" {
+		var lease []byte
+		lease, err = testBytes(tt.Lease)
+		if err != nil {
+			return
+		}
+		if len(lease) != len(txn.Lease) {
+			err = fmt.Errorf("lease must be %d bytes long", len(txn.Lease))
+			return
+		}
+		copy(txn.Lease[:], lease)
+	}
+
+	switch tt.Type {
+	case protocol.PaymentTx:
+		txn.PaymentTxnFields = transactions.PaymentTxnFields{
+			Receiver:         tt.Receiver,
+			Amount:           basics.MicroAlgos{Raw: tt.Amount},
+			CloseRemainderTo: tt.CloseTo,
+		}
+	case protocol.AssetConfigTx:
+		txn.ConfigAsset = tt.Asset
+		if tt.AssetParams != nil {
+			txn.AssetParams = tt.AssetParams.assetParams()
+		}
+	case protocol.AssetTransferTx:
+		txn.AssetTransferTxnFields = transactions.AssetTransferTxnFields{
+			XferAsset:     tt.Asset,
+			AssetAmount:   tt.AssetAmount,
+			AssetSender:   tt.AssetSender,
+			AssetReceiver: tt.AssetReceiver,
+			AssetCloseTo:  tt.AssetCloseTo,
+		}
+	case protocol.AssetFreezeTx:
+		txn.AssetFreezeTxnFields = transactions.AssetFreezeTxnFields{
+			FreezeAccount: tt.FreezeAccount,
+			FreezeAsset:   tt.Asset,
+			AssetFrozen:   tt.Frozen,
+		}
+	case protocol.ApplicationCallTx:
+		err = r.appCallFields(tt, &txn.ApplicationCallTxnFields)
+		if err != nil {
+			return
+		}
+	default:
+		err = fmt.Errorf("unsupported transaction type %q", tt.Type)
+		return
+	}
+	stxn.Txn = txn
+
+	if tt.LogicSig != "" {
+		stxn.Lsig.Logic, err = r.program(tt.LogicSig)
+		if err != nil {
+			return
+		}
+		for _, arg := range tt.LogicSigArgs {
+			var b []byte
+			b, err = testBytes(arg)
+			if err != nil {
+				return
+			}
+			stxn.Lsig.Args = append(stxn.Lsig.Args, b)
+		}
+	}
+	return
+}
+
+func (r *testRunner) appCallFields(tt *testTxn, fields *transactions.ApplicationCallTxnFields) (err error) {
+	fields.ApplicationID = tt.App
+	if tt.OnCompletion != "" {
+		fields.OnCompletion, err = parseOnCompletion(tt.OnCompletion)
+		if err != nil {
+			return
+		}
+	}
+	for _, arg := range tt.Args {
+		var b []byte
+		b, err = testBytes(arg)
+		if err != nil {
+			return
+		}
+		fields.ApplicationArgs = append(fields.ApplicationArgs, b)
+	}
+	fields.Accounts = tt.Accounts
+	fields.ForeignApps = tt.ForeignApps
+	fields.ForeignAssets = tt.ForeignAssets
+	for _, box := range tt.Boxes {
+		ref := transactions.BoxRef{}
+		if box.App != 0 && box.App != tt.App {
+			for i, app := range tt.ForeignApps {
+				if app == box.App {
+					ref.Index = uint64(i + 1)
+				}
+			}
+			if ref.Index == 0 {
+				return fmt.Errorf("box ref with app %d not in foreign-apps", box.App)
+			}
+		}
+		var name []byte
+		name, err = testBytes(box.Key)
+		if err != nil {
+			return
+		}
+		ref.Name = name
+		fields.Boxes = append(fields.Boxes, ref)
+	}
+	if tt.Approval != "" {
+		fields.ApprovalProgram, err = r.program(tt.Approval)
+		if err != nil {
+			return
+		}
+		fields.ClearStateProgram, err = r.clearProgram(tt.Clear, fields.ApprovalProgram)
+		if err != nil {
+			return
+		}
+	}
+	fields.GlobalStateSchema = tt.GlobalSchema.stateSchema()
+	fields.LocalStateSchema = tt.LocalSchema.stateSchema()
+	fields.ExtraProgramPages = tt.ExtraPages
+	return
+}
+
+// run runs a case and returns how it differs from its expectations. The
+// error is set if the case can not be run.
+func (r *testRunner) run(tc *testCase) ([]string, error) {
+	state := testState{
+		Accounts: append(append([]testAccount(nil), r.spec.State.Accounts...), tc.State.Accounts...),
+		Apps:     append(append([]testApp(nil), r.spec.State.Apps...), tc.State.Apps...),
+		Assets:   append(append([]testAsset(nil), r.spec.State.Assets...), tc.State.Assets...),
+		Boxes:    append(append([]testValue(nil), r.spec.State.Boxes...), tc.State.Boxes...),
+	}
+	rs, err := r.replayState(&state)
+	if err != nil {
+		return nil, err
+	}
+	if len(tc.Txns) == 0 {
+		return nil, fmt.Errorf("no transactions")
+	}
+	group := make([]transactions.SignedTxn, len(tc.Txns))
+	for i := range tc.Txns {
+		group[i], err = r.txn(&tc.Txns[i])
+		if err != nil {
+			return nil, fmt.Errorf("transaction %d: %w", i, err)
+		}
+	}
+	if len(group) > 1 {
+		var txGroup transactions.TxGroup
+		for _, stxn := range group {
+			txGroup.TxGroupHashes = append(txGroup.TxGroupHashes, crypto.Digest(stxn.ID()))
+		}
+		gid := crypto.HashObj(txGroup)
+		for i := range group {
+			group[i].Txn.Group = gid
+		}
+	}
+
+	result, rejection := r.evaluate(&rs, group)
+	if rejection != nil {
+		if !tc.Expect.Reject {
+			return []string{fmt.Sprintf("rejected: %v", rejection)}, nil
+		}
+		if !strings.Contains(rejection.Error(), tc.Expect.Error) {
+			return []string{fmt.Sprintf("rejected with an error that does not contain %q: %v", tc.Expect.Error, rejection)}, nil
+		}
+		return nil, nil
+	}
+	if tc.Expect.Reject {
+		return []string{"passed, but expected to be rejected"}, nil
+	}
+
+	var diffs []string
+	for i := range tc.Txns {
+		if tc.Txns[i].Logs == nil {
+			continue
+		}
+		logDiffs, err := testLogDiffs(i, tc.Txns[i].Logs, result.ads[i].EvalDelta.Logs)
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, logDiffs...)
+	}
+	changes := testChanges(&rs, result.deltas)
+	for _, kind := range []struct {
+		name     string
+		expected []testValue
+	}{
+		{"global", tc.Expect.Global},
+		{"local", tc.Expect.Local},
+		{"box", tc.Expect.Boxes},
+	} {
+		if kind.expected == nil {
+			continue
+		}
+		expected, err := testExpectedChanges(kind.name, kind.expected)
+		if err != nil {
+			return nil, err
+		}
+		diffs = append(diffs, testChangeDiffs(expected, changes[kind.name])...)
+	}
+	return diffs, nil
+}
+
+// testGroupResult is the result of a group that passed
+type testGroupResult struct {
+	ads    []transactions.ApplyData
+	deltas ledgercore.StateDelta
+}
+
+// evaluate evaluates a group on the ledger of a ReplayState the way the
+// block evaluator does, after checking it the way the transaction pool does,
+// except for signatures. It returns why the group was rejected if it was.
+func (r *testRunner) evaluate(rs *ledgercore.ReplayState, group []transactions.SignedTxn) (result testGroupResult, rejection error) {
+	var feesPaid, minFees uint64
+	for gi := range group {
+		err := group[gi].Txn.WellFormed(r.specials, r.proto)
+		if err != nil {
+			return result, fmt.Errorf("transaction %d: %w", gi, err)
+		}
+		feesPaid = basics.AddSaturate(feesPaid, group[gi].Txn.Fee.Raw)
+		minFees = basics.AddSaturate(minFees, r.proto.MinTxnFee)
+	}
+	if feesPaid < minFees {
+		return result, fmt.Errorf("group fees %d are below the minimum of %d", feesPaid, minFees)
+	}
+
+	sigEp := logic.NewEvalParams(transactions.WrapSignedTxnsWithAD(group), &r.proto, &r.specials)
+	sigEp.SigLedger = logic.NoHeaderLedger{}
+	for gi := range group {
+		if len(group[gi].Lsig.Logic) == 0 {
+			continue
+		}
+		pass, err := logic.EvalSignature(gi, sigEp)
+		if err != nil {
+			return result, fmt.Errorf("transaction %d: logic signature failed: %w", gi, err)
+		}
+		if !pass {
+			return result, fmt.Errorf("transaction %d: logic signature rejected", gi)
+		}
+	}
+
+	ba := ledger.MakeReplayBalances(rs)
+	ep := logic.NewEvalParams(transactions.WrapSignedTxnsWithAD(group), &r.proto, &r.specials)
+	result.ads = make([]transactions.ApplyData, len(group))
+	for gi := range group {
+		ad, err := ba.Replay(gi, ep)
+		if err != nil {
+			return result, fmt.Errorf("transaction %d: %w", gi, err)
+		}
+		err = ba.CheckMinBalance()
+		if err != nil {
+			return result, fmt.Errorf("transaction %d: %w", gi, err)
+		}
+		result.ads[gi] = ad
+	}
+	result.deltas = ba.Deltas()
+	return result, nil
+}
+
+func testLogDiffs(gi int, expected []string, actual []string) ([]string, error) {
+	var diffs []string
+	for i := 0; i < len(expected) || i < len(actual); i++ {
+		var exp, act []byte
+		if i < len(expected) {
+			var err error
+			exp, err = testBytes(expected[i])
+			if err != nil {
+				return nil, fmt.Errorf("transaction %d: log %d: %w", gi, i, err)
+			}
+		}
+		if i < len(actual) {
+			act = []byte(actual[i])
+		}
+		switch {
+		case i >= len(actual):
+			diffs = append(diffs, fmt.Sprintf("transaction %d log %d: expected %s, got nothing", gi, i, testBytesString(exp)))
+		case i >= len(expected):
+			diffs = append(diffs, fmt.Sprintf("transaction %d log %d: unexpected %s", gi, i, testBytesString(act)))
+		case !bytes.Equal(exp, act):
+			diffs = append(diffs, fmt.Sprintf("transaction %d log %d: expected %s, got %s", gi, i, testBytesString(exp), testBytesString(act)))
+		}
+	}
+	return diffs, nil
+}
+
+// testKeyValueChanges adds the changes from before to after, by key, to changes
+func testKeyValueChanges(changes map[string]string, prefix string, before, after basics.TealKeyValue) {
+	for key, tv := range after {
+		if old, ok := before[key]; !ok || old != tv {
+			changes[prefix+testBytesString([]byte(key))] = testTealValueString(tv)
+		}
+	}
+	for key := range before {
+		if _, ok := after[key]; !ok {
+			changes[prefix+testBytesString([]byte(key))] = "deleted"
+		}
+	}
+}
+
+// testChanges returns the changes of global and local state and boxes made
+// by a group, by kind, as descriptions of their values by descriptions of
+// their keys
+func testChanges(rs *ledgercore.ReplayState, sd ledgercore.StateDelta) map[string]map[string]string {
+	changes := map[string]map[string]string{
+		"global": make(map[string]string),
+		"local":  make(map[string]string),
+		"box":    make(map[string]string),
+	}
+	accounts := make(map[basics.Address]*ledgercore.ReplayAccount, len(rs.Accounts))
+	for i := range rs.Accounts {
+		accounts[rs.Accounts[i].Addr] = &rs.Accounts[i]
+	}
+
+	for _, rec := range sd.Accts.GetAllAppResources() {
+		var beforeGlobal, beforeLocal basics.TealKeyValue
+		if acct, ok := accounts[rec.Addr]; ok {
+			beforeGlobal = acct.AppParams[rec.Aidx].GlobalState
+			beforeLocal = acct.AppLocalStates[rec.Aidx].KeyValue
+		}
+		if rec.Params.Params != nil || rec.Params.Deleted {
+			var after basics.TealKeyValue
+			if rec.Params.Params != nil {
+				after = rec.Params.Params.GlobalState
+			}
+			testKeyValueChanges(changes["global"], fmt.Sprintf("app %d key ", rec.Aidx), beforeGlobal, after)
+		}
+		if rec.State.LocalState != nil || rec.State.Deleted {
+			var after basics.TealKeyValue
+			if rec.State.LocalState != nil {
+				after = rec.State.LocalState.KeyValue
+			}
+			testKeyValueChanges(changes["local"], fmt.Sprintf("app %d account %s key ", rec.Aidx, rec.Addr), beforeLocal, after)
+		}
+	}
+
+	for key, kv := range sd.KvMods {
+		app, name, err := logic.SplitBoxKey(key)
+		if err != nil {
+			continue
+		}
+		desc := fmt.Sprintf("app %d box %s", app, testBytesString([]byte(name)))
+		switch {
+		case kv.Data == nil && kv.OldData != nil:
+			changes["box"][desc] = "deleted"
+		case kv.Data != nil && (kv.OldData == nil || !bytes.Equal(kv.Data, kv.OldData)):
+			changes["box"][desc] = "bytes " + testBytesString(kv.Data)
+		}
+	}
+	return changes
+}
+
+// testExpectedChanges describes expected changes like testChanges does
+func testExpectedChanges(kind string, values []testValue) (map[string]string, error) {
+	changes := make(map[string]string, len(values))
+	for i := range values {
+		v := &values[i]
+		key, err := testBytes(v.Key)
+		if err != nil {
+			return nil, fmt.Errorf("expected %s change %d: %w", kind, i, err)
+		}
+		var desc string
+		switch kind {
+		case "global":
+			desc = fmt.Sprintf("app %d key %s", v.App, testBytesString(key))
+		case "local":
+			desc = fmt.Sprintf("app %d account %s key %s", v.App, v.Account, testBytesString(key))
+		case "box":
+			desc = fmt.Sprintf("app %d box %s", v.App, testBytesString(key))
+		}
+		if v.Deleted {
+			changes[desc] = "deleted"
+			continue
+		}
+		tv, err := testTealValue(v)
+		if err != nil {
+			return nil, fmt.Errorf("expected %s change %d: %w", kind, i, err)
+		}
+		if kind == "box" && tv.Type != basics.TealBytesType {
+			return nil, fmt.Errorf("expected box change %d has no bytes", i)
+		}
+		changes[desc] = testTealValueString(tv)
+	}
+	return changes, nil
+}
+
+func testChangeDiffs(expected, actual map[string]string) []string {
+	keys := make(map[string]bool, len(expected)+len(actual))
+	for key := range expected {
+		keys[key] = true
+	}
+	for key := range actual {
+		keys[key] = true
+	}
+	sorted := make([]string, 0, len(keys))
+	for key := range keys {
+		sorted = append(sorted, key)
+	}
+	sort.Strings(sorted)
+
+	var diffs []string
+	for _, key := range sorted {
+		exp, expOk := expected[key]
+		act, actOk := actual[key]
+		switch {
+		case !actOk:
+			diffs = append(diffs, fmt.Sprintf("%s: expected %s, got no change", key, exp))
+		case !expOk:
+			diffs = append(diffs, fmt.Sprintf("%s: unexpected change to %s", key, act))
+		case exp != act:
+			diffs = append(diffs, fmt.Sprintf("%s: expected %s, got %s", key, exp, act))
+		}
+	}
+	return diffs
+}
@@ -0,0 +1,156 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+const testRunnerApproval = `#pragma version 8
+txn ApplicationID
+bz create
+txn OnCompletion
+int OptIn
+==
+bnz optin
+byte "count"
+byte "count"
+app_global_get
+int 1
++
+app_global_put
+byte "hello"
+log
+byte "box"
+int 8
+box_create
+assert
+int 1
+return
+create:
+byte "count"
+int 1
+app_global_put
+int 1
+return
+optin:
+int 0
+byte "seen"
+int 1
+app_local_put
+int 1
+`
+
+const testRunnerSpec = `{
+  "protocol": "future",
+  "round": 10,
+  "state": {
+    "accounts": [
+      {"address": "%[1]s", "balance": 10000000, "local": [{"app": 1}]},
+      {"address": "%[2]s", "balance": 10000000},
+      {"app": 1, "balance": 1000000}
+    ],
+    "apps": [
+      {"id": 1, "creator": "%[2]s", "approval": "approval.teal",
+       "global-schema": {"uints": 1}, "local-schema": {"uints": 1},
+       "global": [{"key": "str:count", "uint": 5}]}
+    ]
+  },
+  "cases": [
+    {
+      "name": "increment",
+      "txns": [{"type": "appl", "sender": "%[1]s", "app": 1, "boxes": [{"key": "str:box"}], "logs": ["str:hello"]}],
+      "expect": {
+        "global": [{"app": 1, "key": "str:count", "uint": 6}],
+        "local": [],
+        "boxes": [{"app": 1, "key": "str:box", "bytes": "b64:AAAAAAAAAAA="}]
+      }
+    },
+    {
+      "name": "opt in",
+      "txns": [{"type": "appl", "sender": "%[2]s", "app": 1, "on-completion": "optin"}],
+      "expect": {
+        "global": [],
+        "local": [{"app": 1, "account": "%[2]s", "key": "str:seen", "uint": 1}]
+      }
+    },
+    {
+      "name": "create",
+      "txns": [{"type": "appl", "sender": "%[2]s", "approval": "approval.teal", "global-schema": {"uints": 1}}],
+      "expect": {"global": [{"app": 2, "key": "str:count", "uint": 1}]}
+    },
+    {
+      "name": "box below min balance",
+      "state": {"accounts": [{"app": 1, "balance": 100000}]},
+      "txns": [{"type": "appl", "sender": "%[1]s", "app": 1, "boxes": [{"key": "str:box"}]}],
+      "expect": {"reject": true, "error": "below min"}
+    },
+    {
+      "name": "wrong expectations",
+      "txns": [
+        {"type": "pay", "sender": "%[1]s", "receiver": "%[2]s", "amount": 1, "fee": 0},
+        {"type": "appl", "sender": "%[1]s", "app": 1, "boxes": [{"key": "str:box"}], "fee": 2000, "logs": ["str:bye"]}
+      ],
+      "expect": {"global": [{"app": 1, "key": "str:count", "uint": 7}], "boxes": []}
+    },
+    {
+      "name": "fees too low",
+      "txns": [{"type": "pay", "sender": "%[1]s", "receiver": "%[2]s", "fee": 0}]
+    }
+  ]
+}`
+
+func TestTestRunner(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	user := basics.Address{1}
+	creator := basics.Address{2}
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "approval.teal"), []byte(testRunnerApproval), 0600))
+	fname := filepath.Join(dir, "spec.json")
+	require.NoError(t, os.WriteFile(fname, []byte(fmt.Sprintf(testRunnerSpec, user, creator)), 0600))
+
+	spec, err := readTestSpec(fname)
+	require.NoError(t, err)
+	require.NotNil(t, spec.Cases[0].Expect.Local)
+	runner, err := makeTestRunner(spec, dir)
+	require.NoError(t, err)
+
+	diffs := make(map[string][]string)
+	for i := range spec.Cases {
+		diffs[spec.Cases[i].Name], err = runner.run(&spec.Cases[i])
+		require.NoError(t, err, spec.Cases[i].Name)
+	}
+	require.Empty(t, diffs["increment"])
+	require.Empty(t, diffs["opt in"])
+	require.Empty(t, diffs["create"])
+	require.Empty(t, diffs["box below min balance"])
+	require.Equal(t, []string{
+		"transaction 1 log 0: expected str:bye, got str:hello",
+		"app 1 key str:count: expected uint 7, got uint 6",
+		"app 1 box str:box: unexpected change to bytes b64:AAAAAAAAAAA=",
+	}, diffs["wrong expectations"])
+	require.Len(t, diffs["fees too low"], 1)
+	require.Contains(t, diffs["fees too low"][0], "rejected: group fees 0 are below the minimum of 1000")
+}
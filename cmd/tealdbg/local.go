@@ -253,6 +253,7 @@ type LocalRunner struct {
 	protoName string
 	txnGroup  []transactions.SignedTxn
 	runs      []evaluation
+	replay    *replayRun
 }
 
 func makeAppState() (states AppState) {
@@ -306,7 +307,12 @@ func determineEvalMode(program []byte, modeIn string) (mode modeType, err error)
 //  - If no balance records set in CLI then DryrunRequest.Accounts and DryrunRequest.Apps are used.
 //    In this case Accounts data is used as a base for balance records creation,
 //    and Apps supply updates to AppParams field.
+// If a replay state is present, the transaction group of the state is replayed instead.
 func (r *LocalRunner) Setup(dp *DebugParams) (err error) {
+	if len(dp.ReplayBlob) > 0 {
+		return r.setupReplay(dp)
+	}
+
 	ddr, err := ddrFromParams(dp)
 	if err != nil {
 		return
@@ -540,6 +546,10 @@ func (r *LocalRunner) RunAll() error {
 		}
 	}
 
+	if r.replay != nil {
+		return r.runReplay(configureDebugger)
+	}
+
 	txngroup := transactions.WrapSignedTxnsWithAD(r.txnGroup)
 	failed := 0
 	start := time.Now()
@@ -76,6 +76,15 @@ var remoteCmd = &cobra.Command{
 	},
 }
 
+var replayCmd = &cobra.Command{
+	Use:   "replay",
+	Short: "Replay a transaction group of a block",
+	Long:  `Replay the transaction group of a block against the state it was evaluated against, fetched from algod. The state is only available for the recent rounds that algod keeps account state for, and is served by the admin API`,
+	Run: func(cmd *cobra.Command, args []string) {
+		debugReplay()
+	},
+}
+
 type frontendValue struct {
 	*cmdutil.CobraStringValue
 }
@@ -121,6 +130,10 @@ var verbose bool
 var painless bool
 var appID uint64
 var listenForDrReq bool
+var replayRound uint64
+var replayTxid string
+var algodURL string
+var algodToken string
 
 func init() {
 	rootCmd.PersistentFlags().VarP(&frontend, "frontend", "f", "Frontend to use: "+frontend.AllowedString())
@@ -147,8 +160,14 @@ func init() {
 	debugCmd.Flags().StringVarP(&indexerToken, "indexer-token", "", "", "API token for indexer to fetch Balance records from to evaluate stateful TEAL")
 	debugCmd.Flags().BoolVarP(&listenForDrReq, "listen-dr-req", "q", false, "Listen for upcoming debugging dryrun request objects instead of taking program(s) from command line")
 
+	replayCmd.Flags().Uint64VarP(&replayRound, "round", "r", 0, "Round of the block with the transaction to replay")
+	replayCmd.Flags().StringVarP(&replayTxid, "txid", "t", "", "ID of a transaction of the group to replay")
+	replayCmd.Flags().StringVarP(&algodURL, "algod-url", "", "", "URL for algod to fetch the state of the transaction group from")
+	replayCmd.Flags().StringVarP(&algodToken, "algod-token", "", "", "Admin API token for algod to fetch the state of the transaction group from")
+
 	rootCmd.AddCommand(debugCmd)
 	rootCmd.AddCommand(remoteCmd)
+	rootCmd.AddCommand(replayCmd)
 }
 
 func debugRemote() {
@@ -159,6 +178,35 @@ func debugRemote() {
 	}
 }
 
+func debugReplay() {
+	if replayRound == 0 {
+		log.Fatalln("Error: round must be set")
+	}
+	if len(replayTxid) == 0 {
+		log.Fatalln("Error: txid must be set")
+	}
+	if len(algodURL) == 0 {
+		log.Fatalln("Error: algod-url must be set")
+	}
+
+	replayBlob, err := getReplayStateFromAlgod(algodURL, algodToken, replayRound, replayTxid)
+	if err != nil {
+		log.Fatalf("Error fetching replay state: %s", err.Error())
+	}
+
+	dp := DebugParams{
+		ReplayBlob:       replayBlob,
+		DisableSourceMap: noSourceMap,
+	}
+
+	ds := makeDebugServer(iface, port, &frontend, &dp)
+
+	err = ds.startDebug()
+	if err != nil {
+		log.Fatalf("Debug error: %s", err.Error())
+	}
+}
+
 func debugLocal(args []string) {
 	// simple pre-invalidation
 	if roundNumber < 0 {
@@ -0,0 +1,260 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/ledger"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// replayResponse is the response of the transaction replay endpoint of algod
+type replayResponse struct {
+	State ledgercore.ReplayState `codec:"state"`
+}
+
+// replayRun holds the ledger and the transaction group of a replay
+type replayRun struct {
+	ba       ledger.ReplayBalances
+	specials transactions.SpecialAddresses
+	recorded []transactions.SignedTxnWithAD
+	replayed []transactions.ApplyData
+
+	// programs are the programs of the applications of the replay state,
+	// saved so that inner application calls are shown with their states
+	programs []evaluation
+}
+
+func getReplayStateFromAlgod(algodURL string, algodToken string, round uint64, txid string) ([]byte, error) {
+	queryString := fmt.Sprintf("%s/v2/blocks/%d/transactions/%s/replay?format=msgpack", algodURL, round, txid)
+	client := &http.Client{}
+	request, err := http.NewRequest("GET", queryString, nil)
+	if err != nil {
+		return nil, fmt.Errorf("replay request error: %w", err)
+	}
+	request.Header.Set("X-Algo-API-Token", algodToken)
+	resp, err := client.Do(request)
+	if err != nil {
+		return nil, fmt.Errorf("replay request error: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != 200 {
+		msg, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("replay response error: %s, status code: %d, request: %s", string(msg), resp.StatusCode, queryString)
+	}
+	return io.ReadAll(resp.Body)
+}
+
+// replayStateFromParams decodes DebugParams.ReplayBlob as a msgpack response
+// of the transaction replay endpoint of algod
+func replayStateFromParams(dp *DebugParams) (state ledgercore.ReplayState, err error) {
+	var resp replayResponse
+	err = protocol.DecodeReflect(dp.ReplayBlob, &resp)
+	if err != nil {
+		return
+	}
+	state = resp.State
+	if len(state.Group) == 0 {
+		err = fmt.Errorf("replay state has no transactions")
+	}
+	return
+}
+
+// replayAppState returns the states of all applications of the replay state,
+// with the schemas of aidx
+func replayAppState(state *ledgercore.ReplayState, aidx basics.AppIndex, schemas basics.StateSchemas) AppState {
+	states := makeAppState()
+	states.appIdx = aidx
+	states.schemas = schemas
+	for _, acct := range state.Accounts {
+		for aid, params := range acct.AppParams {
+			if aid == aidx {
+				states.schemas = params.StateSchemas
+			}
+			states.global[aid] = params.GlobalState
+		}
+		for aid, local := range acct.AppLocalStates {
+			ls, ok := states.locals[acct.Addr]
+			if !ok {
+				ls = make(map[basics.AppIndex]basics.TealKeyValue)
+			}
+			ls[aid] = local.KeyValue
+			states.locals[acct.Addr] = ls
+		}
+	}
+	return states
+}
+
+// setupReplay prepares the replay of the transaction group of the ReplayState
+// in DebugParams.ReplayBlob. The consensus protocol is the one of the block.
+func (r *LocalRunner) setupReplay(dp *DebugParams) error {
+	state, err := replayStateFromParams(dp)
+	if err != nil {
+		return err
+	}
+
+	var ok bool
+	r.protoName = string(state.Header.CurrentProtocol)
+	r.proto, ok = config.Consensus[state.Header.CurrentProtocol]
+	if !ok {
+		return fmt.Errorf("unknown protocol %s", r.protoName)
+	}
+	log.Printf("Using proto: %s", r.protoName)
+	log.Printf("Replaying transaction %d of a group of %d in round %d", state.GroupIndex, len(state.Group), state.Header.Round)
+
+	replay := &replayRun{
+		ba: ledger.MakeReplayBalances(&state),
+		specials: transactions.SpecialAddresses{
+			FeeSink:     state.Header.FeeSink,
+			RewardsPool: state.Header.RewardsPool,
+		},
+		recorded: state.Group,
+	}
+	for _, acct := range state.Accounts {
+		for aid, params := range acct.AppParams {
+			states := replayAppState(&state, aid, params.StateSchemas)
+			replay.programs = append(replay.programs,
+				evaluation{
+					program: params.ApprovalProgram,
+					name:    fmt.Sprintf("app %d approval program", aid),
+					aidx:    aid,
+					states:  states,
+				},
+				evaluation{
+					program: params.ClearStateProgram,
+					name:    fmt.Sprintf("app %d clear state program", aid),
+					aidx:    aid,
+					states:  states,
+				})
+		}
+	}
+
+	r.txnGroup = make([]transactions.SignedTxn, len(state.Group))
+	r.runs = nil
+	for gi := range state.Group {
+		stxn := state.Group[gi].SignedTxn
+		r.txnGroup[gi] = stxn
+		if len(stxn.Lsig.Logic) > 0 {
+			r.runs = append(r.runs, evaluation{
+				program:    stxn.Lsig.Logic,
+				name:       fmt.Sprintf("transaction %d logic signature", gi),
+				groupIndex: uint64(gi),
+				mode:       modeLogicsig,
+			})
+		}
+		if stxn.Txn.Type != protocol.ApplicationCallTx {
+			continue
+		}
+
+		run := evaluation{
+			groupIndex: uint64(gi),
+			mode:       modeStateful,
+			aidx:       stxn.Txn.ApplicationID,
+		}
+		if run.aidx == 0 {
+			// app create, the recorded ApplyData has the ID of the new app
+			run.aidx = state.Group[gi].ApplyData.ApplicationID
+			run.program = stxn.Txn.ApprovalProgram
+			run.name = fmt.Sprintf("app %d approval program", run.aidx)
+			run.states = replayAppState(&state, run.aidx, basics.StateSchemas{
+				LocalStateSchema:  stxn.Txn.LocalStateSchema,
+				GlobalStateSchema: stxn.Txn.GlobalStateSchema,
+			})
+		} else {
+			program := "approval"
+			if stxn.Txn.OnCompletion == transactions.ClearStateOC {
+				program = "clear state"
+			}
+			name := fmt.Sprintf("app %d %s program", run.aidx, program)
+			for _, p := range replay.programs {
+				if p.name == name {
+					run.program = p.program
+					run.name = p.name
+					run.states = p.states
+				}
+			}
+			if len(run.program) == 0 {
+				return fmt.Errorf("no program found for app idx %d", run.aidx)
+			}
+		}
+		r.runs = append(r.runs, run)
+	}
+	r.replay = replay
+
+	if len(r.runs) == 0 {
+		return fmt.Errorf("no programs found in transactions")
+	}
+	return nil
+}
+
+// runReplay evaluates the logic signatures of the group, and then applies
+// its transactions in order, the way the block evaluator does. The programs of
+// the application calls, including inner ones, run in the debugger.
+func (r *LocalRunner) runReplay(configureDebugger func(ep *logic.EvalParams)) error {
+	if r.debugger != nil {
+		for _, p := range r.replay.programs {
+			r.debugger.SaveProgram(p.name, p.program, p.source, p.offsetToLine, p.states)
+		}
+		for _, run := range r.runs {
+			r.debugger.SaveProgram(run.name, run.program, run.source, run.offsetToLine, run.states)
+		}
+	}
+
+	sigEp := logic.NewEvalParams(transactions.WrapSignedTxnsWithAD(r.txnGroup), &r.proto, &r.replay.specials)
+	sigEp.SigLedger = logic.NoHeaderLedger{}
+	configureDebugger(sigEp)
+	for i := range r.runs {
+		run := &r.runs[i]
+		if run.mode != modeLogicsig {
+			continue
+		}
+		run.result.pass, run.result.err = run.eval(int(run.groupIndex), sigEp)
+		if run.result.err != nil || !run.result.pass {
+			log.Printf("logic signature of transaction %d rejected: %v", run.groupIndex, run.result.err)
+		}
+	}
+
+	ep := logic.NewEvalParams(transactions.WrapSignedTxnsWithAD(r.txnGroup), &r.proto, &r.replay.specials)
+	configureDebugger(ep)
+	r.replay.replayed = make([]transactions.ApplyData, len(r.txnGroup))
+	for gi := range r.txnGroup {
+		ad, err := r.replay.ba.Replay(gi, ep)
+		for i := range r.runs {
+			run := &r.runs[i]
+			if run.mode == modeStateful && run.groupIndex == uint64(gi) {
+				run.result.pass, run.result.err = err == nil, err
+			}
+		}
+		if err != nil {
+			return fmt.Errorf("transaction %d failed: %w", gi, err)
+		}
+		r.replay.replayed[gi] = ad
+		if !ad.Equal(r.replay.recorded[gi].ApplyData) {
+			log.Printf("transaction %d: replayed ApplyData differs from the one recorded in the block", gi)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,136 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestReplay(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	a := require.New(t)
+
+	ops, err := logic.AssembleString(`#pragma version 6
+byte "n"
+dup
+app_global_get
+int 1
++
+app_global_put
+itxn_begin
+int pay
+itxn_field TypeEnum
+txn Sender
+itxn_field Receiver
+int 1000
+itxn_field Amount
+itxn_submit
+int 1`)
+	a.NoError(err)
+
+	creator := basics.Address{1}
+	feeSink := basics.Address{2}
+	appIdx := basics.AppIndex(100)
+	schema := basics.StateSchema{NumUint: 1}
+
+	var creatorData ledgercore.AccountData
+	creatorData.MicroAlgos.Raw = 10_000_000
+	creatorData.TotalAppParams = 1
+	creatorData.TotalAppSchema = schema
+	var appData ledgercore.AccountData
+	appData.MicroAlgos.Raw = 1_000_000
+
+	call := transactions.SignedTxn{
+		Txn: transactions.Transaction{
+			Type: protocol.ApplicationCallTx,
+			Header: transactions.Header{
+				Sender:     creator,
+				Fee:        basics.MicroAlgos{Raw: 2000},
+				FirstValid: 9,
+				LastValid:  20,
+			},
+			ApplicationCallTxnFields: transactions.ApplicationCallTxnFields{
+				ApplicationID: appIdx,
+			},
+		},
+	}
+	state := ledgercore.ReplayState{
+		Header: bookkeeping.BlockHeader{
+			Round: 10,
+			RewardsState: bookkeeping.RewardsState{
+				FeeSink: feeSink,
+			},
+			UpgradeState: bookkeeping.UpgradeState{
+				CurrentProtocol: protocol.ConsensusCurrentVersion,
+			},
+		},
+		TxnCounter: 200,
+		Group:      []transactions.SignedTxnWithAD{{SignedTxn: call}},
+		Accounts: []ledgercore.ReplayAccount{
+			{
+				Addr:        creator,
+				AccountData: creatorData,
+				AppParams: map[basics.AppIndex]basics.AppParams{
+					appIdx: {
+						ApprovalProgram:   ops.Program,
+						ClearStateProgram: ops.Program,
+						GlobalState:       basics.TealKeyValue{"n": {Type: basics.TealUintType, Uint: 41}},
+						StateSchemas:      basics.StateSchemas{GlobalStateSchema: schema},
+					},
+				},
+			},
+			{Addr: appIdx.Address(), AccountData: appData},
+		},
+	}
+
+	dp := DebugParams{ReplayBlob: protocol.EncodeReflect(replayResponse{State: state})}
+	local := MakeLocalRunner(nil) // no debugger
+	err = local.Setup(&dp)
+	a.NoError(err)
+	a.Len(local.runs, 1)
+	a.Equal(appIdx, local.runs[0].aidx)
+	a.Equal("app 100 approval program", local.runs[0].name)
+	a.Equal(uint64(41), local.runs[0].states.global[appIdx]["n"].Uint)
+
+	r := runAllResultFromInvocation(*local)
+	a.Equal(allPassing(len(local.runs)), r)
+
+	a.Len(local.replay.replayed, 1)
+	ad := local.replay.replayed[0]
+	a.Equal(basics.StateDelta{"n": {Action: basics.SetUintAction, Uint: 42}}, ad.EvalDelta.GlobalDelta)
+	a.Len(ad.EvalDelta.InnerTxns, 1)
+	a.Equal(protocol.PaymentTx, ad.EvalDelta.InnerTxns[0].Txn.Type)
+	a.Equal(creator, ad.EvalDelta.InnerTxns[0].Txn.Receiver)
+
+	// the sender paid the fees and got the inner payment back
+	acct, err := local.replay.ba.Get(creator, false)
+	a.NoError(err)
+	a.Equal(uint64(10_000_000-2000+1000), acct.MicroAlgos.Raw)
+
+	_, err = replayStateFromParams(&DebugParams{ReplayBlob: protocol.EncodeReflect(replayResponse{})})
+	a.Error(err)
+}
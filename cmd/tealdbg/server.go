@@ -96,6 +96,7 @@ type DebugParams struct {
 	AppID            uint64
 	Painless         bool
 	ListenForDrReq   bool
+	ReplayBlob       []byte
 }
 
 // FrontendFactory interface for attaching debug frontends
@@ -108,3 +108,8 @@ func (m *MockCatchpointCatchupAccessor) CompleteCatchup(ctx context.Context) (er
 func (m *MockCatchpointCatchupAccessor) Ledger() (l ledger.CatchupAccessorClientLedger) {
 	return nil
 }
+
+// CatchpointFileDownloadPath returns the path the catchpoint file of the given round is downloaded to
+func (m *MockCatchpointCatchupAccessor) CatchpointFileDownloadPath(round basics.Round) string {
+	return ""
+}
@@ -72,6 +72,10 @@ const StateProofFileName = "stateproof.sqlite"
 // It is used for tracking participation key metadata.
 const ParticipationRegistryFilename = "partregistry.sqlite"
 
+// TxPoolFilename is the name of the transaction pool file.
+// It is used to keep the pending transactions across node restarts.
+const TxPoolFilename = "txpool.msgp"
+
 // ConfigurableConsensusProtocolsFilename defines a set of consensus prototocols that
 // are to be loaded from the data directory ( if present ), to override the
 // built-in supported consensus protocols.
@@ -604,3 +604,21 @@ func TestLocal_TxFiltering(t *testing.T) {
 	require.True(t, cfg.TxFilterRawMsgEnabled())
 	require.True(t, cfg.TxFilterCanonicalEnabled())
 }
+
+func TestLocal_RestTLSFiles(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	cfg := GetDefaultLocal()
+	certFile, keyFile, adminClientCAFile := cfg.RestTLSFiles("/data")
+	require.Empty(t, certFile)
+	require.Empty(t, keyFile)
+	require.Empty(t, adminClientCAFile)
+
+	cfg.RestTLSCertFile = "algod.crt"
+	cfg.RestTLSKeyFile = "/etc/algod/algod.key"
+	cfg.RestTLSAdminClientCAFile = filepath.Join("certs", "admin-ca.crt")
+	certFile, keyFile, adminClientCAFile = cfg.RestTLSFiles("/data")
+	require.Equal(t, filepath.Join("/data", "algod.crt"), certFile)
+	require.Equal(t, "/etc/algod/algod.key", keyFile)
+	require.Equal(t, filepath.Join("/data", "certs", "admin-ca.crt"), adminClientCAFile)
+}
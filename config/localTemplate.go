@@ -41,7 +41,7 @@ type Local struct {
 	// Version tracks the current version of the defaults so we can migrate old -> new
 	// This is specifically important whenever we decide to change the default value
 	// for an existing parameter. This field tag must be updated any time we add a new version.
-	Version uint32 `version[0]:"0" version[1]:"1" version[2]:"2" version[3]:"3" version[4]:"4" version[5]:"5" version[6]:"6" version[7]:"7" version[8]:"8" version[9]:"9" version[10]:"10" version[11]:"11" version[12]:"12" version[13]:"13" version[14]:"14" version[15]:"15" version[16]:"16" version[17]:"17" version[18]:"18" version[19]:"19" version[20]:"20" version[21]:"21" version[22]:"22" version[23]:"23" version[24]:"24" version[25]:"25" version[26]:"26"`
+	Version uint32 `version[0]:"0" version[1]:"1" version[2]:"2" version[3]:"3" version[4]:"4" version[5]:"5" version[6]:"6" version[7]:"7" version[8]:"8" version[9]:"9" version[10]:"10" version[11]:"11" version[12]:"12" version[13]:"13" version[14]:"14" version[15]:"15" version[16]:"16" version[17]:"17" version[18]:"18" version[19]:"19" version[20]:"20" version[21]:"21" version[22]:"22" version[23]:"23" version[24]:"24" version[25]:"25" version[26]:"26" version[27]:"27"`
 
 	// environmental (may be overridden)
 	// When enabled, stores blocks indefinitely, otherwise, only the most recent blocks
@@ -467,6 +467,73 @@ type Local struct {
 	// 0x01 (txFilterRawMsg) - check for raw tx message duplicates
 	// 0x02 (txFilterCanonical) - check for canonical tx group duplicates
 	TxIncomingFilteringFlags uint32 `version[26]:"1"`
+
+	// CatchupBlockArchives is a comma delimited list of block archives, laid out in the catchupsrv directory format,
+	// which the catchup service prefers over the network peers when fetching blocks. Each archive is either a local
+	// directory, given as a path or a file:// URL, or an S3-compatible bucket, given as s3://bucket/prefix. The endpoint
+	// and region of an S3-compatible bucket can be set by the endpoint and region URL query parameters.
+	CatchupBlockArchives string `version[27]:""`
+
+	// CatchpointFileDownloadPeers is the number of peers the catchpoint file is downloaded from in parallel during
+	// catchpoint catchup. When non-zero, the catchpoint file is downloaded to disk using HTTP range requests, so that an
+	// interrupted download can be resumed, before being loaded into the ledger. When zero, the catchpoint file is streamed
+	// into the ledger from a single peer, and an interrupted download starts over.
+	CatchpointFileDownloadPeers uint64 `version[27]:"0"`
+
+	// EnableTxHandlerStreamVerifier makes the transaction handler verify the incoming transaction groups with a stream
+	// verifier, which batches the signatures of consecutive groups together, rather than verifying each group on its own.
+	EnableTxHandlerStreamVerifier bool `version[27]:"false"`
+
+	// EnablePersistentVerifiedTxnCache makes the ledger keep the verified transactions cache in a database next to the ledger
+	// databases, so that the transactions verified before a restart are not verified again when they appear in a block.
+	// The persisted transactions are deleted once their last valid round has passed.
+	EnablePersistentVerifiedTxnCache bool `version[27]:"false"`
+
+	// EnableTxPoolPersistence makes the node save the pending transaction groups of the transaction pool into the
+	// data directory every TxPoolPersistenceIntervalSeconds seconds, as well as when the node is shutting down. When
+	// the node starts, the saved transaction groups that are still valid are verified and added back to the pool.
+	EnableTxPoolPersistence bool `version[27]:"false"`
+
+	// TxPoolPersistenceIntervalSeconds is the number of seconds between the saves of the transaction pool, when
+	// EnableTxPoolPersistence is set.
+	TxPoolPersistenceIntervalSeconds int64 `version[27]:"60"`
+
+	// RestRateLimitPerToken is the number of request cost units per second each API token is allowed on the public REST
+	// API. Most requests cost a single unit, while the expensive ones, like dryruns, cost more. 0 disables the limit.
+	RestRateLimitPerToken uint64 `version[27]:"0"`
+
+	// RestRateLimitPerIP is the number of request cost units per second each IP address is allowed on the public REST
+	// API, regardless of the API tokens it uses. 0 disables the limit.
+	RestRateLimitPerIP uint64 `version[27]:"0"`
+
+	// RestRateLimitBurst is the number of request cost units an API token, or an IP address, can use at once before
+	// being limited to the rates above. 0 makes it the same as the rate per second.
+	RestRateLimitBurst uint64 `version[27]:"0"`
+
+	// RestTLSCertFile is the path of the PEM encoded certificate the REST API is served with over TLS, instead of
+	// plain HTTP, once it is set along with RestTLSKeyFile. Relative paths are resolved against the data directory.
+	// The certificate and key are reloaded from their files when algod receives a SIGHUP.
+	RestTLSCertFile string `version[27]:""`
+
+	// RestTLSKeyFile is the path of the PEM encoded private key of RestTLSCertFile.
+	RestTLSKeyFile string `version[27]:""`
+
+	// RestTLSAdminClientCAFile is the path of the PEM encoded certificate authorities the admin REST API clients'
+	// certificates are verified against. Once set, the admin API requires a verified client certificate, in addition
+	// to the admin API token. It requires RestTLSCertFile and RestTLSKeyFile to be set.
+	RestTLSAdminClientCAFile string `version[27]:""`
+}
+
+// RestTLSFiles returns the paths of the REST API TLS certificate, key and admin client certificate authorities
+// files, with the relative paths resolved against the given data directory.
+func (cfg Local) RestTLSFiles(dataDir string) (certFile, keyFile, adminClientCAFile string) {
+	resolve := func(path string) string {
+		if path == "" || filepath.IsAbs(path) {
+			return path
+		}
+		return filepath.Join(dataDir, path)
+	}
+	return resolve(cfg.RestTLSCertFile), resolve(cfg.RestTLSKeyFile), resolve(cfg.RestTLSAdminClientCAFile)
 }
 
 // DNSBootstrapArray returns an array of one or more DNS Bootstrap identifiers
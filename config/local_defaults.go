@@ -20,7 +20,7 @@
 package config
 
 var defaultLocal = Local{
-	Version:                                    26,
+	Version:                                    27,
 	AccountUpdatesStatsInterval:                5000000000,
 	AccountsRebuildSynchronousMode:             1,
 	AgreementIncomingBundlesQueueLength:        7,
@@ -32,9 +32,11 @@ var defaultLocal = Local{
 	BlockServiceCustomFallbackEndpoints:        "",
 	BroadcastConnectionsLimit:                  -1,
 	CadaverSizeTarget:                          0,
+	CatchpointFileDownloadPeers:                0,
 	CatchpointFileHistoryLength:                365,
 	CatchpointInterval:                         10000,
 	CatchpointTracking:                         0,
+	CatchupBlockArchives:                       "",
 	CatchupBlockDownloadRetryAttempts:          1000,
 	CatchupBlockValidateMode:                   0,
 	CatchupFailurePeerRefreshRate:              10,
@@ -64,12 +66,15 @@ var defaultLocal = Local{
 	EnableLedgerService:                        false,
 	EnableMetricReporting:                      false,
 	EnableOutgoingNetworkMessageFiltering:      true,
+	EnablePersistentVerifiedTxnCache:           false,
 	EnablePingHandler:                          true,
 	EnableProcessBlockStats:                    false,
 	EnableProfiler:                             false,
 	EnableRequestLogger:                        false,
 	EnableRuntimeMetrics:                       false,
 	EnableTopAccountsReporting:                 false,
+	EnableTxHandlerStreamVerifier:              false,
+	EnableTxPoolPersistence:                    false,
 	EnableUsageLog:                             false,
 	EnableVerbosedTransactionSyncLogging:       false,
 	EndpointAddress:                            "127.0.0.1:0",
@@ -109,7 +114,13 @@ var defaultLocal = Local{
 	ReservedFDs:                                256,
 	RestConnectionsHardLimit:                   2048,
 	RestConnectionsSoftLimit:                   1024,
+	RestRateLimitBurst:                         0,
+	RestRateLimitPerIP:                         0,
+	RestRateLimitPerToken:                      0,
 	RestReadTimeoutSeconds:                     15,
+	RestTLSAdminClientCAFile:                   "",
+	RestTLSCertFile:                            "",
+	RestTLSKeyFile:                             "",
 	RestWriteTimeoutSeconds:                    120,
 	RunHosted:                                  false,
 	SuggestedFeeBlockHistory:                   3,
@@ -121,6 +132,7 @@ var defaultLocal = Local{
 	TransactionSyncSignificantMessageThreshold: 0,
 	TxIncomingFilteringFlags:                   1,
 	TxPoolExponentialIncreaseFactor:            2,
+	TxPoolPersistenceIntervalSeconds:           60,
 	TxPoolSize:                                 75000,
 	TxSyncIntervalSeconds:                      60,
 	TxSyncServeResponseSize:                    1000000,
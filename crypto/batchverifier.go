@@ -104,8 +104,8 @@ func (b *BatchVerifier) expand() {
 	b.signatures = signatures
 }
 
-// getNumberOfEnqueuedSignatures returns the number of signatures current enqueue onto the batch verifier object
-func (b *BatchVerifier) getNumberOfEnqueuedSignatures() int {
+// GetNumberOfEnqueuedSignatures returns the number of signatures currently enqueued onto the batch verifier object
+func (b *BatchVerifier) GetNumberOfEnqueuedSignatures() int {
 	return len(b.messages)
 }
 
@@ -120,10 +120,10 @@ func (b *BatchVerifier) Verify() error {
 // if some signatures are invalid, true will be set in failed at the corresponding indexes, and
 // ErrBatchVerificationFailed for err
 func (b *BatchVerifier) VerifyWithFeedback() (failed []bool, err error) {
-	if b.getNumberOfEnqueuedSignatures() == 0 {
+	if b.GetNumberOfEnqueuedSignatures() == 0 {
 		return nil, nil
 	}
-	var messages = make([][]byte, b.getNumberOfEnqueuedSignatures())
+	var messages = make([][]byte, b.GetNumberOfEnqueuedSignatures())
 	for i, m := range b.messages {
 		messages[i] = HashRep(m)
 	}
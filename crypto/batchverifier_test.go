@@ -64,7 +64,7 @@ func TestBatchVerifierBulk(t *testing.T) {
 			sig := sigSecrets.Sign(msg)
 			bv.EnqueueSignature(sigSecrets.SignatureVerifier, msg, sig)
 		}
-		require.Equal(t, n, bv.getNumberOfEnqueuedSignatures())
+		require.Equal(t, n, bv.GetNumberOfEnqueuedSignatures())
 		require.NoError(t, bv.Verify())
 	}
 
@@ -155,7 +155,7 @@ func TestBatchVerifierIndividualResults(t *testing.T) {
 			}
 			bv.EnqueueSignature(sigSecrets.SignatureVerifier, msg, sig)
 		}
-		require.Equal(t, n, bv.getNumberOfEnqueuedSignatures())
+		require.Equal(t, n, bv.GetNumberOfEnqueuedSignatures())
 		failed, err := bv.VerifyWithFeedback()
 		if hasBadSig {
 			require.ErrorIs(t, err, ErrBatchVerificationFailed)
@@ -185,10 +185,10 @@ func TestBatchVerifierIndividualResultsAllValid(t *testing.T) {
 			sig := sigSecrets.Sign(msg)
 			bv.EnqueueSignature(sigSecrets.SignatureVerifier, msg, sig)
 		}
-		require.Equal(t, n, bv.getNumberOfEnqueuedSignatures())
+		require.Equal(t, n, bv.GetNumberOfEnqueuedSignatures())
 		failed, err := bv.VerifyWithFeedback()
 		require.NoError(t, err)
-		require.Equal(t, bv.getNumberOfEnqueuedSignatures(), len(failed))
+		require.Equal(t, bv.GetNumberOfEnqueuedSignatures(), len(failed))
 		for _, f := range failed {
 			require.False(t, f)
 		}
@@ -0,0 +1,849 @@
+package merkletrie
+
+// Code generated by github.com/algorand/msgp DO NOT EDIT.
+
+import (
+	"github.com/algorand/msgp/msgp"
+)
+
+// The following msgp objects are implemented in this file:
+// Proof
+//   |-----> (*) MarshalMsg
+//   |-----> (*) CanMarshalMsg
+//   |-----> (*) UnmarshalMsg
+//   |-----> (*) CanUnmarshalMsg
+//   |-----> (*) Msgsize
+//   |-----> (*) MsgIsZero
+//
+// ProofChild
+//      |-----> (*) MarshalMsg
+//      |-----> (*) CanMarshalMsg
+//      |-----> (*) UnmarshalMsg
+//      |-----> (*) CanUnmarshalMsg
+//      |-----> (*) Msgsize
+//      |-----> (*) MsgIsZero
+//
+// ProofNode
+//     |-----> (*) MarshalMsg
+//     |-----> (*) CanMarshalMsg
+//     |-----> (*) UnmarshalMsg
+//     |-----> (*) CanUnmarshalMsg
+//     |-----> (*) Msgsize
+//     |-----> (*) MsgIsZero
+//
+// storedNodeIdentifier
+//           |-----> MarshalMsg
+//           |-----> CanMarshalMsg
+//           |-----> (*) UnmarshalMsg
+//           |-----> (*) CanUnmarshalMsg
+//           |-----> Msgsize
+//           |-----> MsgIsZero
+//
+
+// MarshalMsg implements msgp.Marshaler
+func (z *Proof) MarshalMsg(b []byte) (o []byte) {
+	o = msgp.Require(b, z.Msgsize())
+	// omitempty: check for empty values
+	zb0003Len := uint32(3)
+	var zb0003Mask uint8 /* 4 bits */
+	if (*z).LeafEnd == false {
+		zb0003Len--
+		zb0003Mask |= 0x2
+	}
+	if len((*z).Leaf) == 0 {
+		zb0003Len--
+		zb0003Mask |= 0x4
+	}
+	if len((*z).Nodes) == 0 {
+		zb0003Len--
+		zb0003Mask |= 0x8
+	}
+	// variable map header, size zb0003Len
+	o = append(o, 0x80|uint8(zb0003Len))
+	if zb0003Len != 0 {
+		if (zb0003Mask & 0x2) == 0 { // if not empty
+			// string "le"
+			o = append(o, 0xa2, 0x6c, 0x65)
+			o = msgp.AppendBool(o, (*z).LeafEnd)
+		}
+		if (zb0003Mask & 0x4) == 0 { // if not empty
+			// string "lf"
+			o = append(o, 0xa2, 0x6c, 0x66)
+			o = msgp.AppendBytes(o, (*z).Leaf)
+		}
+		if (zb0003Mask & 0x8) == 0 { // if not empty
+			// string "n"
+			o = append(o, 0xa1, 0x6e)
+			if (*z).Nodes == nil {
+				o = msgp.AppendNil(o)
+			} else {
+				o = msgp.AppendArrayHeader(o, uint32(len((*z).Nodes)))
+			}
+			for zb0001 := range (*z).Nodes {
+				// omitempty: check for empty values
+				zb0004Len := uint32(1)
+				var zb0004Mask uint8 /* 2 bits */
+				if len((*z).Nodes[zb0001].Children) == 0 {
+					zb0004Len--
+					zb0004Mask |= 0x2
+				}
+				// variable map header, size zb0004Len
+				o = append(o, 0x80|uint8(zb0004Len))
+				if (zb0004Mask & 0x2) == 0 { // if not empty
+					// string "c"
+					o = append(o, 0xa1, 0x63)
+					if (*z).Nodes[zb0001].Children == nil {
+						o = msgp.AppendNil(o)
+					} else {
+						o = msgp.AppendArrayHeader(o, uint32(len((*z).Nodes[zb0001].Children)))
+					}
+					for zb0002 := range (*z).Nodes[zb0001].Children {
+						o = (*z).Nodes[zb0001].Children[zb0002].MarshalMsg(o)
+					}
+				}
+			}
+		}
+	}
+	return
+}
+
+func (_ *Proof) CanMarshalMsg(z interface{}) bool {
+	_, ok := (z).(*Proof)
+	return ok
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *Proof) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0003 int
+	var zb0004 bool
+	zb0003, zb0004, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if _, ok := err.(msgp.TypeError); ok {
+		zb0003, zb0004, bts, err = msgp.ReadArrayHeaderBytes(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		if zb0003 > 0 {
+			zb0003--
+			var zb0005 int
+			var zb0006 bool
+			zb0005, zb0006, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "Nodes")
+				return
+			}
+			if zb0005 > MaxProofElementLength {
+				err = msgp.ErrOverflow(uint64(zb0005), uint64(MaxProofElementLength))
+				err = msgp.WrapError(err, "struct-from-array", "Nodes")
+				return
+			}
+			if zb0006 {
+				(*z).Nodes = nil
+			} else if (*z).Nodes != nil && cap((*z).Nodes) >= zb0005 {
+				(*z).Nodes = ((*z).Nodes)[:zb0005]
+			} else {
+				(*z).Nodes = make([]ProofNode, zb0005)
+			}
+			for zb0001 := range (*z).Nodes {
+				var zb0007 int
+				var zb0008 bool
+				zb0007, zb0008, bts, err = msgp.ReadMapHeaderBytes(bts)
+				if _, ok := err.(msgp.TypeError); ok {
+					zb0007, zb0008, bts, err = msgp.ReadArrayHeaderBytes(bts)
+					if err != nil {
+						err = msgp.WrapError(err, "struct-from-array", "Nodes", zb0001)
+						return
+					}
+					if zb0007 > 0 {
+						zb0007--
+						var zb0009 int
+						var zb0010 bool
+						zb0009, zb0010, bts, err = msgp.ReadArrayHeaderBytes(bts)
+						if err != nil {
+							err = msgp.WrapError(err, "struct-from-array", "Nodes", zb0001, "struct-from-array", "Children")
+							return
+						}
+						if zb0009 > 256 {
+							err = msgp.ErrOverflow(uint64(zb0009), uint64(256))
+							err = msgp.WrapError(err, "struct-from-array", "Nodes", zb0001, "struct-from-array", "Children")
+							return
+						}
+						if zb0010 {
+							(*z).Nodes[zb0001].Children = nil
+						} else if (*z).Nodes[zb0001].Children != nil && cap((*z).Nodes[zb0001].Children) >= zb0009 {
+							(*z).Nodes[zb0001].Children = ((*z).Nodes[zb0001].Children)[:zb0009]
+						} else {
+							(*z).Nodes[zb0001].Children = make([]ProofChild, zb0009)
+						}
+						for zb0002 := range (*z).Nodes[zb0001].Children {
+							bts, err = (*z).Nodes[zb0001].Children[zb0002].UnmarshalMsg(bts)
+							if err != nil {
+								err = msgp.WrapError(err, "struct-from-array", "Nodes", zb0001, "struct-from-array", "Children", zb0002)
+								return
+							}
+						}
+					}
+					if zb0007 > 0 {
+						err = msgp.ErrTooManyArrayFields(zb0007)
+						if err != nil {
+							err = msgp.WrapError(err, "struct-from-array", "Nodes", zb0001, "struct-from-array")
+							return
+						}
+					}
+				} else {
+					if err != nil {
+						err = msgp.WrapError(err, "struct-from-array", "Nodes", zb0001)
+						return
+					}
+					if zb0008 {
+						(*z).Nodes[zb0001] = ProofNode{}
+					}
+					for zb0007 > 0 {
+						zb0007--
+						field, bts, err = msgp.ReadMapKeyZC(bts)
+						if err != nil {
+							err = msgp.WrapError(err, "struct-from-array", "Nodes", zb0001)
+							return
+						}
+						switch string(field) {
+						case "c":
+							var zb0011 int
+							var zb0012 bool
+							zb0011, zb0012, bts, err = msgp.ReadArrayHeaderBytes(bts)
+							if err != nil {
+								err = msgp.WrapError(err, "struct-from-array", "Nodes", zb0001, "Children")
+								return
+							}
+							if zb0011 > 256 {
+								err = msgp.ErrOverflow(uint64(zb0011), uint64(256))
+								err = msgp.WrapError(err, "struct-from-array", "Nodes", zb0001, "Children")
+								return
+							}
+							if zb0012 {
+								(*z).Nodes[zb0001].Children = nil
+							} else if (*z).Nodes[zb0001].Children != nil && cap((*z).Nodes[zb0001].Children) >= zb0011 {
+								(*z).Nodes[zb0001].Children = ((*z).Nodes[zb0001].Children)[:zb0011]
+							} else {
+								(*z).Nodes[zb0001].Children = make([]ProofChild, zb0011)
+							}
+							for zb0002 := range (*z).Nodes[zb0001].Children {
+								bts, err = (*z).Nodes[zb0001].Children[zb0002].UnmarshalMsg(bts)
+								if err != nil {
+									err = msgp.WrapError(err, "struct-from-array", "Nodes", zb0001, "Children", zb0002)
+									return
+								}
+							}
+						default:
+							err = msgp.ErrNoField(string(field))
+							if err != nil {
+								err = msgp.WrapError(err, "struct-from-array", "Nodes", zb0001)
+								return
+							}
+						}
+					}
+				}
+			}
+		}
+		if zb0003 > 0 {
+			zb0003--
+			(*z).LeafEnd, bts, err = msgp.ReadBoolBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "LeafEnd")
+				return
+			}
+		}
+		if zb0003 > 0 {
+			zb0003--
+			var zb0013 int
+			zb0013, err = msgp.ReadBytesBytesHeader(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "Leaf")
+				return
+			}
+			if zb0013 > MaxProofElementLength {
+				err = msgp.ErrOverflow(uint64(zb0013), uint64(MaxProofElementLength))
+				return
+			}
+			(*z).Leaf, bts, err = msgp.ReadBytesBytes(bts, (*z).Leaf)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "Leaf")
+				return
+			}
+		}
+		if zb0003 > 0 {
+			err = msgp.ErrTooManyArrayFields(zb0003)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array")
+				return
+			}
+		}
+	} else {
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		if zb0004 {
+			(*z) = Proof{}
+		}
+		for zb0003 > 0 {
+			zb0003--
+			field, bts, err = msgp.ReadMapKeyZC(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+			switch string(field) {
+			case "n":
+				var zb0014 int
+				var zb0015 bool
+				zb0014, zb0015, bts, err = msgp.ReadArrayHeaderBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Nodes")
+					return
+				}
+				if zb0014 > MaxProofElementLength {
+					err = msgp.ErrOverflow(uint64(zb0014), uint64(MaxProofElementLength))
+					err = msgp.WrapError(err, "Nodes")
+					return
+				}
+				if zb0015 {
+					(*z).Nodes = nil
+				} else if (*z).Nodes != nil && cap((*z).Nodes) >= zb0014 {
+					(*z).Nodes = ((*z).Nodes)[:zb0014]
+				} else {
+					(*z).Nodes = make([]ProofNode, zb0014)
+				}
+				for zb0001 := range (*z).Nodes {
+					var zb0016 int
+					var zb0017 bool
+					zb0016, zb0017, bts, err = msgp.ReadMapHeaderBytes(bts)
+					if _, ok := err.(msgp.TypeError); ok {
+						zb0016, zb0017, bts, err = msgp.ReadArrayHeaderBytes(bts)
+						if err != nil {
+							err = msgp.WrapError(err, "Nodes", zb0001)
+							return
+						}
+						if zb0016 > 0 {
+							zb0016--
+							var zb0018 int
+							var zb0019 bool
+							zb0018, zb0019, bts, err = msgp.ReadArrayHeaderBytes(bts)
+							if err != nil {
+								err = msgp.WrapError(err, "Nodes", zb0001, "struct-from-array", "Children")
+								return
+							}
+							if zb0018 > 256 {
+								err = msgp.ErrOverflow(uint64(zb0018), uint64(256))
+								err = msgp.WrapError(err, "Nodes", zb0001, "struct-from-array", "Children")
+								return
+							}
+							if zb0019 {
+								(*z).Nodes[zb0001].Children = nil
+							} else if (*z).Nodes[zb0001].Children != nil && cap((*z).Nodes[zb0001].Children) >= zb0018 {
+								(*z).Nodes[zb0001].Children = ((*z).Nodes[zb0001].Children)[:zb0018]
+							} else {
+								(*z).Nodes[zb0001].Children = make([]ProofChild, zb0018)
+							}
+							for zb0002 := range (*z).Nodes[zb0001].Children {
+								bts, err = (*z).Nodes[zb0001].Children[zb0002].UnmarshalMsg(bts)
+								if err != nil {
+									err = msgp.WrapError(err, "Nodes", zb0001, "struct-from-array", "Children", zb0002)
+									return
+								}
+							}
+						}
+						if zb0016 > 0 {
+							err = msgp.ErrTooManyArrayFields(zb0016)
+							if err != nil {
+								err = msgp.WrapError(err, "Nodes", zb0001, "struct-from-array")
+								return
+							}
+						}
+					} else {
+						if err != nil {
+							err = msgp.WrapError(err, "Nodes", zb0001)
+							return
+						}
+						if zb0017 {
+							(*z).Nodes[zb0001] = ProofNode{}
+						}
+						for zb0016 > 0 {
+							zb0016--
+							field, bts, err = msgp.ReadMapKeyZC(bts)
+							if err != nil {
+								err = msgp.WrapError(err, "Nodes", zb0001)
+								return
+							}
+							switch string(field) {
+							case "c":
+								var zb0020 int
+								var zb0021 bool
+								zb0020, zb0021, bts, err = msgp.ReadArrayHeaderBytes(bts)
+								if err != nil {
+									err = msgp.WrapError(err, "Nodes", zb0001, "Children")
+									return
+								}
+								if zb0020 > 256 {
+									err = msgp.ErrOverflow(uint64(zb0020), uint64(256))
+									err = msgp.WrapError(err, "Nodes", zb0001, "Children")
+									return
+								}
+								if zb0021 {
+									(*z).Nodes[zb0001].Children = nil
+								} else if (*z).Nodes[zb0001].Children != nil && cap((*z).Nodes[zb0001].Children) >= zb0020 {
+									(*z).Nodes[zb0001].Children = ((*z).Nodes[zb0001].Children)[:zb0020]
+								} else {
+									(*z).Nodes[zb0001].Children = make([]ProofChild, zb0020)
+								}
+								for zb0002 := range (*z).Nodes[zb0001].Children {
+									bts, err = (*z).Nodes[zb0001].Children[zb0002].UnmarshalMsg(bts)
+									if err != nil {
+										err = msgp.WrapError(err, "Nodes", zb0001, "Children", zb0002)
+										return
+									}
+								}
+							default:
+								err = msgp.ErrNoField(string(field))
+								if err != nil {
+									err = msgp.WrapError(err, "Nodes", zb0001)
+									return
+								}
+							}
+						}
+					}
+				}
+			case "le":
+				(*z).LeafEnd, bts, err = msgp.ReadBoolBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "LeafEnd")
+					return
+				}
+			case "lf":
+				var zb0022 int
+				zb0022, err = msgp.ReadBytesBytesHeader(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Leaf")
+					return
+				}
+				if zb0022 > MaxProofElementLength {
+					err = msgp.ErrOverflow(uint64(zb0022), uint64(MaxProofElementLength))
+					return
+				}
+				(*z).Leaf, bts, err = msgp.ReadBytesBytes(bts, (*z).Leaf)
+				if err != nil {
+					err = msgp.WrapError(err, "Leaf")
+					return
+				}
+			default:
+				err = msgp.ErrNoField(string(field))
+				if err != nil {
+					err = msgp.WrapError(err)
+					return
+				}
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+func (_ *Proof) CanUnmarshalMsg(z interface{}) bool {
+	_, ok := (z).(*Proof)
+	return ok
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *Proof) Msgsize() (s int) {
+	s = 1 + 2 + msgp.ArrayHeaderSize
+	for zb0001 := range (*z).Nodes {
+		s += 1 + 2 + msgp.ArrayHeaderSize
+		for zb0002 := range (*z).Nodes[zb0001].Children {
+			s += (*z).Nodes[zb0001].Children[zb0002].Msgsize()
+		}
+	}
+	s += 3 + msgp.BoolSize + 3 + msgp.BytesPrefixSize + len((*z).Leaf)
+	return
+}
+
+// MsgIsZero returns whether this is a zero value
+func (z *Proof) MsgIsZero() bool {
+	return (len((*z).Nodes) == 0) && ((*z).LeafEnd == false) && (len((*z).Leaf) == 0)
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *ProofChild) MarshalMsg(b []byte) (o []byte) {
+	o = msgp.Require(b, z.Msgsize())
+	// omitempty: check for empty values
+	zb0001Len := uint32(3)
+	var zb0001Mask uint8 /* 4 bits */
+	if len((*z).Hash) == 0 {
+		zb0001Len--
+		zb0001Mask |= 0x2
+	}
+	if (*z).HashIndex == 0 {
+		zb0001Len--
+		zb0001Mask |= 0x4
+	}
+	if (*z).Leaf == false {
+		zb0001Len--
+		zb0001Mask |= 0x8
+	}
+	// variable map header, size zb0001Len
+	o = append(o, 0x80|uint8(zb0001Len))
+	if zb0001Len != 0 {
+		if (zb0001Mask & 0x2) == 0 { // if not empty
+			// string "h"
+			o = append(o, 0xa1, 0x68)
+			o = msgp.AppendBytes(o, (*z).Hash)
+		}
+		if (zb0001Mask & 0x4) == 0 { // if not empty
+			// string "i"
+			o = append(o, 0xa1, 0x69)
+			o = msgp.AppendByte(o, (*z).HashIndex)
+		}
+		if (zb0001Mask & 0x8) == 0 { // if not empty
+			// string "l"
+			o = append(o, 0xa1, 0x6c)
+			o = msgp.AppendBool(o, (*z).Leaf)
+		}
+	}
+	return
+}
+
+func (_ *ProofChild) CanMarshalMsg(z interface{}) bool {
+	_, ok := (z).(*ProofChild)
+	return ok
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *ProofChild) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0001 int
+	var zb0002 bool
+	zb0001, zb0002, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if _, ok := err.(msgp.TypeError); ok {
+		zb0001, zb0002, bts, err = msgp.ReadArrayHeaderBytes(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		if zb0001 > 0 {
+			zb0001--
+			(*z).HashIndex, bts, err = msgp.ReadByteBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "HashIndex")
+				return
+			}
+		}
+		if zb0001 > 0 {
+			zb0001--
+			(*z).Leaf, bts, err = msgp.ReadBoolBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "Leaf")
+				return
+			}
+		}
+		if zb0001 > 0 {
+			zb0001--
+			var zb0003 int
+			zb0003, err = msgp.ReadBytesBytesHeader(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "Hash")
+				return
+			}
+			if zb0003 > MaxProofElementLength {
+				err = msgp.ErrOverflow(uint64(zb0003), uint64(MaxProofElementLength))
+				return
+			}
+			(*z).Hash, bts, err = msgp.ReadBytesBytes(bts, (*z).Hash)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "Hash")
+				return
+			}
+		}
+		if zb0001 > 0 {
+			err = msgp.ErrTooManyArrayFields(zb0001)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array")
+				return
+			}
+		}
+	} else {
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		if zb0002 {
+			(*z) = ProofChild{}
+		}
+		for zb0001 > 0 {
+			zb0001--
+			field, bts, err = msgp.ReadMapKeyZC(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+			switch string(field) {
+			case "i":
+				(*z).HashIndex, bts, err = msgp.ReadByteBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "HashIndex")
+					return
+				}
+			case "l":
+				(*z).Leaf, bts, err = msgp.ReadBoolBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Leaf")
+					return
+				}
+			case "h":
+				var zb0004 int
+				zb0004, err = msgp.ReadBytesBytesHeader(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Hash")
+					return
+				}
+				if zb0004 > MaxProofElementLength {
+					err = msgp.ErrOverflow(uint64(zb0004), uint64(MaxProofElementLength))
+					return
+				}
+				(*z).Hash, bts, err = msgp.ReadBytesBytes(bts, (*z).Hash)
+				if err != nil {
+					err = msgp.WrapError(err, "Hash")
+					return
+				}
+			default:
+				err = msgp.ErrNoField(string(field))
+				if err != nil {
+					err = msgp.WrapError(err)
+					return
+				}
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+func (_ *ProofChild) CanUnmarshalMsg(z interface{}) bool {
+	_, ok := (z).(*ProofChild)
+	return ok
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *ProofChild) Msgsize() (s int) {
+	s = 1 + 2 + msgp.ByteSize + 2 + msgp.BoolSize + 2 + msgp.BytesPrefixSize + len((*z).Hash)
+	return
+}
+
+// MsgIsZero returns whether this is a zero value
+func (z *ProofChild) MsgIsZero() bool {
+	return ((*z).HashIndex == 0) && ((*z).Leaf == false) && (len((*z).Hash) == 0)
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z *ProofNode) MarshalMsg(b []byte) (o []byte) {
+	o = msgp.Require(b, z.Msgsize())
+	// omitempty: check for empty values
+	zb0002Len := uint32(1)
+	var zb0002Mask uint8 /* 2 bits */
+	if len((*z).Children) == 0 {
+		zb0002Len--
+		zb0002Mask |= 0x2
+	}
+	// variable map header, size zb0002Len
+	o = append(o, 0x80|uint8(zb0002Len))
+	if zb0002Len != 0 {
+		if (zb0002Mask & 0x2) == 0 { // if not empty
+			// string "c"
+			o = append(o, 0xa1, 0x63)
+			if (*z).Children == nil {
+				o = msgp.AppendNil(o)
+			} else {
+				o = msgp.AppendArrayHeader(o, uint32(len((*z).Children)))
+			}
+			for zb0001 := range (*z).Children {
+				o = (*z).Children[zb0001].MarshalMsg(o)
+			}
+		}
+	}
+	return
+}
+
+func (_ *ProofNode) CanMarshalMsg(z interface{}) bool {
+	_, ok := (z).(*ProofNode)
+	return ok
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *ProofNode) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	var field []byte
+	_ = field
+	var zb0002 int
+	var zb0003 bool
+	zb0002, zb0003, bts, err = msgp.ReadMapHeaderBytes(bts)
+	if _, ok := err.(msgp.TypeError); ok {
+		zb0002, zb0003, bts, err = msgp.ReadArrayHeaderBytes(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		if zb0002 > 0 {
+			zb0002--
+			var zb0004 int
+			var zb0005 bool
+			zb0004, zb0005, bts, err = msgp.ReadArrayHeaderBytes(bts)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array", "Children")
+				return
+			}
+			if zb0004 > 256 {
+				err = msgp.ErrOverflow(uint64(zb0004), uint64(256))
+				err = msgp.WrapError(err, "struct-from-array", "Children")
+				return
+			}
+			if zb0005 {
+				(*z).Children = nil
+			} else if (*z).Children != nil && cap((*z).Children) >= zb0004 {
+				(*z).Children = ((*z).Children)[:zb0004]
+			} else {
+				(*z).Children = make([]ProofChild, zb0004)
+			}
+			for zb0001 := range (*z).Children {
+				bts, err = (*z).Children[zb0001].UnmarshalMsg(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "struct-from-array", "Children", zb0001)
+					return
+				}
+			}
+		}
+		if zb0002 > 0 {
+			err = msgp.ErrTooManyArrayFields(zb0002)
+			if err != nil {
+				err = msgp.WrapError(err, "struct-from-array")
+				return
+			}
+		}
+	} else {
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		if zb0003 {
+			(*z) = ProofNode{}
+		}
+		for zb0002 > 0 {
+			zb0002--
+			field, bts, err = msgp.ReadMapKeyZC(bts)
+			if err != nil {
+				err = msgp.WrapError(err)
+				return
+			}
+			switch string(field) {
+			case "c":
+				var zb0006 int
+				var zb0007 bool
+				zb0006, zb0007, bts, err = msgp.ReadArrayHeaderBytes(bts)
+				if err != nil {
+					err = msgp.WrapError(err, "Children")
+					return
+				}
+				if zb0006 > 256 {
+					err = msgp.ErrOverflow(uint64(zb0006), uint64(256))
+					err = msgp.WrapError(err, "Children")
+					return
+				}
+				if zb0007 {
+					(*z).Children = nil
+				} else if (*z).Children != nil && cap((*z).Children) >= zb0006 {
+					(*z).Children = ((*z).Children)[:zb0006]
+				} else {
+					(*z).Children = make([]ProofChild, zb0006)
+				}
+				for zb0001 := range (*z).Children {
+					bts, err = (*z).Children[zb0001].UnmarshalMsg(bts)
+					if err != nil {
+						err = msgp.WrapError(err, "Children", zb0001)
+						return
+					}
+				}
+			default:
+				err = msgp.ErrNoField(string(field))
+				if err != nil {
+					err = msgp.WrapError(err)
+					return
+				}
+			}
+		}
+	}
+	o = bts
+	return
+}
+
+func (_ *ProofNode) CanUnmarshalMsg(z interface{}) bool {
+	_, ok := (z).(*ProofNode)
+	return ok
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z *ProofNode) Msgsize() (s int) {
+	s = 1 + 2 + msgp.ArrayHeaderSize
+	for zb0001 := range (*z).Children {
+		s += (*z).Children[zb0001].Msgsize()
+	}
+	return
+}
+
+// MsgIsZero returns whether this is a zero value
+func (z *ProofNode) MsgIsZero() bool {
+	return (len((*z).Children) == 0)
+}
+
+// MarshalMsg implements msgp.Marshaler
+func (z storedNodeIdentifier) MarshalMsg(b []byte) (o []byte) {
+	o = msgp.Require(b, z.Msgsize())
+	o = msgp.AppendUint64(o, uint64(z))
+	return
+}
+
+func (_ storedNodeIdentifier) CanMarshalMsg(z interface{}) bool {
+	_, ok := (z).(storedNodeIdentifier)
+	if !ok {
+		_, ok = (z).(*storedNodeIdentifier)
+	}
+	return ok
+}
+
+// UnmarshalMsg implements msgp.Unmarshaler
+func (z *storedNodeIdentifier) UnmarshalMsg(bts []byte) (o []byte, err error) {
+	{
+		var zb0001 uint64
+		zb0001, bts, err = msgp.ReadUint64Bytes(bts)
+		if err != nil {
+			err = msgp.WrapError(err)
+			return
+		}
+		(*z) = storedNodeIdentifier(zb0001)
+	}
+	o = bts
+	return
+}
+
+func (_ *storedNodeIdentifier) CanUnmarshalMsg(z interface{}) bool {
+	_, ok := (z).(*storedNodeIdentifier)
+	return ok
+}
+
+// Msgsize returns an upper bound estimate of the number of bytes occupied by the serialized message
+func (z storedNodeIdentifier) Msgsize() (s int) {
+	s = msgp.Uint64Size
+	return
+}
+
+// MsgIsZero returns whether this is a zero value
+func (z storedNodeIdentifier) MsgIsZero() bool {
+	return z == 0
+}
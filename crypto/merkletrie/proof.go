@@ -0,0 +1,219 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package merkletrie
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/algorand/go-algorand/crypto"
+)
+
+// MaxProofElementLength is the maximal element length for which a proof could be encoded. It bounds both the
+// number of nodes on a proof path and the length of each of the hashes in it.
+const MaxProofElementLength = 256
+
+// ErrProofEmptyElement is returned when attempting to prove or verify an empty element.
+var ErrProofEmptyElement = errors.New("element is empty")
+
+// ErrProofElementTooLong is returned when attempting to prove or verify an element longer than MaxProofElementLength.
+var ErrProofElementTooLong = errors.New("element is too long")
+
+// ErrProofMalformed is returned when a proof is not consistent with the element being verified.
+var ErrProofMalformed = errors.New("malformed proof")
+
+// ErrProofRootMismatch is returned when a proof doesn't lead to the expected root hash.
+var ErrProofRootMismatch = errors.New("proof doesn't match root hash")
+
+// ProofChild is a single child entry of a non-leaf node along the proof path.
+type ProofChild struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	// HashIndex is the byte of the element that leads to this child.
+	HashIndex byte `codec:"i"`
+	// Leaf is set when the child is a leaf node.
+	Leaf bool `codec:"l"`
+	// Hash is the hash of a non-leaf child, or the remainder of the element for a leaf child. It's left
+	// empty for the child that continues the proof path, since the verifier recomputes it.
+	Hash []byte `codec:"h,allocbound=MaxProofElementLength"`
+}
+
+// ProofNode is a single non-leaf node along the proof path, starting at the root.
+type ProofNode struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	Children []ProofChild `codec:"c,allocbound=256"`
+}
+
+// Proof is used to convince a verifier that an element is, or isn't, included in a trie. The verifier
+// has a trusted value of the trie root hash.
+type Proof struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	// Nodes are the non-leaf nodes traversed while looking up the element, starting at the root.
+	Nodes []ProofNode `codec:"n,allocbound=MaxProofElementLength"`
+	// LeafEnd is set when the lookup has ended at a leaf. It's cleared if the lookup ended at
+	// a non-leaf node which has no child for the next element byte, or at an empty trie.
+	LeafEnd bool `codec:"le"`
+	// Leaf is the remainder of the element stored in the leaf the lookup has ended at.
+	Leaf []byte `codec:"lf,allocbound=MaxProofElementLength"`
+}
+
+// Prove generates a proof for the given element. The returned found flag indicates whether the proof
+// is an inclusion proof or an exclusion proof.
+func (mt *Trie) Prove(d []byte) (proof Proof, found bool, err error) {
+	if len(d) == 0 {
+		return Proof{}, false, ErrProofEmptyElement
+	}
+	if len(d) > MaxProofElementLength {
+		return Proof{}, false, ErrProofElementTooLong
+	}
+	if mt.root == storedNodeIdentifierNull {
+		return Proof{}, false, nil
+	}
+	if len(d) != mt.elementLength {
+		return Proof{}, false, ErrMismatchingElementLength
+	}
+	// the hashes of the non-leaf nodes are calculated only during commit.
+	if mt.cache.modified {
+		if _, err = mt.Commit(); err != nil {
+			return Proof{}, false, err
+		}
+	}
+	pnode, err := mt.cache.getNode(mt.root)
+	if err != nil {
+		return Proof{}, false, err
+	}
+	depth := 0
+	for !pnode.leaf() {
+		proofNode := ProofNode{Children: make([]ProofChild, len(pnode.children))}
+		var nextNode *node
+		for i, child := range pnode.children {
+			childNode, err := mt.cache.getNode(child.id)
+			if err != nil {
+				return Proof{}, false, err
+			}
+			proofNode.Children[i] = ProofChild{
+				HashIndex: child.hashIndex,
+				Leaf:      childNode.leaf(),
+			}
+			if child.hashIndex == d[depth] {
+				nextNode = childNode
+				continue
+			}
+			proofNode.Children[i].Hash = append([]byte{}, childNode.hash...)
+		}
+		proof.Nodes = append(proof.Nodes, proofNode)
+		if nextNode == nil {
+			// no child for the next byte of the element.
+			return proof, false, nil
+		}
+		pnode = nextNode
+		depth++
+	}
+	proof.LeafEnd = true
+	proof.Leaf = append([]byte{}, pnode.hash...)
+	return proof, bytes.Equal(pnode.hash, d[depth:]), nil
+}
+
+// VerifyProof verifies the given proof for the element d against the root hash. On success, the returned
+// found flag indicates whether the proof has shown the element to be included in the trie or excluded from it.
+func VerifyProof(root crypto.Digest, d []byte, proof *Proof) (found bool, err error) {
+	if len(d) == 0 {
+		return false, ErrProofEmptyElement
+	}
+	if len(d) > MaxProofElementLength {
+		return false, ErrProofElementTooLong
+	}
+	depth := len(proof.Nodes)
+	if depth == 0 {
+		if !proof.LeafEnd {
+			// an empty trie.
+			if root != (crypto.Digest{}) {
+				return false, ErrProofRootMismatch
+			}
+			return false, nil
+		}
+		if crypto.Hash(append([]byte{0}, proof.Leaf...)) != root {
+			return false, ErrProofRootMismatch
+		}
+		return bytes.Equal(proof.Leaf, d), nil
+	}
+	if depth > len(d) || (proof.LeafEnd && len(proof.Leaf) != len(d)-depth) {
+		return false, ErrProofMalformed
+	}
+
+	var childHash []byte
+	childLeaf, hasChild := false, false
+	if proof.LeafEnd {
+		childHash = proof.Leaf
+		childLeaf, hasChild = true, true
+		found = bytes.Equal(proof.Leaf, d[depth:])
+	}
+	for i := depth - 1; i >= 0; i-- {
+		hash, err := proof.Nodes[i].hash(d[:i], d[i], childHash, childLeaf, hasChild)
+		if err != nil {
+			return false, err
+		}
+		childHash = hash[:]
+		childLeaf, hasChild = false, true
+	}
+	if crypto.Hash(append([]byte{1}, childHash...)) != root {
+		return false, ErrProofRootMismatch
+	}
+	return found, nil
+}
+
+// hash calculates the hash of the proof node, in the same way node.calculateHash does, with the child at pathIndex
+// replaced by the provided one. A missing path child asserts that the node has no child at pathIndex.
+func (pn *ProofNode) hash(path []byte, pathIndex byte, pathChildHash []byte, pathChildLeaf bool, hasPathChild bool) (crypto.Digest, error) {
+	if len(pn.Children) == 0 {
+		return crypto.Digest{}, ErrProofMalformed
+	}
+	hashAccumulator := make([]byte, 0, 1+len(path)+len(pn.Children)*(3+crypto.DigestSize))
+	hashAccumulator = append(hashAccumulator, byte(len(path)))
+	hashAccumulator = append(hashAccumulator, path...)
+	pathChildSeen := false
+	for i, child := range pn.Children {
+		if i > 0 && child.HashIndex <= pn.Children[i-1].HashIndex {
+			return crypto.Digest{}, ErrProofMalformed
+		}
+		hash := child.Hash
+		leaf := child.Leaf
+		if child.HashIndex == pathIndex {
+			if !hasPathChild || len(hash) != 0 || leaf != pathChildLeaf {
+				return crypto.Digest{}, ErrProofMalformed
+			}
+			hash = pathChildHash
+			pathChildSeen = true
+		} else if !leaf && len(hash) != crypto.DigestSize {
+			return crypto.Digest{}, ErrProofMalformed
+		}
+		if leaf {
+			hashAccumulator = append(hashAccumulator, byte(0))
+		} else {
+			hashAccumulator = append(hashAccumulator, byte(1))
+		}
+		hashAccumulator = append(hashAccumulator, byte(len(hash)))
+		hashAccumulator = append(hashAccumulator, child.HashIndex)
+		hashAccumulator = append(hashAccumulator, hash...)
+	}
+	if hasPathChild && !pathChildSeen {
+		return crypto.Digest{}, ErrProofMalformed
+	}
+	return crypto.Hash(hashAccumulator), nil
+}
@@ -0,0 +1,186 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package merkletrie
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestProofEmptyTrie(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	mt, err := MakeTrie(nil, defaultTestMemoryConfig)
+	require.NoError(t, err)
+
+	hash := crypto.Hash([]byte{1})
+	proof, found, err := mt.Prove(hash[:])
+	require.NoError(t, err)
+	require.False(t, found)
+
+	found, err = VerifyProof(crypto.Digest{}, hash[:], &proof)
+	require.NoError(t, err)
+	require.False(t, found)
+
+	_, err = VerifyProof(crypto.Hash([]byte{2}), hash[:], &proof)
+	require.ErrorIs(t, err, ErrProofRootMismatch)
+}
+
+func TestProofSingleElement(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	mt, err := MakeTrie(nil, defaultTestMemoryConfig)
+	require.NoError(t, err)
+
+	hash := crypto.Hash([]byte{1})
+	added, err := mt.Add(hash[:])
+	require.NoError(t, err)
+	require.True(t, added)
+	root, err := mt.RootHash()
+	require.NoError(t, err)
+
+	proof, found, err := mt.Prove(hash[:])
+	require.NoError(t, err)
+	require.True(t, found)
+	found, err = VerifyProof(root, hash[:], &proof)
+	require.NoError(t, err)
+	require.True(t, found)
+
+	other := crypto.Hash([]byte{2})
+	proof, found, err = mt.Prove(other[:])
+	require.NoError(t, err)
+	require.False(t, found)
+	found, err = VerifyProof(root, other[:], &proof)
+	require.NoError(t, err)
+	require.False(t, found)
+}
+
+func TestProofInclusionAndExclusion(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	mt, err := MakeTrie(nil, defaultTestMemoryConfig)
+	require.NoError(t, err)
+
+	hashes := make([]crypto.Digest, 5000)
+	for i := 0; i < len(hashes); i++ {
+		hashes[i] = crypto.Hash([]byte{byte(i % 256), byte(i / 256)})
+	}
+	// add only the even hashes, so that we could generate exclusion proofs for the odd ones.
+	for i := 0; i < len(hashes); i += 2 {
+		added, err := mt.Add(hashes[i][:])
+		require.NoError(t, err)
+		require.True(t, added)
+	}
+	root, err := mt.RootHash()
+	require.NoError(t, err)
+
+	for i := 0; i < len(hashes); i++ {
+		proof, found, err := mt.Prove(hashes[i][:])
+		require.NoError(t, err)
+		require.Equal(t, i%2 == 0, found, "i=%d", i)
+
+		// the proof should survive an encoding round trip.
+		var decoded Proof
+		require.NoError(t, protocol.Decode(protocol.Encode(&proof), &decoded))
+
+		found, err = VerifyProof(root, hashes[i][:], &decoded)
+		require.NoError(t, err, "i=%d", i)
+		require.Equal(t, i%2 == 0, found, "i=%d", i)
+	}
+}
+
+func TestProofEmptyLeafRemainder(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	mt, err := MakeTrie(nil, defaultTestMemoryConfig)
+	require.NoError(t, err)
+
+	// these elements differ only in their last byte, leaving their leaves with an empty remainder.
+	elements := [][]byte{{1, 2, 3}, {1, 2, 4}, {1, 2, 5}}
+	for _, e := range elements {
+		added, err := mt.Add(e)
+		require.NoError(t, err)
+		require.True(t, added)
+	}
+	root, err := mt.RootHash()
+	require.NoError(t, err)
+
+	for _, e := range elements {
+		proof, found, err := mt.Prove(e)
+		require.NoError(t, err)
+		require.True(t, found)
+		found, err = VerifyProof(root, e, &proof)
+		require.NoError(t, err)
+		require.True(t, found)
+	}
+
+	missing := []byte{1, 2, 6}
+	proof, found, err := mt.Prove(missing)
+	require.NoError(t, err)
+	require.False(t, found)
+	found, err = VerifyProof(root, missing, &proof)
+	require.NoError(t, err)
+	require.False(t, found)
+}
+
+func TestProofTampering(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	mt, err := MakeTrie(nil, defaultTestMemoryConfig)
+	require.NoError(t, err)
+
+	hashes := make([]crypto.Digest, 1000)
+	for i := 0; i < len(hashes); i++ {
+		hashes[i] = crypto.Hash([]byte{byte(i % 256), byte(i / 256)})
+		added, err := mt.Add(hashes[i][:])
+		require.NoError(t, err)
+		require.True(t, added)
+	}
+	root, err := mt.RootHash()
+	require.NoError(t, err)
+
+	proof, found, err := mt.Prove(hashes[7][:])
+	require.NoError(t, err)
+	require.True(t, found)
+
+	// a proof for one element cannot be used for another.
+	_, err = VerifyProof(root, hashes[8][:], &proof)
+	require.Error(t, err)
+
+	// flipping a sibling hash must break the proof.
+	for _, child := range proof.Nodes[0].Children {
+		if len(child.Hash) > 0 {
+			child.Hash[0] ^= 1
+			break
+		}
+	}
+	_, err = VerifyProof(root, hashes[7][:], &proof)
+	require.ErrorIs(t, err, ErrProofRootMismatch)
+
+	// turning an inclusion proof into an exclusion proof must fail.
+	proof, _, err = mt.Prove(hashes[7][:])
+	require.NoError(t, err)
+	proof.LeafEnd = false
+	proof.Leaf = nil
+	_, err = VerifyProof(root, hashes[7][:], &proof)
+	require.ErrorIs(t, err, ErrProofMalformed)
+}
@@ -19,6 +19,7 @@ package client
 import (
 	"bytes"
 	"context"
+	"crypto/tls"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
@@ -80,6 +81,7 @@ func (e HTTPError) Error() string {
 type RestClient struct {
 	serverURL url.URL
 	apiToken  string
+	transport http.RoundTripper
 }
 
 // MakeRestClient is the factory for constructing a RestClient for a given endpoint
@@ -90,6 +92,14 @@ func MakeRestClient(url url.URL, apiToken string) RestClient {
 	}
 }
 
+// SetTLSConfig sets the TLS configuration the client connects to an https endpoint with, which allows trusting the
+// certificate authorities of nodes serving their API over TLS.
+func (client *RestClient) SetTLSConfig(tlsConfig *tls.Config) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+	client.transport = transport
+}
+
 // filterASCII filter out the non-ascii printable characters out of the given input string.
 // It's used as a security qualifier before adding network provided data into an error message.
 // The function allows only characters in the range of [32..126], which excludes all the
@@ -204,7 +214,7 @@ func (client RestClient) submitForm(response interface{}, path string, request i
 		req.Header.Set(authHeader, client.apiToken)
 	}
 
-	httpClient := &http.Client{}
+	httpClient := &http.Client{Transport: client.transport}
 	resp, err := httpClient.Do(req)
 	if err != nil {
 		return err
@@ -319,6 +329,19 @@ func (client RestClient) GetRawPendingTransactions(maxTxns uint64) (response []b
 	return
 }
 
+type transactionEventsParams struct {
+	Txids   []string `url:"txid,omitempty"`
+	Address string   `url:"address,omitempty"`
+	Timeout uint64   `url:"timeout"`
+}
+
+// WaitForTransactionEvents waits up to timeout seconds for any of the given transactions, or of the pending
+// transactions of the given address, to be committed or removed from the transaction pool
+func (client RestClient) WaitForTransactionEvents(txids []string, address string, timeout uint64) (response model.TransactionEventsResponse, err error) {
+	err = client.get(&response, "/v2/transactions/events", transactionEventsParams{Txids: txids, Address: address, Timeout: timeout})
+	return
+}
+
 // Versions retrieves the VersionResponse from the running node
 // the VersionResponse includes data like version number and genesis ID
 func (client RestClient) Versions() (response common.Version, err error) {
@@ -332,6 +355,39 @@ func (client RestClient) LedgerSupply() (response model.SupplyResponse, err erro
 	return
 }
 
+type onlineStakeHistoryParams struct {
+	MinRound uint64 `url:"min-round"`
+	MaxRound uint64 `url:"max-round"`
+}
+
+// OnlineStakeHistory gets the total online stake at the end of each of the rounds in the given range
+func (client RestClient) OnlineStakeHistory(minRound, maxRound uint64) (response model.OnlineStakeHistoryResponse, err error) {
+	err = client.get(&response, "/v2/ledger/online-stake", onlineStakeHistoryParams{MinRound: minRound, MaxRound: maxRound})
+	return
+}
+
+type topOnlineAccountsParams struct {
+	Round uint64 `url:"round"`
+	Limit uint64 `url:"limit"`
+}
+
+// TopOnlineAccounts gets the online accounts with the largest stake at the given round
+func (client RestClient) TopOnlineAccounts(round, limit uint64) (response model.TopOnlineAccountsResponse, err error) {
+	err = client.get(&response, "/v2/ledger/online-accounts/top", topOnlineAccountsParams{Round: round, Limit: limit})
+	return
+}
+
+type onlineStakeExpiryParams struct {
+	Round      uint64 `url:"round"`
+	BucketSize uint64 `url:"bucket-size"`
+}
+
+// OnlineStakeExpiry gets the online stake at the given round, grouped by participation key expiry
+func (client RestClient) OnlineStakeExpiry(round, bucketSize uint64) (response model.OnlineStakeExpiryResponse, err error) {
+	err = client.get(&response, "/v2/ledger/online-accounts/expiry", onlineStakeExpiryParams{Round: round, BucketSize: bucketSize})
+	return
+}
+
 type pendingTransactionsByAddrParams struct {
 	Max uint64 `url:"max"`
 }
@@ -554,6 +610,53 @@ func (client RestClient) Catchup(catchpointLabel string) (response model.Catchpo
 	return
 }
 
+type catchupFromFileParams struct {
+	File string `url:"file"`
+}
+
+// CatchupFromFile start catching up to the give catchpoint label, loading the catchpoint file stored at the given path
+// on the node's filesystem
+func (client RestClient) CatchupFromFile(catchpointLabel string, catchpointFile string) (response model.CatchpointStartResponse, err error) {
+	err = client.submitForm(&response, fmt.Sprintf("/v2/catchup/%s", catchpointLabel), catchupFromFileParams{File: catchpointFile}, "POST", false, true, false)
+	return
+}
+
+// GenerateDevModeBlocks generates the given number of blocks on a developer mode network
+func (client RestClient) GenerateDevModeBlocks(count uint64) (response model.DevModeRoundResponse, err error) {
+	err = client.post(&response, fmt.Sprintf("/v2/devmode/blocks/%d", count), nil)
+	return
+}
+
+// GetBlockTimeStampOffset gets the block timestamp offset of a developer mode network
+func (client RestClient) GetBlockTimeStampOffset() (response model.GetBlockTimeStampOffsetResponse, err error) {
+	err = client.get(&response, "/v2/devmode/blocks/offset", nil)
+	return
+}
+
+// SetBlockTimeStampOffset sets the block timestamp offset of a developer mode network
+func (client RestClient) SetBlockTimeStampOffset(offset uint64) (err error) {
+	err = client.submitForm(nil, fmt.Sprintf("/v2/devmode/blocks/offset/%d", offset), nil, "POST", false, false, true)
+	return
+}
+
+// CreateDevModeSnapshot creates a snapshot of a developer mode network
+func (client RestClient) CreateDevModeSnapshot() (response model.DevModeSnapshotResponse, err error) {
+	err = client.post(&response, "/v2/devmode/snapshots", nil)
+	return
+}
+
+// RevertDevModeSnapshot reverts a developer mode network to the given snapshot
+func (client RestClient) RevertDevModeSnapshot(snapshotID uint64) (response model.DevModeRoundResponse, err error) {
+	err = client.post(&response, fmt.Sprintf("/v2/devmode/snapshots/%d/revert", snapshotID), nil)
+	return
+}
+
+// RollbackLedger rolls the ledger back to the given round
+func (client RestClient) RollbackLedger(round uint64) (response model.RollbackLedgerResponse, err error) {
+	err = client.post(&response, fmt.Sprintf("/v2/ledger/rollback/%d", round), nil)
+	return
+}
+
 // GetGoRoutines gets a dump of the goroutines from pprof
 // Not supported
 func (client RestClient) GetGoRoutines(ctx context.Context) (goRoutines string, err error) {
@@ -601,7 +704,7 @@ func (client RestClient) doGetWithQuery(ctx context.Context, path string, queryA
 
 	req.Header.Set(authHeader, client.apiToken)
 
-	httpClient := http.Client{}
+	httpClient := http.Client{Transport: client.transport}
 	resp, err := httpClient.Do(req.WithContext(ctx))
 	if err != nil {
 		return
@@ -666,6 +769,12 @@ func (client RestClient) GetParticipationKeyByID(participationID string) (respon
 	return
 }
 
+// GetParticipationHistory gets the recent votes and proposals missed by a participation key
+func (client RestClient) GetParticipationHistory(participationID string) (response model.ParticipationHistoryResponse, err error) {
+	err = client.get(&response, fmt.Sprintf("/v2/participation/%s/history", participationID), nil)
+	return
+}
+
 // RemoveParticipationKeyByID removes a particiption key by its ID
 func (client RestClient) RemoveParticipationKeyByID(participationID string) (err error) {
 	err = client.delete(nil, fmt.Sprintf("/v2/participation/%s", participationID), nil, true)
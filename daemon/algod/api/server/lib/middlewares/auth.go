@@ -42,23 +42,59 @@ type AuthMiddleware struct {
 
 	// Tokens is the set of tokens which can be set to allow access.
 	tokens [][]byte
+
+	// ReadOnlyTokens is the set of tokens which can be set to allow access to the read-only requests.
+	readOnlyTokens [][]byte
+
+	// IsReadOnly tells whether a request is read-only.
+	isReadOnly func(ctx echo.Context) bool
 }
 
-// MakeAuth constructs the auth middleware function
-func MakeAuth(header string, tokens []string) echo.MiddlewareFunc {
-	apiTokenBytes := make([][]byte, 0)
+func tokensToBytes(tokens []string) [][]byte {
+	tokenBytes := make([][]byte, 0)
 	for _, token := range tokens {
-		apiTokenBytes = append(apiTokenBytes, []byte(token))
+		tokenBytes = append(tokenBytes, []byte(token))
 	}
+	return tokenBytes
+}
 
+// MakeAuth constructs the auth middleware function
+func MakeAuth(header string, tokens []string) echo.MiddlewareFunc {
+	return MakeScopedAuth(header, tokens, nil, nil)
+}
+
+// MakeScopedAuth constructs the auth middleware function, which also allows the read-only tokens to make the
+// requests that isReadOnly reports as read-only.
+func MakeScopedAuth(header string, tokens []string, readOnlyTokens []string, isReadOnly func(ctx echo.Context) bool) echo.MiddlewareFunc {
 	auth := AuthMiddleware{
-		header: header,
-		tokens: apiTokenBytes,
+		header:         header,
+		tokens:         tokensToBytes(tokens),
+		readOnlyTokens: tokensToBytes(readOnlyTokens),
+		isReadOnly:     isReadOnly,
 	}
 
 	return auth.handler
 }
 
+// getProvidedToken returns the apiToken provided in the HTTP header, as a bearer token, or in the path.
+func getProvidedToken(ctx echo.Context, header string) []byte {
+	// For debug routes, we place the apiToken in the path itself
+	if ctx.Param(TokenPathParam) != "" {
+		return []byte(ctx.Param(TokenPathParam))
+	}
+
+	// Grab the apiToken from the HTTP header, or as a bearer token
+	providedToken := []byte(ctx.Request().Header.Get(header))
+	if len(providedToken) == 0 {
+		// Accept tokens provided in a bearer token format.
+		authentication := strings.SplitN(ctx.Request().Header.Get("Authorization"), " ", 2)
+		if len(authentication) == 2 && strings.EqualFold("Bearer", authentication[0]) {
+			providedToken = []byte(authentication[1])
+		}
+	}
+	return providedToken
+}
+
 // Auth takes a logger and an array of api token and return a middleware function
 // that ensures one of the api tokens was provided.
 func (auth *AuthMiddleware) handler(next echo.HandlerFunc) echo.HandlerFunc {
@@ -68,21 +104,10 @@ func (auth *AuthMiddleware) handler(next echo.HandlerFunc) echo.HandlerFunc {
 			return next(ctx)
 		}
 
-		// Grab the apiToken from the HTTP header, or as a bearer token
-		providedToken := []byte(ctx.Request().Header.Get(auth.header))
-		if len(providedToken) == 0 {
-			// Accept tokens provided in a bearer token format.
-			authentication := strings.SplitN(ctx.Request().Header.Get("Authorization"), " ", 2)
-			if len(authentication) == 2 && strings.EqualFold("Bearer", authentication[0]) {
-				providedToken = []byte(authentication[1])
-			}
-		}
+		providedToken := getProvidedToken(ctx, auth.header)
 
 		// Handle debug routes with /urlAuth/:token prefix.
 		if ctx.Param(TokenPathParam) != "" {
-			// For debug routes, we place the apiToken in the path itself
-			providedToken = []byte(ctx.Param("token"))
-
 			// Internally, pprof matches exact routes and won't match our APIToken.
 			// We need to rewrite the requested path to exclude the token prefix.
 			// https://git.io/fp2NO
@@ -100,6 +125,13 @@ func (auth *AuthMiddleware) handler(next echo.HandlerFunc) echo.HandlerFunc {
 				return next(ctx)
 			}
 		}
+		if auth.isReadOnly != nil && auth.isReadOnly(ctx) {
+			for _, tokenBytes := range auth.readOnlyTokens {
+				if subtle.ConstantTimeCompare(providedToken, tokenBytes) == 1 {
+					return next(ctx)
+				}
+			}
+		}
 
 		return echo.NewHTTPError(http.StatusUnauthorized, InvalidTokenMessage)
 	}
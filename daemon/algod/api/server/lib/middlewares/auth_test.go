@@ -189,3 +189,38 @@ func TestAuth(t *testing.T) {
 		})
 	}
 }
+
+func TestScopedAuth(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	tokens := []string{"token1"}
+	readOnlyTokens := []string{"readonly1"}
+	isReadOnly := func(ctx echo.Context) bool {
+		return ctx.Request().Method == http.MethodGet
+	}
+	handler := MakeScopedAuth(testAPIHeader, tokens, readOnlyTokens, isReadOnly)(success)
+
+	tests := []struct {
+		token          string
+		method         string
+		expectResponse error
+	}{
+		{tokens[0], http.MethodGet, errSuccess},
+		{tokens[0], http.MethodPost, errSuccess},
+		{readOnlyTokens[0], http.MethodGet, errSuccess},
+		{readOnlyTokens[0], http.MethodPost, invalidTokenError},
+		{"invalid_token", http.MethodGet, invalidTokenError},
+	}
+	for _, test := range tests {
+		req, _ := http.NewRequest(test.method, "N/A", nil)
+		req.Header.Set(testAPIHeader, test.token)
+		ctx := e.NewContext(req, nil)
+		require.Equal(t, test.expectResponse, handler(ctx), "%s %s", test.token, test.method)
+	}
+
+	// read-only tokens are not accepted without a way to tell read-only requests apart
+	handler = MakeScopedAuth(testAPIHeader, tokens, readOnlyTokens, nil)(success)
+	req, _ := http.NewRequest(http.MethodGet, "N/A", nil)
+	req.Header.Set(testAPIHeader, readOnlyTokens[0])
+	require.Equal(t, invalidTokenError, handler(e.NewContext(req, nil)))
+}
@@ -0,0 +1,45 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package middlewares
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+// InvalidClientCertificateMessage is the message set when a verified client certificate is missing.
+const InvalidClientCertificateMessage = "Invalid Client Certificate"
+
+// MakeClientCertificateAuth constructs a middleware function which ensures the client presented a TLS certificate,
+// verified against the client certificate authorities of the server. It doesn't replace the token auth middleware.
+func MakeClientCertificateAuth() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			// OPTIONS responses never require auth
+			if ctx.Request().Method == http.MethodOptions {
+				return next(ctx)
+			}
+
+			tlsState := ctx.Request().TLS
+			if tlsState == nil || len(tlsState.VerifiedChains) == 0 {
+				return echo.NewHTTPError(http.StatusUnauthorized, InvalidClientCertificateMessage)
+			}
+			return next(ctx)
+		}
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package middlewares
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"net/http"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestClientCertificateAuth(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	invalidCertError := echo.NewHTTPError(http.StatusUnauthorized, InvalidClientCertificateMessage)
+	handler := MakeClientCertificateAuth()(success)
+
+	tests := []struct {
+		name           string
+		method         string
+		tlsState       *tls.ConnectionState
+		expectResponse error
+	}{
+		{"Plaintext", http.MethodGet, nil, invalidCertError},
+		{"No client certificate", http.MethodGet, &tls.ConnectionState{}, invalidCertError},
+		{
+			"Unverified client certificate",
+			http.MethodGet,
+			&tls.ConnectionState{PeerCertificates: []*x509.Certificate{{}}},
+			invalidCertError,
+		},
+		{
+			"Verified client certificate",
+			http.MethodGet,
+			&tls.ConnectionState{PeerCertificates: []*x509.Certificate{{}}, VerifiedChains: [][]*x509.Certificate{{{}}}},
+			errSuccess,
+		},
+		{"OPTIONS", http.MethodOptions, nil, errSuccess},
+	}
+	for _, test := range tests {
+		req, _ := http.NewRequest(test.method, "N/A", nil)
+		req.TLS = test.tlsState
+		require.Equal(t, test.expectResponse, handler(e.NewContext(req, nil)), test.name)
+	}
+}
@@ -0,0 +1,200 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package middlewares
+
+import (
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+// RateLimitedMessage is the message set when a request exceeds the rate limits.
+const RateLimitedMessage = "Rate limit exceeded"
+
+// rateLimiterSweepThreshold is the number of buckets above which the buckets that were refilled are removed.
+const rateLimiterSweepThreshold = 10000
+
+// RateLimits are the rates allowed by the rate limiter, in request cost units per second. A zero rate is unlimited.
+type RateLimits struct {
+	PerToken uint64
+	PerIP    uint64
+
+	// Burst is the number of cost units that can be used at once, which defaults to the rate.
+	Burst uint64
+}
+
+// tokenBucket holds the cost units available to a client, as of the last time it was updated.
+type tokenBucket struct {
+	available float64
+	updated   time.Time
+}
+
+type bucketSet struct {
+	rate     float64
+	capacity float64
+	buckets  map[string]*tokenBucket
+}
+
+func makeBucketSet(rate uint64, burst uint64) bucketSet {
+	capacity := burst
+	if capacity == 0 {
+		capacity = rate
+	}
+	return bucketSet{
+		rate:     float64(rate),
+		capacity: float64(capacity),
+		buckets:  make(map[string]*tokenBucket),
+	}
+}
+
+// refill returns the bucket of the given key, updated with the units accumulated since it was last updated.
+func (bs *bucketSet) refill(key string, now time.Time) *tokenBucket {
+	bucket, ok := bs.buckets[key]
+	if !ok {
+		if len(bs.buckets) >= rateLimiterSweepThreshold {
+			bs.sweep(now)
+		}
+		bucket = &tokenBucket{available: bs.capacity, updated: now}
+		bs.buckets[key] = bucket
+		return bucket
+	}
+	if now.After(bucket.updated) {
+		bucket.available = math.Min(bs.capacity, bucket.available+now.Sub(bucket.updated).Seconds()*bs.rate)
+		bucket.updated = now
+	}
+	return bucket
+}
+
+// sweep removes the buckets that would be full by now, as they are no different from new ones.
+func (bs *bucketSet) sweep(now time.Time) {
+	for key, bucket := range bs.buckets {
+		if bucket.available+now.Sub(bucket.updated).Seconds()*bs.rate >= bs.capacity {
+			delete(bs.buckets, key)
+		}
+	}
+}
+
+// wait returns how long it takes for the bucket to have the given units available.
+func (bs *bucketSet) wait(bucket *tokenBucket, cost float64) time.Duration {
+	cost = math.Min(cost, bs.capacity)
+	if bucket.available >= cost {
+		return 0
+	}
+	return time.Duration((cost - bucket.available) / bs.rate * float64(time.Second))
+}
+
+func (bs *bucketSet) take(bucket *tokenBucket, cost float64) {
+	bucket.available -= math.Min(cost, bs.capacity)
+}
+
+// rateLimiter limits the rate of the requests of each API token, and of each IP address, using token buckets.
+type rateLimiter struct {
+	header string
+	cost   func(ctx echo.Context) uint64
+	now    func() time.Time
+
+	mu           sync.Mutex
+	tokenBuckets *bucketSet
+	ipBuckets    *bucketSet
+}
+
+// MakeRateLimiter constructs the rate limiter middleware function. The cost function returns the number of units
+// each request uses, which allows weighting the expensive requests. The API tokens are looked up in the given header
+// like the auth middleware does, so the rate limiter is meant to be used after it.
+func MakeRateLimiter(header string, limits RateLimits, cost func(ctx echo.Context) uint64) echo.MiddlewareFunc {
+	return makeRateLimiter(header, limits, cost, time.Now).handler
+}
+
+func makeRateLimiter(header string, limits RateLimits, cost func(ctx echo.Context) uint64, now func() time.Time) *rateLimiter {
+	limiter := &rateLimiter{
+		header: header,
+		cost:   cost,
+		now:    now,
+	}
+	if limits.PerToken != 0 {
+		tokenBuckets := makeBucketSet(limits.PerToken, limits.Burst)
+		limiter.tokenBuckets = &tokenBuckets
+	}
+	if limits.PerIP != 0 {
+		ipBuckets := makeBucketSet(limits.PerIP, limits.Burst)
+		limiter.ipBuckets = &ipBuckets
+	}
+	return limiter
+}
+
+// allow takes the cost of a request from the buckets of its token and IP address, or returns how long to wait
+// before retrying if either of them doesn't have enough units available.
+func (limiter *rateLimiter) allow(token string, ip string, cost uint64) (retryAfter time.Duration) {
+	limiter.mu.Lock()
+	defer limiter.mu.Unlock()
+
+	now := limiter.now()
+	var byToken, byIP *tokenBucket
+	if limiter.tokenBuckets != nil {
+		byToken = limiter.tokenBuckets.refill(token, now)
+		retryAfter = limiter.tokenBuckets.wait(byToken, float64(cost))
+	}
+	if limiter.ipBuckets != nil {
+		byIP = limiter.ipBuckets.refill(ip, now)
+		if wait := limiter.ipBuckets.wait(byIP, float64(cost)); wait > retryAfter {
+			retryAfter = wait
+		}
+	}
+	if retryAfter > 0 {
+		return retryAfter
+	}
+
+	if byToken != nil {
+		limiter.tokenBuckets.take(byToken, float64(cost))
+	}
+	if byIP != nil {
+		limiter.ipBuckets.take(byIP, float64(cost))
+	}
+	return 0
+}
+
+func (limiter *rateLimiter) handler(next echo.HandlerFunc) echo.HandlerFunc {
+	if limiter.tokenBuckets == nil && limiter.ipBuckets == nil {
+		return next
+	}
+	return func(ctx echo.Context) error {
+		// OPTIONS responses are never limited, like they never require auth
+		if ctx.Request().Method == http.MethodOptions {
+			return next(ctx)
+		}
+
+		// The remote address is used rather than the forwarding headers, which the client controls.
+		ip, _, err := net.SplitHostPort(ctx.Request().RemoteAddr)
+		if err != nil {
+			ip = ctx.Request().RemoteAddr
+		}
+		token := string(getProvidedToken(ctx, limiter.header))
+
+		retryAfter := limiter.allow(token, ip, limiter.cost(ctx))
+		if retryAfter > 0 {
+			seconds := int64(math.Ceil(retryAfter.Seconds()))
+			ctx.Response().Header().Set("Retry-After", strconv.FormatInt(seconds, 10))
+			return echo.NewHTTPError(http.StatusTooManyRequests, RateLimitedMessage)
+		}
+		return next(ctx)
+	}
+}
@@ -0,0 +1,120 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package middlewares
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+var rateLimitedError = echo.NewHTTPError(http.StatusTooManyRequests, RateLimitedMessage)
+
+func TestRateLimiter(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	now := time.Unix(1000, 0)
+	cost := func(ctx echo.Context) uint64 {
+		if ctx.Request().Method == http.MethodPost {
+			return 5
+		}
+		return 1
+	}
+	limiter := makeRateLimiter(testAPIHeader, RateLimits{PerToken: 2, PerIP: 4, Burst: 4}, cost, func() time.Time { return now })
+	handler := limiter.handler(success)
+
+	request := func(token string, ip string, method string) (retryAfter string, err error) {
+		req := httptest.NewRequest(method, "/v2/status", nil)
+		req.RemoteAddr = ip + ":4160"
+		req.Header.Set(testAPIHeader, token)
+		rec := httptest.NewRecorder()
+		err = handler(e.NewContext(req, rec))
+		return rec.Header().Get("Retry-After"), err
+	}
+
+	// each token can use its burst at once
+	for i := 0; i < 4; i++ {
+		_, err := request("token1", "10.0.0.1", http.MethodGet)
+		require.Equal(t, errSuccess, err, i)
+	}
+	retryAfter, err := request("token1", "10.0.0.2", http.MethodGet)
+	require.Equal(t, rateLimitedError, err)
+	require.Equal(t, "1", retryAfter)
+
+	// the IP address is limited as well, across tokens
+	retryAfter, err = request("token2", "10.0.0.1", http.MethodGet)
+	require.Equal(t, rateLimitedError, err)
+	require.Equal(t, "1", retryAfter)
+	_, err = request("token2", "10.0.0.2", http.MethodGet)
+	require.Equal(t, errSuccess, err)
+
+	// the buckets refill over time, at their own rate
+	now = now.Add(500 * time.Millisecond)
+	_, err = request("token1", "10.0.0.1", http.MethodGet)
+	require.Equal(t, errSuccess, err)
+	_, err = request("token1", "10.0.0.1", http.MethodGet)
+	require.Equal(t, rateLimitedError, err)
+
+	// expensive requests cost up to the whole bucket
+	now = now.Add(10 * time.Second)
+	_, err = request("token1", "10.0.0.1", http.MethodPost)
+	require.Equal(t, errSuccess, err)
+	retryAfter, err = request("token1", "10.0.0.1", http.MethodPost)
+	require.Equal(t, rateLimitedError, err)
+	require.Equal(t, "2", retryAfter)
+
+	// OPTIONS requests are never limited
+	_, err = request("token1", "10.0.0.1", http.MethodOptions)
+	require.Equal(t, errSuccess, err)
+}
+
+func TestRateLimiterSweep(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	now := time.Unix(1000, 0)
+	limiter := makeRateLimiter(testAPIHeader, RateLimits{PerToken: 1}, func(echo.Context) uint64 { return 1 }, func() time.Time { return now })
+	require.Nil(t, limiter.ipBuckets)
+
+	for i := 0; i < rateLimiterSweepThreshold; i++ {
+		require.Zero(t, limiter.allow(fmt.Sprintf("token%d", i), "", 1))
+	}
+	require.Len(t, limiter.tokenBuckets.buckets, rateLimiterSweepThreshold)
+
+	// the buckets that were refilled are removed once there are too many of them
+	now = now.Add(time.Second)
+	require.Zero(t, limiter.allow("token0", "", 1))
+	require.NotZero(t, limiter.allow("token0", "", 1))
+	require.Zero(t, limiter.allow("another token", "", 1))
+	require.Len(t, limiter.tokenBuckets.buckets, 2)
+}
+
+func TestRateLimiterDisabled(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	handler := MakeRateLimiter(testAPIHeader, RateLimits{}, nil)(success)
+	req := httptest.NewRequest(http.MethodGet, "/v2/status", nil)
+	for i := 0; i < 100; i++ {
+		require.Equal(t, errSuccess, handler(e.NewContext(req, httptest.NewRecorder())))
+	}
+}
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"net"
 	"net/http"
+	"strings"
 
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
@@ -61,16 +62,88 @@ func registerHandlers(router *echo.Echo, prefix string, routes lib.Routes, ctx l
 	}
 }
 
+// accountInformationCost is the rate limit cost of an account information request which doesn't exclude the
+// resources of the account.
+const accountInformationCost = 5
+
+// requestCosts are the rate limit costs of the expensive requests of the public API, by route. The other requests
+// cost a single unit.
+var requestCosts = map[string]uint64{
+	"/v2/teal/dryrun":                            10,
+	"/v2/teal/compile":                           5,
+	"/v2/teal/disassemble":                       2,
+	"/v2/blocks/:round":                          2,
+	"/v2/transactions/pending":                   2,
+	"/v2/accounts/:address/transactions/pending": 2,
+	"/v2/applications/:application-id/boxes":     5,
+	"/v2/ledger/online-stake":                    5,
+	"/v2/ledger/online-accounts/top":             10,
+	"/v2/transactions/events":                    10,
+}
+
+// requestCost returns the rate limit cost of a request.
+func requestCost(ctx echo.Context) uint64 {
+	if ctx.Path() == "/v2/accounts/:address" && ctx.QueryParam("exclude") != "all" {
+		return accountInformationCost
+	}
+	if cost, ok := requestCosts[ctx.Path()]; ok {
+		return cost
+	}
+	return 1
+}
+
+// isReadOnlyRequest tells whether a request of the public API doesn't modify the state of the node, so that it can
+// be made with a read-only API token.
+func isReadOnlyRequest(ctx echo.Context) bool {
+	switch ctx.Request().Method {
+	case http.MethodGet, http.MethodHead:
+		return true
+	case http.MethodPost:
+		// compiling, disassembling and dryrunning programs doesn't modify anything
+		return strings.HasPrefix(ctx.Path(), "/v2/teal/")
+	default:
+		return false
+	}
+}
+
 // NewRouter builds and returns a new router with our REST handlers registered.
-func NewRouter(logger logging.Logger, node *node.AlgorandFullNode, shutdown <-chan struct{}, apiToken string, adminAPIToken string, listener net.Listener, numConnectionsLimit uint64) *echo.Echo {
+func NewRouter(logger logging.Logger, node *node.AlgorandFullNode, shutdown <-chan struct{}, apiToken string, adminAPIToken string, scopedTokens []tokens.ScopedToken, listener net.Listener, numConnectionsLimit uint64) *echo.Echo {
 	if err := tokens.ValidateAPIToken(apiToken); err != nil {
 		logger.Errorf("Invalid apiToken was passed to NewRouter ('%s'): %v", apiToken, err)
 	}
 	if err := tokens.ValidateAPIToken(adminAPIToken); err != nil {
 		logger.Errorf("Invalid adminAPIToken was passed to NewRouter ('%s'): %v", adminAPIToken, err)
 	}
-	adminAuthenticator := middlewares.MakeAuth(TokenHeader, []string{adminAPIToken})
-	apiAuthenticator := middlewares.MakeAuth(TokenHeader, []string{adminAPIToken, apiToken})
+	adminTokens := []string{adminAPIToken}
+	apiTokens := []string{adminAPIToken, apiToken}
+	var readOnlyTokens []string
+	for _, scopedToken := range scopedTokens {
+		switch scopedToken.Scope {
+		case tokens.AdminScope:
+			adminTokens = append(adminTokens, scopedToken.Token)
+			apiTokens = append(apiTokens, scopedToken.Token)
+		case tokens.SubmitScope:
+			apiTokens = append(apiTokens, scopedToken.Token)
+		case tokens.ReadOnlyScope:
+			readOnlyTokens = append(readOnlyTokens, scopedToken.Token)
+		}
+	}
+	adminAuthenticator := middlewares.MakeAuth(TokenHeader, adminTokens)
+	apiAuthenticator := middlewares.MakeScopedAuth(TokenHeader, apiTokens, readOnlyTokens, isReadOnlyRequest)
+
+	cfg := node.Config()
+	rateLimits := middlewares.RateLimits{
+		PerToken: cfg.RestRateLimitPerToken,
+		PerIP:    cfg.RestRateLimitPerIP,
+		Burst:    cfg.RestRateLimitBurst,
+	}
+	// The rate limiter uses the token the authenticator accepted, so it has to come after it.
+	rateLimiter := middlewares.MakeRateLimiter(TokenHeader, rateLimits, requestCost)
+
+	adminMiddlewares := []echo.MiddlewareFunc{adminAuthenticator}
+	if cfg.RestTLSAdminClientCAFile != "" {
+		adminMiddlewares = []echo.MiddlewareFunc{middlewares.MakeClientCertificateAuth(), adminAuthenticator}
+	}
 
 	e := echo.New()
 
@@ -91,15 +164,15 @@ func NewRouter(logger logging.Logger, node *node.AlgorandFullNode, shutdown <-ch
 
 	// Route pprof requests to DefaultServeMux.
 	// The auth middleware removes /urlAuth/:token so that it can be routed correctly.
-	if node.Config().EnableProfiler {
-		e.GET("/debug/pprof/*", echo.WrapHandler(http.DefaultServeMux), adminAuthenticator)
-		e.GET(fmt.Sprintf("%s/debug/pprof/*", middlewares.URLAuthPrefix), echo.WrapHandler(http.DefaultServeMux), adminAuthenticator)
+	if cfg.EnableProfiler {
+		e.GET("/debug/pprof/*", echo.WrapHandler(http.DefaultServeMux), adminMiddlewares...)
+		e.GET(fmt.Sprintf("%s/debug/pprof/*", middlewares.URLAuthPrefix), echo.WrapHandler(http.DefaultServeMux), adminMiddlewares...)
 	}
 	// Registering common routes (no auth)
 	registerHandlers(e, "", common.Routes, ctx)
 
 	// Registering v1 routes
-	registerHandlers(e, apiV1Tag, routes.V1Routes, ctx, apiAuthenticator)
+	registerHandlers(e, apiV1Tag, routes.V1Routes, ctx, apiAuthenticator, rateLimiter)
 
 	// Registering v2 routes
 	v2Handler := v2.Handlers{
@@ -107,10 +180,10 @@ func NewRouter(logger logging.Logger, node *node.AlgorandFullNode, shutdown <-ch
 		Log:      logger,
 		Shutdown: shutdown,
 	}
-	nppublic.RegisterHandlers(e, &v2Handler, apiAuthenticator)
-	npprivate.RegisterHandlers(e, &v2Handler, adminAuthenticator)
-	ppublic.RegisterHandlers(e, &v2Handler, apiAuthenticator)
-	pprivate.RegisterHandlers(e, &v2Handler, adminAuthenticator)
+	nppublic.RegisterHandlers(e, &v2Handler, apiAuthenticator, rateLimiter)
+	npprivate.RegisterHandlers(e, &v2Handler, adminMiddlewares...)
+	ppublic.RegisterHandlers(e, &v2Handler, apiAuthenticator, rateLimiter)
+	pprivate.RegisterHandlers(e, &v2Handler, adminMiddlewares...)
 
 	return e
 }
@@ -85,3 +85,31 @@ func TestTestSuite(t *testing.T) {
 	partitiontest.PartitionTest(t)
 	suite.Run(t, new(TestSuite))
 }
+
+func TestRequestCostAndScope(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	e := echo.New()
+	testCases := []struct {
+		method   string
+		url      string
+		route    string
+		cost     uint64
+		readOnly bool
+	}{
+		{http.MethodGet, "/v2/status", "/v2/status", 1, true},
+		{http.MethodGet, "/v2/accounts/ADDR", "/v2/accounts/:address", accountInformationCost, true},
+		{http.MethodGet, "/v2/accounts/ADDR?exclude=none", "/v2/accounts/:address", accountInformationCost, true},
+		{http.MethodGet, "/v2/accounts/ADDR?exclude=all", "/v2/accounts/:address", 1, true},
+		{http.MethodPost, "/v2/teal/dryrun", "/v2/teal/dryrun", 10, true},
+		{http.MethodGet, "/v2/transactions/events?txid=TXID", "/v2/transactions/events", 10, true},
+		{http.MethodPost, "/v2/transactions", "/v2/transactions", 1, false},
+		{http.MethodDelete, "/v2/catchup/LABEL", "/v2/catchup/:catchpoint", 1, false},
+	}
+	for _, testCase := range testCases {
+		ctx := e.NewContext(httptest.NewRequest(testCase.method, testCase.url, nil), httptest.NewRecorder())
+		ctx.SetPath(testCase.route)
+		assert.Equal(t, testCase.cost, requestCost(ctx), testCase.url)
+		assert.Equal(t, testCase.readOnly, isReadOnlyRequest(ctx), testCase.url)
+	}
+}
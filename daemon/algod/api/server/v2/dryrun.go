@@ -17,8 +17,10 @@
 package v2
 
 import (
+	"bytes"
 	"encoding/base64"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/algorand/go-algorand/config"
@@ -57,6 +59,12 @@ type DryrunRequest struct {
 	LatestTimestamp int64 `codec:"latest-timestamp"`
 
 	Sources []model.DryrunSource `codec:"sources"`
+
+	// Profile requests the profiles of the programs in the results.
+	Profile bool `codec:"profile"`
+
+	// sourceMaps are the source maps of the programs assembled from Sources, by program ID
+	sourceMaps map[string]logic.SourceMap
 }
 
 // DryrunRequestFromGenerated converts model.DryrunRequest to DryrunRequest field by fields
@@ -79,6 +87,9 @@ func DryrunRequestFromGenerated(gdr *model.DryrunRequest) (dr DryrunRequest, err
 	dr.Round = gdr.Round
 	dr.LatestTimestamp = int64(gdr.LatestTimestamp)
 	dr.Sources = gdr.Sources
+	if gdr.Profile != nil {
+		dr.Profile = *gdr.Profile
+	}
 	return
 }
 
@@ -90,6 +101,11 @@ func (dr *DryrunRequest) ExpandSources() error {
 		if err != nil {
 			return fmt.Errorf("dryrun Source[%d]: %v", i, err)
 		}
+		if dr.sourceMaps == nil {
+			dr.sourceMaps = make(map[string]logic.SourceMap)
+		}
+		name := fmt.Sprintf("sources[%d].teal", i)
+		dr.sourceMaps[logic.GetProgramID(ops.Program)] = logic.GetSourceMap([]string{name}, ops.OffsetToLine)
 		switch s.FieldName {
 		case "lsig":
 			dr.Txns[s.TxnIndex].Lsig.Logic = ops.Program
@@ -111,6 +127,68 @@ func (dr *DryrunRequest) ExpandSources() error {
 	return nil
 }
 
+// dryrunBudgetReport converts the budget uses of the app call of the gi'th
+// transaction, and of its inner app calls
+func dryrunBudgetReport(uses []logic.BudgetUse, gi int) *[]model.DryrunBudgetUse {
+	var report []model.DryrunBudgetUse
+	for _, use := range uses {
+		if use.GroupIndex != gi {
+			continue
+		}
+		converted := model.DryrunBudgetUse{
+			AppIndex:       uint64(use.AppID),
+			BudgetAdded:    uint64(use.Contributed),
+			BudgetConsumed: uint64(use.Consumed),
+		}
+		if len(use.InnerPath) > 0 {
+			path := make([]uint64, len(use.InnerPath))
+			for i, index := range use.InnerPath {
+				path[i] = uint64(index)
+			}
+			converted.InnerPath = &path
+		}
+		report = append(report, converted)
+	}
+	if len(report) == 0 {
+		return nil
+	}
+	return &report
+}
+
+// dryrunProfile converts the profile of program, with the pprof profile of
+// all the programs that prof profiled
+func dryrunProfile(prof *logic.Profiler, program []byte, sourceMaps map[string]logic.SourceMap) (*model.DryrunProfile, error) {
+	result := model.DryrunProfile{
+		Ops:         []model.DryrunOpProfile{},
+		Subroutines: []model.DryrunOpProfile{},
+	}
+	opProfiles := func(profiles map[int]*logic.OpProfile) []model.DryrunOpProfile {
+		converted := make([]model.DryrunOpProfile, 0, len(profiles))
+		for pc, op := range profiles {
+			converted = append(converted, model.DryrunOpProfile{
+				Pc:    uint64(pc),
+				Count: op.Count,
+				Cost:  op.Cost,
+				Time:  uint64(op.Time),
+			})
+		}
+		sort.Slice(converted, func(i, j int) bool { return converted[i].Pc < converted[j].Pc })
+		return converted
+	}
+	if pp := prof.Program(program); pp != nil {
+		result.Ops = opProfiles(pp.Ops)
+		result.Subroutines = opProfiles(pp.Subroutines)
+	}
+
+	var buf bytes.Buffer
+	err := prof.WriteProfile(&buf, sourceMaps)
+	if err != nil {
+		return nil, err
+	}
+	result.Pprof = buf.Bytes()
+	return &result, nil
+}
+
 type dryrunDebugReceiver struct {
 	disassembly   string
 	lines         []string
@@ -415,6 +493,7 @@ func doDryrunRequest(dr *DryrunRequest, response *model.DryrunResponse) {
 		}
 	}
 	ep.PooledApplicationBudget = &pooledAppBudget
+	ep.RecordBudgetUses()
 
 	response.Txns = make([]model.DryrunTxnResult, len(dr.Txns))
 	for ti, stxn := range dr.Txns {
@@ -423,8 +502,19 @@ func doDryrunRequest(dr *DryrunRequest, response *model.DryrunResponse) {
 			var debug dryrunDebugReceiver
 			ep.Debugger = &debug
 			ep.SigLedger = &dl
+			ep.Profiler = nil
+			if dr.Profile {
+				ep.Profiler = logic.NewProfiler()
+			}
 			pass, err := logic.EvalSignature(ti, ep)
 			var messages []string
+			if ep.Profiler != nil {
+				var perr error
+				result.LogicSigProfile, perr = dryrunProfile(ep.Profiler, stxn.Lsig.Logic, dr.sourceMaps)
+				if perr != nil {
+					messages = append(messages, perr.Error())
+				}
+			}
 			result.Disassembly = debug.lines          // Keep backwards compat
 			result.LogicSigDisassembly = &debug.lines // Also add to Lsig specific
 			result.LogicSigTrace = &debug.history
@@ -506,6 +596,10 @@ func doDryrunRequest(dr *DryrunRequest, response *model.DryrunResponse) {
 			} else {
 				var debug dryrunDebugReceiver
 				ep.Debugger = &debug
+				ep.Profiler = nil
+				if dr.Profile {
+					ep.Profiler = logic.NewProfiler()
+				}
 				var program []byte
 				messages = make([]string, 1)
 				if stxn.Txn.OnCompletion == transactions.ClearStateOC {
@@ -519,6 +613,13 @@ func doDryrunRequest(dr *DryrunRequest, response *model.DryrunResponse) {
 				if !pass {
 					delta = ep.TxnGroup[ti].EvalDelta
 				}
+				if ep.Profiler != nil {
+					var perr error
+					result.AppCallProfile, perr = dryrunProfile(ep.Profiler, program, dr.sourceMaps)
+					if perr != nil {
+						messages = append(messages, perr.Error())
+					}
+				}
 				result.Disassembly = debug.lines
 				result.AppCallTrace = &debug.history
 				result.GlobalDelta = StateDeltaToStateDelta(delta.GlobalDelta)
@@ -558,6 +659,7 @@ func doDryrunRequest(dr *DryrunRequest, response *model.DryrunResponse) {
 				budgetConsumed := uint64(cost) + budgetAdded
 				result.BudgetAdded = &budgetAdded
 				result.BudgetConsumed = &budgetConsumed
+				result.BudgetReport = dryrunBudgetReport(ep.BudgetUses(), ti)
 				maxCurrentBudget = pooledAppBudget
 				cumulativeCost += cost
 
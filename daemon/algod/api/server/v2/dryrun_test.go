@@ -18,9 +18,11 @@ package v2
 
 import (
 	"bytes"
+	"compress/gzip"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"io"
 	"strconv"
 	"strings"
 	"testing"
@@ -1392,6 +1394,28 @@ int 1`)
 				cost := int64(*txn.BudgetConsumed) - int64(*txn.BudgetAdded)
 				require.Equal(t, expectedCosts[i], cost, "txn %d cost", i)
 				require.Equal(t, expectedBudgetAdded[i], *txn.BudgetAdded, "txn %d added", i)
+
+				// the budget report has the budget of each app call, and
+				// the inner app calls make up for their budget
+				require.NotNil(t, txn.BudgetReport)
+				report := *txn.BudgetReport
+				require.Equal(t, uint64(appIdx)+uint64(i), report[0].AppIndex)
+				require.Nil(t, report[0].InnerPath)
+				cost = 0
+				for j, use := range report {
+					require.EqualValues(t, 700, use.BudgetAdded)
+					cost += int64(use.BudgetConsumed)
+					if j > 0 {
+						cost -= int64(use.BudgetAdded)
+					}
+				}
+				require.Equal(t, expectedCosts[i], cost, "txn %d report", i)
+				if i == 2 {
+					require.Len(t, report, 2)
+					require.Equal(t, []uint64{0}, *report[1].InnerPath)
+				} else {
+					require.Len(t, report, 1)
+				}
 			}
 		})
 	}
@@ -1586,6 +1610,83 @@ int 1
 	}
 }
 
+func TestDryrunProfile(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+	a := require.New(t)
+
+	ops, err := logic.AssembleString("int 1")
+	a.NoError(err)
+	clst := ops.Program
+
+	sender, err := basics.UnmarshalChecksumAddress("47YPQTIGQEO7T4Y4RWDYWEKV6RTR2UNBQXBABEEGM72ESWDQNCQ52OPASU")
+	a.NoError(err)
+
+	appIdx := basics.AppIndex(7)
+	makeRequest := func(profile bool) DryrunRequest {
+		return DryrunRequest{
+			ProtocolVersion: string(dryrunProtoVersion),
+			Txns: []transactions.SignedTxn{txntest.Txn{
+				Type:          protocol.ApplicationCallTx,
+				Sender:        sender,
+				ApplicationID: appIdx,
+			}.SignedTxn()},
+			Apps: []model.Application{{
+				Id: uint64(appIdx),
+				Params: model.ApplicationParams{
+					ClearStateProgram: clst,
+				},
+			}},
+			Accounts: []model.Account{{Address: sender.String(), Status: "Offline"}},
+			Sources: []model.DryrunSource{{
+				FieldName: "approv",
+				AppIndex:  uint64(appIdx),
+				Source: `#pragma version 6
+int 1
+callsub noop
+callsub noop
+return
+noop:
+retsub`,
+			}},
+			Profile: profile,
+		}
+	}
+
+	dr := makeRequest(false)
+	var response model.DryrunResponse
+	doDryrunRequest(&dr, &response)
+	checkAppCallPass(t, &response)
+	a.Nil(response.Txns[0].AppCallProfile)
+	a.Nil(response.Txns[0].LogicSigProfile)
+
+	dr = makeRequest(true)
+	response = model.DryrunResponse{}
+	doDryrunRequest(&dr, &response)
+	checkAppCallPass(t, &response)
+	profile := response.Txns[0].AppCallProfile
+	a.NotNil(profile)
+	a.Len(profile.Ops, 5)
+	for i, op := range profile.Ops {
+		if i > 0 {
+			a.Less(profile.Ops[i-1].Pc, op.Pc)
+		}
+		a.NotZero(op.Count)
+	}
+	a.Len(profile.Subroutines, 1)
+	a.EqualValues(2, profile.Subroutines[0].Count)
+	a.EqualValues(2, profile.Subroutines[0].Cost)
+
+	zr, err := gzip.NewReader(bytes.NewReader(profile.Pprof))
+	a.NoError(err)
+	data, err := io.ReadAll(zr)
+	a.NoError(err)
+	a.Contains(string(data), "sources[0].teal.main")
+	if t.Failed() {
+		logResponse(t, &response)
+	}
+}
+
 func TestDryrunScratchSpace(t *testing.T) {
 	partitiontest.PartitionTest(t)
 	a := require.New(t)
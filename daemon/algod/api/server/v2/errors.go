@@ -50,4 +50,23 @@ var (
 	errOperationNotAvailableDuringCatchup      = "operation not available during catchup"
 	errRESTPayloadZeroLength                   = "payload was of zero length"
 	errRoundGreaterThanTheLatest               = "given round is greater than the latest round"
+	errCatchpointFileNotFound                  = "catchpoint file not found for the given round"
+	errCatchpointProofMultipleResources        = "only one of asset-id and application-id may be specified"
+	errDevModeTooManyBlocks                    = "cannot generate more than %d blocks at once"
+	errInvalidTimeStampOffset                  = "block timestamp offset is too large"
+	errFailedGeneratingDevModeBlocks           = "failed to generate blocks"
+	errFailedRetrievingTimeStampOffset         = "failed retrieving the block timestamp offset"
+	errFailedSettingTimeStampOffset            = "failed to set the block timestamp offset"
+	errFailedCreatingDevModeSnapshot           = "failed to create a snapshot"
+	errFailedRevertingDevModeSnapshot          = "failed to revert to the snapshot"
+	errFailedRollingBackLedger                 = "failed to roll back the ledger"
+	errInvalidRoundRange                       = "min-round cannot be greater than max-round"
+	errOnlineStakeHistoryTooLong               = "cannot retrieve the online stake of more than %d rounds at once"
+	errTooManyTopOnlineAccounts                = "cannot retrieve more than %d online accounts at once"
+	errInvalidBucketSize                       = "bucket-size must be positive"
+	errFailedRetrievingOnlineStake             = "failed retrieving the online stake"
+	errFailedRetrievingOnlineAccounts          = "failed retrieving the online accounts"
+	errNoTransactionsToWaitFor                 = "no transaction ID or address was specified"
+	errTooManyTransactionsToWaitFor            = "cannot wait for more than %d transactions at once"
+	errTransactionEventsTimeoutTooLong         = "cannot wait for more than %d seconds"
 )
@@ -136,174 +136,215 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9/XPbtrbgv4LRezP5WFF2PtrXeKbz1k3aXm/T3kzs9u6+ONtC5JGEawrgBUBbatb/",
-	"+w4OABIkQYqyVefeN/0psYiPg4ODg/ONT5NUrAvBgWs1Ofk0Kaika9Ag8S+apqLkOmGZ+SsDlUpWaCb4",
-	"5MR/I0pLxpeT6YSZXwuqV5PphNM11G1M/+lEwj9KJiGbnGhZwnSi0hWsqRlYbwvTuhppkyxF4oY4tUOc",
-	"vZncDnygWSZBqS6Uf+X5ljCe5mUGREvKFU3NJ0VumF4RvWKKuM6EcSI4ELEgetVoTBYM8kzN/CL/UYLc",
-	"Bqt0k/cv6bYGMZEihy6cr8V6zjh4qKACqtoQogXJYIGNVlQTM4OB1TfUgiigMl2RhZA7QLVAhPACL9eT",
-	"kw8TBTwDibuVArvG/y4kwO+QaCqXoCcfp7HFLTTIRLN1ZGlnDvsSVJlrRbAtrnHJroET02tGfiyVJnMg",
-	"lJP3370mL168eGUWsqZaQ+aIrHdV9ezhmmz3yckkoxr85y6t0XwpJOVZUrV//91rnP/cLXBsK6oUxA/L",
-	"qflCzt70LcB3jJAQ4xqWuA8N6jc9Ioei/nkOCyFh5J7YxgfdlHD+z7orKdXpqhCM68i+EPxK7OcoDwu6",
-	"D/GwCoBG+8JgSppBPxwnrz5+ejZ9dnz7bx9Ok/9yf37x4nbk8l9X4+7AQLRhWkoJPN0mSwkUT8uK8i4+",
-	"3jt6UCtR5hlZ0WvcfLpGVu/6EtPXss5rmpeGTlgqxWm+FIpQR0YZLGiZa+InJiXPDZsyozlqJ0yRQopr",
-	"lkE2Ndz3ZsXSFUmpskNgO3LD8tzQYKkg66O1+OoGDtNtiBID153wgQv650VGva4dmIANcoMkzYWCRIsd",
-	"15O/cSjPSHih1HeV2u+yIhcrIDi5+WAvW8QdNzSd51uicV8zQhWhxF9NU8IWZCtKcoObk7Mr7O9WY7C2",
-	"JgZpuDmNe9Qc3j70dZARQd5ciBwoR+T5c9dFGV+wZSlBkZsV6JW78ySoQnAFRMz/Dqk22/6/zv/6ExGS",
-	"/AhK0SW8o+kVAZ6KrH+P3aSxG/zvSpgNX6tlQdOr+HWdszWLgPwj3bB1uSa8XM9Bmv3y94MWRIIuJe8D",
-	"yI64g87WdNOd9EKWPMXNradtCGqGlJgqcrqdkbMFWdPN18dTB44iNM9JATxjfEn0hvcKaWbu3eAlUpQ8",
-	"GyHDaLNhwa2pCkjZgkFGqlEGIHHT7IKH8f3gqSWrABw/SC841Sw7wOGwidCMObrmCynoEgKSmZGfHefC",
-	"r1pcAa8YHJlv8VMh4ZqJUlWdemDEqYfFay40JIWEBYvQ2LlDh+Eeto1jr2sn4KSCa8o4ZIbzItBCg+VE",
-	"vTAFEw4rM90rek4VfPmy7wKvv47c/YVo7/rgjo/abWyU2CMZuRfNV3dg42JTo/8I5S+cW7FlYn/ubCRb",
-	"XpirZMFyvGb+bvbPo6FUyAQaiPAXj2JLTnUp4eSSPzV/kYSca8ozKjPzy9r+9GOZa3bOluan3P70VixZ",
-	"es6WPcisYI1qU9htbf8x48XZsd5ElYa3QlyVRbigtKGVzrfk7E3fJtsx9yXM00qVDbWKi43XNPbtoTfV",
-	"RvYA2Yu7gpqGV7CVYKCl6QL/2SyQnuhC/m7+KYrc9NbFIoZaQ8fuvkXbgLMZnBZFzlJqkPjefTZfDRMA",
-	"qyXQusURXqgnnwIQCykKkJrZQWlRJLlIaZ4oTTWO9O8SFpOTyb8d1caVI9tdHQWTvzW9zrGTkUetjJPQ",
-	"othjjHdGrlEDzMIwaPyEbMKyPZSIGLebaEiJGRacwzXlelbrIw1+UB3gD26mGt9WlLH4bulXvQgntuEc",
-	"lBVvbcNHigSoJ4hWgmhFaXOZi3n1w+PToqgxiN9Pi8LiA0VDYCh1wYYprZ7g8ml9ksJ5zt7MyPfh2Chn",
-	"C55vzeVgRQ1zNyzcreVuscpw5NZQj/hIEdxOIWdmazwajAx/CIpDnWElciP17KQV0/gvrm1IZub3UZ3/",
-	"NUgsxG0/caEW5TBnFRj8JdBcHrcop0s4zpYzI6ftvncjGzNKnGDuRCuD+2nHHcBjhcIbSQsLoPti71LG",
-	"UQOzjSys9+SmIxldFObgDAe0hlDd+aztPA9RSJAUWjB8k4v06i9UrQ5w5ud+rO7xw2nICmgGkqyoWs0m",
-	"MSkjPF71aGOOmGmI2juZB1PNqiUeank7lpZRTYOlOXjjYolFPfZDpgcyorv8Ff9Dc2I+m7NtWL8ddkYu",
-	"kIEpe5ydByEzqrxVEOxMpgGaGARZW+2dGK17Lyhf15PH92nUHn1rDQZuh9wicIfE5uDH4BuxicHwjdh0",
-	"joDYgDoEfZhxUIzUsFYj4HvjIBO4/w59VEq67SIZxx6DZLNAI7oqPA08vPHNLLXl9XQu5N24T4utcFLb",
-	"kwk1owbMd9pCEjYti8SRYsQmZRu0BqpdeMNMoz18DGMNLJxr+gdgQZlRD4GF5kCHxoJYFyyHA5D+Ksr0",
-	"51TBi+fk/C+nXzx7/uvzL740JFlIsZR0TeZbDYo8droZUXqbw5PuylA7KnMdH/3Ll94K2Rw3No4SpUxh",
-	"TYvuUNa6aUUg24yYdl2sNdGMq64AHHM4L8Bwcot2Yg33BrQ3TBkJaz0/yGb0ISyrZ8mIgySDncS07/Lq",
-	"abbhEuVWlodQZUFKISP2NTxiWqQiT65BKiYirpJ3rgVxLbx4W7R/t9CSG6qImRtNvyVHgSJCWXrDx/N9",
-	"O/TFhte4GeT8dr2R1bl5x+xLE/nekqhIATLRG04ymJfLhia0kGJNKMmwI97R34M+3/IUrWqHINJ+NW3N",
-	"OJr41Zangc5mNiqHbNnYhPvrZm2sePucneqRioBj0PEWP6Na/wZyTQ8uv7QniMH+2m+kBZZkpiFqwW/Z",
-	"cqUDAfOdFGJxeBhjs8QAxQ9WPM9Nn66Q/pPIwCy2VAe4jOvBalo3expSOJ2LUhNKuMgALSqlil/TPW55",
-	"9AeiG1OHN79eWYl7DoaQUlqa1ZYFQSddh3PUHROaWupNEDWqx4tRuZ9sKzuddfnmEmhmtHrgRMydq8A5",
-	"MXCRFD2M2l90TkiInKUGXIUUKSgFWeJMFDtB8+0sE9EDeELAEeBqFqIEWVB5b2CvrnfCeQXbBP3hijz+",
-	"4Rf15DPAq4Wm+Q7EYpsYeiuFz/mDulCPm36I4NqTh2RHJRDPc412aRhEDhr6ULgXTnr3rw1RZxfvj5Zr",
-	"kOiZ+UMp3k9yPwKqQP2D6f2+0JZFT5SXU3Qu2BrtdpxyoSAVPFPRwXKqdLKLLZtGDW3MrCDghDFOjAP3",
-	"CCVvqdLWm8h4hkYQe53gPFZAMVP0A9wrkJqRf/GyaHfs1NyDXJWqEkxVWRRCashia+CwGZjrJ9hUc4lF",
-	"MHYl/WpBSgW7Ru7DUjC+Q5ZdiUUQ1ZXR3bnbu4tD07S557dRVDaAqBExBMi5bxVgN4x06QGEqRrRlnCY",
-	"alFOFV4znSgtisJwC52UvOrXh6Zz2/pU/1y37RIX1fW9nQkws2sPk4P8xmLWxjitqFGhcWSypldG9kCF",
-	"2Lo9uzCbw5goxlNIhijfHMtz0yo8AjsOaY8twkVRBrO1DkeLfqNE10sEO3ahb8E9hpF3VGqWsgIlxR9g",
-	"e3DBuT1B1FxPMtCUGWU9+GCF6CLsT6wfuz3m3QTpUTpsF/yOEhtZTs4UXhhN4K9gixrLOxsgdRGEVR1A",
-	"E4iMak435QQB9WEXRoAJm8CGpjrfmmtOr2BLbkACUeV8zbS2EW9NRUGLIgkHiNoHB2Z0xnAbXOR3YIx1",
-	"/hyHCpbX3YrpxEpUw/BdtMSqBjqcJFUIkY/QvTvIiEIwym9KCmF2nbkASx+F5ympAaQTYtATUjHPR6qB",
-	"ZlwB+T+iJCnlKLCWGqobQUhks3j9mhnMBVbN6TykNYYghzVYORy/PH3aXvjTp27PmSILuPFRyaZhGx1P",
-	"n6IW/E4o3ThcB7C0mON2FuHtaDg1F4WT4do8ZbeHzo08ZifftQavrK3mTCnlCNcs/94MoHUyN2PWHtLI",
-	"OO8kjjvKJhoMHVs37juaef4YG009dAy67sSBU73+2OdXN/JVvj0An7YDEQmFBIWnKtRLlP0qFmHgujt2",
-	"aqs0rLumG9v11x7B5r0XCzpSpuA545CsBYdtNFeLcfgRP8Z625Pd0xl5bF/fttjUgL8FVnOeMVR4X/zi",
-	"bgek/K4KKDnA5rfHbVntwpB91EohLwglac5QZxVcaVmm+pJTlIqDsxxxvHlZv19Peu2bxBWziN7khrrk",
-	"FJ2ulawcdRYsIKIFfwfg1SVVLpegdEs+WABccteKcVJypnGutdmvxG5YARK9XzPbck23ZEFzVOt+BynI",
-	"vNTNGxMji5U2Wpc1IZppiFhccqpJDkYD/ZHxiw0O503wnmY46BshryoszKLnYQkcFFNJ3EH4vf2KsRtu",
-	"+SsXx4FpXvazNTqZ8evw462GRurS/338nycfTpP/osnvx8mr/3H08dPL2ydPOz8+v/366//X/OnF7ddP",
-	"/vPfYzvlYY/FvTrIz944afLsDYoMtdWpA/uDWRzWjCdRIgt9Ky3aIo+N4OMJ6Elt1nO7fsn1hhtCuqY5",
-	"y6i+Gzm0WVznLNrT0aKaxka0FEi/1j0v4ntwGRJhMi3WeOdrvOtTj0eYoxnUBY3jeVmU3G5lqZwpFgMo",
-	"vW9TLKZVFoHNHj4hGGK+ot4x7/58/sWXk2kdGl59N/q1/foxQsks28QSADLYxOQrd0DwYDxSpKBbBTrO",
-	"PRD2qBvXepPCYddgBHO1YsXDcwql2TzO4XxYmtPTNvyM23gxc37QqLp1thqxeHi4tQTIoNCrWFZhQ1LA",
-	"VvVuArQcXYUU18CnhM1g1taTsiUo71DOgS4wuw0Ng2JMmG11DiyheaoIsB4uZJQyEqMfFG4dt76dTtzl",
-	"rw4uj7uBY3C156wsqP5vLcij77+9IEeOYapHNhfFDh1kD0TsDy5AtuECNdzM5lLbZJxLfsnfwIJxZr6f",
-	"XPKMano0p4ql6qhUIL+hOeUpzJaCnPiY2zdU00vekbR6yx0E0c6kKOc5S8lVKBHX5GlTWLsjXF5+oPlS",
-	"XF5+7HiDuvKrmyrKX+wEyQ3TK1HqxOXoJRJuqMwioKsqRwtHthm2Q7NOiRvbsmKXA+jGj/M8WhSqnavR",
-	"XX5R5Gb5ARkql4lgtowoLaSXRYyAYqHB/f1JuItB0huf4FkqUOS3NS0+MK4/kuSyPD5+AaSRvPCbu/IN",
-	"TW4LaFiq7pRL0rZS4cKtXgMbLWlS0CWo6PI10AJ3H+XlNdpE85xgt0bShA8Kw6HqBXh89G+AhWPvAHBc",
-	"3Lnt5YstxJeAn3ALsY0RN2pXw133K0ijuPN2tVIxOrtU6lViznZ0VcqQuN+ZKgd7aYQs7/9RbIkxNi5d",
-	"fQ4kXUF6BRlmzsK60Ntpo7t3MTpB07MOpmyGuQ2CxjRINOrNgZRFRp0oTvm2nY+mQGsf5PMermB7Ieos",
-	"yn0S0Jr5UKrvoCKlBtKlIdbw2Lox2pvv/NiYA1IUPq0I48s9WZxUdOH79B9kK/Ie4BDHiKKRr9OHCCoj",
-	"iLDE34OCOyzUjHcv0o8tz2gZc3vzRRLSPe8nrkmtPDmXc7gaTEOy39eA5SrEjSJzauR24Sot2JyfgIuV",
-	"ii6hR0IO7aojM2satlgcZNe9F73pxKJ9oXXumyjItnFi1hylFDBfDKmgMtMKNPAzWdM9rmBGsICSQ9g8",
-	"RzGpisiwTIfKhn3bVoTpAy1OwCB5LXB4MJoYCSWbFVW+CATWyvBneZQM8AfmsA1lLp8FPvKgIEaVl+x5",
-	"bvucdrRLl7/sk5Z9pnKoWo7IOjYSPoblxbZDcBSAMshhaRduG3tCqfPp6g0ycPx1scgZB5LE3O1UKZEy",
-	"W8WjvmbcHGDk46eEWBMwGT1CjIwDsNElhQOTn0R4NvlyHyC5ywekfmx0ZgV/Qzx02QagGZFHFIaFM94T",
-	"6ug5AHUxGtX91YoUwmEI41Ni2Nw1zQ2bcxpfPUgngRbF1la6rHOKPukTZwcs8PZi2WtN9iq6y2pCmckD",
-	"HRfoBiCei01icxeiEu98Mzf0Ho3Jw0yK2MG0qcqPFJmLDTra8WqxMWA7YOmHw4MRaPgbppBesV/fbW6B",
-	"GZp2WJqKUaFCknHmvIpc+sSJMVP3SDB95PI4yD6+EwAtY0ddp88pvzuV1KZ40r3M61ttWlfV8OHOsePf",
-	"d4Siu9SDv64VpsoXdiaE95AKmfXbKQyhMl0VPuyaF1zZRsM3RmcUDxRhPG1qG16F6O5cjz+4AU89zwAi",
-	"3thg/Q4k324KYaRbG8xvM7sdUqycKMHmKClrs1KML3MnGPShKbZgH43iMW6XXFdq8QOOk51jm9uj5A/B",
-	"UhRxOPbRVN47/AxA0XPKazhQDr8nJC67exCW2376eNcW7aMHpRlY0awpEOhasdvBkE/Xm9n1mSrIAbXn",
-	"pKFtJFcxH/fl5QcFKJqd+26BlQ8rF1C+fRJE60hYMqWh9jYZCdZj+qHt+BQLJgmx6F+dLuTCrO+9EJU8",
-	"ZytyYMfGMh98BddCQ7JgUukEXXXRJZhG3ym0Pn1nmsaVimY8kK0dyLL4JYrTXsE2yVhexunVzfvDGzPt",
-	"T5XsoMo5CiaME6Dpisyx1mU0SnBgahtIOrjgt3bBb+nB1jvuNJimZmJpyKU5x7/IuWjddEPsIEKAMeLo",
-	"7lovSgcu0CA3rssdAwXDHk68TmdDborOYcr82Dvjq3yGXp8wZ0caWAuGBvWGZUYCcshSirKwTL0ucx3N",
-	"YuNCJw3jRwRdlYFHaXplMzGaG8yXlU0lHjZl9epRQ7u2Owbk48fju4dzQnCSwzXku8NfKWLcG3AwMsKO",
-	"gKE3BAPJfYzHbqm+uwM1wqqVtmGMUktHuhly3NaqkSs8VevWSLAGdy5ldLT3zkhont5q+u667ooiySCH",
-	"aILG34IMDFoUmGbtG8eSFcxgjGewiYNjP01jxai7xvuScW0LFx6qJlprnPHLDiuHjUFBYWtc7V93rV/H",
-	"DHYpRHP/onqIsnIODDJiHLzS7IIy/m3q67nGaVGwbNPye9pRe63jB8EYXlBusB0YCGgjlvojQTUrxtXG",
-	"PFu3uFGwZTYKMxfNum6hTBNOxZSvut9FVJUauAtXF0DzH2D7i2mLy5ncTif3c5PGcO1G3IHrd9X2RvGM",
-	"YXjWbdaIetgT5bQopLimeeKcyX2kKcW1I01s7n3PDyytxbnexbenb9858G+nkzQHKpNK2+ldFbYr/mVW",
-	"ZYvT9RwQX9V7RXVln7PacLD5VUWt0AF9swJXQTlQqDulHuvgguAoOof0Ih4NvNO97OIg7BIH4iGgqMIh",
-	"aledjYZoRkDQa8py7yPz0PZE7uLixt2NUa4QDnDvSIrwLjoou+mc7vjpqKlrB08K5xqo8by2ZcwVEbwd",
-	"Lme0YHS9IamuKRZqtB6QLnPi5Rq9BonKWRr3p/K5MsTBbZyMaUywcY8+bUYsWU/YFS9ZMJZppkYYtVtA",
-	"BnNEkemLfvbhbi7c+zMlZ/8ogbAMuDafJJ7K1kFF+6nzrHev07hU6Qa23vh6+PvIGGGR0vaN52SuIQEj",
-	"jMrpgPumsvr5hVbeJ/NDEH6wR3BfOGPnShwIzHP04ajZJiqsmtE1oyX0nW/VePubq5baM0f07RmmkoUU",
-	"v0PcVIUWvkheoC/LyjCi9Xfgs4i43mYxlSenfkKnnr13u/ukm9Dj1AxI7KF63PkgBAfrQ3pvNOV2q+1T",
-	"EI249jjBhBkkR3b8mmAczJ2sm5zezGmseKYRMgxMgful4TfXgvjOHvfOR8NcpdwZCeLGqrbMZswXIOuU",
-	"3W71nTsKDHba0aJCLRkg1YYywdTG+uRKRIYp+Q3l9kUR9EbgUXK9jYLvDUI3QmK9CxV38WeQsnXUuHR5",
-	"+SFLu+7cjC2ZfU+jVBA82OAGsg8RWSpyj17YcLoaNWcLcjwNnoRxu5Gxa6bYPAds8cy2mFMF1qjiIzd8",
-	"F7M84HqlsPnzEc1XJc8kZHqlLGKVIJVQh+pNFagyB30DwMkxtnv2ijzGEB3FruGJwaK7nycnz16hg9X+",
-	"cRy7ANzDOUPcJEN24vX/OB1jjJIdwzBuN+osag2wr531M66B02S7jjlL2NLxut1naU05XUI8KnS9Aybb",
-	"F3cTfQEtvPDMPtWjtBRbwnR8ftDU8KeeTDPD/iwYJBXrNdNrF8ihxNrQU/0ag53UD2ff/XGFdD1c/iPG",
-	"QxU+HKSlRD6s38feb7FVY9TaT3QNTbROCbVFTnJWRyr68t7kzNdQwsrCVUFhixszl1k6ijkYuLgghWRc",
-	"o2JR6kXyFUlXVNLUsL9ZH7jJ/MuXkWrKzaqefD/AHxzvEhTI6zjqZQ/ZexnC9SWPueDJ2nCU7Emd2Rmc",
-	"yt7ArXiITl+c0PDQY4UyM0rSS25lg9xowKnvRXh8YMB7kmK1nr3oce+VPThlljJOHrQ0O/Tz+7dOylgL",
-	"GSuMWB93J3FI0JLBNcbpxzfJjHnPvZD5qF24D/Sf13nqRc5ALPNnuVcR2MfjE+gG6PMJIxPv4u1penoa",
-	"MlfU7YMazjgPiH0scJff4z7PiDQ67wOV59DjoOsxIjQSYFsY208Dvr+JIXD5NHaoD0fNpcUo8xsRWbKv",
-	"PV/5eFzGZMRu1XeBmA+GQc3dUFPSrPP98BE13i3SjewwXzys+Ecb2M/MbBDJfgU9mxi8QRDdzqz6HgSX",
-	"UfKN2Izd1Bbv9hv7T4CaKEpKlme/1LVBWk88SMrTVTRYZG46/lo/Rlctzh7maGXMFeXcRiN0bROopfzq",
-	"tZmIvvV3MXaeNeMj27ZfnbDLbS2uBrwJpgfKT2jQy3RuJgix2iy7UKX15UuREZynLsNY3+vd10qCmvL/",
-	"KEHp2L2IH2xqAVrUF4aKbWl34BnaMWbke/uY9ApIo0oc2g/YusxtxTFbYNu6esoiFzSbEjPOxbenb4md",
-	"1faxTyrZkupLe+02VtEfn7tPoO1QbO0hMvrMqpXGoo1K03URK1FiWlz4BlgHJfQuoWIdYmdG3libhvIa",
-	"s53E0MOCyTVkpJrOSdVIE+Y/WtN0hcaCBkvtJ/nxbwF4qlTB+5vVO1pV2VU8dwZu9xyAfQ1gSoSRHG6Y",
-	"sm8IwzU0q6JUJYKcGOCrpDSXJ0vOLaVEpeKhElZ3QbsHzkZBegdUFLIW4veUXlyY+p5PI5xjr2gdw/Y7",
-	"C52HN22Njep9JP82fEq54CzFKoKxq9m9RzzGOzui4GI8M8DF26hJ5HBFX3eokjUcFnvfe/CM0CGu6x4K",
-	"vppNtdRh/9T48O2KarIErRxng2zqHylxFmrGFbgyuvg0dcAnhWx4vJFDRoMoajl5TzLC5Owek8N35ttP",
-	"ziCFWYtXjKPq6XMkbIKktSHjc6na6KtMk6XADAp3KMI1fTB9ZlisJYPNx5l/XhXHsA5js2wbHdEd6tTH",
-	"SrjYBNP2tWlrC+rVPzfy4Oykp0XhJu1/wiYqD+gN70VwxOddBXoFyK3GD0cbILfBICe8Tw2hwTWGSEBB",
-	"XGpMz3MurSQYI7RaisIWxMZHR+toRcNE3zIO9eO/kQsijV4JuDF4Xnv6qVRSbUXAUTztAmiOcRExhqa0",
-	"c4rdd6jWBrt40iKd+Dn6t7F+iaaHcVQNasGN8m315rCh7kCYeI2PnTtEdt+VQanKCVEuuab50kyMcRjG",
-	"7d+yal4A3WPQlYlsdy2pPTn73ER9pUrmZbYEndAsi9kTvsGvBL+SrETJATaQllX95qIgKVbma5Yq7FKb",
-	"mygVXJXrgbl8g3tOFzzdFKGG8Pkov8MYeD3f4r+x4sX9O+PCg/aOsfexQFmVPreP3NwcqSP1GppOFFsm",
-	"4zGBd8r90VFPfTdCr/sflNJzsWwC8sAFyoa4XLhHMf72rbk4wvpdnYrc9mqpymthOKjwD26i2lgVhmly",
-	"JZ912pkzeNBv2ADR/zTfFC+/nryWwNZL7f1q/dp92S1pbzIW1a5+gqZkkAX15qTbuDKbfY5QxG36fbFk",
-	"NpTMfO70HicZduRsHHsQoT5IsQvQDz4CmhSUuaCNmll0MevSvfrNhUOHrt7g9iJcElWvxe6H676EJ58H",
-	"bDM7Wo+ZXYErqlRIuGai9OEQPl7Oq4T2V/eYdJBX3Lv+btwMTvV5zaC9RtsL93CGXabTyX/4xUZXEuBa",
-	"bv8JTLidTe88BRerWdx4CM4JV1F7kx57V76pXpO7uk7WIhtKmP7hF/LG+5ZG3TuekGPllkTmnl+KJou/",
-	"dcX/fTMjfY6e9kfX6bQohqfuyRDvTm4b7jt9X6kpcz6HrG7v/Pm1D+iFJoSIrhKkM3PY6PhTOZ1s2Bsg",
-	"sCkAa90Gic391TPGEpRLckRtNcmBKhjAcFi1zbUdieSLzVvTflyyffwJw/6Ss3WZWWSehVCsfpYl9rbh",
-	"yJDjC3yeMPAYdsfy8X7XkGohG3FMEmCfArpmsuDd3D9Lz/YYSqrIbE//A2Vmp5OQt0QTFd3xonWJHPSq",
-	"ocs1Uqretokwe9eZmUNSwtQPYX5Y0FzFX6nqDXZtVT4JAlYihZ7jCzvLRlT7dsuZBjEQLBtGZDwTwAZ/",
-	"//dEpo1rPyw6O681DWsVncILQfEQ+6jObI8AkiqKGiVD3K8lcPek8iKGmt1ZUYsFpJpd7yh08bcV8KCI",
-	"wtRbghGWRVD3glVZNlhQdH8/Rw3QUB2KQXiCwv73BqcvR/QKto8UaVBD9JWfqRfu71JLEjGAt5YRPAqh",
-	"YlGK1nXlAseYqigDseCjgm13qKty9z6vGMg5d5zLk2RT4hmY8lrEbN+j5jJd96oEhgkjfbUwug+c9Vs8",
-	"3uB7cqp6+tjXogztguSsW7H/xtWyxLIklbfWV7UE5X/zNYjsLDm7gvABSPSNYwkF1yJq7PV25GRATupk",
-	"f/vHudpAL6qZWZ3D0c33jdSAxuinNBdGCU760p2aaRNVmNcjZYNDUUzBl+MQrgVI91Au3gy5UJBo4UPr",
-	"huAYQoWNgL0TElTvuwsWuN5qqO/rcq/4/owtlkFd4Gu4QCJhTQ10MijK2j/nELJf2+8+wdXX5Npp067o",
-	"NdlZVdVn7zDVQWJI9QvibsvdibN3MW8zzu2z/CoWU8gNKkP/ayFFVqauEExwMCoXwOiCZQOsJGoZTrur",
-	"7Bj5cqwG/jYoQ3AF2yNrf0lXlC+D8moh9Fa0t2sIKpe1dvuglv+4kTNf2gUsDwLn57SeTyeFEHnS43A9",
-	"6xaabZ+BK5ZeGTG7rOPee55YJI/Rz1dF1Nystr6walEAh+zJjJBTbjONfHBN86Wj1uT8kR6af4OzZqWt",
-	"/ewM+7NLHk/ZwKI+8p78zQ8zzNUUGOZ3z6nsIDvKmG56itxKehN5cLQbTzc63KX9CGRNVBaKmJRyx1Jd",
-	"o85317gfIf3gFcRh7Ses5FdHMUvrI0JpyXtu2sLLj7XrZ9x7jL7DDvBCY03wIqPnRg6czxxq/GOFlGAp",
-	"vZTQWP4u+49bYM2Xgi1SmDVplmkLENswtea+BMY99bqymcXx3DWtYdk+wbHmb9ckp9BnaMuwBoRjzqW8",
-	"pvnDm9WwnuMp4sM9Kx5faKj/hki2qFR3i/d7S0fNHei6h5uav0Mz4N/A7FHU2euGcs6f6iVM7yLDEvc0",
-	"J7moX8TFIckNjmm9w8++JHOXRVdISJlirQTjG/+qSaXu4SNf9Wvzw/rlrnX+IvQ9yNgpCKIgP9UvJGiB",
-	"90MNYX1EPzNT6Tm5USqPUV+HLCL4i/GosJzNjuviquE2ti/OtOIhhYQDu4+DQLA93cfdQj1jl2ddpObS",
-	"KRV01zn6tm7gNnJR12sbG/vQRe5QGf0xIQvx1zFMd4yZsAjBp2UIgkp+e/YbkbDAtyMFefoUJ3j6dOqa",
-	"/va8+dkc56dPo2Lcg0VLWBy5Mdy8UYpxzrROKgxsCiZ7iv69d8zdXdjoviPYAeLVOXOIvgaDU/u40Qcu",
-	"BY0y904Dv12aa7yLnwUo80uuJorh/pe+3AUbn9+TJtM6CyXLs12HspH0VL98i2k9v7qE3M/y9u6v1pbd",
-	"ZZPu/cN9YuTaBwARE1lrY/JgqiCdaUQmk+sWyVtC4kpLyfQW64R50yf7NRpT833lLXFe4KqyjJM7tLiC",
-	"qtJc7VsplZdsvhc0R1nA6DMYoaiFyGfk2w1dFzk4JvX1o/l/wIuvXmbHL579x/yr4y+OU3j5xavjY/rq",
-	"JX326sUzeP7VFy+P4dniy1fz59nzl8/nL5+//PKLV+mLl8/mL7989R+PzB1gQLaATnxVisn/xgeqk9N3",
-	"Z8mFAbbGCS3YD7C1b2EaMvavbNIUuSCsKcsnJ/6n/+m52ywV63p4/+vEJb1PVloX6uTo6ObmZhZ2OVqi",
-	"MTXRokxXR36ezjOcp+/OqvQwGwuFO2ozfwwp4KY6UjjFb++/Pb8gp+/OZjXBTE4mx7Pj2TOsZVwApwWb",
-	"nExe4E94ela470e+iPDJp9vp5GgFNEefuPljDVqy1H9SN3S5BDlzz42an66fH3kx7uiTMyTfDn07Cl/u",
-	"OfrUsLdnO3pioMvRJ1/Earh1o0qU8zMEHUZCMdTsaI4ZyGObggoa9y8FlTt19AnVk97fj1xaZvwjqon2",
-	"DBx5p1S8ZQNLn/TGwNrqkVKdrsri6BP+B2kyAMsGQQfgTpYxj/n3oH1kWPiqSB3bV9H2WWabd0LOXHk6",
-	"W6/35MO4p8nAT2e09AwUczUMkUuYI1AfYp/tVLNodMcHtWWHqjDdfsRSLGisxmP1/Pj4YC/2dnARebq3",
-	"HYCXVbFzL4+fHQySZkRzBIwzjs5nw4qIZbUIwcuHg+A16r9caLJgPLPPj2mKVGG3GAH66uEA0mztjcYc",
-	"n14EhTz/iwNSyIh9MbISzQm2tNO/eLjpz0FesxTIBawLIalk+Zb8zKu80aCKWZd3/MyvuLjhHnIjvZTr",
-	"NZVbx1coaZ8P/0qt5THB+9Lm2qRLhVZjya4pypEo3X+8dQzNHp8jrKKzrfmc/3nLXdpWDjH/+89cgVc5",
-	"bL72lqd9XA4bn295+r5iPR0GgsT6gHRyXsGLRwgdtP8UPOTP03L/0/Ie1uIaFHEXWUCcRILRWqy3C8MV",
-	"axqeDZ2aae9972zn3am836AevXP57zgU47ehqYoO+N9HwbkjYMYOP+b9/+p9/VaWhJ3qUWyHJn9ygj85",
-	"wQE5gS4l7z2iwQWGQWRQuPJdKU1XMNvjGt3yNFQOChGrk3I+wC1cdYg+ZnHeZBb/girCQ5/r15T7A93Y",
-	"chu2QGXOQFZkQHm3YMefbOC/j/iMorFTw6dEQ56r8PBrgYffGtJdcDC3EQljGUH7ffjYz0efms+uNQwi",
-	"alXqTNwEfdGBab3vXTtJ9WJ34++jG8p0shDSRQZjTeluZw00P3KFR1q/1rm+nS+YwBz8GNhU4r8eVfX0",
-	"oh/bxqrYV2es8Y1qa3Ro3UUeWNl1P3w0HAgrvjr2WBsrT46OMJxuJZQ+mtxOP7UMmeHHj9Wm+4Jr1ebf",
-	"frz9/wEAAP//msdBKCzJAAA=",
+	"H4sIAAAAAAAC/+x9/ZPbNrLgv4LSe1VOfNKMv5LduGrr3cR2El/sxOWZZO/O9mUhsiVhhwK4ADgjxTf/",
+	"+ys0ABIkAYrSyOPs1v5kj4iPRqPRaPTnx0km1qXgwLWaPP04Kamka9Ag8S+aZaLiesZy81cOKpOs1Ezw",
+	"yVP/jSgtGV9OphNmfi2pXk2mE07XMHka9p9OJPyjYhLyyVMtK5hOVLaCNTUD621pWtcjbWZLMXNDnNkh",
+	"Xj6f3Ax8oHkuQak+lD/zYksYz4oqB6Il5Ypm5pMi10yviF4xRVxnwjgRHIhYEL1qNSYLBkWuTvwi/1GB",
+	"3AardJOnl3TTgDiTooA+nM/Ees44eKigBqreEKIFyWGBjVZUEzODgdU31IIooDJbkYWQO0C1QITwAq/W",
+	"k6fvJgp4DhJ3KwN2hf9dSIDfYaapXIKefJjGFrfQIGearSNLe+mwL0FVhVYE2+Ial+wKODG9TsjrSmky",
+	"B0I5efvdM/L48eNvzELWVGvIHZElV9XMHq7Jdp88neRUg//cpzVaLIWkPJ/V7d9+9wznP3cLHNuKKgXx",
+	"w3JmvpCXz1ML8B0jJMS4hiXuQ4v6TY/IoWh+nsNCSBi5J7bxUTclnP+z7kpGdbYqBeM6si8EvxL7OcrD",
+	"gu5DPKwGoNW+NJiSZtB3D2bffPj4cPrwwc1/vDub/V/351ePb0Yu/1k97g4MRBtmlZTAs+1sKYHiaVlR",
+	"3sfHW0cPaiWqIicreoWbT9fI6l1fYvpa1nlFi8rQCcukOCuWQhHqyCiHBa0KTfzEpOIFKIWjOWonTJFS",
+	"iiuWQz4ljJPrFctWJKPKDoHtyDUrCkODlYI8RWvx1Q0cppsQJQaug/CBC/rjIqNZ1w5MwAa5wSwrhIKZ",
+	"FjuuJ3/jUJ6T8EJp7iq132VFLlZAcHLzwV62iDtuaLootkTjvuaEKkKJv5qmhC3IVlTkGjenYJfY363G",
+	"YG1NDNJwc1r3qDm8KfT1kBFB3lyIAihH5Plz10cZX7BlJUGR6xXolbvzJKhScAVEzP8OmTbb/r/Of/6J",
+	"CEleg1J0CW9odkmAZyJP77GbNHaD/10Js+FrtSxpdhm/rgu2ZhGQX9MNW1drwqv1HKTZL38/aEEk6Ery",
+	"FEB2xB10tqab/qQXsuIZbm4zbUtQM6TEVFnQ7Ql5uSBruvnLg6kDRxFaFKQEnjO+JHrDk0KamXs3eDMp",
+	"Kp6PkGG02bDg1lQlZGzBICf1KAOQuGl2wcP4fvA0klUADuM7wGF8HDgcNhGaMUfXfCElXUJAMifkF8e5",
+	"8KsWl8BrBkfmW/xUSrhiolJ1pwSMOPWweM2FhlkpYcEiNHbu0KEIJbaNY69rJ+BkgmvKOOSEcQu00GA5",
+	"URKmYMLhx0z/ip5TBV8/mdzs+jpy9xeiu+uDOz5qt7HRzB7JyL1ovroDGxebWv1HPP7CuRVbzuzPvY1k",
+	"ywtzlSxYgdfM383+eTRUCplACxH+4lFsyamuJDx9z++bv8iMnGvKcypz88va/vS6KjQ7Z0vzU2F/eiWW",
+	"LDtnywQya1ijrynstrb/mPHi7Fhvoo+GV0JcVmW4oKz1Kp1vycvnqU22Y+5LmGf1UzZ8VVxs/Etj3x56",
+	"U29kAsgk7kpqGl7CVoKBlmYL/GezQHqiC/m7+acsC9Nbl4sYag0du/sWdQNOZ3BWlgXLqEHiW/fZfDVM",
+	"AOwrgTYtTvFCffoxALGUogSpmR2UluWsEBktZkpTjSP9p4TF5OnkP04b5cqp7a5Og8lfmV7n2MnIo1bG",
+	"mdGy3GOMN0auUQPMwjBo/IRswrI9lIgYt5toSIkZFlzAFeX6ZDKNncnmAL9zMzX4tqKMxXfnfZVEOLEN",
+	"56CseGsb3lMkQD1BtBJEK0qby0LM6x++OCvLBoP4/awsLT5QNASGUhdsmNLqS1w+bU5SOM/L5yfk+3Bs",
+	"lLOF0R3NwYka5m5YuFvL3WK14sitoRnxniK4nUYTczOt0aAU6GNQHL4ZVqIwUs9OWjGNf3BtQzIzv4/q",
+	"/M9BYiFu08RlWhGHOfuAwV+Cl8sXHcrpE47T5ZyQs27fw8jGjBInmINoZXA/7bgDeKxReC1paQF0X+xd",
+	"yji+wGwjC+stuelIRheFufkc0hpCdfBZ23keopCYD10Yvi1EdvkDVasjnPm5H6t//HAasgKagyQrqlYn",
+	"k5iUER6vZrQxR8w0xNc7mQdTndRLPNbydiwtp5qeTLrwxsUSi3rsh0wPZOTt8jP+hxbEfDZnm2r/Ljc6",
+	"CYZHVAQWhNw85e0Dwc5kGpiN14Ks7eudmFf3XlA+ayaP79OoPXphFQZuh9wicIfE5ujH4FuxicHwrdj0",
+	"joDYgDoGfYiN/Q/TsFYj4HvuIBO4/w59VEq67SMZxx6DZLNAI7oqPA08vPHNLI3m9Wwu5GHcp8NWOGn0",
+	"yYSaUQPmO+0gCZtW5cyRYkQnZRt0BmpMeMNMozt8DGMtLLyRQiyOTnyd8WP7hB8cx6IF5RkosgZ5WQDR",
+	"kgEBruX2pL1l55p+gi1TmgaYvsWWtQc69paJdckKOIZoymmxVWznCX0jxVLS9ZlvfjOdrKKXm1GGPH5E",
+	"zn84++rho98effW12dbS9ibzrQZFvnBvUKL0toAv+0jBV2BV6PjoXz/x2tb2uLFxlKhkBmta9oeyWlwr",
+	"6tlmxLTrI7y9Q7jqGsAxTOgCzI1ld4xYA4UB7TlcvRY5oGbmCBs5IOoXVIPSjY7paKK8B9ur47w6J5zQ",
+	"nuocrqAw4JK1yIFw0NdCXgZoOOe0VCuhPy0mLEQZLY1mqdZqKjd3DDfTif86Y4lBfQPCcuBGMgA5Gsvt",
+	"4Q/FeQq/NWiIaKaoUrCeH4VvpA5o3sySE0f5ORzlaE6tMEc5F5pqyHce1X3PaAP7NjynciurY+idQEoh",
+	"I8pwvGK0yEQxuwKpmIjYNd+4FsS18G/Rsvu7hZZcU0XM3AZPpOJ5iySbiY0BZrSQZoe+2PAGN4Niml1v",
+	"ZHVu3jH70ka+p3lFSmMz3nCSw7xattQWCynW5kBgRxQZvgeNcvsFW8O5puvy58XiOHodgQPFuYJma1Bm",
+	"NmJbkTnoawBu1qAgqzS7Aiv8KzQfK8gEtz5LOziHm/U2DBrn7YO4g1V/D/p8y7M7uLHWjKNhU215Fmiq",
+	"8G6BfLkHg73VNYZT3VMRcAw6XuFnVGY+h0LTowvO3QlisD/zJ8ICS3LTEMWzV2y50sGz+tMI99FZdoj4",
+	"henTV038ZMQATXWljiDVN4M1TMPsacgq6FxUmlDCDZ0rbByX9xPOSOgFgc4bOnxC6JXVM8zBEFJGK7Na",
+	"YxcSMRbcdJzRzFLvzLKF+ISN0d22stNZR5dCAs2NLhM4EXNnIHVCDi6Sol+F9nere21ExZ4ArlKKDJQy",
+	"OmirWdwJmm9nubEewBMCjgDXsxAlyILKWwN7ebUTzkvYztALSJEvfvxVffkZ4NVC02IHYrFNDL21movx",
+	"BNTjph8iuO7kIdlRCcTzXKIFvnIK0JBC4V44Se5fF6LeLt4eLVcg0R79SSneT3I7AqpB/cT0fltoqzLh",
+	"2+o0JkY8MxvGKRdOGIoOVlClZ7vYsmkUrkWZFQScMMaJceCEUPKKKm19KBjPUfWr3Eu3fueaKdIAJyV7",
+	"M/KvXqjvj43SIleVqiV8VZWlkK33T7MG43iTnusn2NRziUUwdv2M0IJUCnaNnMJSML5Dll2JRRDVtanR",
+	"ORn1F4cGOXPPb6OobAHRIGIIkHPfKsBu6N+XAISpBtGWcJjqUE7tVDidKC3K0nALPat43S+FpnPb+kz/",
+	"0rTtExfVzb2dCzCzaw+Tg/zaYtZ6dq6oIg4OsqaXRvZA9Zh19ujDbA7jTDGewWyI8vHVZFqFR2DHIU0o",
+	"NZ3veDBb53B06DdKdEki2LELqQUnNKw/84JxI0FewotNyeT2GDaRKrsEPf7B3YPhWxyg//AeYegPzOqK",
+	"XIMEYlx7DDunMb1X3PxVMa6No1vXHuPWNT3Cm0vgmokyiyZLKarSnj9z1bCMlVZyv4QtAUTJpL1XPzCl",
+	"xVE2ywIyQ0BG7xiejwCcnTqS1ixHw5u4AkkokZQ7F0/kEgaYNyEab4OsQVtBZJKo0s1QJ2TAdWd7V7aP",
+	"fS32dr63jh/hE6/hR4jCf0Zy0JQZTWfwIQa1da7rjnnYO3cUIfbB7xFiZDkFUyjP9VBuacd6bV8Evt5H",
+	"eKhHRiXMRloYQL0vKORtJ3PY0EwXW0JRwthalqaq+Zppbd3w28dZi3IWDhC1Aw7M6Cz01uPZ78AYl4Fz",
+	"HCpYXox92wfPMHwXnVdPCx3uoVMKUYxQjfWQEYVglDMXKYXZdeaiPnxogKekFpDujVFsPbhc5HBPtdCM",
+	"KyD/R1Qkoxzfk5WGWmATEqUg0xdnYCqY07ltNRiCAtZgn8n45f797sLv33d7zhRZwLUPlbp/v4+O+/dR",
+	"SfVGKN06XEe4asxxexm5vdHKaRi8e2J1ecputyE38pidfNMZ3E+KZ0opR7hm+bdmAJ2TuRmz9pBGxrlM",
+	"6c3IlQfria4b9/2tKIo5zS6tTvZf2V5rg1KkKIq2HpyY5RtUoEL602iTm6Fj4PcnDpwem48pv0fzEiy2",
+	"R7iy7EBEQilBIYMJNSjKfhWLMLDQcSC1VRrWfSWz7fpbgibe+gdM7z3sxMe14LCNxtIzDq/xY6y3ZXKJ",
+	"znjdpPp2H3gt+DtgtecZQ6a3xS/u9oUo7fqdN+wxWFWoDNzjBecgSLwI7vrx5vej+8BJ6VnDlwVaKtcG",
+	"7WZ8NfXMsgFuJRRE5EfUFF/RgtU62RR32+vlWW/ItPaniCzuNqxRi9JjoF7kfGuxgWTWXB4vruA4ZAZX",
+	"sA+RdUHYbZi3498GLXaIWjDx8Zyh+NhGzpva6f4IDLg7bsfGF4Y1ow4bipJQkhUMNdyCKy2rTL/nFHVo",
+	"AdQRfz+vGUxrVZ/5JnE1bkTL6oZ6zymisNasRX00FhA5nt8BeOWqqpZLULrzXFkAvOeuFeOk4sxuFx7e",
+	"mWWapbnUtxpObMs13ZKFCc/VgvwOUpB5pdsCPEZfKm10tNbgaKYhYvGeU00KoEqT18x4iJjhvMHe823v",
+	"k+SxEPe2WgIHxdQs7lz4vf2K/u1u+Svn627+7zpbE5UZvwnR3GpopXf4f1/811OT1oHOfn8w++Z/nH74",
+	"+OTmy/u9Hx/d/OUv/7/90+Obv3z5X/8Z2ykPO8uTkL987h63L5/jC6axUfVgvzP7hAkoXkDiDvCuDx3a",
+	"Il9woWsC+rIxArpdf8+Nd44WludTfRg5dMWM3lm0p6NDNa2N6Kib/Vr3fBfcgsuQCJPpsMaDRek2qzKL",
+	"j0fh4mXuAmtNK7KouN3KSjnDLQaZeZcysZjWkdY2w9JTgmG4K+qdet2fj776ejJtwmfr75PpxH39EKFk",
+	"lm9iQdI5bGLPPXdA8GDcU6SkWwUJX02EPeo9Z31PwmHXYPQEasXKu+cUSrN5nMP50B2nNtrwl9zG1Jjz",
+	"gybYrbPsiMXdw60lQA6lXsUyr7SkdWzV7CZAxy3GBNcBnxJ2AiddtU2+BOX9+AqgC0OgVmQUY0IR63Ng",
+	"Cc1TRYD1cCGjdCMx+sEHpuPW7QP9FkzyC3yQHuFY10HK/TMdulBNe+cGrSdTtA22ZHTzA4bQ2HvHtEJ/",
+	"UTCeATZry5IyrvTYqKhgvb3NsNDvExCFPWwCEzMsof1FIcKdtKWOroRwA8dg7M5ZG7j931qQe9+/uCCn",
+	"7oZS9xAjbuggpD2if7Yf2h5qmlCX4Mu+l97z9/w5LBhn5vvT9zynmp7OqWKZOq0UyG9t+MzJUpCnPhD0",
+	"OdX0Pe+JtskcfAG1kLKaFyxDs0uEH9i8Sv0R3r9/Z8jk/fsPPWed/qPdTRVl6HaCmUljJCo9cw+NmYRr",
+	"KvMI6KpOHIIjY+/BWafEjd16yLjx45cMLUvVTSDQX35ZFmb5ARkqFx5vtowoLaQX/pjy0OD+/iTcTSzp",
+	"tc86VClQ5G9rWr5jXH8gs/fVgwePgbQi6v/mZCxDk9sSWpaKgxIcdPUUuHD7voaNlnRW0iWo6PI10BJ3",
+	"Hx8oa7MF5mWB3UKc1BE8OFSzAI+P9AZYOPaOSsbFndtePgNgfAn4CbcQ2xj5rvEEOXS/gtj+g7erkx+g",
+	"t0uVXs3M2Y6uShkS9ztTJwaz/N655xjjlzkELofaHEi2guwSckznBOtSb6et7mLRkuw962DKpj2zkbmY",
+	"mweNOiYdWplT9/ahfNtNkqJAa69oeAuXsL0QTWqffbKitJN0qNRBRUoNxHlDrOGxdWN0N9+5GRpIaVn6",
+	"XBcY9OzJ4mlNF75P+iDbN8YRDnGMKFpJJFKIoDKCCOyQQsEBCzXj3Yr0Y8szzzoXOBrJkuZ5v48tbV6r",
+	"ziMwXM3Fqv6+BsyhKK4VmVMFOREu/Z9NRBFwsUrRJSSeJKEudGS6h5YtDgfZde9FbzpjyW9faL37Jgqy",
+	"bTwza45SCpgvhlTw9djxA/UzWdMtruCEYFZfh7B5gWJSoDI2TIfKltqYL4dAixMwSN4IHB6MNkZCyWZF",
+	"lc9MmE+DszxKBviEiVWG0mm9DFwYgyyNdbIsz3O757T3nHdJtXwmLZ8+K3zLj0iFNZ24qInYdgiOAlAO",
+	"BSztwm3jjs3gngo2yMDx82KB6vZZzBuSKiUyZh8pzTXj5gAjH98nxFpdyOgRYmQcgI0uCTgw+UmEZ5Mv",
+	"9wGSuyQ11I+NzgzB3xAP0bPxAUbkEaVh4YwnIlE8B6DOhba+vzqO3DgMYXxKDJu7ogVw7Z/YzSC9rE4o",
+	"tnZyODmnmC9T4uyA2dFeLHutCXsctJpQZvJAxwW6AYjnYjOzgeZRiXe+mRt6j4ZMmF7Rg2nzZ91T5kHu",
+	"DGU8t4lp1Q5Y0nB4MBoAMDGSWTv2S93mFpihaYelqRgVKvJFLds05JISJ8ZMnZBgUuTyRZAS6yAAuibH",
+	"On+ee/zufKS2xZP+Zd7cao0Vs45Gix3/1BGK7lICf32NTJ3EyqkQ3kImZJ7WUxhCZbrOxt9XL9h2M8M3",
+	"Rqe5GqgMcNZ+bfgnRH/nEv5ALXiaeQYQ8dzGUvYgebEphQLlYi3xqneDOzlRgo1aV1ZJqBhfFk4wSKEp",
+	"tmDvjegxbpfcpA/1A46TnWObm3jkD8FSlnE49nmpvHX4GYAiccobOEyD20LiUo4NwnKTpo83XdE+elBa",
+	"rTqJ7oK3Vux2MOTTNx/3jdQKCsDX86z12phdwjauBAAUzc59t0DLh+n0KN9+GXhrSlgypaEx7zHVYPqu",
+	"DScUs/gKsUivTpdyYdb3VohansOO1mzSWuadr+BKaJgtmDRhL8Y2Gl2CafSdQu3Td6Zp/FHR2mxiE9qz",
+	"PH6J4rQm/C9nRRWnVzfvj8/NtD/VsoOq5iiYME6AZisyxwIMUS/xgaltnM/ggl/ZBb+iR1vvuNNgmpqJ",
+	"pSGX9hz/JOeia3MZYAcRAowRR3/XkigduECD1AV97hg8MOzhxOv0ZMhM0TtMuR97p1OpT6CQEubsSANr",
+	"QX/IpFt+xAsxjF5qai9FkwxwoWct5UcEXbWCx4b4ME54e4P50k8Tj5sV9l09amjXdseAfPx4fPdwTgie",
+	"FSb/yO7wB3RArBU46IpiR0BfJ4Jxft6pZrdU39+BBmH1SrswRqmlJ90MWcqbp5HLhty8rZFgDe6slDne",
+	"emckNE9vDX33TXdlOTOKh2j87F+DAFlaWvOwbxyLJTWDMeO/EQfHftrbT/VYibo744xfdpjOegwKUJxT",
+	"ByQDT78xg10K0ZxeVIIo/YzDjBgHr192jXTao77ENU7LkuWbjt3TjprUjh8FY3hBucF2YCCgjVhktgTV",
+	"2vdAmWeL6bSyiJ6MwsxFO9l4KNOEUzHlS8H1EVVnbtjpDQy0+BG2v5q2uJzJzXRyOzNpDNduxB24flNv",
+	"bxTP6H9izWYtr4c9UU5L401Ei5kzJqdIU4orR5rY3Nue71hai3O9ixdnr1yOTbTXFUDlrH7tJFeF7cp/",
+	"mlXZjOmJA+JLTa2orvVz9jUcbH6d5jk0QF+vwJX1CR7UvfoDjXNBM543SC/i7tc7zcvOD8IuccAfAsra",
+	"HaIx1WHnjgcEvaKs8DYyD23CVRoXN+5ujHKFcIBbe1KEd9FR2U3vdMdPR0NdO3hSONdA4aG1ra2liOBd",
+	"/0TzCjYzWFI1bvNzcBaQPnPi1RqtBjNVsCxuT+VzZYiDWz8Z05hg48R72oxYsYTbFa9YMJZpNialYAfI",
+	"YI4oMlU062GDu7lwRVErzv5RQZAYtfZGDA4q6k+dZb1/ncalSjcw9gmGv42MEVbO6N54TuYaEjBCr5we",
+	"uM9rrZ9faG19otxL6/s694Uz9q7EAcc8Rx+Omm1kyKrtXTNaQt9ZQNXr31wJj8Qc0YKoTM0WUvwOcVUV",
+	"avgiceFuIhSmsPdJRFzvspjaktPUdW1mT253SroJPpK2Q2KC6nHnAxcczHPrrdGU26229QlbgQRxggla",
+	"qFM7fkMwDuZemFNBrzG+NypkGJgC80vLbq4F8Z097p2NhrnyLSck8Bur2zKb0KgE2aRs6CdHPFBgsNOO",
+	"FhUaycB0bMkE1n+aFkpEhqn4NeUafFEae5RcbwVWf296XQuJ6chU3MSfQ8bWUeXS+/fv8qxvzs3Zktki",
+	"j5WCoIqgG8hWx7VU5CoxWne6BjUvF+TBNKhT6nYjZ1dMsXkB2OKhbWFsWrg2f5brLmZ5wPVKYfNHI5qv",
+	"Kp5LyPVKWcQqQWqhDp83taOKT5f7ANs9/IZ8gS46il3BlwaL7n6ePH34DRpY7R8PYheAq+Y6xE1yZCf+",
+	"/R+nY/RRsmMYxu1GPYlqA2wJ7jTjGjhNtuuYs4QtHa/bfZbWlNMlxL1C1ztgsn1xN9EW0MELx0Y5KC3F",
+	"ljAdnx80NfwpEdpn2J8Fg2RivWZ67Rw5lFgbempKBNpJ/XC2GK29m2q4/Ef0hyq9O0jnEXm3dh97v8VW",
+	"jV5rP9E1tNE6JdTmoCtY46noa06Rlz7FJZa7qavcWNyYuczSUcwxW4glGBjX+LCo9GL2Z5KtqKSZYX8n",
+	"KXBn86+fREr8tEsw8P0Av3O8S1Agr+Kolwmy9zKE62uCHflszQyr/7IJpQ1OZdJxKzqtTvkJDQ89Vigz",
+	"o8yS5Fa1yI0GnPpWhMcHBrwlKdbr2Yse917ZnVNmJePkQSuzQ7+8feWkjLWQsbzVzXF3EocELRlcQZ7c",
+	"JDPmLfdCFqN24TbQf17jqRc5A7HMn+XkQ2Afi0/wNkCbT+iZeIi1p23paclcsQ3EDyMtILaC/S67x21q",
+	"W7Y67wOV6zISuoQSoRVx3MHYfi/g26sYApNPa4dSOGovLUaZ34rIkn1BtNrG4yImI3qr1AViPhgGNXdD",
+	"TUm7KNPde9R4s0jfs8N88bDiH11gPzOzQST7FSQ2MSiMF93OvP4eOJdR8q3YjN3UDu/2G/sHQE0UJRUr",
+	"8l+bZCztFc4l5dkq6iwyNx1/ayqk14uzhzmauHxFObfeCL3h7CvlN/+aiby3/i7GzrNmfGTbbupdu9zO",
+	"4hrA22B6oPyEBr1MF2aCEKvtPBd1WF+xFDnBeZos2c293g8W75cWTGVmsLUEBkr/OanFvm6JFqg4DfO7",
+	"F3QOxcn4a/MiCAQyi3SpIt19ItGlxGQgmBLhJrVFhediE5WLPOgzKYROxQU13oixlaJwarYJwxl8BENk",
+	"iXfLXYOV7Qh5EoswW6C1uXVLrTXrids2MKPBzGY0mOVsCSqBTfutVXzAosnC0s6M8IdE7M7KMh0Im+Qs",
+	"6ProqzH0czV01KdGuE9JQBe1QGWoOzCLtmO0aof2fc6K64pqmDrlxAHP2ajb5kXiENXAhVlG7n5vL69m",
+	"SbCNMynj4VGpfTusEbFmEmKzP77V3S82kXTHrHWtlqa8ci1ahDv1uVLYpPw4I+DWNwT2+YPykYT4O7Qe",
+	"PJNCNsfa/DAlQtZ0554F00EC/MzCc/vO7d1U8cukxXctc2kSBDnaGBLIbUHCb6t8CfqXWHx0p4G3P4nS",
+	"bAGZ4+9O4EG3JpJhsFqeW8FGr+pGhtFr1U3j4z6aL95zJBNcVeuY88GAX2bXJ82AAYfYgC1AM1xB5G1h",
+	"wU2sr0lA5CPxHUIS0oGdyq83OZtv4Ef1iBKL1iRN7heDTcY5yPpbwvcCG81KqlcJdQLUXuB2vFb+RO88",
+	"Um97Lq55YJ1wUIVs3eUgtR7HLfR0s9AHUA5m/wydSFt718dv+gD8XL6RYsGK5AGoGwSVSvFPa9FmLjte",
+	"Uy/BbhEyIfxBVXMpKs14rAi3iImFP7eOmCpRDNi6vainwxncz80c9fFqflKE6SFSSKRxagJnsLxmd3oi",
+	"qQWBR9oF8BhemzyS5tLNoo8pxw8qrt3YkcW7nzEEpLsRbSjiU8ereP2VFsUsq+uKWvxPO7WCRiV5tFza",
+	"eWSUmavcM0CMO0jRfSZUa8nmVZOXVel62xHkkBDNqbRcocaPVdgGBNInTBGLnXTzq8h+1L/Vs5qLQVI+",
+	"OoaxexwjsZSlOXpJsDoQ2Ium/ZvyV1HNIlu3hq1fi6z8d4blp+pEt/NqsXAOFs48i8CckDd+5KosBM1t",
+	"/gUfNIvB/bbGA6/RxqT//hmiDps93729QeNPv7udwyMw4NtueBvs9Ol5C/+oos9s98FKi6YzXki2sDIB",
+	"nttnPPke09OZZbZquOBZYeuqsPVAQqWA3fMpMeMYD2FiZ7V9JOhKusLOS2sUaemYbpmRPYg0T0U+HyPf",
+	"kq2oMKsrLMcy9poWF74BYR3fX3R7CLFzQp5bjxPlJQY7CckEXzBpxJ16OmfzRI2d+Y/WNDPPFi1ahyet",
+	"kCxTLNVzjZA9TMONS7JWe4Z38dO+CWd8cXSvvmx87qj/f9aUT8RTbVDo6qPb8uhTIoyJ6ZqZohIrquEK",
+	"2vmKa57mzrTPX9zGtKw4t0QbVcgMFXg4hAI8cE4Bxwcg69DAnkK+Y7171oo/x16x89ErPN9xJfbZb13G",
+	"jhPy2rmFZZQLzjIsNxSz4WCqz3Fu/CMqM6XLDbjcGr1zHi13X2f1cFhMFsCfTlqISwg09qvZVEsd9k8N",
+	"G/ewXIJuLtYpmvtZAc6VkXEFrhymIaLW86R9tyOz3vGm3JOMMItfwjflO/PtJ+e5ZI4guWRWFPFygbb+",
+	"nOhsaDJSGWrnhGmyFKDceto5cNU70+cE0yjnsPlw8kosWXbOljiGjSwwy7ZhNP2hznxQjRethSTPTFtb",
+	"bqb5uZUwyU56VpZu0qhIUe9w75Pe8CSChx5zAXLr8cPRBshtMBoOr3ZDaCZBMFEaSuJyqLQJA6SM2Shf",
+	"2LTChqKwBbGB9DGkxOOJXzHunV8PfQpF+6lMGlXQ+DobQAsMoIkxNKWd9/Rth+pssAs8xmeQnSO9jRcb",
+	"U42sKnSKcdQNGgsf5VviD4Wh7kCueWY0FF50RXms7cfD81qec1lYbMpuKyHGGYdh3LM1KOUjpbpajOAY",
+	"9MUz2z2QTXZfQYHkXA+gJc1g36sslRR3D/VXXpm1EdhAVtWaD68K6lQhOYLy6yjTSSiF1DsWZkihq3Or",
+	"ZzIf51ur+Oq+Ixv9yh5b0ShfI9uRM0WVgvW8iJhfntcfIa8p25wwA2DhFB7jKdLFz+2dhMIHy+V1fql9",
+	"ni7tkXoPD3OWZyaD5HhM4F16e3Q0Ux92wJv+h57wZoSjHvFCLNtLuWOL1dD9EO5y7GZ4Ya7cMEV+r+ip",
+	"vZTrDPYYcS3wu0/6WOdebvNzn9itN6fb/simd4D3DaOAX9EikTomcKekVjKx1tNUApksme+IapeiVFMy",
+	"yAyTaR9t6CZ+t1DE3WZT4Zo2WtN87vU+sCAajj2IUB8H3Afox9oQXVLm4qIadtPHrLNxp02SQ4eu2eDu",
+	"IlyeoqQN7serVE4hn2oPv3drll2Cy1teSrhionIbVisq/GPa/mr9fcLUfcn1R238n9vTcNAwbCoS2WU6",
+	"bcaPvzrfA3S3+gN4SfY23RZeHcoo9czLtE7Z6MTSqNJQj71tn9s72vp0rEU+lJPwx1/Jc+++Pere8YQc",
+	"y2gucvTvSaRafeXqK/tmRm4fPe1r1+msLIenTiRh7E9uG+47fSqbuzmfQ6rTN/78zmtzk1e+RF55QcZA",
+	"DpuYxc4oTroJ566BwKYErN8V5A5MJ6gdS1Aujxi+82cFUAUDGA4LI7i2I5F8sXll2o/LZ/mKLVcay0z9",
+	"gM4Sb3aU0WpKZyHzLIVijSW3MIO1HPBOxkb1X6B1LHDK74/lTU1XkGkhW6GCEmCfomBmMu8Y8+9yWmkV",
+	"U538wNP/QOms6STkLdFcYO540SYLNTquY1RDxNJv20SYvYS66Ls0fv1uCPPDghYKoraEZDx5J7lwEBMW",
+	"KV4XX9jLfDcu/XKmQZgRy4cRGU+2cWaDc/4lkWlTRxwXnZFKzFGOYFMWWieVdtbCA73LXaMDcgulcniE",
+	"ozuft15dZtj4chboKLazpMUuxf3urLUXtUtJr2Z1P1PsUPLWUaAUdBiSgn5qQHanhU8lWg1gT1MqkuiL",
+	"Tcnk9tsqu4QEHXRLUyfrb4MZyr7ubWV8ypdI54gkdbJHXvJ2LooDIcALyMBwAAVYnA7EI4R0KBa3mqug",
+	"w1MV9Fgz7SzE7s66m0c1OB84/dboftvznzaDhlvRKbWbqr0+nbQyov/AlBYy8Y6WkEHv2K5sD+eu1SW0",
+	"Pdj0WZ1Axnrlm3t0CRy9AvJOKsbR9cYEV6hvv4LZmikF+cwc+p3HCBsR28Mlr63rN5tvZE1zIJUKlBkH",
+	"0Jh90kBu3kOlULTYCZdlD4bAfNLKBlc2z7T167UDQlMS9XDYRuFrLFxmsE/NYjJxBUGMk6NP9LXEOuoY",
+	"f8+Fa90utbo9KMVD6tq7hO09RVrn6+XzvYuJd/ja3S/PHRxPpBZONRB7FiEEd5Qo8aMM+UzvAqhfb64F",
+	"4Kc6RViBP2c5v3cLLKLMcSsM+jN0ZOwd/ZzfBl1JcS7J0KPctMfGuldkrCR9lNgjRBbd0g46d963P8J2",
+	"UNsQuVKD2iRAuMjhM9+xsFhAhhsy+CL5q+FLTY2GqfcfCrzfLctidRJPrFd6wNVVA1TQA+Ep6PHAuf3t",
+	"4Awbh5SqRAzYyCtPuSmHR5eXhqmaMhALPunYbpHCTxfoeA+cy5NkW9s7MKU5bQfOlRBJ0iwIeUaq1MYb",
+	"K9u3ys+nrL3PQVNWKJeCh9bvgtAZxDjGdSvJX7tSmVj1pHY39kUzQfnffIkjO0vBLqGpyuR8hLFCg2sR",
+	"dRHy3kdj49awGWFxoBf1zKxJEdlPJ97fY5tcJSuEEbhnQ5qY5qqqs8jcUzb3FKpor0E6uBYgZRMEZ8aG",
+	"mRYRBVEPjp3R24chIZFfDNOPG+CSxVbfNtVkm2enRWpngUTCmhroZFDzNT3nELKf2e8+f3YdHLfLkamm",
+	"190ZDHxyUKZ6SAypfkHcbbk7L/chzkE2xtB7SHdjDHtBhaUUeZXZCzo8GLXf12j3qgFWEvWKyfqr7Dk4",
+	"FFhs/FVQ5eAStqfW9pytjKqkqd4WQm/NGnYNQWG0zm4f1W8q7uBRLO0ClkeB83N6Dk0npRDFLOGm+7Jf",
+	"x7Z7Bi6ZqQJPzN3h0+pxkcO99mkxk5Av0Du0jsO4Xm193dayBA75lyeEnHGbyNSHZISVdHuT83t6aP4N",
+	"zppXtrS0c2o6ec/jGSGxZpC8JX/zwwxzNQU8v/VUdpDhifQmUUPXFGVXGOqQ4JVOlhgdJNGRUwKislBE",
+	"pRTrXXjGabFVLFoRhGqWEeoa1LYjwbUUBVkU4posJS1XrSjNOpAQ66i4oCKf3znDUcKAo77AMTd8f1fK",
+	"OTcZVgznghRClMqmT8gqqdgV+DBIJXxJgM0MY6Dm7vWKidZUIlcw4i71NgearTAgCKTsrWV0CLjhbyJV",
+	"stfmkPIHH8vkZ5fuHPXDg5kkJmi9pHrVJCVoMBGEMPd9e3eD6TEX2RBhnnoZVXUuBcQwWuq2CA7RKymq",
+	"5aodztoUdk+Gd5O/+gSZfqcZ8htHHNMmpg1pz/uEVdz7M1uKsAJuiyTiR9UsEn35Zwnj/2ub25qsAP0f",
+	"XP3K7BJzZSJJnKR8XbLLmQFamgOjUh4WDRU5rYp5b3KA3GbrxNsZyYF35jZZCOt0ols8aggO5Hvu82BE",
+	"7UVno2wcvHVhaA5E5hQRo2SA83q4mgVFoKp4PfyQ1jcWwC0r3mM3hAtLmBZH6jZZGzyjCo5In5BinBef",
+	"pIFNccjC5Gx51gbuhACwli2a8oG0fWZqn7E7BqsDVBy7RPnb6v9aq/LTxdB7YInLUUTb99iN0CwCkHDY",
+	"aqn1wgq4TfZPaR2/kUF6d+zuFr9u/Ll3vmIQEt9hB3ihB1bTrhazHTifOcvQ6xopwVKSlNBa/i6nLrfA",
+	"RuAOtsjKMWaZtnC/jdpt70vgsaee1Y5wcTz3/eVsPkKOtfL7fnaqcRUICcccJnlFP0OaK6yDfIb4gPzt",
+	"OMNciGSLSnVY+PMrOmrugn6CqU2AyxXwv6IsEI3gcEM5j27piczLPJzqStKCFGIZJKS4Ak6ucUzcafLw",
+	"azJ32edLCRlTrFOY41pURe610qjHBMkWzihgXGiHFae71vmr0Lcg44VPlER+alxRtMCHTwNhc0Q/M1NJ",
+	"nNwolceor0cWEfxFeVRfDBrzEguzMU2J4Hh3iOtYjKdcqqGcSLVo2XopGPHTxQ9aKa+ROp2EjSJgojTm",
+	"qDdcMNsnesYd8nTx6SLaEI55tKAfdCX5J3thGCPBFRBbHMyyCf9KCnDJ9s9SdXF47qmuhcQQ23ScaIyw",
+	"xI5EWBlxhwR12QqPMjvQNiXYEJojh0kFoeJ7hkn1az6OXR6uA+WwSkF/naMF2BZuI7Jrs7axMX4RDU4y",
+	"NE/Px4Tm2R9i3TE20CLENDohCCr528O/EQmY1koLcv8+TnD//tQ1/duj9mdzw92/Hz0ddxYVaHHkxnDz",
+	"RimmUfO9uIrewWcxm6EzdNEwRzfy1bXwqUJ7Kl2jNYwl9ju+rYZ1QDtANhnSmzstnRI8DkwPC2awEDAu",
+	"dBS4MCFIyqIfThY15XcIAUeK7rwLF+rl00ev3UTl8LdO0nWvFwxQcm6+8RL/hZ+jE+2EHV1OkTvO7Iaa",
+	"9Z0hDHZprvEuJAco80uuJ4rh/tdUXiubuymRa7/DBU1a/l3suFU5wRgqgYNiCmsD/Oaq+twt+j0Elr77",
+	"F6SFda88Al3Wh4iJrLU1eTBVUBNhRDkE1y1S/ACJK6sk01ssNuwdHNhv0ajh72ufKBfnVpendI8wLS6h",
+	"LlfdeFA1HsHfC1ogI6E8t1kctOGx5MWGrsvCaXXJX+7N/wSP//wkf/D44Z/mf37w1YMMnnz1zYMH9Jsn",
+	"9OE3jx/Coz9/9eQBPFx8/c38Uf7oyaP5k0dPvv7qm+zxk4fzJ19/86d7k+mEGZAtoBNf2m7yv2dnxVLM",
+	"zt68nF0YYBuc0JIZt7ObG7RuL4Rj9ZpmeMXAmrJi8tT/9D89Kz7JxLoZ3v86cZWzJiutS/X09PT6+vok",
+	"7HK6RJeJmRZVtjr189xMOxg/e/OyzmJoo71xR21WOG9h8aRwht/evji/IGdvXp40BDN5Onlw8uDkoRlf",
+	"lMBpySZPJ4/xJzw9K9z3U0dsk6cfb6aT0xXQQq/cH2vQkmX+k7qmyyXIE0xkZn+6enTq37SnH527yM3Q",
+	"t9NAWDM/N3/NWL6jJ4bynn70lXCHW7dKzTrJIOgwEoqhZqdzsdmjKaigcXopqOlSpx9RlEj+fupqu8Q/",
+	"os7MnoFT73oWb9nC0kdzB98c0EOCKeTadGnymDf9cNRBYhnoNX4bcJCqPP3YjBZMYdPaBMidLGPhS9+D",
+	"9pH6toc9hI3+vj6JL3PbvJcCYDqpuaSaPH2XFhabuG4bTIvTUWn+q5gr2448zRzYhuV4F93mQsHwyElT",
+	"336o8OzNh+nEatddjPejBw8853PCdYDlU3fgJ+OK5/dwgcx1OCFCXucyePLg4dEgaWeYiYDxkqNDrGGc",
+	"xF4MCMGTu4PgGaouudBkwXhOqMUEUoXdYgToz3cHkGZr78jCiXQJeG+mk68ePLg7IF5yDZLTgmBLO/3j",
+	"u5v+HOQVy4BcwLoUkkpWbMkvvM6AGhRu7vOOX/glN14IDnI0KK/XVG4toyCUdM+HSyTqeMwScxb7462p",
+	"UVq+m5SSXVGUevEt8uGmZmhXa5GDZ9JisbAh2UOfTz/af2+S7T4ik458V5yWaiW0Gvh0+tH/d2bvhiuQ",
+	"AUj2wJ86K2Z9H+DLZLuzmRZlqo0387Y/SlEUxmmkf6G6BlhztT+x2nKnICwg5k79C1egW0WltjxLXRDY",
+	"+HzLs7c11+7xXjznd3jEzmt4kfugv+0fgv3+m9HcntG8Rc2OIk4GCIiTSFBGpjeDNIofS8MnQwxnmhSV",
+	"nMW4P5W3ljej9+SmHYdi/Da0dQ4DKrpRcO7Q7qd8Lvob7De/m/DLTnUvtkOTf3OCf3OCI3ICXUmePKLB",
+	"BYYxQVC6Ys8ZzVZwMkICCe7L8F1VRs2N5wPcQvBBZnHeZhb/hK+ruz7Xzyj3B7q15dYLncqCgazJgPKW",
+	"CtEJMv9mA/8qLw98VTgNxpRoMHb64PBrgYfflYGxsZ7cuTeMZASt2NxGnm79fPqx9Wdb87Wr5emqzsfh",
+	"eqhVpU2dsuAXTTVYL7W+xG8+Vqr79+k1ZdqY1lxoKF1okP3OGmhx6uoVdH5tEt32vmD23uDHQHkW//UU",
+	"roDr1Me6mHv0Y1fJGfvqVHa+UWPFCK0CyFJre8C7D4ahKZBXnts2Su6np6cYbLUSSp9ObqYfOwrw8OOH",
+	"moZ8te+alm4+3Pz3AD0OCds+BgEA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -106,6 +106,12 @@ const (
 	GetTransactionProofParamsFormatMsgpack GetTransactionProofParamsFormat = "msgpack"
 )
 
+// Defines values for GetTransactionReplayStateParamsFormat.
+const (
+	GetTransactionReplayStateParamsFormatJson    GetTransactionReplayStateParamsFormat = "json"
+	GetTransactionReplayStateParamsFormatMsgpack GetTransactionReplayStateParamsFormat = "msgpack"
+)
+
 // Defines values for GetPendingTransactionsParamsFormat.
 const (
 	GetPendingTransactionsParamsFormatJson    GetPendingTransactionsParamsFormat = "json"
@@ -114,8 +120,8 @@ const (
 
 // Defines values for PendingTransactionInformationParamsFormat.
 const (
-	Json    PendingTransactionInformationParamsFormat = "json"
-	Msgpack PendingTransactionInformationParamsFormat = "msgpack"
+	PendingTransactionInformationParamsFormatJson    PendingTransactionInformationParamsFormat = "json"
+	PendingTransactionInformationParamsFormatMsgpack PendingTransactionInformationParamsFormat = "msgpack"
 )
 
 // Account Account information at a given round.
@@ -497,6 +503,84 @@ type BuildVersion struct {
 	Minor       uint64 `json:"minor"`
 }
 
+// CatchpointProof Proof that a balances merkle trie entry is committed to by a catchpoint label.
+type CatchpointProof struct {
+	// Address The account the element was derived from, omitted for a box.
+	Address *string `json:"address,omitempty"`
+
+	// BalancesRoot The root of the balances merkle trie, as hashed into the catchpoint label.
+	BalancesRoot []byte `json:"balances-root"`
+
+	// BalancesRound The round of the ledger state captured by the catchpoint.
+	BalancesRound uint64 `json:"balances-round"`
+
+	// BlockHeaderDigest The digest of the catchpoint round block header, as hashed into the catchpoint label.
+	BlockHeaderDigest []byte `json:"block-header-digest"`
+
+	// Catchpoint The catchpoint label the proof is verified against.
+	Catchpoint string `json:"catchpoint"`
+
+	// CreatableIndex The asset or application of the account resource the element was derived from, omitted for account data and boxes.
+	CreatableIndex *uint64 `json:"creatable-index,omitempty"`
+
+	// Key The balances merkle trie element being proven.
+	Key []byte `json:"key"`
+
+	// KvKey The key in the ledger key-value store of the box the element was derived from, omitted for accounts.
+	KvKey *[]byte `json:"kv-key,omitempty"`
+
+	// Proof The msgpack encoded merkle trie proof.
+	Proof []byte `json:"proof"`
+
+	// Totals The msgpack encoded account totals, as hashed into the catchpoint label.
+	Totals []byte `json:"totals"`
+
+	// Value The msgpack encoded account data or resource data, or the box content, the element was derived from.
+	Value []byte `json:"value"`
+}
+
+// DryrunBudgetUse DryrunBudgetUse is the opcode budget that an app call added to the budget of its group, and the budget its program consumed.
+type DryrunBudgetUse struct {
+	// AppIndex The application called.
+	AppIndex uint64 `json:"app-index"`
+
+	// BudgetAdded Budget added to the budget of the group by the app call.
+	BudgetAdded uint64 `json:"budget-added"`
+
+	// BudgetConsumed Budget consumed by the program of the app call, without its inner app calls.
+	BudgetConsumed uint64 `json:"budget-consumed"`
+
+	// InnerPath Indexes in the inner transactions of each app call down to the app call, omitted for the top-level app call.
+	InnerPath *[]uint64 `json:"inner-path,omitempty"`
+}
+
+// DryrunOpProfile DryrunOpProfile is the profile of an instruction of a program, or of a subroutine.
+type DryrunOpProfile struct {
+	// Cost Opcode budget spent by the instruction, or by the subroutine and the subroutines it calls.
+	Cost uint64 `json:"cost"`
+
+	// Count Number of times the instruction ran, or number of times the subroutine was called.
+	Count uint64 `json:"count"`
+
+	// Pc Program counter of the instruction, or of the first instruction of the subroutine.
+	Pc uint64 `json:"pc"`
+
+	// Time Wall-clock time spent, in nanoseconds.
+	Time uint64 `json:"time"`
+}
+
+// DryrunProfile DryrunProfile attributes the cost and the time of a program to its instructions and subroutines.
+type DryrunProfile struct {
+	// Ops Profiles of the instructions of the program that ran.
+	Ops []DryrunOpProfile `json:"ops"`
+
+	// Pprof Profile of the program, and of the programs of its inner application calls, in the gzipped protocol buffers format of pprof. Programs uploaded as sources have the lines of their sources.
+	Pprof []byte `json:"pprof"`
+
+	// Subroutines Profiles of the subroutines of the program that ran.
+	Subroutines []DryrunOpProfile `json:"subroutines"`
+}
+
 // DryrunRequest Request data type for dryrun endpoint. Given the Transactions and simulated ledger state upload, run TEAL scripts and return debugging information.
 type DryrunRequest struct {
 	Accounts []Account     `json:"accounts"`
@@ -505,6 +589,9 @@ type DryrunRequest struct {
 	// LatestTimestamp LatestTimestamp is available to some TEAL scripts. Defaults to the latest confirmed timestamp this algod is attached to.
 	LatestTimestamp uint64 `json:"latest-timestamp"`
 
+	// Profile Profile the programs, and return the cost and the time of their instructions and subroutines.
+	Profile *bool `json:"profile,omitempty"`
+
 	// ProtocolVersion ProtocolVersion specifies a specific version string to operate under, otherwise whatever the current protocol of the network this algod is running in.
 	ProtocolVersion string `json:"protocol-version"`
 
@@ -540,8 +627,11 @@ type DryrunState struct {
 
 // DryrunTxnResult DryrunTxnResult contains any LogicSig or ApplicationCall program debug information and state updates from a dryrun.
 type DryrunTxnResult struct {
-	AppCallMessages *[]string      `json:"app-call-messages,omitempty"`
-	AppCallTrace    *[]DryrunState `json:"app-call-trace,omitempty"`
+	AppCallMessages *[]string `json:"app-call-messages,omitempty"`
+
+	// AppCallProfile DryrunProfile attributes the cost and the time of a program to its instructions and subroutines.
+	AppCallProfile *DryrunProfile `json:"app-call-profile,omitempty"`
+	AppCallTrace   *[]DryrunState `json:"app-call-trace,omitempty"`
 
 	// BudgetAdded Budget added during execution of app call transaction.
 	BudgetAdded *uint64 `json:"budget-added,omitempty"`
@@ -549,6 +639,9 @@ type DryrunTxnResult struct {
 	// BudgetConsumed Budget consumed during execution of app call transaction.
 	BudgetConsumed *uint64 `json:"budget-consumed,omitempty"`
 
+	// BudgetReport Budget added and consumed by the app call and by each of its inner app calls.
+	BudgetReport *[]DryrunBudgetUse `json:"budget-report,omitempty"`
+
 	// Disassembly Disassembled program line by line.
 	Disassembly []string `json:"disassembly"`
 
@@ -557,10 +650,13 @@ type DryrunTxnResult struct {
 	LocalDeltas *[]AccountStateDelta `json:"local-deltas,omitempty"`
 
 	// LogicSigDisassembly Disassembled lsig program line by line.
-	LogicSigDisassembly *[]string      `json:"logic-sig-disassembly,omitempty"`
-	LogicSigMessages    *[]string      `json:"logic-sig-messages,omitempty"`
-	LogicSigTrace       *[]DryrunState `json:"logic-sig-trace,omitempty"`
-	Logs                *[][]byte      `json:"logs,omitempty"`
+	LogicSigDisassembly *[]string `json:"logic-sig-disassembly,omitempty"`
+	LogicSigMessages    *[]string `json:"logic-sig-messages,omitempty"`
+
+	// LogicSigProfile DryrunProfile attributes the cost and the time of a program to its instructions and subroutines.
+	LogicSigProfile *DryrunProfile `json:"logic-sig-profile,omitempty"`
+	LogicSigTrace   *[]DryrunState `json:"logic-sig-trace,omitempty"`
+	Logs            *[][]byte      `json:"logs,omitempty"`
 }
 
 // ErrorResponse An error response with optional data field.
@@ -661,6 +757,72 @@ type ModifiedAsset struct {
 	Id uint64 `json:"id"`
 }
 
+// OnlineAccountStake The stake of an online account.
+type OnlineAccountStake struct {
+	// Address The account address.
+	Address string `json:"address"`
+
+	// Amount The account balance, in microalgos, excluding its pending rewards.
+	Amount uint64 `json:"amount"`
+
+	// VoteFirstValid The first round the account participation key is valid.
+	VoteFirstValid uint64 `json:"vote-first-valid"`
+
+	// VoteLastValid The last round the account participation key is valid.
+	VoteLastValid uint64 `json:"vote-last-valid"`
+}
+
+// OnlineStakeExpiryBucket The online accounts whose participation keys expire within a range of rounds.
+type OnlineStakeExpiryBucket struct {
+	// Accounts The number of online accounts whose participation keys expire within the range.
+	Accounts uint64 `json:"accounts"`
+
+	// FirstRound The first round of the range.
+	FirstRound uint64 `json:"first-round"`
+
+	// LastRound The last round of the range.
+	LastRound uint64 `json:"last-round"`
+
+	// Stake The total balance of these accounts, in microalgos, excluding their pending rewards.
+	Stake uint64 `json:"stake"`
+}
+
+// ParticipationHistory The recent participation history of a participation key.
+type ParticipationHistory struct {
+	// Address Address the key was generated for.
+	Address string `json:"address"`
+
+	// ConsecutiveMissedVotes The number of votes missed since the last vote made using the key.
+	ConsecutiveMissedVotes uint64 `json:"consecutive-missed-votes"`
+
+	// ExpectedProposals The number of rounds in which the key was selected to propose a block.
+	ExpectedProposals uint64 `json:"expected-proposals"`
+
+	// ExpectedVotes The number of rounds in which the key was selected to vote.
+	ExpectedVotes uint64 `json:"expected-votes"`
+
+	// FirstRound The first round covered by the history, or zero when no round was evaluated yet.
+	FirstRound uint64 `json:"first-round"`
+
+	// Id The key's ParticipationID.
+	Id string `json:"id"`
+
+	// LastRound The last round covered by the history, or zero when no round was evaluated yet.
+	LastRound uint64 `json:"last-round"`
+
+	// MissedProposalRounds The rounds in which the key missed a proposal.
+	MissedProposalRounds []uint64 `json:"missed-proposal-rounds"`
+
+	// MissedProposals The number of rounds in which the key was selected to propose a block but didn't.
+	MissedProposals uint64 `json:"missed-proposals"`
+
+	// MissedVoteRounds The rounds in which the key missed a vote.
+	MissedVoteRounds []uint64 `json:"missed-vote-rounds"`
+
+	// MissedVotes The number of rounds in which the key was selected to vote but didn't.
+	MissedVotes uint64 `json:"missed-votes"`
+}
+
 // ParticipationKey Represents a participation key used by the node.
 type ParticipationKey struct {
 	// Address Address the key was generated for.
@@ -733,6 +895,42 @@ type PendingTransactionResponse struct {
 	Txn map[string]interface{} `json:"txn"`
 }
 
+// ProgramAnalysis Static analysis of the control flow graph of a program. Program locations are the pcs of instructions.
+type ProgramAnalysis struct {
+	// Bounded Whether the program has no loops or recursive calls, so that max-cost bounds its cost.
+	Bounded bool `json:"bounded"`
+
+	// Errors The reachable err instructions.
+	Errors *[]uint64 `json:"errors,omitempty"`
+
+	// Loops The branches that go back to an instruction of their own path, and the recursive subroutine calls.
+	Loops *[]uint64 `json:"loops,omitempty"`
+
+	// MaxCost Worst-case opcode cost of any path through the program, including the subroutines it calls. When the program isn't bounded, the cost of a single run of its loops and recursive calls.
+	MaxCost uint64 `json:"max-cost"`
+
+	// MaxStackDepth Maximum height the stack can reach.
+	MaxStackDepth uint64 `json:"max-stack-depth"`
+
+	// StackUnderflows The instructions which may need more values than the stack can hold when they are reached.
+	StackUnderflows *[]uint64 `json:"stack-underflows,omitempty"`
+
+	// Subroutines The subroutines called from reachable code.
+	Subroutines *[]SubroutineAnalysis `json:"subroutines,omitempty"`
+
+	// Unreachable The first instructions of the runs of instructions no path reaches.
+	Unreachable *[]uint64 `json:"unreachable,omitempty"`
+}
+
+// RoundOnlineStake The total online stake at the end of a round.
+type RoundOnlineStake struct {
+	// OnlineStake The total online stake, in microalgos.
+	OnlineStake uint64 `json:"online-stake"`
+
+	// Round The round.
+	Round uint64 `json:"round"`
+}
+
 // StateDelta Application state delta.
 type StateDelta = []EvalDeltaKeyValue
 
@@ -763,6 +961,24 @@ type StateProofMessage struct {
 	VotersCommitment []byte `json:"VotersCommitment"`
 }
 
+// SubroutineAnalysis Static analysis of a subroutine, on its own.
+type SubroutineAnalysis struct {
+	// Args Number of values the subroutine may consume from the stack of its caller.
+	Args uint64 `json:"args"`
+
+	// Bounded Whether the subroutine has no loops or recursive calls, so that max-cost bounds its cost.
+	Bounded bool `json:"bounded"`
+
+	// MaxCost Worst-case opcode cost of running the subroutine, including the subroutines it calls in turn.
+	MaxCost uint64 `json:"max-cost"`
+
+	// MaxStackDepth Maximum height the stack can reach above its height when the subroutine is called.
+	MaxStackDepth uint64 `json:"max-stack-depth"`
+
+	// Pc The first instruction of the subroutine.
+	Pc uint64 `json:"pc"`
+}
+
 // TealKeyValue Represents a key-value pair in an application store.
 type TealKeyValue struct {
 	Key string `json:"key"`
@@ -786,6 +1002,18 @@ type TealValue struct {
 	Uint uint64 `json:"uint"`
 }
 
+// TransactionEvent A pending transaction that was committed or removed from the transaction pool.
+type TransactionEvent struct {
+	// ConfirmedRound The round where this transaction was confirmed, if it was committed.
+	ConfirmedRound *uint64 `json:"confirmed-round,omitempty"`
+
+	// PoolError The reason this transaction was removed from the pool, if it was not committed.
+	PoolError *string `json:"pool-error,omitempty"`
+
+	// Txid The transaction ID.
+	Txid string `json:"txid"`
+}
+
 // TxLease defines model for TxLease.
 type TxLease struct {
 	// Expiration Round that the lease expires
@@ -945,6 +1173,9 @@ type CatchpointAbortResponse struct {
 	CatchupMessage string `json:"catchup-message"`
 }
 
+// CatchpointProofResponse Proof that a balances merkle trie entry is committed to by a catchpoint label.
+type CatchpointProofResponse = CatchpointProof
+
 // CatchpointStartResponse An catchpoint start response.
 type CatchpointStartResponse struct {
 	// CatchupMessage Catchup start response string
@@ -953,6 +1184,9 @@ type CatchpointStartResponse struct {
 
 // CompileResponse defines model for CompileResponse.
 type CompileResponse struct {
+	// Analysis Static analysis of the control flow graph of a program. Program locations are the pcs of instructions.
+	Analysis *ProgramAnalysis `json:"analysis,omitempty"`
+
 	// Hash base32 SHA512_256 of program bytes (Address style)
 	Hash string `json:"hash"`
 
@@ -963,10 +1197,28 @@ type CompileResponse struct {
 	Sourcemap *map[string]interface{} `json:"sourcemap,omitempty"`
 }
 
+// DevModeRoundResponse defines model for DevModeRoundResponse.
+type DevModeRoundResponse struct {
+	// Round The latest round.
+	Round uint64 `json:"round"`
+}
+
+// DevModeSnapshotResponse defines model for DevModeSnapshotResponse.
+type DevModeSnapshotResponse struct {
+	// Round The round captured by the snapshot.
+	Round uint64 `json:"round"`
+
+	// SnapshotId The snapshot identifier.
+	SnapshotId uint64 `json:"snapshot-id"`
+}
+
 // DisassembleResponse defines model for DisassembleResponse.
 type DisassembleResponse struct {
 	// Result disassembled Teal code
 	Result string `json:"result"`
+
+	// Sourcemap JSON of the source map, when annotated
+	Sourcemap *map[string]interface{} `json:"sourcemap,omitempty"`
 }
 
 // DryrunResponse defines model for DryrunResponse.
@@ -978,6 +1230,12 @@ type DryrunResponse struct {
 	Txns            []DryrunTxnResult `json:"txns"`
 }
 
+// GetBlockTimeStampOffsetResponse defines model for GetBlockTimeStampOffsetResponse.
+type GetBlockTimeStampOffsetResponse struct {
+	// Offset The timestamp offset between consecutive blocks, in seconds.
+	Offset uint64 `json:"offset"`
+}
+
 // GetSyncRoundResponse defines model for GetSyncRoundResponse.
 type GetSyncRoundResponse struct {
 	// Round The minimum sync round for the ledger.
@@ -1047,6 +1305,22 @@ type NodeStatusResponse struct {
 	TimeSinceLastRound uint64 `json:"time-since-last-round"`
 }
 
+// OnlineStakeExpiryResponse defines model for OnlineStakeExpiryResponse.
+type OnlineStakeExpiryResponse struct {
+	Buckets []OnlineStakeExpiryBucket `json:"buckets"`
+
+	// Round The round the accounts were queried at.
+	Round uint64 `json:"round"`
+}
+
+// OnlineStakeHistoryResponse defines model for OnlineStakeHistoryResponse.
+type OnlineStakeHistoryResponse struct {
+	OnlineStake []RoundOnlineStake `json:"online-stake"`
+}
+
+// ParticipationHistoryResponse The recent participation history of a participation key.
+type ParticipationHistoryResponse = ParticipationHistory
+
 // ParticipationKeyResponse Represents a participation key used by the node.
 type ParticipationKeyResponse = ParticipationKey
 
@@ -1074,6 +1348,12 @@ type PostTransactionsResponse struct {
 	TxId string `json:"txId"`
 }
 
+// RollbackLedgerResponse defines model for RollbackLedgerResponse.
+type RollbackLedgerResponse struct {
+	// Round The latest round.
+	Round uint64 `json:"round"`
+}
+
 // StateProofResponse Represents a state proof and its corresponding message
 type StateProofResponse = StateProof
 
@@ -1089,6 +1369,22 @@ type SupplyResponse struct {
 	TotalMoney uint64 `json:"total-money"`
 }
 
+// TopOnlineAccountsResponse defines model for TopOnlineAccountsResponse.
+type TopOnlineAccountsResponse struct {
+	Accounts []OnlineAccountStake `json:"accounts"`
+
+	// Round The round the accounts were queried at.
+	Round uint64 `json:"round"`
+
+	// TotalOnlineStake The total online stake, in microalgos, of the accounts whose participation keys are valid in the round.
+	TotalOnlineStake uint64 `json:"total-online-stake"`
+}
+
+// TransactionEventsResponse defines model for TransactionEventsResponse.
+type TransactionEventsResponse struct {
+	Events []TransactionEvent `json:"events"`
+}
+
 // TransactionParametersResponse TransactionParams contains the parameters that help a client construct
 // a new transaction.
 type TransactionParametersResponse struct {
@@ -1141,6 +1437,12 @@ type TransactionProofResponse struct {
 // * sha256
 type TransactionProofResponseHashtype string
 
+// TransactionReplayStateResponse defines model for TransactionReplayStateResponse.
+type TransactionReplayStateResponse struct {
+	// State The block header, the transaction group, and the accounts and boxes the group was evaluated against.
+	State map[string]interface{} `json:"state"`
+}
+
 // VersionsResponse algod version information.
 type VersionsResponse = Version
 
@@ -1227,6 +1529,63 @@ type GetTransactionProofParamsHashtype string
 // GetTransactionProofParamsFormat defines parameters for GetTransactionProof.
 type GetTransactionProofParamsFormat string
 
+// GetTransactionReplayStateParams defines parameters for GetTransactionReplayState.
+type GetTransactionReplayStateParams struct {
+	// Format Configures whether the response object is JSON or MessagePack encoded.
+	Format *GetTransactionReplayStateParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// GetTransactionReplayStateParamsFormat defines parameters for GetTransactionReplayState.
+type GetTransactionReplayStateParamsFormat string
+
+// GetCatchpointAccountProofParams defines parameters for GetCatchpointAccountProof.
+type GetCatchpointAccountProofParams struct {
+	// AssetId Prove the account holding or parameters of this asset, instead of the account data.
+	AssetId *uint64 `form:"asset-id,omitempty" json:"asset-id,omitempty"`
+
+	// ApplicationId Prove the account local state or parameters of this application, instead of the account data.
+	ApplicationId *uint64 `form:"application-id,omitempty" json:"application-id,omitempty"`
+}
+
+// GetCatchpointBoxProofParams defines parameters for GetCatchpointBoxProof.
+type GetCatchpointBoxProofParams struct {
+	// Name A box name, in the goal app call arg form 'encoding:value'. For ints, use the form 'int:1234'. For raw bytes, use the form 'b64:A=='. For printable strings, use the form 'str:hello'. For addresses, use the form 'addr:XYZ...'.
+	Name string `form:"name" json:"name"`
+}
+
+// StartCatchupParams defines parameters for StartCatchup.
+type StartCatchupParams struct {
+	// File Path of the catchpoint file on the node's filesystem. When provided, the catchpoint file is loaded from it rather than downloaded.
+	File *string `form:"file,omitempty" json:"file,omitempty"`
+}
+
+// GetOnlineStakeExpiryParams defines parameters for GetOnlineStakeExpiry.
+type GetOnlineStakeExpiryParams struct {
+	// Round The round to query, which defaults to the latest round.
+	Round *uint64 `form:"round,omitempty" json:"round,omitempty"`
+
+	// BucketSize The number of rounds spanned by each bucket.
+	BucketSize uint64 `form:"bucket-size" json:"bucket-size"`
+}
+
+// GetTopOnlineAccountsParams defines parameters for GetTopOnlineAccounts.
+type GetTopOnlineAccountsParams struct {
+	// Round The round to query, which defaults to the latest round.
+	Round *uint64 `form:"round,omitempty" json:"round,omitempty"`
+
+	// Limit The maximal number of accounts to return, which defaults to 100.
+	Limit *uint64 `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetOnlineStakeHistoryParams defines parameters for GetOnlineStakeHistory.
+type GetOnlineStakeHistoryParams struct {
+	// MinRound The first round of the range.
+	MinRound uint64 `form:"min-round" json:"min-round"`
+
+	// MaxRound The last round of the range.
+	MaxRound uint64 `form:"max-round" json:"max-round"`
+}
+
 // ShutdownNodeParams defines parameters for ShutdownNode.
 type ShutdownNodeParams struct {
 	Timeout *uint64 `form:"timeout,omitempty" json:"timeout,omitempty"`
@@ -1239,6 +1598,30 @@ type TealCompileTextBody = openapi_types.File
 type TealCompileParams struct {
 	// Sourcemap When set to `true`, returns the source map of the program as a JSON. Defaults to `false`.
 	Sourcemap *bool `form:"sourcemap,omitempty" json:"sourcemap,omitempty"`
+
+	// Analyze When set to `true`, returns the static analysis of the program: its worst-case cost and stack depth, and its unreachable, failing and looping code. Defaults to `false`.
+	Analyze *bool `form:"analyze,omitempty" json:"analyze,omitempty"`
+}
+
+// TealDisassembleParams defines parameters for TealDisassemble.
+type TealDisassembleParams struct {
+	// Annotate When set to `true`, names the labels after the subroutines, ABI methods and OnCompletion they dispatch to, and returns the source map of the disassembly as a JSON. Defaults to `false`.
+	Annotate *bool `form:"annotate,omitempty" json:"annotate,omitempty"`
+
+	// Method With `annotate`, the ABI method signatures whose selectors name the labels they dispatch to.
+	Method *[]string `form:"method,omitempty" json:"method,omitempty"`
+}
+
+// WaitForTransactionEventsParams defines parameters for WaitForTransactionEvents.
+type WaitForTransactionEventsParams struct {
+	// Txid The transaction IDs to wait for, at most 64.
+	Txid *[]string `form:"txid,omitempty" json:"txid,omitempty"`
+
+	// Address An account public key, whose pending transactions to wait for.
+	Address *string `form:"address,omitempty" json:"address,omitempty"`
+
+	// Timeout The maximal number of seconds to wait for, which defaults to and cannot exceed 60.
+	Timeout *uint64 `form:"timeout,omitempty" json:"timeout,omitempty"`
 }
 
 // GetPendingTransactionsParams defines parameters for GetPendingTransactions.
@@ -21,12 +21,42 @@ import (
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Get the state to replay the transaction group of a transaction in a block.
+	// (GET /v2/blocks/{round}/transactions/{txid}/replay)
+	GetTransactionReplayState(ctx echo.Context, round uint64, txid string, params GetTransactionReplayStateParams) error
+	// Get a proof for an account state captured by a catchpoint.
+	// (GET /v2/catchpoints/{round}/proof/accounts/{address})
+	GetCatchpointAccountProof(ctx echo.Context, round uint64, address string, params GetCatchpointAccountProofParams) error
+	// Get a proof for a box captured by a catchpoint.
+	// (GET /v2/catchpoints/{round}/proof/applications/{application-id}/box)
+	GetCatchpointBoxProof(ctx echo.Context, round uint64, applicationId uint64, params GetCatchpointBoxProofParams) error
 	// Aborts a catchpoint catchup.
 	// (DELETE /v2/catchup/{catchpoint})
 	AbortCatchup(ctx echo.Context, catchpoint string) error
 	// Starts a catchpoint catchup.
 	// (POST /v2/catchup/{catchpoint})
-	StartCatchup(ctx echo.Context, catchpoint string) error
+	StartCatchup(ctx echo.Context, catchpoint string, params StartCatchupParams) error
+	// Returns the block timestamp offset of a developer mode network.
+	// (GET /v2/devmode/blocks/offset)
+	GetBlockTimeStampOffset(ctx echo.Context) error
+	// Sets the block timestamp offset of a developer mode network.
+	// (POST /v2/devmode/blocks/offset/{offset})
+	SetBlockTimeStampOffset(ctx echo.Context, offset uint64) error
+	// Generates blocks on a developer mode network.
+	// (POST /v2/devmode/blocks/{count})
+	GenerateDevModeBlocks(ctx echo.Context, count uint64) error
+	// Creates a snapshot of a developer mode network.
+	// (POST /v2/devmode/snapshots)
+	CreateDevModeSnapshot(ctx echo.Context) error
+	// Reverts a developer mode network to a snapshot.
+	// (POST /v2/devmode/snapshots/{snapshot-id}/revert)
+	RevertDevModeSnapshot(ctx echo.Context, snapshotId uint64) error
+	// Get the online stake grouped by participation key expiry.
+	// (GET /v2/ledger/online-accounts/expiry)
+	GetOnlineStakeExpiry(ctx echo.Context, params GetOnlineStakeExpiryParams) error
+	// Rolls the ledger back to an earlier round.
+	// (POST /v2/ledger/rollback/{round})
+	RollbackLedger(ctx echo.Context, round uint64) error
 
 	// (POST /v2/shutdown)
 	ShutdownNode(ctx echo.Context, params ShutdownNodeParams) error
@@ -37,6 +67,118 @@ type ServerInterfaceWrapper struct {
 	Handler ServerInterface
 }
 
+// GetTransactionReplayState converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTransactionReplayState(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "round" -------------
+	var round uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "round", runtime.ParamLocationPath, ctx.Param("round"), &round)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round: %s", err))
+	}
+
+	// ------------- Path parameter "txid" -------------
+	var txid string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "txid", runtime.ParamLocationPath, ctx.Param("txid"), &txid)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter txid: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTransactionReplayStateParams
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", ctx.QueryParams(), &params.Format)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter format: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetTransactionReplayState(ctx, round, txid, params)
+	return err
+}
+
+// GetCatchpointAccountProof converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCatchpointAccountProof(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "round" -------------
+	var round uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "round", runtime.ParamLocationPath, ctx.Param("round"), &round)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round: %s", err))
+	}
+
+	// ------------- Path parameter "address" -------------
+	var address string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "address", runtime.ParamLocationPath, ctx.Param("address"), &address)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCatchpointAccountProofParams
+	// ------------- Optional query parameter "asset-id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "asset-id", ctx.QueryParams(), &params.AssetId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter asset-id: %s", err))
+	}
+
+	// ------------- Optional query parameter "application-id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "application-id", ctx.QueryParams(), &params.ApplicationId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter application-id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetCatchpointAccountProof(ctx, round, address, params)
+	return err
+}
+
+// GetCatchpointBoxProof converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCatchpointBoxProof(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "round" -------------
+	var round uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "round", runtime.ParamLocationPath, ctx.Param("round"), &round)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round: %s", err))
+	}
+
+	// ------------- Path parameter "application-id" -------------
+	var applicationId uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "application-id", runtime.ParamLocationPath, ctx.Param("application-id"), &applicationId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter application-id: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCatchpointBoxProofParams
+	// ------------- Required query parameter "name" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "name", ctx.QueryParams(), &params.Name)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetCatchpointBoxProof(ctx, round, applicationId, params)
+	return err
+}
+
 // AbortCatchup converts echo context to params.
 func (w *ServerInterfaceWrapper) AbortCatchup(ctx echo.Context) error {
 	var err error
@@ -68,8 +210,138 @@ func (w *ServerInterfaceWrapper) StartCatchup(ctx echo.Context) error {
 
 	ctx.Set(Api_keyScopes, []string{""})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params StartCatchupParams
+	// ------------- Optional query parameter "file" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "file", ctx.QueryParams(), &params.File)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter file: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.StartCatchup(ctx, catchpoint, params)
+	return err
+}
+
+// GetBlockTimeStampOffset converts echo context to params.
+func (w *ServerInterfaceWrapper) GetBlockTimeStampOffset(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetBlockTimeStampOffset(ctx)
+	return err
+}
+
+// SetBlockTimeStampOffset converts echo context to params.
+func (w *ServerInterfaceWrapper) SetBlockTimeStampOffset(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "offset" -------------
+	var offset uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "offset", runtime.ParamLocationPath, ctx.Param("offset"), &offset)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter offset: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.SetBlockTimeStampOffset(ctx, offset)
+	return err
+}
+
+// GenerateDevModeBlocks converts echo context to params.
+func (w *ServerInterfaceWrapper) GenerateDevModeBlocks(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "count" -------------
+	var count uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "count", runtime.ParamLocationPath, ctx.Param("count"), &count)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter count: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GenerateDevModeBlocks(ctx, count)
+	return err
+}
+
+// CreateDevModeSnapshot converts echo context to params.
+func (w *ServerInterfaceWrapper) CreateDevModeSnapshot(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.CreateDevModeSnapshot(ctx)
+	return err
+}
+
+// RevertDevModeSnapshot converts echo context to params.
+func (w *ServerInterfaceWrapper) RevertDevModeSnapshot(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "snapshot-id" -------------
+	var snapshotId uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "snapshot-id", runtime.ParamLocationPath, ctx.Param("snapshot-id"), &snapshotId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter snapshot-id: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.RevertDevModeSnapshot(ctx, snapshotId)
+	return err
+}
+
+// GetOnlineStakeExpiry converts echo context to params.
+func (w *ServerInterfaceWrapper) GetOnlineStakeExpiry(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetOnlineStakeExpiryParams
+	// ------------- Optional query parameter "round" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "round", ctx.QueryParams(), &params.Round)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round: %s", err))
+	}
+
+	// ------------- Required query parameter "bucket-size" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "bucket-size", ctx.QueryParams(), &params.BucketSize)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter bucket-size: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetOnlineStakeExpiry(ctx, params)
+	return err
+}
+
+// RollbackLedger converts echo context to params.
+func (w *ServerInterfaceWrapper) RollbackLedger(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "round" -------------
+	var round uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "round", runtime.ParamLocationPath, ctx.Param("round"), &round)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{""})
+
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.StartCatchup(ctx, catchpoint)
+	err = w.Handler.RollbackLedger(ctx, round)
 	return err
 }
 
@@ -121,8 +393,18 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 		Handler: si,
 	}
 
+	router.GET(baseURL+"/v2/blocks/:round/transactions/:txid/replay", wrapper.GetTransactionReplayState, m...)
+	router.GET(baseURL+"/v2/catchpoints/:round/proof/accounts/:address", wrapper.GetCatchpointAccountProof, m...)
+	router.GET(baseURL+"/v2/catchpoints/:round/proof/applications/:application-id/box", wrapper.GetCatchpointBoxProof, m...)
 	router.DELETE(baseURL+"/v2/catchup/:catchpoint", wrapper.AbortCatchup, m...)
 	router.POST(baseURL+"/v2/catchup/:catchpoint", wrapper.StartCatchup, m...)
+	router.GET(baseURL+"/v2/devmode/blocks/offset", wrapper.GetBlockTimeStampOffset, m...)
+	router.POST(baseURL+"/v2/devmode/blocks/offset/:offset", wrapper.SetBlockTimeStampOffset, m...)
+	router.POST(baseURL+"/v2/devmode/blocks/:count", wrapper.GenerateDevModeBlocks, m...)
+	router.POST(baseURL+"/v2/devmode/snapshots", wrapper.CreateDevModeSnapshot, m...)
+	router.POST(baseURL+"/v2/devmode/snapshots/:snapshot-id/revert", wrapper.RevertDevModeSnapshot, m...)
+	router.GET(baseURL+"/v2/ledger/online-accounts/expiry", wrapper.GetOnlineStakeExpiry, m...)
+	router.POST(baseURL+"/v2/ledger/rollback/:round", wrapper.RollbackLedger, m...)
 	router.POST(baseURL+"/v2/shutdown", wrapper.ShutdownNode, m...)
 
 }
@@ -130,173 +412,245 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9+3PcNtLgv4Ka/ar8uOFIfmXXqtr6TrGSrM6PuCxt9u6zfAmG7JnBigMwBCjNxKf/",
-	"/QoNgARJgMORFHvz1f5ka4hHo9Fo9BufJ6lYF4IDV3Jy9HlS0JKuQUGJf9E0FRVXCcv0XxnItGSFYoJP",
-	"jtw3IlXJ+HIynTD9a0HVajKdcLqGpo3uP52U8GvFSsgmR6qsYDqR6QrWVA+stoVuXY+0SZYisUMcmyFO",
-	"TyY3Ax9olpUgZR/KH3m+JYyneZUBUSXlkqb6kyTXTK2IWjFJbGfCOBEciFgQtWo1JgsGeSZnbpG/VlBu",
-	"vVXayeNLumlATEqRQx/OV2I9ZxwcVFADVW8IUYJksMBGK6qInkHD6hoqQSTQMl2RhSh3gGqA8OEFXq0n",
-	"Rx8nEngGJe5WCuwK/7soAX6DRNFyCWryaRpa3EJBmSi2Dizt1GK/BFnlShJsi2tcsivgRPeakbeVVGQO",
-	"hHLy4ftX5NmzZy/1QtZUKcgskUVX1czur8l0nxxNMqrAfe7TGs2XoqQ8S+r2H75/hfOf2QWObUWlhPBh",
-	"OdZfyOlJbAGuY4CEGFewxH1oUb/uETgUzc9zWIgSRu6JaXyvm+LP/1V3JaUqXRWCcRXYF4Jfifkc5GFe",
-	"9yEeVgPQal9oTJV60I+HyctPn59Mnxze/OnjcfJf9s8Xz25GLv9VPe4ODAQbplVZAk+3ybIEiqdlRXkf",
-	"Hx8sPciVqPKMrOgVbj5dI6u3fYnua1jnFc0rTScsLcVxvhSSUEtGGSxolSviJiYVzzWb0qNZaidMkqIU",
-	"VyyDbKq57/WKpSuSUmmGwHbkmuW5psFKQhajtfDqBg7TjY8SDdet8IEL+tdFRrOuHZiADXKDJM2FhESJ",
-	"HdeTu3Eoz4h/oTR3ldzvsiLnKyA4uf5gLlvEHdc0nedbonBfM0IlocRdTVPCFmQrKnKNm5OzS+xvV6Ox",
-	"tiYaabg5rXtUH94Y+nrICCBvLkQOlCPy3Lnro4wv2LIqQZLrFaiVvfNKkIXgEoiY/xNSpbf9f539+I6I",
-	"krwFKekS3tP0kgBPRRbfYztp6Ab/pxR6w9dyWdD0Mnxd52zNAiC/pRu2rtaEV+s5lHq/3P2gBClBVSWP",
-	"AWRG3EFna7rpT3peVjzFzW2mbQlqmpSYLHK6nZHTBVnTzV8PpxYcSWiekwJ4xviSqA2PCml67t3gJaWo",
-	"eDZChlF6w7xbUxaQsgWDjNSjDEBip9kFD+P7wdNIVh44bpAoOPUsO8DhsAnQjD66+gsp6BI8kpmRv1vO",
-	"hV+VuAReMzgy3+KnooQrJipZd4rAiFMPi9dcKEiKEhYsQGNnFh2ae5g2lr2urYCTCq4o45BpzotACwWG",
-	"E0Vh8iYcVmb6V/ScSvjmeewCb76O3P2F6O764I6P2m1slJgjGbgX9Vd7YMNiU6v/COXPn1uyZWJ+7m0k",
-	"W57rq2TBcrxm/qn3z6GhksgEWohwF49kS05VVcLRBX+s/yIJOVOUZ7TM9C9r89PbKlfsjC31T7n56Y1Y",
-	"svSMLSPIrGENalPYbW3+0eOF2bHaBJWGN0JcVoW/oLSllc635PQktslmzH0J87hWZX2t4nzjNI19e6hN",
-	"vZERIKO4K6hueAnbEjS0NF3gP5sF0hNdlL/pf4oi171VsQihVtOxvW/RNmBtBsdFkbOUaiR+sJ/1V80E",
-	"wGgJtGlxgBfq0WcPxKIUBZSKmUFpUSS5SGmeSEUVjvQfJSwmR5M/HTTGlQPTXR54k7/Rvc6wk5ZHjYyT",
-	"0KLYY4z3Wq6RA8xCM2j8hGzCsD2UiBg3m6hJiWkWnMMV5WrW6CMtflAf4I92pgbfRpQx+O7oV1GEE9Nw",
-	"DtKIt6bhA0k81BNEK0G0orS5zMW8/uHhcVE0GMTvx0Vh8IGiITCUumDDpJKPcPm0OUn+PKcnM/KDPzbK",
-	"2YLnW305GFFD3w0Le2vZW6w2HNk1NCM+kAS3U5QzvTUODVqGvw+KQ51hJXIt9eykFd34b7atT2b691Gd",
-	"/xgk5uM2TlyoRVnMGQUGf/E0l4cdyukTjrXlzMhxt+/tyEaPEiaYW9HK4H6acQfwWKPwuqSFAdB+MXcp",
-	"46iBmUYG1jty05GMLgizd4Y9WkOobn3Wdp6HICRICh0Yvs1Fevk3Klf3cObnbqz+8cNpyApoBiVZUbma",
-	"TUJShn+8mtHGHDHdELV3MvemmtVLvK/l7VhaRhX1lmbhDYslBvXYD5kelAHd5Uf8D82J/qzPtmb9ZtgZ",
-	"OUcGJs1xth6ETKvyRkEwM+kGaGIQZG20d6K17r2gfNVMHt6nUXv0nTEY2B2yi8AdEpt7Pwbfik0Ihm/F",
-	"pncExAbkfdCHHgfFSAVrOQK+EwuZwP236KNlSbd9JOPYY5CsF6hFV4mngfs3vp6lsbwez0V5O+7TYSuc",
-	"NPZkQvWoHvOddpCETasisaQYsEmZBp2BGhfeMNPoDh/CWAsLZ4r+DliQetT7wEJ7oPvGglgXLId7IP1V",
-	"kOnPqYRnT8nZ345fPHn689MX32iSLEqxLOmazLcKJHlodTMi1TaHR/2VoXZU5So8+jfPnRWyPW5oHCmq",
-	"MoU1LfpDGeumEYFMM6Lb9bHWRjOuugZwzOE8B83JDdqJMdxr0E6Y1BLWen4vmxFDWNbMkhELSQY7iWnf",
-	"5TXTbP0lltuyug9VFspSlAH7Gh4xJVKRJ1dQSiYCrpL3tgWxLZx4W3R/N9CSayqJnhtNvxVHgSJAWWrD",
-	"x/N9M/T5hje4GeT8Zr2B1dl5x+xLG/nOkihJAWWiNpxkMK+WLU1oUYo1oSTDjnhH/wDqbMtTtKrdB5HG",
-	"1bQ142jil1ueejqb3qgcsmVrE+6um3Wx4uxzZqoHMgCORscb/Ixq/Qnkit67/NKdIAT7K7eRBliS6Yao",
-	"Bb9hy5XyBMz3pRCL+4cxNEsIUPxgxPNc9+kL6e9EBnqxlbyHy7gZrKF1vac+hdO5qBShhIsM0KJSyfA1",
-	"HXHLoz8Q3ZjKv/nVykjcc9CElNJKr7YqCDrpepyj6ZjQ1FBvgqiRES9G7X4yrcx0xuWbl0AzrdUDJ2Ju",
-	"XQXWiYGLpOhhVO6is0JC4Cy14CpKkYKUkCXWRLETNNfOMBE1gCcEHAGuZyFSkAUt7wzs5dVOOC9hm6A/",
-	"XJKHr3+Sj74CvEoomu9ALLYJobdW+Kw/qA/1uOmHCK47uU92tATieK7WLjWDyEFBDIV74SS6f12Iert4",
-	"d7RcQYmemd+V4t0kdyOgGtTfmd7vCm1VRKK8rKJzztZot+OUCwmp4JkMDpZTqZJdbFk3amljegUeJwxx",
-	"Yhw4IpS8oVIZbyLjGRpBzHWC8xgBRU8RBzgqkOqRf3KyaH/sVN+DXFayFkxlVRSiVJCF1sBhMzDXO9jU",
-	"c4mFN3Yt/SpBKgm7Ro5hyRvfIsusxCCIqtrobt3t/cWhaVrf89sgKltANIgYAuTMtfKw60e6RABhskG0",
-	"IRwmO5RTh9dMJ1KJotDcQiUVr/vF0HRmWh+rvzdt+8RFVXNvZwL07MrBZCG/Npg1MU4rqlVoHJms6aWW",
-	"PVAhNm7PPsz6MCaS8RSSIcrXx/JMt/KPwI5DGrFF2ChKb7bO4ejQb5DookSwYxdiC44YRt7TUrGUFSgp",
-	"vobtvQvO3QmC5nqSgaJMK+veByNEF35/YvzY3TFvJ0iP0mH74PeU2MBycibxwmgDfwlb1FjemwCpcy+s",
-	"6h40gcCo+nRTThBQF3ahBRi/CWxoqvKtvubUCrbkGkogspqvmVIm4q2tKChRJP4AQfvgwIzWGG6Ci9wO",
-	"jLHOn+FQ3vL6WzGdGIlqGL7zjljVQoeVpAoh8hG6dw8ZQQhG+U1JIfSuMxtg6aLwHCW1gLRCDHpCaub5",
-	"QLbQjCsg/0dUJKUcBdZKQX0jiBLZLF6/egZ9gdVzWg9pgyHIYQ1GDscvjx93F/74sd1zJskCrl1Usm7Y",
-	"Rcfjx6gFvxdStQ7XPVha9HE7DfB2NJzqi8LKcF2esttDZ0ces5PvO4PX1lZ9pqS0hKuXf2cG0DmZmzFr",
-	"92lknHcSxx1lE/WGDq0b9x3NPL+PjaYZOgRdf2LPqd58jPnVtXyVb++BT5uBSAlFCRJPla+XSPNVLPzA",
-	"dXvs5FYqWPdNN6brzxHB5oMTC3pSpuA545CsBYdtMFeLcXiLH0O9zcmOdEYeG+vbFZta8HfAas8zhgrv",
-	"il/cbY+U39cBJfew+d1xO1Y7P2QftVLIC0JJmjPUWQWXqqxSdcEpSsXeWQ443pysH9eTXrkmYcUsoDfZ",
-	"oS44RadrLSsHnQULCGjB3wM4dUlWyyVI1ZEPFgAX3LZinFScKZxrrfcrMRtWQIner5lpuaZbsqA5qnW/",
-	"QSnIvFLtGxMji6XSWpcxIeppiFhccKpIDloDfcv4+QaHcyZ4RzMc1LUoL2sszILnYQkcJJNJ2EH4g/mK",
-	"sRt2+Ssbx4FpXuazMTrp8Zvw462CVurS/334n0cfj5P/oslvh8nL/3Hw6fPzm0ePez8+vfnrX/9f+6dn",
-	"N3999J//EdopB3so7tVCfnpipcnTExQZGqtTD/YvZnFYM54Eicz3rXRoizzUgo8joEeNWc/u+gVXG64J",
-	"6YrmLKPqduTQZXG9s2hOR4dqWhvRUSDdWve8iO/AZUiAyXRY462v8b5PPRxhjmZQGzSO52VRcbOVlbSm",
-	"WAygdL5NsZjWWQQme/iIYIj5ijrHvP3z6YtvJtMmNLz+rvVr8/VTgJJZtgklAGSwCclX9oDgwXggSUG3",
-	"ElSYeyDsQTeu8Sb5w65BC+ZyxYovzymkYvMwh3NhaVZP2/BTbuLF9PlBo+rW2mrE4svDrUqADAq1CmUV",
-	"tiQFbNXsJkDH0VWU4gr4lLAZzLp6UrYE6RzKOdAFZrehYVCMCbOtz4EhNEcVHtb9hYxSRkL0g8Kt5dY3",
-	"04m9/OW9y+N24BBc3TlrC6r7Wwny4IfvzsmBZZjygclFMUN72QMB+4MNkG25QDU3M7nUJhnngl/wE1gw",
-	"zvT3owueUUUP5lSyVB5UEspvaU55CrOlIEcu5vaEKnrBe5JWtNyBF+1Mimqes5Rc+hJxQ54mhbU/wsXF",
-	"R5ovxcXFp543qC+/2qmC/MVMkFwztRKVSmyOXlLCNS2zAOiyztHCkU2G7dCsU2LHNqzY5gDa8cM8jxaF",
-	"7OZq9JdfFLlevkeG0mYi6C0jUonSySJaQDHQ4P6+E/ZiKOm1S/CsJEjyy5oWHxlXn0hyUR0ePgPSSl74",
-	"xV75mia3BbQsVbfKJelaqXDhRq+BjSppUtAlyODyFdACdx/l5TXaRPOcYLdW0oQLCsOhmgU4fMQ3wMCx",
-	"dwA4Lu7M9HLFFsJLwE+4hdhGixuNq+G2++WlUdx6uzqpGL1dqtQq0Wc7uCqpSdztTJ2DvdRClvP/SLbE",
-	"GBubrj4Hkq4gvYQMM2dhXajttNXduRitoOlYB5Mmw9wEQWMaJBr15kCqIqNWFKd8281Hk6CUC/L5AJew",
-	"PRdNFuU+CWjtfCgZO6hIqZ50qYnVP7Z2jO7mWz825oAUhUsrwvhyRxZHNV24PvGDbETeezjEIaJo5evE",
-	"EEHLACIM8UdQcIuF6vHuRPqh5WktY25uvkBCuuP9xDZplCfrcvZXg2lI5vsasFyFuJZkTrXcLmylBZPz",
-	"43GxStIlRCRk3646MrOmZYvFQXbde8GbTiy6F1rvvgmCbBones1BSgH9RZMKKjOdQAM3kzHd4wpmBAso",
-	"WYTNcxST6ogMw3Ro2bJvm4owMdDCBAwlbwQOB0YbI75ks6LSFYHAWhnuLI+SAX7HHLahzOVTz0fuFcSo",
-	"85Idz+2e0552afOXXdKyy1T2VcsRWcdawsewvNB2CI4CUAY5LM3CTWNHKE0+XbNBGo4fF4uccSBJyN1O",
-	"pRQpM1U8mmvGzgFaPn5MiDEBk9EjhMjYAxtdUjgweSf8s8mX+wDJbT4gdWOjM8v7G8KhyyYATYs8otAs",
-	"nPFIqKPjANTGaNT3VydSCIchjE+JZnNXNNdszmp8zSC9BFoUWzvpstYp+igmzg5Y4M3FsteazFV0m9X4",
-	"MpMDOizQDUA8F5vE5C4EJd75Zq7pPRiTh5kUoYNpUpUfSDIXG3S049ViYsB2wBKHw4HhafgbJpFesV/s",
-	"NjfADE07LE2FqFAiyVhzXk0uMXFizNQRCSZGLg+97ONbAdAxdjR1+qzyu1NJbYsn/cu8udWmTVUNF+4c",
-	"Ov6xIxTcpQj++laYOl/YmhA+QCrKLG6n0ITKVF34sG9esGUbNd8YnVE8UITxuK1tOBWiv3MRf3ALnmae",
-	"AUScmGD9HiTfbQqhpVsTzG8yuy1SjJxYgslRksZmJRlf5lYwiKEptGAXjeIwbpbcVGpxA46TnUObG1Hy",
-	"h2ApijAc+2gqHyx+BqCInPIGDpTD7wiJze4ehOUmTh/vu6J98KC0AyvaNQU8XSt0O2jy6Xsz+z5TCTmg",
-	"9py0tI3kMuTjvrj4KAFFszPXzbPyYeUCyrePvGidEpZMKmi8TVqCdZj+0nZ8igWThFjEV6eKcqHX90GI",
-	"Wp4zFTmwY2uZX3wFV0JBsmClVAm66oJL0I2+l2h9+l43DSsV7XggUzuQZeFLFKe9hG2SsbwK06ud9/WJ",
-	"nvZdLTvIao6CCeMEaLoic6x1GYwSHJjaBJIOLviNWfAbem/rHXcadFM9canJpT3HH+RcdG66IXYQIMAQ",
-	"cfR3LYrSgQvUy43rc0dPwTCHE6/T2ZCboneYMjf2zvgql6EXE+bMSANrwdCgaFhmICCHLEtRFYapN2Wu",
-	"g1lsXKikZfwIoKs28EhFL00mRnuD+bK2qYTDpoxePWpo23bHgHz8eHz3cFYITnK4gnx3+CtFjDsDDkZG",
-	"mBEw9IZgILmL8dgt1fd3oEFYvdIujEFq6Uk3Q47bRjWyhaca3RoJVuPOpoyO9t5pCc3RW0PffdddUSQZ",
-	"5BBM0PiHl4FBiwLTrF3jULKCHozxDDZhcMynaagYdd94XzGuTOHC+6qJ1hln/LL9ymFjUFCYGlf7112L",
-	"65jeLvloji8qQpS1c2CQEePgtWbnlfHvUl/kGqdFwbJNx+9pRo1ax+8FY3hB2cF2YMCjjVDqTwmyXTGu",
-	"MeaZusWtgi2zUZg5b9d182UafyomXdX9PqLq1MBduDoHmr+G7U+6LS5ncjOd3M1NGsK1HXEHrt/X2xvE",
-	"M4bhGbdZK+phT5TToijFFc0T60yOkWYprixpYnPne/7C0lqY651/d/zmvQX/ZjpJc6BlUms70VVhu+IP",
-	"sypTnC5yQFxV7xVVtX3OaMPe5tcVtXwH9PUKbAVlT6HulXpsggu8o2gd0otwNPBO97KNgzBLHIiHgKIO",
-	"h2hcdSYaoh0BQa8oy52PzEEbidzFxY27G4NcwR/gzpEU/l10r+ymd7rDp6Ohrh08yZ9roMbz2pQxl0Tw",
-	"bric1oLR9YakuqZYqNF4QPrMiVdr9BokMmdp2J/K51ITBzdxMroxwcYRfVqPWLFI2BWvmDeWbiZHGLU7",
-	"QHpzBJHpin7GcDcX9v2ZirNfKyAsA670pxJPZeegov3Uetb712lYqrQDG298M/xdZAy/SGn3xrMy15CA",
-	"4Ufl9MA9qa1+bqG190n/4IUf7BHc58/YuxIHAvMsfVhqNokKq3Z0zWgJfedbNc7+ZqulRuYIvj3DZLIo",
-	"xW8QNlWhhS+QF+jKsjKMaP0N+CwgrndZTO3JaZ7QaWaPbndMuvE9Tu2AxAjV4857IThYH9J5oyk3W22e",
-	"gmjFtYcJxs8gOTDjNwRjYe5l3eT0ek5DxTO1kKFh8twvLb+5EsR1dri3PhpmK+XOiBc3VrdlJmO+gLJJ",
-	"2e1X37mlwGCmHS0qNJIBUq0vE0xNrE8uRWCYil9Tbl4UQW8EHiXbWyv4ziB0LUqsdyHDLv4MUrYOGpcu",
-	"Lj5mad+dm7ElM+9pVBK8BxvsQOYhIkNF9tELE07XoOZ0QQ6n3pMwdjcydsUkm+eALZ6YFnMqwRhVXOSG",
-	"66KXB1ytJDZ/OqL5quJZCZlaSYNYKUgt1KF6UweqzEFdA3ByiO2evCQPMURHsit4pLFo7+fJ0ZOX6GA1",
-	"fxyGLgD7cM4QN8mQnTj9P0zHGKNkxtCM2446C1oDzGtnccY1cJpM1zFnCVtaXrf7LK0pp0sIR4Wud8Bk",
-	"+uJuoi+ggxeemad6pCrFljAVnh8U1fwpkmmm2Z8Bg6RivWZqbQM5pFhrempeYzCTuuHMuz+2kK6Dy33E",
-	"eKjChYN0lMgv6/cx91to1Ri19o6uoY3WKaGmyEnOmkhFV96bnLoaSlhZuC4obHCj59JLRzEHAxcXpCgZ",
-	"V6hYVGqR/IWkK1rSVLO/WQzcZP7N80A15XZVT74f4F8c7yVIKK/CqC8jZO9kCNuXPOSCJ2vNUbJHTWan",
-	"dyqjgVvhEJ1YnNDw0GOFMj1KEiW3qkVu1OPUdyI8PjDgHUmxXs9e9Lj3yr44ZVZlmDxopXfo7x/eWClj",
-	"LcpQYcTmuFuJowRVMrjCOP3wJukx77gXZT5qF+4C/dd1njqR0xPL3FmOKgL7eHw83QB9Pn5k4m28PW1P",
-	"T0vmCrp9UMMZ5wExjwXu8nvc5RmRVud9oHIcehx0ESNCKwG2g7H9NOC7mxg8l09rh2I4ai8tRJnfisCS",
-	"Xe352sdjMyYDdqvYBaI/aAY1t0NNSbvO95ePqHFukX5kh/7iYMU/usB+ZWaDSHYriGyi9wZBcDuz+rsX",
-	"XEbJt2IzdlM7vNtt7L8AaoIoqVie/dTUBuk88VBSnq6CwSJz3fHn5jG6enHmMAcrY64o5yYaoW+bQC3l",
-	"Z6fNBPStf4qx86wZH9m2++qEWW5ncQ3gbTAdUG5CjV6mcj2Bj9V22YU6rS9fiozgPE0ZxuZe779W4tWU",
-	"/7UCqUL3In4wqQVoUV9oKjal3YFnaMeYkR/MY9IrIK0qcWg/YOsqNxXHTIFt4+qpilzQbEr0OOffHb8h",
-	"ZlbTxzypZEqqL82121pFPD53n0Dbodja+8jo06uWCos2SkXXRahEiW5x7hpgHRTfu4SKtY+dGTkxNg3p",
-	"NGYziaaHBSvXkJF6OitVI03o/yhF0xUaC1osNU7y498CcFQpvfc363e06rKreO403PY5APMawJQILTlc",
-	"M2neEIYraFdFqUsEWTHAVUlpL6+sODeUEpSKh0pY3QbtDjgTBekcUEHIOojfU3qxYep7Po1whr2CdQy7",
-	"7yz0Ht40NTbq95Hc2/Ap5YKzFKsIhq5m+x7xGO/siIKL4cwAG28jJ4HDFXzdoU7WsFiMvvfgGKFFXN89",
-	"5H3Vm2qow/yp8OHbFVVkCUpazgbZ1D1SYi3UjEuwZXTxaWqPT4qy5fFGDhkMomjk5D3JCJOzIyaH7/W3",
-	"d9YghVmLl4yj6ulyJEyCpLEh43OpSuurTJGlwAwKeyj8NX3UfWZYrCWDzaeZe14VxzAOY71sEx3RH+rY",
-	"xUrY2ATd9pVuawrqNT+38uDMpMdFYSeNP2ETlAfUhkcRHPB514FeHnLr8f3RBshtMMgJ71NNaHCFIRJQ",
-	"EJsaE3nOpZMEo4VWQ1HYgpj46GAdrWCY6BvGoXn8N3BBpMErATcGz2ukn0xLqowIOIqnnQPNMS4ixNCk",
-	"sk6xuw7V2WAbT1qkEzdHfBubl2gijKNu0AhulG/rN4c1dXvCxCt87Nwisv+uDEpVVoiyyTXtl2ZCjEMz",
-	"bveWVfsC6B+DvkxkuquSmpOzz00UK1Uyr7IlqIRmWcie8C1+JfiVZBVKDrCBtKrrNxcFSbEyX7tUYZ/a",
-	"7ESp4LJaD8zlGtxxOu/ppgA1+M9HuR3GwOv5Fv8NFS+O74wND9o7xt7FAmV1+tw+cnN7pJ7Uq2k6kWyZ",
-	"jMcE3il3R0cz9e0Ivel/r5Sei2UbkC9coGyIy/l7FOJv3+mLw6/f1avIba6WurwWhoMK9+Amqo11YZg2",
-	"V3JZp705vQf9hg0Q8af5pnj5RfJaPFsvNfer8WvHslvSaDIWVbZ+gqJkkAVFc9JNXJnJPkcowjb9WCyZ",
-	"CSXTn3u9x0mGPTkbxx5EqAtS7AP02kVAk4IyG7TRMIs+Zm26V9xcOHTomg3uLsImUUUtdq+vYglPLg/Y",
-	"ZHZ0HjO7BFtUqSjhionKhUO4eDmnEppf7WPSXl5xdP39uBmc6uuaQaNG23P7cIZZptXJX/9koisJcFVu",
-	"/wVMuL1N7z0FF6pZ3HoIzgpXQXuTGntXntSvyV1eJWuRDSVMv/6JnDjf0qh7xxFyqNySyOzzS8Fk8Te2",
-	"+L9rpqXP0dO+tZ2Oi2J46kiGeH9y03Df6WOlpvT5HLK6vXfn1zyg55sQArqKl87MYaPCT+X0smGvgcCm",
-	"AKx16yU2x6tnjCUom+SI2mqSA5UwgGG/apttOxLJ55s3uv24ZPvwE4bxkrNNmVlknoWQrHmWJfS24ciQ",
-	"43N8ntDzGPbHcvF+V5AqUbbimEqAfQro6sm8d3P/XXo2YiipI7Md/Q+UmZ1OfN4STFS0x4s2JXLQq4Yu",
-	"10CpetMmwOxtZ6YPSQVTN4T+YUFzGX6lKhrs2ql84gWsBAo9hxd2mo2o9m2XM/ViIFg2jMhwJoAJ/v7v",
-	"iUwT136/6Oy91jSsVfQKL3jFQ8yjOrM9AkjqKGqUDHG/lsDtk8qLEGp2Z0UtFpAqdrWj0MU/VsC9IgpT",
-	"ZwlGWBZe3QtWZ9lgQdH9/RwNQEN1KAbh8Qr73xmcWI7oJWwfSNKihuArP1Mn3N+mliRiAG8tLXgUQoai",
-	"FI3rygaOMVlTBmLBRQWb7tBU5Y4+r+jJObecy5FkW+IZmPJKhGzfo+bSXfeqBIYJI7FaGP0HzuIWjxN8",
-	"T07WTx+7WpS+XZCc9iv2X9talliWpPbWuqqWIN1vrgaRmSVnl+A/AIm+cSyhYFsEjb3OjpwMyEm97G/3",
-	"OFcX6EU9M2tyOPr5voEa0Bj9lOZCK8FJLN2pnTZRh3k9kCY4FMUUfDkO4VpAaR/KxZshFxISJVxo3RAc",
-	"Q6gwEbC3QoKMvrtggItWQ/3QlHvF92dMsQxqA1/9BZIS1lRDV3pFWeNzDiH7lfnuElxdTa6dNu2aXpOd",
-	"VVVd9g6TPST6VL8g9rbcnTh7G/M249w8yy9DMYVco9L3vxalyKrUFoLxDkbtAhhdsGyAlQQtw2l/lT0j",
-	"X47VwN94ZQguYXtg7C/pivKlV17Nh96I9mYNXuWyzm7fq+U/bOTMl2YBy3uB82taz6eTQog8iThcT/uF",
-	"Zrtn4JKll1rMrpq498gTi+Qh+vnqiJrr1dYVVi0K4JA9mhFyzE2mkQuuab901JmcP1BD829w1qwytZ+t",
-	"YX92wcMpG1jUp7wjf3PDDHM1CZr53XEqM8iOMqabSJHbkl4HHhztx9ONDnfpPgLZEJWBIiSl3LJU16jz",
-	"3TfuB0jfewVxWPvxK/k1Ucyl8RGhtOQ8N13h5W3j+hn3HqPrsAM831jjvcjouJEF5yuHGr+tkeItJUoJ",
-	"reXvsv/YBTZ8ydsiiVmTepmmALEJU2vvi2fck69qm1kYz33TGpbtExxr/vZNchJ9hqYMq0c4+lyWVzT/",
-	"8mY1rOd4jPiwz4qHF+rrvz6SDSrl7eL93tBRc3u67v1Nzd+jGfAfoPco6Oy1Q1nnT/0SpnORYYl7mpNc",
-	"NC/i4pDkGsc03uEn35C5zaIrSkiZZJ0E42v3qkmt7uEjX81r88P65a51/iTUHcjYKgiiIO+aFxKUwPuh",
-	"gbA5ol+ZqURObpDKQ9TXI4sA/kI8yi9ns+O6uGy5jc2LM514SFHCPbuPvUCwPd3H/UI9Y5dnXKT60qkk",
-	"9Nc5+rZu4TZwUTdrGxv70EfuUBn9MSEL4dcxdHeMmTAIwadlCIJKfnnyCylhgW9HCvL4MU7w+PHUNv3l",
-	"afuzPs6PHwfFuC8WLWFwZMew8wYpxjrTeqkwsClYGSn698Eyd3tho/uOYAcIV+fMIfgaDE7t4ka/cClo",
-	"lLl3GvjN0mzjXfzMQ5lbcj1RCPc/xXIXTHx+JE2mcxYqlme7DmUr6al5+RbTen62Cblf5e3dn40tu88m",
-	"7fuH+8TIdQ8AIiaw1tbk3lReOtOITCbbLZC3hMSVViVTW6wT5kyf7OdgTM0PtbfEeoHryjJW7lDiEupK",
-	"c41vpZJOsvlB0BxlAa3PYISiEiKfke82dF3kYJnUXx/M/wzP/vI8O3z25M/zvxy+OEzh+YuXh4f05XP6",
-	"5OWzJ/D0Ly+eH8KTxTcv50+zp8+fzp8/ff7Ni5fps+dP5s+/efnnB/oO0CAbQCeuKsXkf+MD1cnx+9Pk",
-	"XAPb4IQW7DVszVuYmozdK5s0RS4Ia8ryyZH76X867jZLxboZ3v06sUnvk5VShTw6OLi+vp75XQ6WaExN",
-	"lKjS1YGbp/cM5/H70zo9zMRC4Y6azB9NCriplhSO8duH787OyfH701lDMJOjyeHscPYEaxkXwGnBJkeT",
-	"Z/gTnp4V7vuBKyJ89PlmOjlYAc3RJ67/WIMqWeo+yWu6XEI5s8+N6p+unh44Me7gszUk3wx9O/Bf7jn4",
-	"3LK3Zzt6YqDLwWdXxGq4datKlPUzeB1GQjHU7GCOGchjm4L0GseXgsqdPPiM6kn09wOblhn+iGqiOQMH",
-	"zikVbtnC0me10bB2eqRUpauqOPiM/0GavDFMIoeQC8pkM1LSNJ8SpgidixKrR6l0pfmCK1vDpNdygpRq",
-	"iPw008Ste70yELgCdaZi79HHfgAiDkTcSMgJNJk3B7U1U8OL0e/uFZGtb5pW++a++XiYvPz0+cn0yeHN",
-	"n/R9Yv988exmpC/5VT0uOasvi5ENP2HNF7SK4/l9eni419PAPbW0WaTZpDocORDEYHYiWccsJ3arOgOR",
-	"Ghk7alN0hg89pXwznTzfc8WDtrtWiHbgSeRvaUZcgi/O/eTLzX3K0ZOv+Tox99bNdPLiS67+lGuSpznB",
-	"ll6xsf7W/51fcnHNXUstZFTrNS237hjLFlMgdrPxKqNLiZbckl1RlO244O1y9Z/QexBKso7wG6noLfjN",
-	"me71b37zpfgNbtJ98Jv2QPfMb57ueeb/+Cv+N4f9o3HYM8Pu7sRhrcBn8tr6EqiJ7D/A+mLb/s9bngZ/",
-	"7A/UfTI49PPB5/ZLPC0ZWa4qlYlrUx4leClgrWaa28KOaICuFSoliBugCSgkP9qsq3yLVneWAaEY3S4q",
-	"1Wi8urNzEzfmJT1C85z4knGcAA37OIupYEq9UB0JqeDm8d3OBWQheycy6F9AeMX8WkG5be4YC+Nk2uJA",
-	"loQC9ULvzND7DONmPwJDB4TxnvWJo35xt/X3wTVlSl9TNrIPMdrvrIDmB7ZwQOfXJlev9wUTEL0fPZ0o",
-	"/OtBXQ8r+LGrbIa+WmXLNWqsSb51Bve8tst8/KS3Dis2WnJojA1HBwcYDrMSUh1MbqafO4YI/+Onerdc",
-	"waR6124+3fz/AAAA///m3Czx7MQAAA==",
+	"H4sIAAAAAAAC/+y9fZPbNpIw/lVQuqty4pNm/JbcZqpS95vETta/2InLM5u9u9jPLkRCEnYogAuAM1L8",
+	"zHd/qhsACZIARWlke73rP1LxiHhpNBqNRr++m2RyXUrBhNGTs3eTkiq6ZoYp/ItmmayEmfEc/sqZzhQv",
+	"DZdicua/EW0UF8vJdMLh15Ka1WQ6EXTNJmdh/+lEsb9XXLF8cmZUxaYTna3YmsLAZltC63qkzWwpZ26I",
+	"czvE86eT24EPNM8V07oP5S+i2BIusqLKGTGKCk0z+KTJDTcrYlZcE9eZcEGkYEQuiFm1GpMFZ0WuT/wi",
+	"/14xtQ1W6SZPL+m2AXGmZMH6cH4v13MumIeK1UDVG0KMJDlbYKMVNQRmAFh9QyOJZlRlK7KQageoFogQ",
+	"Xiaq9eTst4lmImcKdytj/Br/uVCM/c5mhqolM5O309jiFoapmeHryNKeO+wrpqvCaIJtcY1Lfs0EgV4n",
+	"5GWlDZkzQgV5/cP35PHjx9/AQtbUGJY7Ikuuqpk9XJPtPjmb5NQw/7lPa7RYSkVFPqvbv/7he5z/wi1w",
+	"bCuqNYsflnP4Qp4/TS3Ad4yQEBeGLXEfWtQPPSKHovl5zhZSsZF7YhsfdVPC+T/qrmTUZKtScmEi+0Lw",
+	"K7Gfozws6D7Ew2oAWu1LwJSCQX97MPvm7buH04cPbv/tt/PZ/7o/v3p8O3L539fj7sBAtGFWKcVEtp0t",
+	"FaN4WlZU9PHx2tGDXsmqyMmKXuPm0zWyeteXQF/LOq9pUQGd8EzJ82IpNaGOjHK2oFVhiJ+YVKJgWuNo",
+	"jtoJ16RU8prnLJ8SLsjNimcrklFth8B25IYXBdBgpVmeorX46gYO022IEoDrIHzggv5xkdGsawcm2Aa5",
+	"wSwrpGYzI3dcT/7GoSIn4YXS3FV6v8uKXK4Ywcnhg71sEXcCaLootsTgvuaEakKJv5qmhC/IVlbkBjen",
+	"4FfY360GsLYmgDTcnNY9Coc3hb4eMiLIm0tZMCoQef7c9VEmFnxZKabJzYqZlbvzFNOlFJoROf8bywxs",
+	"+/9/8cvPRCrykmlNl+wVza4IE5nM03vsJo3d4H/TEjZ8rZclza7i13XB1zwC8ku64etqTUS1njMF++Xv",
+	"ByOJYqZSIgWQHXEHna3ppj/ppapEhpvbTNsS1ICUuC4Luj0hzxdkTTffPpg6cDShRUFKJnIulsRsRFJI",
+	"g7l3gzdTshL5CBnGwIYFt6YuWcYXnOWkHmUAEjfNLni42A+eRrIKwOFiBzhcjANHsE2EZuDowhdS0iUL",
+	"SOaE/MlxLvxq5BUTNYMj8y1+KhW75rLSdacEjDj1sHgtpGGzUrEFj9DYhUOHJpTYNo69rp2Ak0lhKBcs",
+	"J1xYoKVhlhMlYQomHH7M9K/oOdXs6yeT211fR+7+QnZ3fXDHR+02NprZIxm5F+GrO7BxsanVf8TjL5xb",
+	"8+XM/tzbSL68hKtkwQu8Zv4G++fRUGlkAi1E+ItH86WgplLs7I24D3+RGbkwVORU5fDL2v70sioMv+BL",
+	"+KmwP72QS55d8GUCmTWs0dcUdlvb/8F4cXZsNtFHwwspr6oyXFDWepXOt+T509Qm2zH3Jczz+ikbviou",
+	"N/6lsW8Ps6k3MgFkEnclhYZXbKsYQEuzBf5vs0B6ogv1O/yvLAvobcpFDLVAx+6+Rd2A0xmcl2XBMwpI",
+	"fO0+w1dgAsy+EmjT4hQv1LN3AYilkiVThttBaVnOCpnRYqYNNTjSvyu2mJxN/u20Ua6c2u76NJj8BfS6",
+	"wE4gj1oZZ0bLco8xXoFcoweYBTBo/IRswrI9lIi4sJsIpMSBBRfsmgpzMpnGzmRzgH9zMzX4tqKMxXfn",
+	"fZVEOLEN50xb8dY2vKdJgHqCaCWIVpQ2l4Wc1z98cV6WDQbx+3lZWnygaMg4Sl1sw7XRX+LyaXOSwnme",
+	"Pz0hP4Zjo5wtQXc0Z07UgLth4W4td4vViiO3hmbEe5rgdoIm5nZao0FrZo5BcfhmWMkCpJ6dtAKN/+ja",
+	"hmQGv4/q/GmQWIjbNHFBK+IwZx8w+EvwcvmiQzl9wnG6nBNy3u17GNnAKHGCOYhWBvfTjjuAxxqFN4qW",
+	"FkD3xd6lXOALzDaysN6Rm45kdFGYm88hrSFUB5+1nechCgl86MLwXSGzqz9SvTrCmZ/7sfrHD6chK0Zz",
+	"psiK6tXJJCZlhMerGW3MEYOG+Hon82Cqk3qJx1rejqXl1NCTSRfeuFhiUY/9kOkxFXm7/IL/oAWBz3C2",
+	"qfHvctBJcDyiMrAg5PCUtw8EOxM0gI03kqzt653Aq3svKL9vJo/v06g9emYVBm6H3CJwh+Tm6MfgO7mJ",
+	"wfCd3PSOgNwwfQz6kBv7D27YWo+A76mDTOL+O/RRpei2j2QcewySYYEgumo8DSK88WGWRvN6PpfqMO7T",
+	"YSuCNPpkQmHUgPlOO0jCplU5c6QY0UnZBp2BGhPeMNPoDh/DWAsLr5SUi6MTX2f82D7hB8exaEFFxjRZ",
+	"M3VVMGIUZ4QJo7Yn7S27MPQ9bJk2NMD0HbasPdCxt0yuS16wY4imghZbzXee0FdKLhVdn/vmt9PJKnq5",
+	"gTLk8SNy8cfzrx4++sujr76GbS1tbzLfGqbJF+4NSrTZFuzLPlLwFVgVJj7610+8trU9bmwcLSuVsTUt",
+	"+0NZLa4V9WwzAu36CG/vEK66BnAME7pkcGPZHSPWQAGgPWXXL2XOUDNzhI0cEPULapg2jY7paKK8B9ur",
+	"47w6J5zQnuqcXbMCwCVrmTMimLmR6ipAw4WgpV5J834xYSHKaAmapVqrqd3cMdxMJ/7rjCcG9Q0Iz5kA",
+	"yYCp0VhuD38ozlP4rUFDRHNNtWbr+VH4RuqA5s0sOXGUn7OjHM2pFeaoENJQw/KdR3XfM9rAvg3Pqdqq",
+	"6hh6J6aUVBFlOF4xRmaymF0zpbmM2DVfuRbEtfBv0bL7u4WW3FBNYG7AE6lE3iLJZmIwwIwW0uzQlxvR",
+	"4GZQTLPrjazOzTtmX9rI9zSvSQk2440gOZtXy5baYqHkGg4EdkSR4UdmUG6/5Gt2Yei6/GWxOI5eR+JA",
+	"ca5g+JppmI3YVmTOzA1jAtagWVYZfs2s8K/RfKxZJoX1WdrBOdysd2HQOG8fxB2s+kdmLrYi+wA31poL",
+	"NGzqrcgCTRXeLSxf7sFg73SN4VT3dAQcQMcL/IzKzKesMPTognN3ghjs3/sTYYElOTRE8ewFX65M8Kx+",
+	"P8J9dJYdIn4BffqqiZ9BDDDUVPoIUn0zWMM0YE9DVkHnsjKEEgF0rrFxXN5POCOhFwQ6b5jwCWFWVs8w",
+	"Z0BIGa1gtWAXkjEW3HSc0cxS78yyhfiEjdHdtrLTWUeXQjGagy6TCSLnzkDqhBxcJEW/CuPvVvfaiIo9",
+	"AVylkhnTGnTQVrO4EzTfznJjM4AnBBwBrmchWpIFVXcG9up6J5xXbDtDLyBNvvjpV/3lR4DXSEOLHYjF",
+	"NjH01mouLhJQj5t+iOC6k4dkRxUjnucSI/GVUzDDUijcCyfJ/etC1NvFu6Plmim0R79XiveT3I2AalDf",
+	"M73fFdqqTPi2Oo0JiGewYYIK6YSh6GAF1Wa2iy1Do3AtGlYQcMIYJ8aBE0LJC6qN9aHgIkfVr3Yv3fqd",
+	"C1OkAU5K9jDyr16o74+N0qLQla4lfF2VpVSt90+zBnC8Sc/1M9vUc8lFMHb9jDCSVJrtGjmFpWB8hyy7",
+	"EosgampTo3My6i8ODXJwz2+jqGwB0SBiCJAL3yrAbujflwCE6wbRlnC47lBO7VQ4nWgjyxK4hZlVou6X",
+	"QtOFbX1u/tS07RMXNc29nUsGsxsPk4P8xmLWenauqCYODrKmVyB7oHrMOnv0YYbDONNcZGw2RPn4aoJW",
+	"4RHYcUgTSk3nOx7M1jkcHfqNEl2SCHbsQmrBCQ3rL6LgAiTIK/ZsU3K1PYZNpMqumBn/4O7B8B0O0H94",
+	"jzD0B2Z1TW6YYgRce4Cd05jeK27+qrgw4OjWtce4dU2P8OaSuGaiYdFkqWRV2vMHVw3PeGkl9yu2JQxR",
+	"Mmnv1R+5NvIom2UBmSEgo3cMz0cAzk4dSWuWo+FNXjNFKFFUOBdP5BIAzKsQjXdB1qCtIDJJVOkG1Mky",
+	"Jkxne1e2j30t9na+t46f2Htew08sCv85yZmhHDSdwYcY1Na5rjvmYe/cUYTYB79HiJHlFFyjPNdDuaUd",
+	"67V9Gfh6H+GhHhmVcBtpAYB6X1CWt53M2YZmptgSihLG1rI0Xc3X3Bjrht8+zkaWs3CAqB1wYEZnobce",
+	"z34HxrgMXOBQwfJi7Ns+eIbhu+y8elrocA+dUspihGqsh4woBKOcuUgpYde5i/rwoQGeklpAujdGsfXg",
+	"Cpmze7qFZlwB+R9ZkYwKfE9WhtUCm1QoBUFfnIHrYE7nttVgiBVszewzGb/cv99d+P37bs+5Jgt240Ol",
+	"7t/vo+P+fVRSvZLatA7XEa4aOG7PI7c3WjmBwbsnVpen7HYbciOP2clXncH9pHimtHaEC8u/MwPonMzN",
+	"mLWHNDLOZcpsRq48WE903bjvr2VRzGl2ZXWy/8z2WhuUomRRtPXgBJYPqECF9PvRJjdDx8DvTxw4PTYf",
+	"U36P8BIstke4suxARLFSMY0MJtSgaPtVLsLAQseB9FYbtu4rmW3XvyRo4rV/wPTew058XEvBttFYei7Y",
+	"S/wY622ZXKIzXjepvt0HXgv+DljtecaQ6V3xi7t9KUu7fucNewxWFSoD93jBOQgSL4IP/Xjz+9F94KT0",
+	"rOHLAi2Va0A7jK+nnlk2wK2kZhH5ETXF17TgtU42xd32ennWGzKt/Skii7sLazSy9BioFznfWmwgmTWX",
+	"x7NrdhwyY9dsHyLrgrDbMG/Hvwta7BC1YOLjOUPxsY2cV7XT/REYcHfcjo0vDGtGHTYrSkJJVnDUcEuh",
+	"jaoy80ZQ1KEFUEf8/bxmMK1V/d43iatxI1pWN9QbQRGFtWYt6qOxYJHj+QNjXrmqq+WSadN5riwYeyNc",
+	"Ky5IJbjdLjy8M8s0S7jUt4ad2JZruiULCM81kvzOlCTzyrQFeIy+1AZ0tNbgCNMQuXgjqCEFo9qQlxw8",
+	"RGA4b7D3fNv7JHksxL2tlkwwzfUs7lz4o/2K/u1u+Svn6w7/dp2tiQrGb0I0t4a10jv8ny/+6wzSOtDZ",
+	"7w9m3/zH6dt3T26/vN/78dHtt9/+3/ZPj2+//fK//j22Ux52nichf/7UPW6fP8UXTGOj6sH+wewTEFC8",
+	"YIk7wLs+dGiLfCGkqQnoy8YI6Hb9jQDvHCMtz6fmMHLoihm9s2hPR4dqWhvRUTf7te75LrgDlyERJtNh",
+	"jQeL0m1WBYuPR+HiZe4Ca6EVWVTCbmWlneEWg8y8S5lcTOtIa5th6YxgGO6Keqde9+ejr76eTJvw2fr7",
+	"ZDpxX99GKJnnm1iQdM42seeeOyB4MO5pUtKtZglfTYQ96j1nfU/CYdcM9AR6xcsPzym04fM4h/OhO05t",
+	"tBHPhY2pgfODJtits+zIxYeH2yjGclaaVSzzSktax1bNbjLWcYuB4DompoSfsJOu2iZfMu39+ApGF0Cg",
+	"VmSUY0IR63NgCc1TRYD1cCGjdCMx+sEHpuPW7QP9mkHyC3yQHuFY10HK/TMdulBNe+cGrSdTtA22ZHT4",
+	"AUNo7L0DrdBflIFngM3asqRcaDM2KipYb28zLPT7BERhD5vABIYltL8oRLiTtvTRlRBu4BiM3TlrA7f/",
+	"20hy78dnl+TU3VD6HmLEDR2EtEf0z/ZD20PNEOoSfNn30hvxRjxlCy44fD97I3Jq6Omcap7p00oz9Z0N",
+	"nzlZSnLmA0GfUkPfiJ5om8zBF1ALKat5wTM0u0T4gc2r1B/hzZvfgEzevHnbc9bpP9rdVFGGbieYQRoj",
+	"WZmZe2jMFLuhKo+AruvEITgy9h6cdUrc2K2HjBs/fsnQstTdBAL95ZdlAcsPyFC78HjYMqKNVF7449pD",
+	"g/v7s3Q3saI3PutQpZkmf13T8jcuzFsye1M9ePCYkVZE/V+djAU0uS1Zy1JxUIKDrp4CF27f12xjFJ2V",
+	"dMl0dPmG0RJ3Hx8oa9gCeFlgtxAndQQPDtUswOMjvQEWjr2jknFxF7aXzwAYXwJ+wi3ENiDfNZ4gh+5X",
+	"ENt/8HZ18gP0dqkyqxmc7eiqNJC435k6MZjl9849B4xfcAhcDrU5I9mKZVcsx3RObF2a7bTVXS5akr1n",
+	"HVzbtGc2Mhdz86BRB9KhlTl1bx8qtt0kKZoZ4xUNr9kV217KJrXPPllR2kk6dOqgIqUG4jwQa3hs3Rjd",
+	"zXduhgApLUuf6wKDnj1ZnNV04fukD7J9YxzhEMeIopVEIoUIqiKIwA4pFBywUBjvTqQfWx4861zgaCRL",
+	"muf9Pra0ea06j8BwNZer+vuaYQ5FeaPJnGqWE+nS/9lEFAEXqzRdssSTJNSFjkz30LLF4SC77r3oTQeW",
+	"/PaF1rtvoiDbxjNYc5RSGHwBUsHXY8cP1M9kTbe4ghOCWX0dwuYFikmByhiYDlUttbFYDoEWJ2CmRCNw",
+	"eDDaGAklmxXVPjNhPg3O8igZ4D0mVhlKp/U8cGEMsjTWybI8z+2e095z3iXV8pm0fPqs8C0/IhXWdOKi",
+	"JmLbIQUKQDkr2NIu3Dbu2Azu6WCDAI5fFgtUt89i3pBUa5lx+0hprhk3BwP5+D4h1upCRo8QI+MAbHRJ",
+	"wIHJzzI8m2K5D5DCJamhfmx0Zgj+ZvEQPRsfACKPLIGFc5GIRPEcgDoX2vr+6jhy4zCEiykBNndNCyaM",
+	"f2I3g/SyOqHY2snh5JxivkyJswNmR3ux7LUm7HHQakKZyQMdF+gGIJ7LzcwGmkcl3vlmDvQeDZmAXtGD",
+	"afNn3dPwIHeGMpHbxLR6ByxpODwYDQCYGAnWjv1St7kFZmjaYWkqRoWafFHLNg25pMSJMVMnJJgUuXwR",
+	"pMQ6CICuybHOn+cevzsfqW3xpH+ZN7daY8Wso9Fixz91hKK7lMBfXyNTJ7FyKoTXLJMqT+spgFC5qbPx",
+	"99ULtt0M+MboNFcDlQHO268N/4To71zCH6gFTzPPACKe2ljKHiTPNqXUTLtYS7zq3eBOTlTMRq1rqyTU",
+	"XCwLJxik0BRbsPdG9Bi3S27Sh/oBx8nOsc1NPPKHYCnLOBz7vFReO/wMQJE45Q0c0OCukLiUY4Ow3Kbp",
+	"41VXtI8elFarTqK74K0Vux2AfPrm476RWrOC4et51nptzK7YNq4EYCiaXfhugZYP0+lRsf0y8NZUbMm1",
+	"YY15j+sG0x/acEIxi6+Ui/TqTKkWsL7XUtbyHHa0ZpPWMj/4Cq6lYbMFVxD2ArbR6BKg0Q8atU8/QNP4",
+	"o6K12cQmtOd5/BLFaSH8L+dFFadXN+9PT2Han2vZQVdzFEy4IIxmKzLHAgxRL/GBqW2cz+CCX9gFv6BH",
+	"W++40wBNYWIF5NKe4xM5F12bywA7iBBgjDj6u5ZE6cAFGqQu6HPH4IFhDydepydDZoreYcr92DudSn0C",
+	"hZQwZ0caWAv6Qybd8iNeiGH0UlN7KZpkQEgzayk/IuiqFTw2xIcLItobLJZ+mnjcrLTv6lFDu7Y7BhTj",
+	"xxO7h3NC8KyA/CO7wx/QAbFW4KArih0BfZ0Ixvl5p5rdUn1/BxqE1Svtwhillp50M2Qpb55GLhty87ZG",
+	"ggXcWSlzvPUOJDRPbw199013ZTkDxUM0fvbPQYAsLa152DeOxZLCYBz8N+Lg2E97+6keK1F3Z5zxyw7T",
+	"WY9BAYpz+oBk4Ok3ZrBLIZrTi0oQpZ9xmBHj4PXLrpFOe9SXuMZpWfJ807F72lGT2vGjYAwvKDfYDgwE",
+	"tBGLzFZMt/Y9UObZYjqtLKInozBz2U42Hso04VRc+1JwfUTVmRt2egMzWvzEtr9CW1zO5HY6uZuZNIZr",
+	"N+IOXL+qtzeKZ/Q/sWazltfDniinJXgT0WLmjMkp0lTy2pEmNve25w8srcW53uWz8xcuxyba6wpG1ax+",
+	"7SRXhe3KT2ZVNmN64oD4UlMramr9nH0NB5tfp3kODdA3K+bK+gQP6l79gca5oBnPG6QXcffrneZl5wdh",
+	"lzjgD8HK2h2iMdVh544HBL2mvPA2Mg9twlUaFzfuboxyhXCAO3tShHfRUdlN73THT0dDXTt4UjjXQOGh",
+	"ta2tpYkUXf9EeAXDDJZUwW1+zpwFpM+cRLVGq8FMFzyL21PFXANxCOsnA40JNk68p2HEiifcrkTFg7Gg",
+	"2ZiUgh0ggzmiyNTRrIcN7ubSFUWtBP97xYLEqLU3YnBQUX/qLOv96zQuVbqBsU8w/F1kjLByRvfGczLX",
+	"kIAReuX0wH1aa/38QmvrExVeWt/XuS+csXclDjjmOfpw1GwjQ1Zt75rREvrOAqpe/+ZKeCTmiBZE5Xq2",
+	"UPJ3FldVoYYvEhfuJkJhCnufRMT1LoupLTlNXddm9uR2p6Sb4CNpOyQmqB53PnDBwTy33hpNhd1qW5+w",
+	"FUgQJ5ighT614zcE42DuhTkV9Abje6NCBsAUmF9adnMjie/sce9sNNyVbzkhgd9Y3ZbbhEYlU03Khn5y",
+	"xAMFBjvtaFGhkQygY0smsP7TtNAyMkwlbqgwzBelsUfJ9dbM6u+h141UmI5Mx038Ocv4OqpcevPmtzzr",
+	"m3NzvuS2yGOlWVBF0A1kq+NaKnKVGK07XYOa5wvyYBrUKXW7kfNrrvm8YNjioW0BNi1cmz/LdRdYHhNm",
+	"pbH5oxHNV5XIFcvNSlvEaklqoQ6fN7Wjik+X+wDbPfyGfIEuOppfsy8Bi+5+npw9/AYNrPaPB7ELwFVz",
+	"HeImObIT//6P0zH6KNkxgHG7UU+i2gBbgjvNuAZOk+065ixhS8frdp+lNRV0yeJeoesdMNm+uJtoC+jg",
+	"RWCjnGmj5JZwE5+fGQr8KRHaB+zPgkEyuV5zs3aOHFqugZ6aEoF2Uj+cLUZr76YaLv8R/aFK7w7SeUR+",
+	"WLuPvd9iq0avtZ/pmrXROiXU5qAreOOp6GtOkec+xSWWu6mr3FjcwFywdBRzYAuxBAMXBh8WlVnM/kCy",
+	"FVU0A/Z3kgJ3Nv/6SaTET7sEg9gP8A+Od8U0U9dx1KsE2XsZwvWFYEcxW3Ng9V82obTBqUw6bkWnNSk/",
+	"oeGhxwplMMosSW5Vi9xowKnvRHhiYMA7kmK9nr3oce+VfXDKrFScPGgFO/Sn1y+clLGWKpa3ujnuTuJQ",
+	"zCjOrlme3CQY8457oYpRu3AX6D+u8dSLnIFY5s9y8iGwj8UneBugzSf0TDzE2tO29LRkrtgG4oeRFhBb",
+	"wX6X3eMutS1bnfeBynUZCV1CidCKOO5gbL8X8N1VDIHJp7VDKRy1lxajzO9kZMm+IFpt43ERkxG9VeoC",
+	"gQ/AoOZuqClpF2X68B413izS9+yALx5W/KML7EdmNohkv4LEJgaF8aLbmdffA+cySr6Tm7Gb2uHdfmP/",
+	"AVATRUnFi/zXJhlLe4VzRUW2ijqLzKHjX5oK6fXi7GGOJi5fUSGsN0JvOPtK+Yt/zUTeW3+TY+dZczGy",
+	"bTf1rl1uZ3EN4G0wPVB+QkAvNwVMEGK1neeiDusrljInOE+TJbu51/vB4v3SgqnMDLaWwEDpPye12Nct",
+	"MRIVp2F+94LOWXEy/tq8DAKBYJEuVaS7TxS6lEAGgimRblJbVHguN1G5yIM+U1KaVFxQ440YWykKp7BN",
+	"GM7gIxgiS/yw3DVY2Y6QJ7kIswVam1u31FqznrhtAzMazGxGg1nOl0wnsGm/tYoPWDRZWNqZEf4hEbuz",
+	"skwHwiY5C7o++moM/VwNHfUpCPcpCeiyFqiAugOzaDtGq3Zo3+esuK6ohqlTThzwnI26bV4mDlENXJhl",
+	"5MPv7dX1LAk2OJNyER6V2rfDGhFrJiE3++Nbf/jFJpLuwFrXegnllWvRItypj5XCJuXHGQG3viGwzz8o",
+	"H0mIv0PrwTMpVXOs4YcpkaqmO/csmA4S4EcWntt3bu+mil8mLb5rmUuTIMjRxpBAbgsSflflS2b+FIuP",
+	"7jTw9idZwhaQOf7uBB50ayIZBqvluRVszKpuBIze6G4aH/cRvnjPkUwKXa1jzgcDfpldnzQAgx1iA7YA",
+	"zXAFkbeFBTexviYBkY/EdwhJSAd2Kr/e5Gy+gR/VI0ouWpM0uV8Am1wIpupvCd8LbDQrqVkl1Ams9gK3",
+	"47XyJ3rnkXrbc3kjAuuEgypk6y4HqfU4bqGnm4U+gHIw+2foRNrauz5+0wfgl/KVkgteJA9A3SCoVIp/",
+	"Wos2d9nxmnoJdouQCeEPuporWRkuYkW4ZUws/KV1xHSJYsDW7UU9Hc7gfm7mqI9X85Mm3AyRQiKNUxM4",
+	"g+U1u9MTRS0IItIugAd4bfJIwqWbRR9Tjh9UwrixI4t3P2MISHcj2lDEp45X8fozLYpZVtcVtfifdmoF",
+	"jUryaLm088goM1e5Z4AYd5Ci+0yoMYrPqyYvqzb1tiPIISHCqbRcocaPVdgGBNInTBmLnXTz68h+1L/V",
+	"s8LFoKgYHcPYPY6RWMoSjl4SrA4E9qJp/6b9VVSzyNatYevXIiv/nWP5qTrR7bxaLJyDhTPPIjAn5JUf",
+	"uSoLSXObf8EHzWJwv63xIGq0ceW/f4Sow2bPd29v0Pj9727n8EgM+LYb3gY7fXpes79X0We2+2ClReiM",
+	"F5ItrEyYyO0znvyI6elgma0aLnhW+LoqbD2QUClg93xKYBzwECZ2VttHMVMpV9h5aY0iLR3THTOyB5Hm",
+	"qcjnY+RbshUVZnWF5VjGXmhx6RsQ3vH9RbeHEDsn5Kn1ONFeYrCTkEyKBVcg7tTTOZsnauzgH8bQDJ4t",
+	"RrYOT1ohWaZYqucaIXuYhhuXZK32DO/ip30Tzvji6F592fjcUf/vrCmfiKcaUOjqo9vy6FMiwcR0w6Go",
+	"xIoads3a+YprnubOtM9f3Ma0qoSwRBtVyAwVeDiEAjxwTgEnBiDr0MCeQr5jvXvWir/AXrHz0Ss833El",
+	"9tlvXcaOE/LSuYVlVEjBMyw3FLPhYKrPcW78IyozpcsNuNwavXMeLXdfZ/VwWEwWwJ9OWohLCDT2K2yq",
+	"pQ77p2Eb97BcMtNcrFM09/OCOVdGLjRz5TCBiFrPk/bdjsx6x5tyTzLCLH4J35Qf4NvPznMJjiC54lYU",
+	"8XKBsf6c6GwIGamA2gXhhiwl02497Ry4+jfoc4JplHO2eXvyQi55dsGXOIaNLIBl2zCa/lDnPqjGi9ZS",
+	"ke+hrS030/zcSphkJz0vSzdpVKSod7j3yWxEEsFDj7kAufX44WgD5DYYDYdXOxAaJAgm2rCSuBwqbcJg",
+	"SsVslM9sWmGgKGxBbCB9DCnxeOIXXHjn10OfQtF+OlOgChpfZ4PRAgNoYgxNG+c9fdehOhvsAo/xGWTn",
+	"SG/j5QaqkVWFSTGOukFj4aNiS/yhAOoO5JrvQUPhRVeUx9p+PCKv5TmXhcWm7LYSYpxxAOOerZnWPlKq",
+	"q8UIjkFfPLPdA9lk9xUUSM71AEbRjO17laWS4u6h/sorWBthG5ZVtebDq4I6VUiOoPw6ynSKlVKZHQsD",
+	"Uujq3OqZ4ON8axVf3Xdko1/ZYysa5WtkO3KuqdZsPS8i5pen9UeW15QNJwwALJzCYzxFuvi5vZNQ+GC5",
+	"vM4vtc/TpT1S7+EBZ3kGGSTHYwLv0rujo5n6sAPe9D/0hDcjHPWIF3LZXsoHtlgN3Q/hLsduhmdw5YYp",
+	"8ntFT+2lXGewx4hrid990sc693Kbn/vEbr053fZHNr0DvG8YBfyaFonUMYE7JbWSibWephLIZMl8R9S4",
+	"FKWGkkFmmEz7aEM38buFIu42mwrXtNGa8LnX+8CCaDj2IEJ9HHAfoJ9qQ3RJuYuLathNH7POxp02SQ4d",
+	"umaDu4tweYqSNrifrlM5hXyqPfzerVl2xVze8lKxay4rt2G1osI/pu2v1t8nTN2XXH/Uxv+xPQ0HDcNQ",
+	"kcgu02kzfvrV+R6gu9U/gJdkb9Nt4dWhjFLfe5nWKRudWBpVGpqxt+1Te0dbn461zIdyEv70K3nq3bdH",
+	"3TuekGMZzWWO/j2JVKsvXH1l3wzk9tHTvnSdzstyeOpEEsb+5LbhvtOnsrnD+RxSnb7y53dem5u88iXy",
+	"ygsyBgq2iVnsQHHSTTh3wwjblAzrdwW5A9MJascSlMsjhu/8WcGoZgMYDgsjuLYjkXy5eQHtx+WzfMGX",
+	"K4Nlpv6IzhKvdpTRakpnIfMspeaNJbeAwVoOeCdjo/ov0ToWOOX3x/KmpmuWGalaoYKKsX2KgsFk3jHm",
+	"czmttIqpTn7g6X+gdNZ0EvKWaC4wd7xok4UaHdcxqiFi6bdtIsxesbrouwK/fjcE/LCghWZRW0IynryT",
+	"XDiICYsUr4sv7Hm+G5d+OdMgzIjnw4iMJ9s4t8E5/5TItKkjjovOSCXmKEewKQutk0o7a+GB3uWu0QG5",
+	"hVI5PMLRnc9bry4z2/hyFugotrOkxS7F/e6stZe1S0mvZnU/U+xQ8tZRoBR0GJKCvm9AdqeFTyVaDWBP",
+	"UyqS6LNNydX2uyq7Ygk66JamTtbfZjCUfd3byvhULJHOEUn6ZI+85O1cFAdCgBcQwHAABVicDsQjhHQo",
+	"F3eaq6DDUxX0WDPtLMTuzrqbRzc4Hzj91uh+1/OfNoOGW9EptZuqvT6dtDKi/5FrI1XiHa1YxnrHdmV7",
+	"OHetLqHtwabP6wQy1isf7tElE+gVkHdSMY6uNyaFRn37NZutudYsn8Gh33mMsBGxPVzy2rp+M3wja5oz",
+	"UulAmXEAjdknDcvhPVRKTYudcFn2AATmk1Y2uLJ5pq1frx2QNSVRD4dtFL7GwgWDvW8Wk8lrFsQ4OfpE",
+	"X0uso47x90K61u1Sq9uDUjykrr0rtr2nSet8PX+6dzHxDl/78MtzB8cTqYVTD8SeRQjBHSVK/ChDPtO7",
+	"AOrXm2sB+L5OEVbgz3ku7t0Biyhz3AmD/gwdGXtHP+d3QVdSnEsy9Cg37bGx7hUZK0kfJfYIkUW3tIPO",
+	"nfftT2w7qG2IXKlBbRJGhMzZR75j2WLBMtyQwRfJn4EvNTUapt5/KPB+tyyL10k8sV7pAVdXDVBBD4Sn",
+	"oMcD5+63gzNsHFKqEjFgI6885aYcHl1eGq5rykAs+KRju0UKP12g4z1wLk+SbW3vwJRw2g6cKyGSpFkQ",
+	"8oxUqY1XVrZvlZ9PWXufMkN5oV0KHlq/C0JnEHCM61aSv3GlMrHqSe1u7ItmMu1/8yWO7CwFv2JNVSbn",
+	"I4wVGlyLqIuQ9z4aG7eGzQiPA72oZ+ZNish+OvH+HtvkKlkhQeCeDWlimquqziJzT9vcU6iivWHKwbVg",
+	"SjVBcDA2mxkZURD14NgZvX0YEhL5xTD9OACXLLb6uqkm2zw7LVI7CySKrSlAp4Kar+k5h5D9vf3u82fX",
+	"wXG7HJlqet2dwcAnB+W6h8SQ6hfE3Za783If4hxkYwy9h3Q3xrAXVFgqmVeZvaDDg1H7fY12rxpgJVGv",
+	"mKy/yp6DQ4HFxl8EVQ6u2PbU2p6zFahKmuptIfTWrGHXEBRG6+z2Uf2m4g4exdIuYHkUOD+m59B0UkpZ",
+	"zBJuus/7dWy7Z+CKQxV4AneHT6snZM7utU8LTEK+QO/QOg7jZrX1dVvLkgmWf3lCyLmwiUx9SEZYSbc3",
+	"ubhnhubf4Kx5ZUtLO6emkzcinhESawapO/I3P8wwV9NM5Heeyg4yPJHZJGroQlF2jaEOCV7pZInRQRId",
+	"OSUgKgtFVEqx3oXnghZbzaMVQajhGaGuQW07ksIoWZBFIW/IUtFy1YrSrAMJsY6KCyry+Z0zHCUMOOoL",
+	"HHPg+7tSzrnJsGK4kKSQstQ2fUJWKc2vmQ+D1NKXBNjMMAZq7l6vmGhNJ3IFI+5Sb3NGsxUGBDGlemsZ",
+	"HQIO/E2mSvbaHFL+4GOZ/OzKnaN+eDBXBILWS2pWTVKCBhNBCHPft3c3mB5zkQ2R8NTLqK5zKSCG0VK3",
+	"RXCIWSlZLVftcNamsHsyvJv82SfI9DvNkd844pg2MW1Ie94nrBLen9lShBVwWyQRP6qwSPTlnyWM/y9t",
+	"bmuyYuj/4OpXZleYKxNJ4iTl65JdzQBoBQdGpzwsGipyWhV4bwrGcputE29nJAfRmRuyENbpRLd41BAc",
+	"lu+5z4MRtZedjbJx8NaFoTkQmVNEjJIBLurhahYUgaoS9fBDWt9YALeqRI/dECEtYVoc6btkbfCMKjgi",
+	"fUKKcV58kgY2xSELk7PlWRu4EwKYtWzRlA+k7TPT+4zdMVgdoOLYJcrfVf/XWpWfLobeA0tcjiLavsdu",
+	"hGYRgITDVkutF1bAbbJ/Kuv4jQzSu2N3t/hl48+98xWDkPgOO8ALPbCadrWY7cD5yFmGXtZICZaSpITW",
+	"8nc5dbkFNgJ3sEVWjoFl2sL9Nmq3vS+Bx57+vnaEi+O57y9n8xEKrJXf97PTjatASDhwmNQ1/QhprrAO",
+	"8jnig+WvxxnmQiRbVOrDwp9f0FFzF/Q9TA0BLtdM/BllgWgEhxvKeXQrT2Re5hHUVIoWpJDLICHFNRPk",
+	"BsfEnSYPvyZzl32+VCzjmncKc9zIqsi9Vhr1mEzxhTMKgAvtsOJ01zp/leYOZLzwiZLIz40ripH48Gkg",
+	"bI7oR2YqiZMbpfIY9fXIIoK/KI/qi0FjXmJhNqYpkQLvDnkTi/FUSz2UE6kWLVsvBRA/XfyglfIaqdNJ",
+	"2CgCJkpjjnrDBbO9p2fcIU8Xny6iDeGYRwv6QVdKvLcXBhgJrhmxxcEsm/CvpACXfP8sVZeH557qWkiA",
+	"2KbjRGOEJXYkwsqIOySoq1Z4FOxA25RgQ2iOHCYVhIrvGSbVr/k4dnm4DpTDKs366xwtwLZwG5Fdm7WN",
+	"jfGLaHCSoXlmPiY0z/4Q646xgRYh0OiEIKjkrw//ShTDtFZGkvv3cYL796eu6V8ftT/DDXf/fvR0fLCo",
+	"QIsjN4abN0oxjZrv2XX0Dj6P2QydoYuGObqRr66lTxXaU+mC1jCW2O/4threAe0A2WRIb+60dFqKODA9",
+	"LMBgIWBCmihwYUKQlEU/nCxqyu8QAo4U3XkXLtTLp49eu4nK4a+dpOteLxig5Nx84yX+Cz9HJ9oJO7qc",
+	"Ih84sxtq1neGMNiluca7kBygzC+5niiG+19Tea1s7qZErv0OF4S0/LvYcatyAhgqmWCaa6wN8BdX1efD",
+	"ot9DYOm7f0FaWPfKI9BlfYiYyFpbkwdTBTURRpRDcN0ixQ+QuLJKcbPFYsPewYH/JRo1/GPtE+Xi3Ory",
+	"lO4RZuQVq8tVNx5UjUfwj5IWyEioyG0WBwM8ljzb0HVZOK0u+fbe/D/Z4z88yR88fvif8z88+OpBxp58",
+	"9c2DB/SbJ/ThN48fskd/+OrJA/Zw8fU380f5oyeP5k8ePfn6q2+yx08ezp98/c1/3ptMJxxAtoBOfGm7",
+	"yX/PzoulnJ2/ej67BGAbnNCSg9vZ7S1atxfSsXpDM7xi2JryYnLmf/r/PCs+yeS6Gd7/OnGVsyYrY0p9",
+	"dnp6c3NzEnY5XaLLxMzIKlud+nlupx2Mn796XmcxtNHeuKM2K5y3sHhSOMdvr59dXJLzV89PGoKZnE0e",
+	"nDw4eQjjy5IJWvLJ2eQx/oSnZ4X7fuqIbXL27nY6OV0xWpiV+2PNjOKZ/6Rv6HLJ1AkmMrM/XT869W/a",
+	"03fOXeR26NtpIKzBz81fM57v6ImhvKfvfCXc4datUrNOMgg6jIRiqNnpXG72aMp00Di9FNR06dN3KEok",
+	"fz91tV3iH1FnZs/AqXc9i7dsYekd3MG3B/RQDAq5AjEtWTRbJzwHdaBHjNrxbbZtn3rbpu7sSCyN97ar",
+	"LXEWxlDpafgUgL8QzU2JhyCpdy0HTzvWhFYWhsY9DBVRZtV4hrimjKqCt/1e7Ax1C+shSC7rxXNNFAPm",
+	"jzmg3ZI8q/TjuTFwmUQK1B+5AQN9va79XskVY6W11bM1+sBb8xl6yMMS4bY3jT/nS7o5zzLzQsortKk6",
+	"Z+YvngT1Y79EfQPHWF6s1tfkgHSKcO1dtKeEwX5JQajKVhyysQFUyKhqtvU8x9vEtLx4gHJsepvppL5Y",
+	"9OTst7jA0DQ5dfLA7TQtibd8s8MtomXJqC0kiBcGcMOGn3v/5+a2xtjTia5L8/ef/7tlYKuuCn/zuYmR",
+	"Io0k9hwlgEIBeQimRh4CGefB7Ju3/xERCN9OJ9au4qL7Hz144O8896wKztCpY/XhLC3hTsfT8116uq/r",
+	"3EQPe2OlrxWjsbMaPfljvURCCutKYBb6tzEBKUSCq1XxL4iHnmTyzNnEHBv3JNuhawT3BOSOJ3tS16DN",
+	"sZUvatQm7TNcb60vaQHYY7ljJt6dVnXONYYb0cC4gvwY1bjC1tABzhxcfh5rFkEPP1kEPRcYTgBiJ7Fi",
+	"NS7oySe7oJ+lmLEN1wbrM+C5be82LPCrT5iknwu4IWhBsGVQgL7Puv4krgR4U7mW6BizXlO1tXd4jJ7T",
+	"El3v2gsDJyjYZX6blIpfWzFASBEE+ojl5O2tk0WbAjmNQIriavwVEhdHbVZ42q/MRvG/bnqEKeHGZRDX",
+	"hPo6UWVTHTCM4q+rFUnBvIEoWsXM1zeC4TXGNfUK8HWrCkZrOpFLz3DWlTZNfYKg4YI3ApuTq2tHlJ5s",
+	"1pRF9FE0LrdKRzYbLApXD3+QbLXmAgxAk7MH0xFy1nmzYWU1L3hGrPEhMnUTtrKPEPXuqz/cxhRrkRRE",
+	"DvfdWu0yUJnobtlvuMMZzbtl7YCQagz+vWJqG6zDvRon+0mkfQCLwPc9AWRDsoeB2noHDwN8V8l0iDN2",
+	"q31GeGOdQmqo2ud7F2p6YH1Hc+LrYLxneeHjX/C302HGgqxMqhb5WfLN0AVESMzEj2FF7/uufp+XK3X3",
+	"C+r+RHepQeFS2i1bepzLdITaa7+7ddq1Rz9/6p84rlL3iEs2qP53x0vT1xrX9tacs/opLmkR5IdWS+sF",
+	"CFwc3gLknv/zDHXW907ID1IRjrqnSlvuahtyYc4ePnr8xDWBUAe0dXbbzb9+cnb+7beuWam4wLKoLvKk",
+	"11wbdbZiRSFdB3en9ceFD2f//T//e3Jycm/HTf+d3HwKt3wYWZkzYSB+R8Wn7107d9HknIdUmiKTT5w6",
+	"Ync3/m8E6o6mWvp8gf9LXeCWnf/TXtt2fce8q6vy9F0zxK2Fr2CGjbmH8Yalc6nQZdFkK7jTrNoZJf1W",
+	"7dn2ZXEOvb63EOy6I87tQMSPFGHOrZnS3KXWXLbaB+8zfJw9nD58cPtv9Vvt4fSrx7cj03Y0LIVc1Bb7",
+	"kQ3vyup6t1twRnCT6qz3EU8kuxOzdcqX321VZyBSI2PYR6Q7fEwt/C/PQz9FDnVuD39LOHebPZodTSdl",
+	"1I84wW+0oQfwmwvo9ZnftBr2lDm0Sbrcu19FbYa4p/EnvdWGrV00KURY8CZ4tNOZa+LKnqJbIDdEUeeq",
+	"TgVWhbafU1IjDDJ5n1LiEOtEejsG62wPdGTW+WhP9vXpr/jzZfGpXRYXlnPf6bJwsqv1VOl7NOXsei1z",
+	"5h2M5GLh0onHNUvMhSbadvgUd6W7p2TOzA1zISB1zQXUYgc58qzJKczxJgWhJIfy9XBkyFrmdYnSE3Ju",
+	"U0ja6VoZQLw6ql3iQZOFLCAXBHy7Ab1A5k1cPd0LBllBgd0L6PmLXflRHTMabEbcUjzIfnEefX1khWge",
+	"EfbiZh3jSeBpuFsKp4NVD6JcJLfqX/RVjUa/XDLMB+ELBnPRQdKnyn9Cn8H9SWJ/FhVhRafv7P/xoR0X",
+	"ey8+NFO6YAYgJ7TFnBTTth5qjyUhsxrJly6SfGmnVri7N122EZHKZcP0DlIRJ8TINmy//PRZ3feZMR1X",
+	"MPJH/oNzpXdoBBzgRj5QQweeHk0OSDvKMHup/RVtjgfMgTYllShcnlzF8PdIoJ32ppFYJF1X/rFgPmXX",
+	"L2XOkOfoMVymt5YgO2qCy2Su4MVxmcyBMtlgTnPDtEkmiOnKWamkL+PFrHDC3cLVZ/75mX8ezU7imdQI",
+	"hnQgw9SClnoljU7zytcskypvx8254ozOHyp9Jpo0DNw4CxYcJwU2LMWumbLuB33OZ4tZOb534YCcfCge",
+	"g59aFilYvMdVIm2b+zpLhfn6BoFJfjT/ag9/KDdL7VIN2mc28k/IRuxR0oQ2FPgeJC8/tj59F5Dq7ak9",
+	"5UPcBb679PkBV5kSCkEBTTRZXIicDq2FGBmu2mfNdoFjLtDMfbWBJVhUP/i1KclHF4YpwqGdYiTnOqPK",
+	"6fbbnMsuqM+5dspsCf4QEdVCXvBZYLujwPaZ20W53dT7njTH55/GAcXznHF8Y1+OaLnYqUs+WUdbYEKJ",
+	"dOTvjzaK1UTKAVITvFKdj2iyQB+1qV95bl+ZtPYqdUJMHXvFVbq+n3veYslCTXRJRQBBr6wPo9lqaq1i",
+	"KGVEwAXeinGxBglQzGzOcCmYdvlnTaUEiwda9GopjuGmbp2SoNHVx/g6ctE+J3eXN8XMtLUEdqBDZrQU",
+	"EuBUWNES0OdwnQLBfp1p/jv7R2H4jjhaST2GznaqIGYkodROwbwV/omlOQBfHG7pO9eM8uuaHuEiamXi",
+	"xQgvu+O9c0ccc/hs//1E3RkP2e0j3StGlo2huN3Gp3Nuf1SyKCCRQWBmTsnmsihakrnPKd/j704eRvZf",
+	"FI283jarcHNCYExohkNhfocFpiJ1njguMM1dxNpeRPbUQ5l0iUkC6wf5+0vvgOVXWWligpFNWqbChczZ",
+	"VlrGtHYRni28oX+QSx7j4XFMJwDcSIS9g8HIC8Nt4AscfK/LUEk3dntD309owr/IC8O+DD05dJD7MVk6",
+	"mRHTOj01kbYWgJL+nC2kYv1TdeXOgD9LUjX06t4OTi/ZPrmfr5ODHycppktFnfKmofT97w9dlWWx7d0K",
+	"eiuy6I99b6TWhZb4+fRd68924qZdLU9XdTlp10OvKgMOlQP+BSXLOC3Imgq6ZJhUus4LZiTxAzTVL8kv",
+	"2JUWxdb7eRKK+iVZmSbAFzp7j6bGoQnpX69cMu0lFzgBPn9wFssTaCD3Byb+ji+Bg+xnmUcS+8ReAw7G",
+	"1pOkJrX3wYj7foq3+5EeshSbEb9PTvCx0t2/T28oNyCtuzKUiNF+Z8NogaeVF6zza8411Zqt5/0vaquq",
+	"gHJD42z811N2zYRJfcQ9S37sJlSLfXXpwXyjJmNimIEQCaLOPfjbW9hXzdS1p5Umod7Z6SkGrK+kNqfo",
+	"Et1Othd+fFtv5TtPYH5Lb9/e/r8BAP3k9C6qPgEA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -54,6 +54,12 @@ type ServerInterface interface {
 	// Get a proof for a transaction in a block.
 	// (GET /v2/blocks/{round}/transactions/{txid}/proof)
 	GetTransactionProof(ctx echo.Context, round uint64, txid string, params GetTransactionProofParams) error
+	// Get the top online accounts by stake.
+	// (GET /v2/ledger/online-accounts/top)
+	GetTopOnlineAccounts(ctx echo.Context, params GetTopOnlineAccountsParams) error
+	// Get the online stake history over a range of rounds.
+	// (GET /v2/ledger/online-stake)
+	GetOnlineStakeHistory(ctx echo.Context, params GetOnlineStakeHistoryParams) error
 	// Get the current supply reported by the ledger.
 	// (GET /v2/ledger/supply)
 	GetSupply(ctx echo.Context) error
@@ -71,7 +77,7 @@ type ServerInterface interface {
 	TealCompile(ctx echo.Context, params TealCompileParams) error
 	// Disassemble program bytes into the TEAL source code.
 	// (POST /v2/teal/disassemble)
-	TealDisassemble(ctx echo.Context) error
+	TealDisassemble(ctx echo.Context, params TealDisassembleParams) error
 	// Provide debugging information for a transaction (or group).
 	// (POST /v2/teal/dryrun)
 	TealDryrun(ctx echo.Context) error
@@ -384,6 +390,60 @@ func (w *ServerInterfaceWrapper) GetTransactionProof(ctx echo.Context) error {
 	return err
 }
 
+// GetTopOnlineAccounts converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTopOnlineAccounts(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTopOnlineAccountsParams
+	// ------------- Optional query parameter "round" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "round", ctx.QueryParams(), &params.Round)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter round: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetTopOnlineAccounts(ctx, params)
+	return err
+}
+
+// GetOnlineStakeHistory converts echo context to params.
+func (w *ServerInterfaceWrapper) GetOnlineStakeHistory(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetOnlineStakeHistoryParams
+	// ------------- Required query parameter "min-round" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "min-round", ctx.QueryParams(), &params.MinRound)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter min-round: %s", err))
+	}
+
+	// ------------- Required query parameter "max-round" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "max-round", ctx.QueryParams(), &params.MaxRound)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter max-round: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetOnlineStakeHistory(ctx, params)
+	return err
+}
+
 // GetSupply converts echo context to params.
 func (w *ServerInterfaceWrapper) GetSupply(ctx echo.Context) error {
 	var err error
@@ -457,6 +517,13 @@ func (w *ServerInterfaceWrapper) TealCompile(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sourcemap: %s", err))
 	}
 
+	// ------------- Optional query parameter "analyze" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "analyze", ctx.QueryParams(), &params.Analyze)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter analyze: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshalled arguments
 	err = w.Handler.TealCompile(ctx, params)
 	return err
@@ -468,8 +535,24 @@ func (w *ServerInterfaceWrapper) TealDisassemble(ctx echo.Context) error {
 
 	ctx.Set(Api_keyScopes, []string{""})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params TealDisassembleParams
+	// ------------- Optional query parameter "annotate" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "annotate", ctx.QueryParams(), &params.Annotate)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter annotate: %s", err))
+	}
+
+	// ------------- Optional query parameter "method" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "method", ctx.QueryParams(), &params.Method)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter method: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshalled arguments
-	err = w.Handler.TealDisassemble(ctx)
+	err = w.Handler.TealDisassemble(ctx, params)
 	return err
 }
 
@@ -534,6 +617,8 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.GET(baseURL+"/v2/blocks/:round/hash", wrapper.GetBlockHash, m...)
 	router.GET(baseURL+"/v2/blocks/:round/lightheader/proof", wrapper.GetLightBlockHeaderProof, m...)
 	router.GET(baseURL+"/v2/blocks/:round/transactions/:txid/proof", wrapper.GetTransactionProof, m...)
+	router.GET(baseURL+"/v2/ledger/online-accounts/top", wrapper.GetTopOnlineAccounts, m...)
+	router.GET(baseURL+"/v2/ledger/online-stake", wrapper.GetOnlineStakeHistory, m...)
 	router.GET(baseURL+"/v2/ledger/supply", wrapper.GetSupply, m...)
 	router.GET(baseURL+"/v2/stateproofs/:round", wrapper.GetStateProof, m...)
 	router.GET(baseURL+"/v2/status", wrapper.GetStatus, m...)
@@ -548,227 +633,278 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9a3PctpLoX0HNbpUfOxz5mT1RVWqvbCc52tiOy1ayj8g3wZA9MzjiADwAKM3E1//9",
-	"FhoACZIghyPJcpzok60hCTQajUa/+8MkFetCcOBaTQ4/TAoq6Ro0SPyLpqkouU5YZv7KQKWSFZoJPjn0",
-	"z4jSkvHlZDph5teC6tVkOuF0DfU75vvpRMI/SyYhmxxqWcJ0otIVrKkZWG8L83Y10iZZisQNcWSHOH4x",
-	"+TjwgGaZBKW6UP7I8y1hPM3LDIiWlCuamkeKXDC9InrFFHEfE8aJ4EDEguhV42WyYJBnauYX+c8S5DZY",
-	"pZu8f0kfaxATKXLowvlcrOeMg4cKKqCqDSFakAwW+NKKamJmMLD6F7UgCqhMV2Qh5A5QLRAhvMDL9eTw",
-	"l4kCnoHE3UqBneN/FxLgd0g0lUvQk/fT2OIWGmSi2TqytGOHfQmqzLUi+C6uccnOgRPz1Yy8KpUmcyCU",
-	"k7ffPSePHz/+2ixkTbWGzBFZ76rq2cM12c8nh5OMavCPu7RG86WQlGdJ9f7b757j/O/cAse+RZWC+GE5",
-	"Mk/I8Yu+BfgPIyTEuIYl7kOD+s0XkUNR/zyHhZAwck/sy9e6KeH8n3VXUqrTVSEY15F9IfiU2MdRHhZ8",
-	"PsTDKgAa7xcGU9IM+suD5Ov3Hx5OHz74+C+/HCX/6/58+vjjyOU/r8bdgYHoi2kpJfB0mywlUDwtK8q7",
-	"+Hjr6EGtRJlnZEXPcfPpGlm9+5aYby3rPKd5aeiEpVIc5UuhCHVklMGClrkmfmJS8tywKTOao3bCFCmk",
-	"OGcZZFPDfS9WLF2RlCo7BL5HLlieGxosFWR9tBZf3cBh+hiixMB1KXzggv64yKjXtQMTsEFukKS5UJBo",
-	"seN68jcO5RkJL5T6rlL7XVbkZAUEJzcP7GWLuOOGpvN8SzTua0aoIpT4q2lK2IJsRUkucHNydobfu9UY",
-	"rK2JQRpuTuMeNYe3D30dZESQNxciB8oRef7cdVHGF2xZSlDkYgV65e48CaoQXAER839Aqs22/+e7H18T",
-	"IckrUIou4Q1NzwjwVGT9e+wmjd3g/1DCbPhaLQuansWv65ytWQTkV3TD1uWa8HI9B2n2y98PWhAJupS8",
-	"DyA74g46W9NNd9ITWfIUN7eetiGoGVJiqsjpdkaOF2RNN988mDpwFKF5TgrgGeNLoje8V0gzc+8GL5Gi",
-	"5NkIGUabDQtuTVVAyhYMMlKNMgCJm2YXPIzvB08tWQXg+EF6walm2QEOh02EZszRNU9IQZcQkMyM/OQ4",
-	"Fz7V4gx4xeDIfIuPCgnnTJSq+qgHRpx6WLzmQkNSSFiwCI29c+gw3MO+49jr2gk4qeCaMg6Z4bwItNBg",
-	"OVEvTMGEw8pM94qeUwVfPem7wOunI3d/Idq7Prjjo3YbX0rskYzci+apO7Bxsanx/QjlL5xbsWVif+5s",
-	"JFuemKtkwXK8Zv5h9s+joVTIBBqI8BePYktOdSnh8JTfN3+RhLzTlGdUZuaXtf3pVZlr9o4tzU+5/eml",
-	"WLL0HVv2ILOCNapN4Wdr+48ZL86O9SaqNLwU4qwswgWlDa10viXHL/o22Y65L2EeVapsqFWcbLymse8X",
-	"elNtZA+QvbgrqHnxDLYSDLQ0XeA/mwXSE13I380/RZGbr3WxiKHW0LG7b9E24GwGR0WRs5QaJL51j81T",
-	"wwTAagm0fuMAL9TDDwGIhRQFSM3soLQoklykNE+UphpH+lcJi8nh5F8OauPKgf1cHQSTvzRfvcOPjDxq",
-	"ZZyEFsUeY7wxco0aYBaGQeMjZBOW7aFExLjdRENKzLDgHM4p17NaH2nwg+oA/+JmqvFtRRmL75Z+1Ytw",
-	"Yl+cg7LirX3xjiIB6gmilSBaUdpc5mJe/XD3qChqDOLzo6Kw+EDREBhKXbBhSqt7uHxan6RwnuMXM/J9",
-	"ODbK2YLnW3M5WFHD3A0Ld2u5W6wyHLk11CPeUQS3U8iZ2RqPBiPDXwfFoc6wErmRenbSinn57+7dkMzM",
-	"76M+/jJILMRtP3GhFuUwZxUY/CXQXO62KKdLOM6WMyNH7W8vRzZmlDjBXIpWBvfTjjuAxwqFF5IWFkD3",
-	"xN6ljKMGZl+ysF6Rm45kdFGYgzMc0BpCdemztvM8RCFBUmjB8CwX6dnfqVpdw5mf+7G6xw+nISugGUiy",
-	"omo1m8SkjPB41aONOWLmRdTeyTyYalYt8bqWt2NpGdU0WJqDNy6WWNTjd8j0QEZ0lx/xPzQn5rE524b1",
-	"22Fn5AQZmLLH2XkQMqPKWwXBzmReQBODIGurvROjde8F5fN68vg+jdqjb63BwO2QWwTukNhc+zF4JjYx",
-	"GJ6JTecIiA2o66APMw6KkRrWagR8LxxkAvffoY9KSbddJOPYY5BsFmhEV4WngYc3vpmltrwezYW8HPdp",
-	"sRVOansyoWbUgPlOW0jCV8sicaQYsUnZF1oD1S68YabRHj6GsQYW3mn6CbCgzKjXgYXmQNeNBbEuWA7X",
-	"QPqrKNOfUwWPH5F3fz96+vDRr4+efmVIspBiKemazLcaFLnrdDOi9DaHe92VoXZU5jo++ldPvBWyOW5s",
-	"HCVKmcKaFt2hrHXTikD2NWLe62KtiWZcdQXgmMN5AoaTW7QTa7g3oL1gykhY6/m1bEYfwrJ6low4SDLY",
-	"SUz7Lq+eZhsuUW5leR2qLEgpZMS+hkdMi1TkyTlIxUTEVfLGvUHcG168Ldq/W2jJBVXEzI2m35KjQBGh",
-	"LL3h4/m+Hfpkw2vcDHJ+u97I6ty8Y/aliXxvSVSkAJnoDScZzMtlQxNaSLEmlGT4Id7R34N+t+UpWtWu",
-	"g0j71bQ142jiV1ueBjqb2agcsmVjE66um7Wx4u1zdqo7KgKOQcdLfIxq/QvINb12+aU9QQz2534jLbAk",
-	"My+iFvySLVc6EDDfSCEW1w9jbJYYoPjAiue5+aYrpL8WGZjFluoaLuN6sJrWzZ6GFE7notSEEi4yQItK",
-	"qeLXdI9bHv2B6MbU4c2vV1binoMhpJSWZrVlQdBJ1+Ec9YcJTS31Joga1ePFqNxP9i07nXX55hJoZrR6",
-	"4ETMnavAOTFwkRQ9jNpfdE5IiJylBlyFFCkoBVniTBQ7QfPvWSaiB/CEgCPA1SxECbKg8srAnp3vhPMM",
-	"tgn6wxW5+8PP6t5ngFcLTfMdiMV3YuitFD7nD+pCPW76IYJrTx6SHZVAPM812qVhEDlo6EPhXjjp3b82",
-	"RJ1dvDpazkGiZ+aTUryf5GoEVIH6ien9qtCWRU+Ul1N0Ttga7XaccqEgFTxT0cFyqnSyiy2blxramFlB",
-	"wAljnBgH7hFKXlKlrTeR8QyNIPY6wXmsgGKm6Ae4VyA1I//sZdHu2Km5B7kqVSWYqrIohNSQxdbAYTMw",
-	"12vYVHOJRTB2Jf1qQUoFu0buw1IwvkOWXYlFENWV0d2527uLQ9O0uee3UVQ2gKgRMQTIO/9WgN0w0qUH",
-	"EKZqRFvCYapFOVV4zXSitCgKwy10UvLquz40vbNvH+mf6ne7xEV1fW9nAszs2sPkIL+wmLUxTitqVGgc",
-	"mazpmZE9UCG2bs8uzOYwJorxFJIhyjfH8p15KzwCOw5pjy3CRVEGs7UOR4t+o0TXSwQ7dqFvwT2GkTdU",
-	"apayAiXFH2B77YJze4KouZ5koCkzynrwwArRRfg9sX7s9piXE6RH6bBd8DtKbGQ5OVN4YTSBP4Mtaixv",
-	"bIDUSRBWdQ2aQGRUc7opJwioD7swAkz4CmxoqvOtueb0CrbkAiQQVc7XTGsb8dZUFLQoknCAqH1wYEZn",
-	"DLfBRX4Hxljn3+FQwfK6WzGdWIlqGL6TlljVQIeTpAoh8hG6dwcZUQhG+U1JIcyuMxdg6aPwPCU1gHRC",
-	"DHpCKuZ5RzXQjCsg/yNKklKOAmupoboRhEQ2i9evmcFcYNWczkNaYwhyWIOVw/HJ/fvthd+/7/acKbKA",
-	"Cx+VbF5so+P+fdSC3wilG4frGiwt5rgdR3g7Gk7NReFkuDZP2e2hcyOP2ck3rcEra6s5U0o5wjXLvzID",
-	"aJ3MzZi1hzQyzjuJ446yiQZDx9aN+45mnk9jo6mHjkHXnThwqtcP+/zqRr7Kt9fAp+1AREIhQeGpCvUS",
-	"ZZ+KRRi47o6d2ioN667pxn76a49g89aLBR0pU/CccUjWgsM2mqvFOLzCh7Gv7cnu+Rh5bN+3bbGpAX8L",
-	"rOY8Y6jwqvjF3Q5I+U0VUHINm98et2W1C0P2USuFvCCUpDlDnVVwpWWZ6lNOUSoOznLE8eZl/X496bl/",
-	"Ja6YRfQmN9Qpp+h0rWTlqLNgAREt+DsAry6pcrkEpVvywQLglLu3GCclZxrnWpv9SuyGFSDR+zWzb67p",
-	"lixojmrd7yAFmZe6eWNiZLHSRuuyJkQzDRGLU041ycFooK8YP9ngcN4E72mGg74Q8qzCwix6HpbAQTGV",
-	"xB2E39unGLvhlr9ycRyY5mUfW6OTGb8OP95qaKQu/d+7/3H4y1HyvzT5/UHy9b8dvP/w5OO9+50fH338",
-	"5pv/1/zp8cdv7v3Hv8Z2ysMei3t1kB+/cNLk8QsUGWqrUwf2G7M4rBlPokQW+lZatEXuGsHHE9C92qzn",
-	"dv2U6w03hHROc5ZRfTlyaLO4zlm0p6NFNY2NaCmQfq17XsRX4DIkwmRarPHS13jXpx6PMEczqAsax/Oy",
-	"KLndylI5UywGUHrfplhMqywCmz18SDDEfEW9Y979+ejpV5NpHRpePTf6tX36PkLJLNvEEgAy2MTkK3dA",
-	"8GDcUaSgWwU6zj0Q9qgb13qTwmHXYARztWLFzXMKpdk8zuF8WJrT0zb8mNt4MXN+0Ki6dbYasbh5uLUE",
-	"yKDQq1hWYUNSwLfq3QRoOboKKc6BTwmbwaytJ2VLUN6hnANdYHYbGgbFmDDb6hxYQvNUEWA9XMgoZSRG",
-	"PyjcOm79cTpxl7+6dnncDRyDqz1nZUH1f2tB7nz/7Qk5cAxT3bG5KHboIHsgYn9wAbINF6jhZjaX2ibj",
-	"nPJT/gIWjDPz/PCUZ1TTgzlVLFUHpQL5jOaUpzBbCnLoY25fUE1PeUfS6i13EEQ7k6Kc5ywlZ6FEXJOn",
-	"TWHtjnB6+gvNl+L09H3HG9SVX91UUf5iJ0gumF6JUicuRy+RcEFlFgFdVTlaOLLNsB2adUrc2JYVuxxA",
-	"N36c59GiUO1cje7yiyI3yw/IULlMBLNlRGkhvSxiBBQLDe7va+EuBkkvfIJnqUCR39a0+IVx/Z4kp+WD",
-	"B4+BNJIXfnNXvqHJbQENS9WlcknaVipcuNVrYKMlTQq6BBVdvgZa4O6jvLxGm2ieE/yskTThg8JwqHoB",
-	"Hh/9G2Dh2DsAHBf3zn7liy3El4CPcAvxHSNu1K6Gy+5XkEZx6e1qpWJ0dqnUq8Sc7eiqlCFxvzNVDvbS",
-	"CFne/6PYEmNsXLr6HEi6gvQMMsychXWht9PG597F6ARNzzqYshnmNgga0yDRqDcHUhYZdaI45dt2PpoC",
-	"rX2Qz1s4g+2JqLMo90lAa+ZDqb6DipQaSJeGWMNj68Zob77zY2MOSFH4tCKML/dkcVjRhf+m/yBbkfca",
-	"DnGMKBr5On2IoDKCCEv8PSi4xELNeFci/djyjJYxtzdfJCHd837iXqmVJ+dyDleDaUj2+RqwXIW4UGRO",
-	"jdwuXKUFm/MTcLFS0SX0SMihXXVkZk3DFouD7Lr3ojedWLQvtM59EwXZvpyYNUcpBcwTQyqozLQCDfxM",
-	"1nSPK5gRLKDkEDbPUUyqIjIs06GyYd+2FWH6QIsTMEheCxwejCZGQslmRZUvAoG1MvxZHiUDfMIctqHM",
-	"5ePARx4UxKjykj3PbZ/Tjnbp8pd90rLPVA5VyxFZx0bCx7C82HYIjgJQBjks7cLty55Q6ny6eoMMHD8u",
-	"FjnjQJKYu50qJVJmq3jU14ybA4x8fJ8QawImo0eIkXEANrqkcGDyWoRnky/3AZK7fEDqx0ZnVvA3xEOX",
-	"bQCaEXlEYVg44z2hjp4DUBejUd1frUghHIYwPiWGzZ3T3LA5p/HVg3QSaFFsbaXLOqfovT5xdsACby+W",
-	"vdZkr6LLrCaUmTzQcYFuAOK52CQ2dyEq8c43c0Pv0Zg8zKSIHUybqnxHkbnYoKMdrxYbA7YDln44PBiB",
-	"hr9hCukVv+u7zS0wQ9MOS1MxKlRIMs6cV5FLnzgxZuoeCaaPXO4G2ceXAqBl7Kjr9Dnld6eS2hRPupd5",
-	"fatN66oaPtw5dvz7jlB0l3rw17XCVPnCzoTwFlIhs347hSFUpqvCh13zgivbaPjG6IzigSKMR01tw6sQ",
-	"3Z3r8Qc34KnnGUDECxus34Hk200hjHRrg/ltZrdDipUTJdgcJWVtVorxZe4Egz40xRbso1E8xu2S60ot",
-	"fsBxsnNsc3uU/CFYiiIOxz6ayluHnwEoek55DQfK4VeExGV3D8LysZ8+3rRF++hBaQZWNGsKBLpW7HYw",
-	"5NP1ZnZ9pgpyQO05aWgbyVnMx316+osCFM3e+c8CKx9WLqB8ey+I1pGwZEpD7W0yEqzH9E3b8SkWTBJi",
-	"0b86XciFWd9bISp5zlbkwA8by7zxFZwLDcmCSaUTdNVFl2Be+k6h9ek782pcqWjGA9nagSyLX6I47Rls",
-	"k4zlZZxe3bw/vDDTvq5kB1XOUTBhnABNV2SOtS6jUYIDU9tA0sEFv7QLfkmvbb3jToN51UwsDbk05/hC",
-	"zkXrphtiBxECjBFHd9d6UTpwgQa5cV3uGCgY9nDidTobclN0DlPmx94ZX+Uz9PqEOTvSwFowNKg3LDMS",
-	"kEOWUpSFZep1metoFhsXOmkYPyLoqgw8StMzm4nR3GC+rGwq8bApq1ePGtq9u2NAPn48vns4JwQnOZxD",
-	"vjv8lSLGvQEHIyPsCBh6QzCQ3Md47JbquztQI6xaaRvGKLV0pJshx22tGrnCU7VujQRrcOdSRkd774yE",
-	"5umtpu+u664okgxyiCZo/FeQgUGLAtOs/cuxZAUzGOMZbOLg2EfTWDHqrvG+ZFzbwoXXVROtNc74ZYeV",
-	"w8agoLA1rvavu9avYwa7FKK5f1E9RFk5BwYZMQ5eaXZBGf829fVc47QoWLZp+T3tqL3W8WvBGF5QbrAd",
-	"GAhoI5b6I0E1K8bVxjxbt7hRsGU2CjMnzbpuoUwTTsWUr7rfRVSVGrgLVydA8x9g+7N5F5cz+TidXM1N",
-	"GsO1G3EHrt9U2xvFM4bhWbdZI+phT5TTopDinOaJcyb3kaYU54408XXve75haS3O9U6+PXr5xoH/cTpJ",
-	"c6AyqbSd3lXhe8UXsypbnK7ngPiq3iuqK/uc1YaDza8qaoUO6IsVuArKgULdKfVYBxcER9E5pBfxaOCd",
-	"7mUXB2GXOBAPAUUVDlG76mw0RDMCgp5TlnsfmYe2J3IXFzfuboxyhXCAK0dShHfRtbKbzumOn46aunbw",
-	"pHCugRrPa1vGXBHB2+FyRgtG1xuS6ppioUbrAekyJ16u0WuQqJylcX8qnytDHNzGyZiXCb7co0+bEUvW",
-	"E3bFSxaMZV5TI4zaLSCDOaLI9EU/+3A3F67/TMnZP0sgLAOuzSOJp7J1UNF+6jzr3es0LlW6ga03vh7+",
-	"KjJGWKS0feM5mWtIwAijcjrgvqisfn6hlffJ/BCEH+wR3BfO2LkSBwLzHH04araJCqtmdM1oCX1nrxpv",
-	"f3PVUnvmiPaeYSpZSPE7xE1VaOGL5AX6sqwMI1p/Bz6LiOttFlN5cuoWOvXsvdvdJ92EHqdmQGIP1ePO",
-	"ByE4WB/Se6Mpt1ttW0E04trjBBNmkBzY8WuCcTB3sm5yejGnseKZRsgwMAXul4bfXAviP/a4dz4a5irl",
-	"zkgQN1a9y2zGfAGyTtntVt+5pMBgpx0tKtSSAVJtKBNMbaxPrkRkmJJfUG47iqA3Ao+S+9oo+N4gdCEk",
-	"1rtQcRd/BilbR41Lp6e/ZGnXnZuxJbP9NEoFQcMGN5BtRGSpyDW9sOF0NWqOF+TBNGgJ43YjY+dMsXkO",
-	"+MZD+8acKrBGFR+54T8xywOuVwpffzTi9VXJMwmZXimLWCVIJdShelMFqsxBXwBw8gDfe/g1uYshOoqd",
-	"wz2DRXc/Tw4ffo0OVvvHg9gF4BrnDHGTDNmJ1//jdIwxSnYMw7jdqLOoNcB2O+tnXAOnyX465izhm47X",
-	"7T5La8rpEuJRoesdMNlvcTfRF9DCC89sqx6lpdgSpuPzg6aGP/Vkmhn2Z8EgqVivmV67QA4l1oae6m4M",
-	"dlI/nO374wrperj8Q4yHKnw4SEuJvFm/j73fYqvGqLXXdA1NtE4JtUVOclZHKvry3uTY11DCysJVQWGL",
-	"GzOXWTqKORi4uCCFZFyjYlHqRfI3kq6opKlhf7M+cJP5V08i1ZSbVT35foDfON4lKJDncdTLHrL3MoT7",
-	"ltzlgidrw1Gye3VmZ3AqewO34iE6fXFCw0OPFcrMKEkvuZUNcqMBp74S4fGBAa9IitV69qLHvVd245RZ",
-	"yjh50NLs0E9vXzopYy1krDBifdydxCFBSwbnGKcf3yQz5hX3QuajduEq0H9e56kXOQOxzJ/lXkVgH49P",
-	"oBugzyeMTLyMt6fp6WnIXFG3D2o44zwgtlngLr/HVdqIND7eByrPocdB12NEaCTAtjC2nwZ8dRND4PJp",
-	"7FAfjppLi1HmMxFZsq89X/l4XMZkxG7Vd4GYB4ZBzd1QU9Ks833zETXeLdKN7DBPPKz4RxvYz8xsEMl+",
-	"BT2bGPQgiG5nVj0PgssoeSY2Yze1xbv9xv4BUBNFScny7Oe6NkirxYOkPF1Fg0Xm5sNf62Z01eLsYY5W",
-	"xlxRzm00Qtc2gVrKr16biehb/xBj51kzPvLddtcJu9zW4mrAm2B6oPyEBr1M52aCEKvNsgtVWl++FBnB",
-	"eeoyjPW93u1WEtSU/2cJSsfuRXxgUwvQor4wVGxLuwPP0I4xI9/bZtIrII0qcWg/YOsytxXHbIFt6+op",
-	"i1zQbErMOCffHr0kdlb7jW2pZEuqL+2121hFf3zuPoG2Q7G115HRZ1atNBZtVJqui1iJEvPGiX8B66CE",
-	"3iVUrEPszMgLa9NQXmO2kxh6WDC5hoxU0zmpGmnC/Edrmq7QWNBgqf0kP74XgKdKFfTfrPpoVWVX8dwZ",
-	"uF07ANsNYEqEkRwumLI9hOEcmlVRqhJBTgzwVVKay5Ml55ZSolLxUAmry6DdA2ejIL0DKgpZC/F7Si8u",
-	"TH3P1gjv8KtoHcN2n4VO401bY6Pqj+R7w6eUC85SrCIYu5pdP+Ix3tkRBRfjmQEu3kZNIocr2t2hStZw",
-	"WOzt9+AZoUNc1z0UPDWbaqnD/qmx8e2KarIErRxng2zqm5Q4CzXjClwZXWxNHfBJIRseb+SQ0SCKWk7e",
-	"k4wwObvH5PCdefbaGaQwa/GMcVQ9fY6ETZC0NmRsl6qNvso0WQrMoHCHIlzTL+abGRZryWDzfubbq+IY",
-	"1mFslm2jI7pDHflYCRebYN59bt61BfXqnxt5cHbSo6Jwk/a3sInKA3rDexEc8XlXgV4Bcqvxw9EGyG0w",
-	"yAnvU0NocI4hElAQlxrT086llQRjhFZLUfgGsfHR0Tpa0TDRl4xD3fw3ckGk0SsBNwbPa893KpVUWxFw",
-	"FE87AZpjXESMoSntnGJXHaq1wS6etEgnfo7+baw70fQwjuqFWnCjfFv1HDbUHQgTz7HZuUNkt68MSlVO",
-	"iHLJNc1OMzHGYRi372XVvAC6x6ArE9nPtaT25OxzE/WVKpmX2RJ0QrMsZk94hk8JPiVZiZIDbCAtq/rN",
-	"RUFSrMzXLFXYpTY3USq4KtcDc/kXrjhd0LopQg1h+yi/wxh4Pd/iv7Hixf0748KD9o6x97FAWZU+t4/c",
-	"3BypI/Uamk4UWybjMYF3ytXRUU99OUKvv79WSs/FsgnIDRcoG+Jy4R7F+Nu35uII63d1KnLbq6Uqr4Xh",
-	"oMI33ES1sSoM0+RKPuu0M2fQ0G/YANHfmm+Kl19PXktg66X2frV+7b7slrQ3GYtqVz9BUzLIgnpz0m1c",
-	"mc0+RyjiNv2+WDIbSmYed74eJxl25GwcexChPkixC9APPgKaFJS5oI2aWXQx69K9+s2FQ4eu3uD2IlwS",
-	"Va/F7ofzvoQnnwdsMztazczOwBVVKiScM1H6cAgfL+dVQvurayYd5BX3rr8bN4NTfV4zaK/R9sQ1zrDL",
-	"dDr5Dz/b6EoCXMvtH8CE29n0Tiu4WM3iRiM4J1xF7U167F35ouomd3aerEU2lDD9w8/khfctjbp3PCHH",
-	"yi2JzLVfiiaLv3TF//1rRvocPe0r99FRUQxP3ZMh3p3cvrjv9H2lpsz5HLK6vfHn1zbQC00IEV0lSGfm",
-	"sNHxVjmdbNgLILApAGvdBonN/dUzxhKUS3JEbTXJgSoYwHBYtc29OxLJJ5uX5v1xyfbxFob9JWfrMrPI",
-	"PAuhWN2WJdbbcGTI8Qm2Jww8ht2xfLzfOaRayEYckwTYp4CumSzom3tberbHUFJFZnv6HygzO52EvCWa",
-	"qOiOF61L5KBXDV2ukVL19p0Is3cfM3NISpj6IcwPC5qreJeq3mDXVuWTIGAlUug5vrDjbES1b7ecaRAD",
-	"wbJhRMYzAWzw958TmTau/XrR2enWNKxVdAovBMVDbFOd2R4BJFUUNUqGuF9L4K6l8iKGmt1ZUYsFpJqd",
-	"7yh08V8r4EERham3BCMsi6DuBauybLCg6P5+jhqgoToUg/AEhf2vDE5fjugZbO8o0qCGaJefqRfuL1NL",
-	"EjGAt5YRPAqhYlGK1nXlAseYqigDseCjgu3nUFfl7m2vGMg5l5zLk2RT4hmY8lzEbN+j5jKf7lUJDBNG",
-	"+mphdBuc9Vs8XmA/OVW1Pva1KEO7IDnuVuy/cLUssSxJ5a31VS1B+d98DSI7S87OIGwAib5xLKHg3oga",
-	"e70dORmQkzrZ3745VxvoRTUzq3M4uvm+kRrQGP2U5sIowUlfulMzbaIK87qjbHAoiinYOQ7hWoB0jXLx",
-	"ZsiFgkQLH1o3BMcQKmwE7KWQoHr7Lljgequhvq3LvWL/GVssg7rA13CBRMKaGuhkUJS1f84hZD+3z32C",
-	"q6/JtdOmXdFrsrOqqs/eYaqDxJDqF8TdlrsTZy9j3mac27b8KhZTyA0qQ/9rIUVWpq4QTHAwKhfA6IJl",
-	"A6wkahlOu6vsGPlyrAb+MihDcAbbA2t/SVeUL4PyaiH0VrS3awgql7V2+1ot/3EjZ760C1heC5yf03o+",
-	"nRRC5EmPw/W4W2i2fQbOWHpmxOyyjnvvabFI7qKfr4qouVhtfWHVogAO2b0ZIUfcZhr54Jpmp6PW5PyO",
-	"Hpp/g7Nmpa397Az7s1MeT9nAoj7yivzNDzPM1RQY5nfFqewgO8qYbnqK3Ep6EWk42o2nGx3u0m4CWROV",
-	"hSImpVyyVNeo89017kdIP+iCOKz9hJX86ihmaX1EKC15z01beHlVu37G9WP0H+wALzTWBB0ZPTdy4Hzm",
-	"UONXFVKCpfRSQmP5u+w/boE1Xwq2SGHWpFmmLUBsw9Sa+xIY99TzymYWx3PXtIZl+wTHmr9dk5xCn6Et",
-	"wxoQjjmX8pzmN29Ww3qOR4gP11Y8vtBQ/w2RbFGpLhfv95KOmjvQda9vav4GzYD/BWaPos5eN5Rz/lSd",
-	"ML2LDEvc05zkou6Ii0OSCxzTeocffkXmLouukJAyxVoJxhe+q0ml7mGTr7rb/LB+uWudPwt9BTJ2CoIo",
-	"yOu6Q4IWeD/UENZH9DMzlZ6TG6XyGPV1yCKCvxiPCsvZ7LguzhpuY9txphUPKSRcs/s4CATb033cLdQz",
-	"dnnWRWounVJBd52jb+sGbiMXdb22sbEPXeQOldEfE7IQ745hPseYCYsQbC1DEFTy28PfiIQF9o4U5P59",
-	"nOD+/al79bdHzcfmON+/HxXjbixawuLIjeHmjVKMc6Z1UmFgUzDZU/TvrWPu7sJG9x3BDyBenTOHaDcY",
-	"nNrHjd5wKWiUuXca+O3S3Mu7+FmAMr/kaqIY7n/uy12w8fk9aTKts1CyPNt1KBtJT3XnW0zr+dUl5H6W",
-	"3ru/Wlt2l026/of7xMi1DwAiJrLWxuTBVEE604hMJvdZJG8JiSstJdNbrBPmTZ/s12hMzfeVt8R5gavK",
-	"Mk7u0OIMqkpztW+lVF6y+V7QHGUBo89ghKIWIp+Rbzd0XeTgmNQ3d+b/Do//9iR78Pjhv8//9uDpgxSe",
-	"PP36wQP69RP68OvHD+HR354+eQAPF199PX+UPXryaP7k0ZOvnn6dPn7ycP7kq6///Y65AwzIFtCJr0ox",
-	"+W9sUJ0cvTlOTgywNU5owX6Are2FacjYd9mkKXJBWFOWTw79T//Hc7dZKtb18P7XiUt6n6y0LtThwcHF",
-	"xcUs/ORgicbURIsyXR34eTptOI/eHFfpYTYWCnfUZv4YUsBNdaRwhM/efvvuhBy9OZ7VBDM5nDyYPZg9",
-	"xFrGBXBasMnh5DH+hKdnhft+4IsIH374OJ0crIDm6BM3f6xBS5b6R+qCLpcgZ67dqPnp/NGBF+MOPjhD",
-	"8kcz6jLmN7WJbkF2U7cLp3NKYbSwTWRrdLVSrsT0tOp15uw8PMP8I2ubNSy+QtZxVqeRH9eMypc7s/Vf",
-	"D3+JBDQt2LKUaDyq07OrUE3XCJEp8p/vfnxNhCROnXxD07MwdgsJ8p8lyG1NMI6VhYVLfV8qlwm0Vsui",
-	"GTZfs/SIahFtZ4ozm30OKLXy6dScCL3OYRPoiq8aXvkg+fr9h6d/+zgZAQg6GBVgWZvfaJ7/Ri4YdsVE",
-	"L00ztV1NIz2YUDWZ1j4C/KDepinG/VdPwzab1TvNbLPfuODwW982OMCi+0Dz3LwoOMT24D0WXkFKwEP0",
-	"6MGDa+vPWyVY2uyBahRPEpcYqMth7KOqz++FpIU9aL7gAqarol3BLxS7Ej+5xoU2w6OvvNz2cJ1FP6MZ",
-	"tj4Epe1SHn6xSznm6OM3HJ/YG+3jdPL0C96bY254Ds0JvhlUNeveIj/xMy4uuH/TSDPlek3lFmWVoD9r",
-	"K3mbLhWai5FF2rPdLIn//mPvlXYQNpw7+NBwE2dXuvA6vTaPX+y4A++oPs7ZrQnc6mfnqvDbGh3oSHRN",
-	"+7CBmro3I9+HXyP3xhI7toBNKbkLVHK2KZYZPuwUEl+JsIbtjgrjj6I3cmB7v72cP+nlfNQ0CzWKysaA",
-	"aZD4IEydOJKr3o7dBLzraJMQtI27REH+T9oTtaUZ2pnexxS3nVz4Fnc9uOuTgQJ4K3Go2cXs0/Ndn/BS",
-	"XRON++ATcuUvXKJ7RXNDJ8FyW8UAbKXlW0nvLyPpVaGFSyt6ueYCV5P9MMPm4IOvnn0N8p6rHj5C0muU",
-	"g6u/Dao7322xk3sz1z0yeOdyPMPFEu6U4bCm+a309qmlt24zgBgYdYn3zyexXaVmYqOR714lB79QEe0v",
-	"jKxemcxVHd0hjV2CN3YkLceJPxnP/FNKWA5pt7LVX1q2qsL3ryRdNdp5uISQwLt0Jbtb267GdCVmNVM4",
-	"As6GKSWGobgjPK1bjxkWgzW3fLkVNfVqH3o2rUZoN2vaUQq78tP3EGqfz7bHL3aJTl+QEWd07cfILRDf",
-	"m0/NS6MOg7c34zAYx5uePHhycxCEu/BaaPId3uKfmEN+UpYWJ6t9WdgQRzqY26rUQ1yJt9gSMoq62nTA",
-	"o7DdTFjR2gZK3HV9ysMqIfdmxNe+VlWPGZeuvxSGQfkaXFQu7UeGxxkkkDv+z0Mc/86MfCckYVyrKcba",
-	"adeAhNxhXB8+fPT4iXtF0gsbytZ+b/7Vk8Ojb75xr9U1+K1+03ldaXm4gjwX7gN3N3THNQ8O//t//nc2",
-	"m93ZyU7F5tn2tS0r+EfhqV21Ltz4vt36wjcppqW7co87UXcjDvdnYhPl/mJze/t8ttvHYP9PcevMm2Tk",
-	"FNDKPNlIA77GW8gek33uoamvHG74TnWZzMhr4SoylDmVRMgMpGvKtSyppFwDZDNPqWSBqdeYgZ7mDLg2",
-	"CiO2GZKJYhnYRNZlKSEjOVtjH24J55gigNOjLt+AYDejx6DePyyTf0U3QZb2vLqmtXBLxpz3Nd34RmfY",
-	"ykdI/Ombb8iDaa215LkZIKkQE2Oua7qZ3KC1ryK2UeH3zY4PO2NkcewxlqNa+rE9JWmzvPxfm3N/sRK7",
-	"JXe3sdfEOff25tTemtB+4OoeDFoOrGBn26BhX64tqfKSjZTnRag4izMzjDUK/IF9AztN0lHls43e20N8",
-	"q/xfiZW0CWpPtoFJt+rgA/oyQp7RObeYNPgn8oEGDiEp1t4jJMgCdLpyycgtvEZ4j28m0c94hprcXrfI",
-	"glvUrWUe1jrE5qsjixQEeaLolQMZodAffV1n85gtsNRE1SjE93JGfxPz7Q2rzoau/ytTPrze5yybXdwL",
-	"yuf15F1pC9FyHU7NWwTvh+AO5/vWNytDjLlF/BkC8L2emJDXok6Jd30y/oz+xE95bX/qBb0WHKzj3Ii1",
-	"lhZvfaSVTIH2eUSKr4VilZOqYvml5YsD33ZvUMj4u216NyhojLm9zWRf5BX+92ir9cYtY9Y225kYXY82",
-	"hjmbF2295Wal5c+oonwWfvoH1Fs+B8e6GRaDh9TzGScW8OtlOlheyBLzQVXMtI8DxeuWj+ZGWlSxZdFS",
-	"43PIBV+qPyYrGqKOOF4iVFJVdI+Xbf/rnd3nWLmIC18k1NWyUoynYNtKYkccpsiaKeUiIJ88+NvNQajZ",
-	"2tf/42Eq6WfmLk8fPL656d+BPGcpkBNYF0JSyfIt+YlXLUCvwu2w+HdVW86beqN9CNCV1Kx5loYFmi7P",
-	"BBvxaB/0hmUfdzPDoD7hnnyQ8YAPhjUnaVEAlZdngLv9UietGY9fhCG/jZrUVbWwCCgGRXtGvf/bZKTd",
-	"CbPQxcJdfiW3gPrKZo5NuHhcsZhWkS9GChCLQ3LK7xO1ok8fPvr10dOv/J+Pnn7VYzkz87iCRF3bWT2Q",
-	"eWyHGWNA++Pa+q5XJK+Qd3jTW7nfDk0nLNtEC9DWzU/Cc+ECc5BP3FGkoNveutXFjuYt4bB1I5ebr9Ko",
-	"NJuvosqT122qXsbH/Fml4tpSgq7nyW3Tlp50h4CJGEKru7dUWB9u5DIgKrbIsupMcNOaZ50WYG8xjzzZ",
-	"ulA+qxSrP5cGmqACCtxLLU20fD6BEYskTwNHddUdHqNOyqIQUlenW81GyXLQ53BriHJ9hLuXpJZSna7K",
-	"4uAD/gfLY32sUwVsP9bAQ+d+tx3pDqz/fUiIe2ffuOKd2JKWrddfNpmTr9TmYgLEgrxiqRRHWHvbXTdq",
-	"qzSsu32E7Ke/DvXkj15NgueMQ7IWPFbk7Ud8+gof9rZZ6/sY26r1fdtuG9SAvwVWc54xnPGq+P2D6NlX",
-	"sg+1VivBHOO6YZKl/z2Pmj80W552T9KWp91j1uja1PPzwYfGny76xr2pVqXOxEXwLWp3lheNcbwHhb/H",
-	"G8UrhadVQFuRDJQh2i/PAhXgIXZiqqeR6l9BeffeAmB/UZvUgvGsRSQoUabiHKSqrBXSB8rcGqb+PIap",
-	"0fu+F4+1pSx3cbRSXa9E8lpkYMdtVo+NJXpykYGruNkVRCoZLK7v+1upfq+lgaW0XK40KQuiRUzXqz9M",
-	"aGqZrO3rpnY1wrJv+YYv50BoLoFmWzIH4ETMzaKbDQUJVRjkXrVNtJJmvJ9TDVchRQpKQZb4xNZdoFV1",
-	"TFG91AN4QsAR4GoWogRZUHllYM/Od8JZ1V1X5O4PP6t7nwFeKwoOI9aG1kbQW0X4OGmvC/W46YcIrj15",
-	"SHZUAvGiAdq3xLrIwVm4IijcCye9+9eGqLOLV0cLmoDYJ6Z4P8nVCKgC9RPT+1WhLQtsuB3pOGefnrA1",
-	"SmKccqEgFTxT/X0hd7Fl7H0SrEWZFQScMMaJceAehfMlVfqt82SE7bOCHitmioFGln015s3IP1cV5jtj",
-	"p+Y+5KpUVRl6Z8CALLYGDpuBuV7DppoLXUl+7MpCogUpFewauQ9LwfgOWSrsTKkDHxB2QOkuDquRUGeg",
-	"6KKyAUSNiCFA3vm3AuyG/okeQJiqEV21m2tSTtCnWGlRFIZb6KTk1Xd9aHpn3z7SP9XvdonLNXXAezsT",
-	"oELrlYP8wmJWYbrFiiri4CBreuYMXEtXrakLszmMCXqdkyHKN8fynXkrPAI7DmnbGBIe/8Y5ax2OFv1G",
-	"ia6XCHbsQt+CY+aXLzKbqe31+oTxOk3zUyA+zy6jGhxcUKaThZCunzFdaJARS0irCjtl2idLWfuzFs6b",
-	"THAEx3XcOK4vbl1xwLU0tCAQ34qRrSMFTMxU3wk5KuOhGfpDmSYl1ywPsj4rReOPZ265VaFuVahbFepW",
-	"hbpVoW5VqFsV6laFulWhblWoq6hQnytJJPH82kfXccETDkuq2TlU2SO3RSv+VEHV1Un3Kh0qgUYFcyXg",
-	"rphFooHmuGqW2zadQvVW08CuqUqUMgWSGpgYJ0VOjSwFG12VIGoWt/PlNl3fVKyXRxU8fkTe/f3IB4Su",
-	"XOBi8927vl2m0tsc7rk84Kq5nk8IBm7Q7PKBqVeBfakiV7iJ5UCUQei3+PYLOIfcqHM21owYhbSrIp8A",
-	"zZ873OzQkBvt08xov00birlD25oWQX9oXCtVhGLwcKv72YLmqr/9mR1vTYtYtaCKmVvdGfnHM5FtW2fC",
-	"7NoBbmDzNNRhoYxTuY3Ee3fOQIc0tDAcyhFWV/n/eO3By12i7ZLZLgqLiTcSVPTkDlF5NGq32rDOUDZy",
-	"fNGik2jvz3ao6qQCcEzAlaFnvyfkrf3u8+Y9IkTuiNXs+w8Tp9J8s2Ia+K6Ruhzr+VKTFD3io6cXz/7U",
-	"EHZWpkCYVsTHP+++XqaTTWJGWgJPHANK5iLbJg32NWncQhlTVClYz3ffRCH/dPUx3eVjngzfU5/nGnkR",
-	"LG6IJ4dEs0kcA+7hzjZofxxvrrCFIzr2HGD8U7PoPjYagkAcf4pp4e2uBHsyvXqa7S3ju2V8wWlsSQSM",
-	"u3yRNhOZfULGJ7ey5P0879sNpKUBLjzJd9GciT4M2OiGIyiDeblcYp3PjlPDLA1wPCb4Z2KFdrljueB+",
-	"FGQHr2q/XbUiSXu4LncJciPuCkmWUpTFPdvQhG/R+rsuKN96Hxkkiq3L3OLQVlG6XkZrUzpiDe29La/f",
-	"DPjGW/sCY5e7apu/W7SQC6pcY3PISMkzF6neSfza8PE1Ru3QJxtes+nBKqN2vZHVuXnHXBF+l11IdeUX",
-	"LEAmesPtgWoWArYJZvbkzm7rG/41ro03tnFQD4PtJkvVDOGabg8Z8DW8PoJ89zr1otmVxfaM6gtUDpPf",
-	"7ZvX6m3vDN90ugcdm6xTCfKCUF98OhVcaVmm+pRTNGoHC5t1HfLeVN/P3577V+J+lYjbww11yinWJq5M",
-	"3VE+t4CIE+s7AM9GVblcgjK8MiSSBcApd28xTkpuNC2xIGuWSpHYtCdzhox8MrNvrumWLGiOXpnfQQoy",
-	"Nzd7sOvWRKw0y3MXAWCmIWJxyqkmOVClyStmuKwZzlsRq9AX0BdCnlVYiKdLL4GDYiqJG1++t08xI9kt",
-	"3xv50GBpH9eZhDebiuxhZ1kv5McvDNwUKyvkTOnaadyB/cYchmvGkyiRnayAuBiaNm2Ru4bxegK6V3vl",
-	"3a6fcnPDaUGQq1N9OXJoO3Y6Z9GejhbVNDai5f/xax2l4l0LlyERJnPrTPkTJQIFdGBovNp47FrQ3vs9",
-	"3SiDjdBiT115Gv+SPSZ4iRu4IS0l01t0NNCC/XoG5v/vP743z+S590GUMp8cTlZaF4cHB9jCbCWUPph8",
-	"nIbPVOvh+2ppH7w7oZDsHIuevv/4/wMAAP//YBoQ3DE9AQA=",
+	"H4sIAAAAAAAC/+y9a5PbtpIA+ldQ2q3yY6UZv5I9marU3vEjiTe247Ln5OxunJtAJCThDAXwEOCMFF//",
+	"91vdDZAgCUqURjO2E3+yR8Sj0Wg0Gv18P0r0MtdKKGtGJ+9HOS/4UlhR4F88SXSp7ESm8FcqTFLI3Eqt",
+	"Rif+GzO2kGo+Go8k/JpzuxiNR4ovxegk7D8eFeJfpSxEOjqxRSnGI5MsxJLDwHadQ+tqpNVkriduiFMa",
+	"4vnT0YcNH3iaFsKYLpQ/qWzNpEqyMhXMFlwZnsAnwy6lXTC7kIa5zkwqppVgesbsotGYzaTIUnPkF/mv",
+	"UhTrYJVu8v4lfahBnBQ6E104n+jlVCrhoRIVUNWGMKtZKmbYaMEtgxkAVt/QamYEL5IFm+liC6gERAiv",
+	"UOVydPLLyAiVigJ3KxHyAv87K4T4Q0wsL+bCjn4dxxY3s6KYWLmMLO25w34hTJlZw7AtrnEuL4Ri0OuI",
+	"vSyNZVPBuGJvvnvCHj58+A0sZMmtFakjst5V1bOHa6Luo5NRyq3wn7u0xrO5LrhKJ1X7N989wfnfugUO",
+	"bcWNEfHDcgpf2POnfQvwHSMkJJUVc9yHBvVDj8ihqH+eipkuxMA9ocYH3ZRw/o+6Kwm3ySLXUtnIvjD8",
+	"yuhzlIcF3TfxsAqARvscMFXAoL/cm3zz6/v74/v3PvzbL6eT/3N/fvXww8DlP6nG3YKBaMOkLAqhkvVk",
+	"XgiOp2XBVRcfbxw9mIUus5Qt+AVuPl8iq3d9GfQl1nnBsxLoRCaFPs3m2jDuyCgVM15mlvmJWakyYQyO",
+	"5qidScPyQl/IVKRjJhW7XMhkwRJuaAhsxy5llgENlkakfbQWX92Gw/QhRAnAtRc+cEGfLjLqdW3BhFgh",
+	"N5gkmTZiYvWW68nfOFylLLxQ6rvK7HZZsbOFYDg5fKDLFnGngKazbM0s7mvKuGGc+atpzOSMrXXJLnFz",
+	"MnmO/d1qAGtLBkjDzWnco3B4+9DXQUYEeVOtM8EVIs+fuy7K1EzOy0IYdrkQduHuvEKYXCsjmJ7+UyQW",
+	"tv2/3/70iumCvRTG8Ll4zZNzJlSi0/49dpPGbvB/Gg0bvjTznCfn8es6k0sZAfklX8lluWSqXE5FAfvl",
+	"7werWSFsWag+gGjELXS25KvupGdFqRLc3HrahqAGpCRNnvH1EXs+Y0u++vbe2IFjGM8ylguVSjVndqV6",
+	"hTSYezt4k0KXKh0gw1jYsODWNLlI5EyKlFWjbIDETbMNHql2g6eWrAJwpNoCjlTDwFFiFaEZOLrwheV8",
+	"LgKSOWJ/d5wLv1p9LlTF4Nh0jZ/yQlxIXZqqUw+MOPVm8VppKyZ5IWYyQmNvHToM44zaOPa6dAJOopXl",
+	"UomUSUVAayuIE/XCFEy4+THTvaKn3IivH40+bPs6cPdnur3rG3d80G5jowkdyci9CF/dgY2LTY3+Ax5/",
+	"4dxGzif0c2cj5fwMrpKZzPCa+Sfsn0dDaZAJNBDhLx4j54rbshAn79Rd+ItN2FvLVcqLFH5Z0k8vy8zK",
+	"t3IOP2X00ws9l8lbOe9BZgVr9DWF3Zb0D4wXZ8d2FX00vND6vMzDBSWNV+l0zZ4/7dtkGnNXwjytnrLh",
+	"q+Js5V8au/awq2oje4DsxV3OoeG5WBcCoOXJDP9ZzZCe+Kz4A/7J8wx623wWQy3QsbtvUTfgdAaneZ7J",
+	"hAMS37jP8BWYgKBXAq9bHOOFevI+ADEvdC4KK2lQnueTTCc8mxjLLY7074WYjU5G/3ZcK1eOqbs5DiZ/",
+	"Ab3eYieQR0nGmfA832GM1yDXmA3MAhg0fkI2QWwPJSKpaBOBlCSw4ExccGWPRuPYmawP8C9uphrfJMoQ",
+	"vlvvq16EM2o4FYbEW2p4y7AA9QzRyhCtKG3OMz2tfrh9muc1BvH7aZ4TPlA0FBKlLrGSxpo7uHxen6Rw",
+	"nudPj9j34dgoZ2vQHU2FEzXgbpi5W8vdYpXiyK2hHvGWYbidoIn5MK7QYIywh6A4fDMsdAZSz1ZagcY/",
+	"uLYhmcHvgzp/HiQW4rafuKAVc5ijBwz+Erxcbrcop0s4TpdzxE7bffcjGxglTjB70crG/aRxN+CxQuFl",
+	"wXMC0H2hu1QqfIFRI4L1itx0IKOLwlx/DmkNodr7rG09D1FI4EMbhseZTs5/4GZxgDM/9WN1jx9OwxaC",
+	"p6JgC24WR6OYlBEer3q0IUcMGuLrnU2DqY6qJR5qeVuWlnLLj0ZteONiCaEe+yHTE0Xk7fIT/odnDD7D",
+	"2ebWv8tBJyHxiOrAgpDCU54eCDQTNICNt5ot6fXO4NW9E5RP6snj+zRoj56RwsDtkFsE7pBeHfwYPNar",
+	"GAyP9apzBPRKmEPQh17Rf6QVSzMAvqcOMo3779DHi4Kvu0jGsYcgGRYIoqvB06DCGx9mqTWvp1Nd7Md9",
+	"WmxFsVqfzDiMGjDfcQtJ2LTMJ44UIzopatAaqDbhbWYa7eFjGGtg4XWh9ezgxNcaP7ZP+MFxLJ5xlQjD",
+	"lqI4zwSzhRRMKFusj5pb9tbya9gyY3mA6StsWXOgQ2+ZXuYyE4cQTRXP1kZuPaGvCz0v+PLUN/8wHi2i",
+	"lxsoQx4+YG9/OP3q/oPfHnz1NWxrTr3ZdG2FYbfdG5QZu87EnS5S8BVYZjY++tePvLa1OW5sHKPLIhFL",
+	"nneHIi0uiXrUjEG7LsKbO4SrrgAcwoTOBNxYtGOMDBQA2lNx8VKnAjUzB9jIDaJ+xq0wttYxHUyU92B7",
+	"dZxX54QT0qlOxYXIAFy21KlgSthLXZwHaHireG4W2l4vJgiihOegWaq0msbNHcPNeOS/TmTPoL4Bk6lQ",
+	"IBmIYjCWm8Pvi/M+/FagIaKl4caI5fQgfKPvgKb1LClzlJ+KgxzNMQlzXCltuRXp1qO66xmtYV+H57RY",
+	"F+Uh9E6iKHQRUYbjFWN1orPJhSiM1BG75mvXgrkW/i2at38naNklNwzmBjyxUqUNkqwnBgPMYCGNhj5b",
+	"qRo3G8U0Wm9kdW7eIfvSRL6necNysBmvFEvFtJw31BazQi/hQGBHFBm+Fxbl9jO5FG8tX+Y/zWaH0eto",
+	"HCjOFaxcCgOzMWrFpsJeCqFgDUYkpZUXgoR/g+ZjIxKtyGdpC+dws16FQeO8XRC3sOrvhX27VskN3FhL",
+	"qdCwadYqCTRVeLeIdL4Dg73SNYZT3TIRcAAdL/AzKjOfiszygwvO7QlisD/xJ4KAZSk0RPHshZwvbPCs",
+	"vh7hPjrLFhE/gz5d1cQrEAMst6U5gFRfD1YzDdjTkFXwqS4t40wBnRtsHJf3e5yR0AsCnTds+ISwC9Iz",
+	"TAUQUsJLWC3YhXSMBdcdJzwh6p0QW4hPWBvdqRVNR44uWSF4CrpMoZieOgOpE3JwkRz9Kqy/W91rIyr2",
+	"BHDlhU6EMaCDJs3iVtB8O+LGdgOeEHAEuJqFGc1mvLgysOcXW+E8F+sJegEZdvvHn82djwCv1ZZnWxCL",
+	"bWLordRcUvVAPWz6TQTXnjwkO14I5nkusxpfOZmwog+FO+Gkd//aEHV28epouRAF2qOvleL9JFcjoArU",
+	"a6b3q0Jb5j2+rU5jAuIZbJjiSjthKDpYxo2dbGPL0Chci4EVBJwwxolx4B6h5AU3lnwopEpR9WvcS7d6",
+	"58IU/QD3SvYw8s9eqO+OjdKiMqWpJHxT5rkuGu+feg3geNM/1yuxqubSs2Ds6hlhNSuN2DZyH5aC8R2y",
+	"aCWEIG4rU6NzMuouDg1ycM+vo6hsAFEjYhMgb32rALuhf18PINLUiCbCkaZFOZVT4XhkrM5z4BZ2Uqqq",
+	"Xx+a3lLrU/v3um2XuLit7+1UC5jdepgc5JeEWfLsXHDDHBxsyc9B9kD1GDl7dGGGwzgxUiVisony8dUE",
+	"rcIjsOWQ9ig1ne94MFvrcLToN0p0vUSwZRf6FtyjYf1JZVKBBHkunq1yWawPYRMpk3Nhhz+4OzA8xgG6",
+	"D+8Bhv7ArG7YpSgEA9ceYOc8pveKm79KqSw4urXtMW5d4wO8uTSumRlYNJsXuszp/MFVIxOZk+R+LtZM",
+	"IEpGzb36QRqrD7JZBMgEARm8Y3g+AnC26kgasxwMb/pCFIyzgivn4olcAoB5HaLxKsjaaCuITBJVugF1",
+	"ikQo29reBfWh12Jn5zvr+FFc8xp+FFH4T1kqLJeg6Qw+xKAm57r2mPu9cwcRYhf8DiFGlpNJg/JcB+VE",
+	"O+S1fRb4eh/goR4ZlUmKtABAvS+oSJtO5mLFE5utGUcJY00szZTTpbSW3PCbx9nqfBIOELUDbpjRWejJ",
+	"49nvwBCXgbc4VLC8GPumB89m+M5ar54GOtxDJ9c6G6Aa6yAjCsEgZy6Wa9h16aI+fGiAp6QGkO6Nka09",
+	"uEqn4pZpoBlXwP5XlyzhCt+TpRWVwKYLlIKgL84gTTCnc9uqMSQysRT0TMYvd++2F373rttzadhMXPpQ",
+	"qbt3u+i4exeVVK+1sY3DdYCrBo7b88jtjVZOYPDuidXmKdvdhtzIQ3bydWtwPymeKWMc4cLyr8wAWidz",
+	"NWTtIY0Mc5myq4ErD9YTXTfu+xudZVOenJNO9s9sr6WglEJnWVMPzmD5gApUSF+PNrkeOgZ+d+LA6bH+",
+	"2Of3CC/BbH2AK4sGYoXIC2GQwYQaFENf9SwMLHQcyKyNFcuukpm6/tZDE2/8A6bzHnbi41IrsY7G0ksl",
+	"XuLHWG9icj2d8brp69t+4DXgb4HVnGcImV4Vv7jbZzqn9Ttv2EOwqlAZuMMLzkHQ8yK46ceb34/2A6dP",
+	"zxq+LNBSuQS0w/hm7JllDdxCGxGRH1FTfMEzWelk+7jbTi/PakPGlT9FZHFXYY1W5x4D1SKna8IGkll9",
+	"eTy7EIchM3EhdiGyNgjbDfM0/lXQQkNUgomP5wzFxyZyXldO9wdgwO1xWza+MKwZddgiyxlnSSZRw62V",
+	"sUWZ2HeKow4tgDri7+c1g/1a1Se+SVyNG9GyuqHeKY4orDRrUR+NmYgcz++E8MpVU87nwtjWc2UmxDvl",
+	"WknFSiVpu/DwTohp5nCpr604opZLvmYzCM+1mv0hCs2mpW0K8Bh9aSzoaMngCNMwPXunuGWZ4MaylxI8",
+	"RGA4b7D3fNv7JHksxL2t5kIJI80k7lz4PX1F/3a3/IXzdYf/u85kooLx6xDNtRWN9A7/7+3/OoG0Dnzy",
+	"x73JN/9x/Ov7Rx/u3O38+ODDt9/+f82fHn749s5//XtspzzsMu2F/PlT97h9/hRfMLWNqgP7jdknIKB4",
+	"JnruAO/60KItdltpWxHQndoI6Hb9nQLvHKuJ53O7Hzm0xYzOWaTT0aKaxka01M1+rTu+C67AZViEybRY",
+	"496idJNVweLjUbh4mbvAWmjFZqWirSyNM9xikJl3KdOzcRVpTRmWThiG4S64d+p1fz746uvRuA6frb6P",
+	"xiP39dcIJct0FQuSTsUq9txzBwQPxi3Dcr42osdXE2GPes+R70k47FKAnsAsZH7znMJYOY1zOB+649RG",
+	"K/VcUUwNnB80wa6dZUfPbh5uWwiRitwuYplXGtI6tqp3U4iWWwwE1wk1ZvJIHLXVNulcGO/Hlwk+AwIl",
+	"kVEPCUWszgERmqeKAOvhQgbpRmL0gw9Mx62bB/qNgOQX+CA9wLGugpS7Zzp0oRp3zg1aT8ZoG2zI6PAD",
+	"htDQvQOt0F9UgGcAZW2Zc6mMHRoVFay3sxkE/S4BUdiDEpjAsIx3F4UId9KWObgSwg0cg7E9Z2Xg9n9b",
+	"zW59/+yMHbsbytxCjLihg5D2iP6ZPjQ91CzjLsEXvZfeqXfqqZhJJeH7yTuVcsuPp9zIxByXRhSPKXzm",
+	"aK7ZiQ8Efcotf6c6om1vDr6AWlheTjOZoNklwg8or1J3hHfvfgEyeffu146zTvfR7qaKMnSaYAJpjHRp",
+	"J+6hMSnEJS/SCOimShyCI2PvjbOOmRu78ZBx48cvGZ7npp1AoLv8PM9g+QEZGhceD1vGjNWFF/6k8dDg",
+	"/r7S7iYu+KXPOlQaYdjvS57/IpX9lU3elffuPRSsEVH/u5OxgCbXuWhYKvZKcNDWU+DC6X0tVrbgk5zP",
+	"hYku3wqe4+7jA2UJWwAvC+wW4qSK4MGh6gV4fPRvAMGxc1QyLu4t9fIZAONLwE+4hdgG5LvaE2Tf/Qpi",
+	"+/ferlZ+gM4ulXYxgbMdXZUBEvc7UyUGI37v3HPA+AWHwOVQmwqWLERyLlJM5ySWuV2PG931rCHZe9Yh",
+	"DaU9o8hczM2DRh1Ih5an3L19uFq3k6QYYa1XNLwR52J9puvUPrtkRWkm6TB9BxUpNRDngVjDY+vGaG++",
+	"czMESHme+1wXGPTsyeKkogvfp/8g0xvjAIc4RhSNJBJ9iOBFBBHYoQ8FeywUxrsS6ceWB886FzgayZLm",
+	"eb+PLa1fq84jMFzN2aL6vhSYQ1FfGjblRqRMu/R/lIgi4GKl4XPR8yQJdaED0z00bHE4yLZ7L3rTgSW/",
+	"eaF17psoyNR4AmuOUoqAL0Aq+Hps+YH6mch0iys4YpjV1yFsmqGYFKiMgenwoqE2VvNNoMUJWBSqFjg8",
+	"GE2MhJLNghufmTAdB2d5kAxwjYlVNqXTeh64MAZZGqtkWZ7nts9p5znvkmr5TFo+fVb4lh+QCms8clET",
+	"se3QCgWgVGRiTgunxi2bwS0TbBDA8dNshur2ScwbkhujE0mPlPqacXMIkI/vMkZWFzZ4hBgZB2CjSwIO",
+	"zF7p8Gyq+S5AKpekhvux0Zkh+FvEQ/QoPgBEHp0DC5eqJxLFcwDuXGir+6vlyI3DMKnGDNjcBc+Esv6J",
+	"XQ/SyeqEYmsrh5NzirnTJ85uMDvSxbLTmrDHXqsJZSYPdFyg2wDxVK8mFGgelXinqynQezRkAnpFDybl",
+	"z7pl4EHuDGUqpcS0Zgss/XB4MGoAMDESrB379d3mBMymaTdLUzEqNOx2JdvU5NInTgyZukeC6SOX20FK",
+	"rL0AaJscq/x57vG79ZHaFE+6l3l9q9VWzCoaLXb8+45QdJd68NfVyFRJrJwK4Y1IdJH26ymAUKWtsvF3",
+	"1QvUbgJ8Y3Caqw2VAU6brw3/hOjuXI8/UAOeep4NiHhKsZQdSJ6tcm2EcbGWeNW7wZ2cWAiKWjekJDRS",
+	"zTMnGPShKbZg743oMU5LrtOH+gGHyc6xze155G+CJc/jcOzyUnnj8LMBip5TXsMBDa4KiUs5thGWD/30",
+	"8bot2kcPSqNVK9Fd8NaK3Q5APl3zcddIbUQm8PU8abw2JudiHVcCCBTN3vpugZYP0+lxtb4TeGsWYi6N",
+	"FbV5T5oa0zdtOOGYxVfrWf/qbF7MYH1vtK7kOexIZpPGMm98BRfaislMFhD2ArbR6BKg0XcGtU/fQdP4",
+	"o6Kx2YwS2ss0fonitBD+l8qsjNOrm/fHpzDtq0p2MOUUBROpmODJgk2xAEPUS3zD1BTns3HBL2jBL/jB",
+	"1jvsNEBTmLgAcmnO8Zmci7bNZQM7iBBgjDi6u9aL0g0XaJC6oMsdgwcGHU68To82mSk6hyn1Y291KvUJ",
+	"FPqEORppw1rQH7LXLT/ihRhGL9W1l6JJBpS2k4byI4KuSsFDIT5SMdXcYDX308TjZjW9qwcN7dpuGVAN",
+	"H09tH84JwZMM8o9sD39AB8RKgYOuKDQC+joxjPPzTjXbpfruDtQIq1bahjFKLR3pZpOlvH4auWzI9dsa",
+	"CRZwR1LmcOsdSGie3mr67pru8nwCiodo/Ow/ggBZnpN52DeOxZLCYBL8N+Lg0Ked/VQPlai7Nc7wZYfp",
+	"rIegAMU5s0cy8P43ZrBLIZr7F9VDlH7GzYwYB69edrV02qG+nmuc57lMVy27J43aqx0/CMbwgnKDbcFA",
+	"QBuxyOxCmMa+B8o8KqbTyCJ6NAgzZ81k46FME04ljS8F10VUlblhqzew4NmPYv0ztMXljD6MR1czk8Zw",
+	"7UbcguvX1fZG8Yz+J2Q2a3g97IhynoM3Ec8mzpjcR5qFvnCkic297fmGpbU41zt7dvrC5dhEe10meDGp",
+	"Xju9q8J2+WezKsqY3nNAfKmpBbeVfo5ew8HmV2meQwP05UK4sj7Bg7pTf6B2LqjH8wbpWdz9eqt52flB",
+	"0BI3+EOIvHKHqE112LnlAcEvuMy8jcxD2+MqjYsbdjdGuUI4wJU9KcK76KDspnO646ejpq4tPCmca0Ph",
+	"oSXV1jJMq7Z/IryCYQYiVXCbnwpnAekyJ1Uu0WowMZlM4vZUNTVAHIr8ZKAxw8Y972kYsZQ9bleqlMFY",
+	"0GxISsEWkMEcUWSaaNbDGndT7Yqilkr+qxRBYtTKGzE4qKg/dZb17nUalyrdwNgnGP4qMkZYOaN94zmZ",
+	"a5OAEXrldMB9Wmn9/EIr6xNXXlrf1bkvnLFzJW5wzHP04aiZIkMWTe+awRL61gKqXv/mSnj0zBEtiCrN",
+	"ZFboP0RcVYUavkhcuJsIhSnsfRQR19ssprLk1HVd69l7t7tPugk+sqZDYg/V484HLjiY59Zbo7mirab6",
+	"hI1AgjjBBC3MMY1fE4yDuRPmlPFLjO+NChkAU2B+adjNrWa+s8e9s9FIV77liAV+Y1VbSQmNclHUKRu6",
+	"yRH3FBho2sGiQi0ZQMeGTED+0zwzOjJMqS65ssIXpaGj5HobQfp76HWpC0xHZuIm/lQkchlVLr1790ua",
+	"dM25qZxLKvJYGhFUEXQDUXVcoiJXiZHc6WrUPJ+xe+OgTqnbjVReSCOnmcAW96kF2LRwbf4sV11geULZ",
+	"hcHmDwY0X5QqLURqF4YQazSrhDp83lSOKj5d7j1sd/8bdhtddIy8EHcAi+5+Hp3c/wYNrPTHvdgF4Kq5",
+	"buImKbIT//6P0zH6KNEYwLjdqEdRbQCV4O5nXBtOE3UdcpawpeN128/Skis+F3Gv0OUWmKgv7ibaAlp4",
+	"UdgoFcYWes2kjc8vLAf+1BPaB+yPwGCJXi6lXTpHDqOXQE91iUCa1A9HxWjpbqrg8h/RHyr37iCtR+TN",
+	"2n3ofoutGr3WXvGlaKJ1zDjloMtk7anoa06x5z7FJZa7qarcEG5gLlg6ijmwhViCQSqLD4vSziZ/Y8mC",
+	"FzwB9nfUB+5k+vWjSImfZgkGtRvgN473QhhRXMRRX/SQvZchXF8IdlSTpQRWf6cOpQ1OZa/jVnRa2+cn",
+	"tHnooUIZjDLpJbeyQW484NRXIjy1YcArkmK1np3oceeV3ThllkWcPHgJO/T3Ny+clLHURSxvdX3cncRR",
+	"CFtIcSHS3k2CMa+4F0U2aBeuAv3HNZ56kTMQy/xZ7n0I7GLxCd4GaPMJPRP3sfY0LT0NmSu2gfhhoAWE",
+	"Kthvs3tcpbZlo/MuULkuA6HrUSI0Io5bGNvtBXx1FUNg8mnsUB+OmkuLUeZjHVmyL4hW2XhcxGREb9V3",
+	"gcAHYFBTN9SYNYsy3bxHjTeLdD074IuHFf9oA/uRmQ0i2a+gZxODwnjR7Uyr74FzGWeP9WroprZ4t9/Y",
+	"TwA1UZSUMkt/rpOxNFc4LbhKFlFnkSl0/K2ukF4tjg5zNHH5gitF3gid4eiV8pt/zUTeW//UQ+dZSjWw",
+	"bTv1Li23tbga8CaYHig/IaBX2gwmCLHazHNRhfVlc50ynKfOkl3f691g8W5pwb7MDFRLYEPpPye10OuW",
+	"WY2K0zC/e8anIjsafm2eBYFAsEiXKtLdJwW6lEAGgjHTblIqKjzVq6hc5EGfFFrbvrig2hsxtlIUTmGb",
+	"MJzBRzBElniz3DVY2ZaQJz0LswWSza1daq1eT9y2gRkNJpTRYJLKuTA92KRvjeIDhCaCpZkZ4ZNE7NbK",
+	"Mi0I6+Qs6ProqzF0czW01Kcg3PdJQGeVQAXUHZhFmzFalUP7LmfFdUU1TJVyYo/nbNRt86znEFXAhVlG",
+	"bn5vzy8mvWCDM6lU4VGpfDvIiFgxCb3aHd/m5hfbk3QH1ro0cyivXIkW4U59rBQ2fX6cEXCrGwL7fKJ8",
+	"pEf83bQePJO6qI81/DBmuqjozj0LxhsJ8CMLz807t3NTxS+TBt8l5lInCHK0sUkgp4KEj8t0LuzfY/HR",
+	"rQbe/qRz2AI2xd+dwINuTSzBYLU0JcHGLqpGwOitaafxcR/hi/ccSbQy5TLmfLDBL7PtkwZgiH1swATQ",
+	"BFcQeVsQuD3rqxMQ+Uh8h5Ae6YCm8uvtnc038KN6ROlZY5I69wtgUyoliupbj+8FNprk3C561Ami8gKn",
+	"8Rr5E73zSLXtqb5UgXXCQRWydZeDlDyOG+hpZ6EPoNyY/TN0Im3sXRe//Qfgp/x1oWcy6z0AVYOgUin+",
+	"SRZt6bLj1fUSaIuQCeEPppwWurRSxYpw65hY+FPjiJkcxYC124tqOpzB/VzPUR2v+ifDpN1ECj1pnOrA",
+	"GSyv2Z6eFZxAUJF2ATzAa3uPJFy6SfQx5fhBqawbO7J49zOGgLQ3oglFfOp4Fa9/8CybJFVdUcL/uFUr",
+	"aFCSR+LSziMjT1zlng3EuIUU3WfGrS3ktKzzshpbbTuCHBIinEriChV+SGEbEEiXMHUsdtLNbyL7Uf1W",
+	"zQoXQ8HV4BjG9nGMxFLmcPR6wWpBQBdN8zfjr6KKRTZuDapfi6z8D4nlp6pEt9NyNnMOFs48i8Acsdd+",
+	"5DLPNE8p/4IPmsXgfqrxoCq0ycJ//whRh/Web9/eoPH1727r8GgM+KYNb4Ldf3reiH+V0We2+0DSInTG",
+	"C4kKKzOhUnrGs+8xPR0ss1HDBc+KXJYZ1QMJlQK052MG44CHMKNZqU8hbFm4ws5zMoo0dExXzMgeRJr3",
+	"RT4fIt8SVVSYVBWWYxl7ocWZb8Bky/cX3R5C7Byxp+RxYrzEQJOwRKuZLEDcqaZzNk/U2MF/rOUJPFus",
+	"bhyefoVk3sdSPdcI2cM43Lhe1kpneBs/7ZpwhhdH9+rL2ueO+/8ndflEPNWAQlcfncqjj5kGE9OlhKIS",
+	"C27FhWjmK654mjvTPn9xE9NFqRQRbVQhs6nAwz4U4IFzCji1AbIWDewo5DvWu2Ot+LfYK3Y+OoXnW67E",
+	"Pvuty9hxxF46t7CEK61kguWGYjYcTPU5zI1/QGWm/nIDLrdG55xHy91XWT0cFnsL4I9HDcT1CDT0FTaV",
+	"qIP+tGLlHpZzYeuLdYzmfpkJ58oolRGuHCYQUeN50rzbkVlveVPuSEaYxa/HN+U7+PbKeS7BEWTnkkQR",
+	"LxdY8udEZ0PISAXUrpi0bK6Fcetp5sA1v0CfI0yjnIrVr0cv9Fwmb+Ucx6DIAlg2hdF0hzr1QTVetNYF",
+	"ewJtqdxM/XMjYRJNeprnbtKoSFHtcOeTXaleBG96zAXIrcYPR9tAbhuj4fBqB0KDBMHMWJEzl0OlSRii",
+	"KGI2ymeUVhgoClswCqSPISUeT/xCKu/8uu9TKNrPJAWogobX2RA8wwCaGEMz1nlPX3Wo1ga7wGN8BtEc",
+	"/dt4toJqZGVm+xhH1aC28HG1Zv5QAHUHcs0T0FB40RXlsaYfj0orec5lYaGU3SQhxhkHMO7JUhjjI6Xa",
+	"WozgGHTFM+oeyCbbr6BAcq4GsAVPxK5XWV9S3B3UX2kJa2NiJZKy0nx4VVCrCskBlF8Hma4QuS7sloUB",
+	"KbR1btVM8HG6JsVX+x1Z61d22Ipa+RrZjlQaboxYTrOI+eVp9VGkFWXDCQMAM6fwGE6RLn5u5yQUPlgu",
+	"rfJL7fJ0aY7UeXjAWZ5ABsnhmMC79OroqKfe74DX/fc94fUIBz3imZ43l3LDFqtN90O4y7Gb4RlcuWGK",
+	"/E7RU7qUqwz2GHGt8btP+ljlXm7yc5/YrTOn2/7IpreA9w2jgF/wrCd1TOBOyUkyIetpXwKZpDffEbcu",
+	"RanlbCMz7E37SKGb+J2giLvN9oVrUrQmfO703rMgGo69EaE+DrgL0I+VITrn0sVF1eymi1ln4+43SW46",
+	"dPUGtxfh8hT12uB+vOjLKeRT7eH3ds2yc+HylueFuJC6dBtWKSr8Y5p+JX+fMHVf7/qjNv6P7Wm40TAM",
+	"FYlomU6b8ePPzvcA3a0+AS/JzqZT4dVNGaWeeJnWKRudWBpVGtqht+1TuqPJp2Op0005CX/8mT317tuD",
+	"7h1PyLGM5jpF/56eVKsvXH1l3wzk9sHTvnSdTvN889Q9SRi7k1PDXafvy+YO53OT6vS1P7/TytzklS+R",
+	"V16QMVCJVcxiB4qTdsK5S8HEKhdYvyvIHdifoHYoQbk8YvjOn2SCG7EBw2FhBNd2IJLPVi+g/bB8li/k",
+	"fGGxzNQP6CzxeksZrbp0FjLPXBtZW3IzGKzhgHc0NKr/DK1jgVN+dyxvaroQidVFI1SwEGKXomAwmXeM",
+	"+VJOq1/FVCU/8PS/oXTWeBTylmguMHe8eJ2FGh3XMaohYumnNhFmX4iq6HsBfv1uCPhhxjMjoraE3njy",
+	"VnLhICYsUrwuvrDn6XZc+uWMgzAjmW5GZDzZxikF5/wpkUmpIw6Lzkgl5ihHoJSF5KTSzFq4p3e5a7RH",
+	"bqG+HB7h6M7nrVOXWax8OQt0FNta0mKb4n571tqzyqWkU7O6myl2U/LWQaBkfDMkGb9uQLanhe9LtBrA",
+	"3k+pSKLPVrks1o/L5Fz00EG7NHVv/W0BQ9HrnirjczVHOkckmaMd8pI3c1HsCQFeQADDHhRAON0QjxDS",
+	"oZ5daa6Mb54q44eaaWshdnfW3TymxvmG009G96ue/34zaLgVrVK7fbXXx6NGRvQfpLG66HlHFyIRnWO7",
+	"oB7OXatNaDuw6dMqgQx55cM9OhcKvQLSVirGwfXGtDKob78Qk6U0RqQTOPRbjxE2YtTDJa+t6jfDN7bk",
+	"qWClCZQZe9AYPWlECu+hXBuebYWL2AMQmE9aWeOK8kyTXy8NKOqSqPvDNghfQ+GCwa6bxST6QgQxTo4+",
+	"0dcS66hj/L3SrnWz1Op6rxQPfdfeuVjfMqxxvp4/3bmYeIuv3fzy3MHxREpwmg2xZxFCcEeJMz/KJp/p",
+	"bQB16801ALyuU4QV+FOZqltXwCLKHFfCoD9DB8bewc/5VdDVK871MvQoN+2wsfYVGStJHyX2CJFFt7SF",
+	"zq337Y9ivVHbELlSg9okgimdio98x4rZTCS4IRtfJP8AvlTXaBh7/6HA+51YlqySeGK90j2urgqgjO8J",
+	"T8YPB87Vbwdn2NinVCVigCKvPOX2OTy6vDTSVJSBWPBJx7aLFH66QMe751yeJJva3g1Twmnbc64ekaSf",
+	"BSHP6Cu18Zpk+0b5+T5r71NhucyMS8HDq3dB6AwCjnHtSvKXrlQmVj2p3I190Uxh/G++xBHNkslzUVdl",
+	"cj7CWKHBtYi6CHnvo6Fxa9iMyTjQs2pmWaeI7KYT7+4xJVdJMg0C92STJqa+qqosMrcM5Z5CFe2lKBxc",
+	"M1EUdRAcjC0mVkcURB04tkZv74eEnvximH4cgOsttvqmriZbPzsJqa0FskIsOUBXBDVf++fchOwn9N3n",
+	"z66C47Y5MlX0uj2DgU8OKk0HiSHVz5i7Lbfn5d7HOYhiDL2HdDvGsBNUmBc6LRO6oMODUfl9DXav2sBK",
+	"ol4xSXeVHQeHDIuNvwiqHJyL9THZnpMFqErq6m0h9GTWoDUEhdFau31Qv6m4g0c2pwXMDwLnx/QcGo9y",
+	"rbNJj5vu824d2/YZOJdQBZ7B3eHT6imdilvN0wKTsNvoHVrFYVwu1r5ua54LJdI7R4ydKkpk6kMywkq6",
+	"ncnVLbtp/hXOmpZUWto5NR29U/GMkFgzqLgif/PDbOZqRqj0ylPRIJsnsqueGrpQlN1gqEMPr3SyxOAg",
+	"iZacEhAVQRGVUsi78FTxbG1ktCIItzJh3DWobEda2UJnbJbpSzYveL5oRGlWgYRYR8UFFfn8zgmOEgYc",
+	"dQWOKfD9bSnn3GRYMVxplmmdG0qfkJSFkRfCh0Ea7UsCrCYYAzV1r1dMtGZ6cgUj7vre5oInCwwIEkXR",
+	"WcvgEHDgb7qvZC/lkPIHH8vkJ+fuHHXDg2XBIGg953ZRJyWoMRGEMHd9e7eD6TEX2RANT72EmyqXAmIY",
+	"LXVrBIfZRaHL+aIZzloXdu8N72b/8Aky/U5L5DeOOMZ1TBvSnvcJK5X3ZyaKIAG3QRLxowqLRF/+SY/x",
+	"/yXltmYLgf4Prn5lco65MpEkjvp8XZLzCQBdwIExfR4WNRU5rQq8N5UQKWXrxNsZyUG15oYshFU60TUe",
+	"NQRHpDvu88aI2rPWRlEcPLkw1AcicYqIQTLA22q4igVFoCpVNfwmrW8sgLsoVYfdMKWJMAlH5ipZGzyj",
+	"Co5Il5BinBefpIFNcZOFydnyyAbuhABBli3e5wNJfSZml7FbBqs9VBzbRPmr6v8aq/LTxdC7Z4nLQUTb",
+	"9diN0CwC0OOw1VDrhRVw6+yfBTl+I4P07tjtLX5Z+3NvfcUgJL7DFvBCD6y6XSVmO3A+cpahlxVSgqX0",
+	"UkJj+ducutwCa4E72CKSY2CZVLifonab+xJ47JknlSNcHM9dfznKR6iwVn7Xz87UrgIh4cBhKi74R0hz",
+	"hXWQTxEfIn0zzDAXIplQafYLf37BB82d8WuYGgJcLoT6B8oC0QgON5Tz6C48kXmZR3FbFjxjmZ4HCSku",
+	"hGKXOCbuNLv/NZu67PN5IRJpZKswx6Uus9RrpVGPKQo5c0YBcKHdrDjdts6ftb0CGc98oiT2qnZFsRof",
+	"PjWE9RH9yEyl5+RGqTxGfR2yiOAvyqO6YtCQl1iYjWnMtMK7Q1/GYjyLudmUE6kSLRsvBRA/XfwgSXm1",
+	"1OkkbBQBe0pjDnrDBbNd0zNun6eLTxfRhHDIowX9oMtCXdsLA4wEF4JRcTBiE/6VFOBS7p6l6mz/3FNt",
+	"CwkQ23iYaIywxI5EWBlxiwR13giPgh1omhIohObAYVJBqPiOYVLdmo9Dl4frQDmsNKK7zsECbAO3Edm1",
+	"XtvQGL+IBqc3NM9Oh4Tm0Q+x7hgbSAiBRkcMQWW/3/+dFQLTWlnN7t7FCe7eHbumvz9ofoYb7u7d6Om4",
+	"sahAwpEbw80bpZhazffsInoHn8Zshs7QxcMc3chXl9qnCu2odEFrGEvsd3hbjWyBtodssklv7rR0Rqs4",
+	"MB0swGAhYErbKHBhQpA+i344WdSU3yIEHCm68y5cqJNPH712eyqHv3GSrnu9YICSc/ONl/jP/BytaCfs",
+	"6HKK3HBmN9Ssbw1hoKW5xtuQHKDML7maKIb7n/vyWlHupp5c+y0uCGn5t7HjRuUEMFQKJYw0WBvgN1fV",
+	"52bR7yEg+u5ekATrTnkE2qwPERNZa2PyYKqgJsKAcgiuW6T4ARJXUhbSrrHYsHdwkL9Fo4a/r3yiXJxb",
+	"VZ7SPcKsPhdVuerag6r2CP5e8wwZCVcpZXGwwGPZsxVf5pnT6rJvb03/Uzz826P03sP7/zn9272v7iXi",
+	"0Vff3LvHv3nE73/z8L548LevHt0T92dffzN9kD549GD66MGjr7/6Jnn46P700dff/Oet0XgkAWQCdORL",
+	"243+Z3KazfXk9PXzyRkAW+OE5xLczj58QOv2TDtWb3mCV4xYcpmNTvxP/49nxUeJXtbD+19HrnLWaGFt",
+	"bk6Ojy8vL4/CLsdzdJmYWF0mi2M/z4dxC+Onr59XWQwp2ht3lLLCeQuLJ4VT/Pbm2dszdvr6+VFNMKOT",
+	"0b2je0f3YXydC8VzOToZPcSf8PQscN+PHbGNTt5/GI+OF4JnduH+WApbyMR/Mpd8PhfFESYyo58uHhz7",
+	"N+3xe+cu8gFGncfCQigfY5D5rgp+KaeZTHwYvJPcXdo+E4bJGHzKl2ZchRw4a65KUfNOHhhmNB5VyHqe",
+	"1rWonteMytdMBjo2o5NfIiHbMzkvCzQR14+1KhkFHSYmDfvvtz+9YrpgTrf2OkhrfuQJ8l+lKNY1wRAU",
+	"o/GoLkcuVLkEruCyxLn86AFXrll6RM/SRaSfGfa5nrj23Ko5EcbVBZDUfBV45b3JN7++/+pvH0YDAEFj",
+	"lRGWWc1+51n2O7uUWeZiPlr1scy48T7JajeMce0JhB3qbRqjQa36GnSv2zQzEf6utBK/922DAyy6DzzL",
+	"oKFWIrYHv45HnhLwED24d89zDiecBtAduwMzGlh83ucB/TBujOJJYo+BuhyGPr2pUqsUPKeD5r5QVlWK",
+	"x3KNjoCRPDrgQpsJYK683PZwnUU/5ikrXEpZXMr9z3YpzxV68gLHZ3SjfRiPvvqM9+a5Ap7DM4Ytg9LI",
+	"3Vvk7+pcgZ3ftUST7XLJIVBr9L2wFS9sV4DioP/7ZUQsks524E+u5qNfP/ReacfB6uHn+q+JTK904eEF",
+	"FozHnj/dcgfeMn2cE8ciHz/3w+3TPEcPt7fV99M8p0J/+NITEq82sZLGmjtH7PuwN3JvrNNJVTDLQrlQ",
+	"bKeol5hizT1IfDnzGrZbJoywjt7IgSHyy+V8rZfzaVMhKFOhrJxJUfQA0yDxjTB1dKFXvR27yRkDj84d",
+	"0l/XlF8lBaC0NTuM4YtibjfsByla8PwG/AcosRCZuOBqSF6LPpv+EC78BXc9uOuTgQJ4K3GorlZ5M3zX",
+	"p/SqronGfXCNXPkzl+he8gzoJFhuK1H086dfJL2/lKRXBRDNSfTK8wPIfsYI/IEiXg4h71EemSGSXqOm",
+	"dN23Fo/Y7RY7uXPETttt9uMZLmJoqwwH7b5Ib9cuveGmbpXbHJF+VIntKoXXK1HDZ5caXLf8MxXR/sLI",
+	"6pXJANLt0tgevLEjaTlOfG08808pYTmkfZGt/tKyVRWkeyXpKowYPXYuHIF16Up6t7ZeTdpKzAo/NThb",
+	"5d/mjvDYFWPhVC8A67EE9aXcsw8+uRchbda48yjsyk/fi/D1+Xj9/Ok20ekzUuIMLlEWuQXie3PdvDRq",
+	"MHhzMwaDYbzp0b1HNwdBuAuvtGXf4S1+zRzyWllanKx2ZWGbONLxVK+2cSXVYku+BD2DQ9vgUb7kNXzA",
+	"VuQocRudYJt50O8csceupWFLV5HM177UPAuKnhRz6gQ8DpDAbvk/T3D8W0fsO10wien7SkMhq9RQKnty",
+	"/8HDR64JxO+iA1+73fTrRyen337rmuWFVFjr34VTd5obW5wsRJZp18HdDd1x4cPJ//zv/x0dHd3ayk71",
+	"6vH6FZWc+lR4avdZF25832595psUe6Ur2petqLsRg/tjvYpyf736cvt8tNsHsP+nuHWmTTJyD9BKPdlI",
+	"9nPAW0iYXe+hsbt3MOyuukyO2Cvt8q6VGS+YLlJBOTEMm5e84MoKkR55SmUzTLCEARlJJoWyTBfMiAKy",
+	"WhiJASZO+weugEvMAV0IcOl208PYTQi2M3phPmUm/5KvglxM0+qattotGTNbLfmKSfK5NsJiDkv46dtv",
+	"2b1x/WrJMhhgUiEmxlyXfDW6QW1fRWyDAi8e69VThx09JLZ8Fc0cGOcXhFbKkM6bVZD/2pz7s5XYidzd",
+	"xh6Ic+5szamtNaH+AH/cojkgwY6yDJgyz7M1q7IP8awWoeIsDmYYqhT4hG0DW1XS0cdnG71fDvGXx/+V",
+	"WEmboHZkG5iBwBy/R1tGyDM65xYjqP9ENtDAIFTopbcIaTYTFtQQsNo2XiO8x+cU7mc8S6kg8Hd0cm98",
+	"7SILblG3cmxYzQmCvoamIguC5tEqJ2J1aX/ylSvhMxifuBVVEfkzl3AX7U10k4g6nxGjmaCBc6/3CRxg",
+	"F3eC8kk9eVfaynSDJvY3an5B8G4I7nC+Z3TC3fFyi/gzOOD7d+KEvdJ1fhB6Hv0p7YnXeW1f94JeaSXI",
+	"cA5iLdHiFxtpJVOgfh6R4hND0eOkzvO1r3xxDMGgW4WMH6DRFkFjyO0Nk32WV/gPDksbbhlY2/bo83q0",
+	"IcwZGlLymWYtyY/4RPko/PQTfLd8DI51MywGD6nnM/STVodlOphrjYj5uCpZ0MeB4pVZB3Mjq4OyKZFi",
+	"qlORaTU3nyYr2kQdcbxEqKSqWRsvTPvXO7tPMI0bJh1Bt0aX2I9Kjhm9pEQcTFIxMucB+eje324OQiuX",
+	"Psu3CkNJPzJ3+erew5ub/q0oLmQi2JlY5rrghczW7O+KX3CZYXbaK3A7Q+mz9ayh6o1WWkZTUjMBZBJm",
+	"q9ufCTb80d5DWpoP25lhkJ5oRz7YKB8VzA0absGL/RngdrtUN01P6PLbqDxTpU6MgAIo2tHr/T9GA/VO",
+	"0AhYJF1+pSJAfZpHxyacP66ejSvPF62g2wl7p+4ys+Bf3X/w24OvvvZ/Pvjq6x7NGczjUlF1dWf1QPCZ",
+	"hhmiQPt0dX2HFckr5J3c9FbutkPjkUxX0TITdXn38Fw4xxzkE7cMy/m6tzpNvqU8fThsXar+5lPWGiun",
+	"i+jjyb9tXHWFlXquHldPXMqr6qq6fylL3xPuEDARILS6Pn2F9c2l6jeIii2yrOqP3fTLsw4LoFvMI69o",
+	"XSgfVYq1H+sFOsEHqFBeammi5eMJjAJahilc80JbneiMvE7KPNeFrU63ORoky4k+g1tDlOsj3ENLaoXI",
+	"M76u4wcSbpMFJtKq+yFc8bxV23sN8P4NBynz4/f1aMEUmG8/tCdWv18sdSr8WvVsRpFdmz4fv6d/+4d5",
+	"j2uNfDeK52ahrdnw6fi9/++EUHxB5hfXPgN+WRy7ugQVWjHX4HprM6vzXgeMN4EfdKeYu7ROd8CLuTC2",
+	"WRoiUImM++u+c6ooIlO64rn1PTjoHqopZBEpFBF12TjTOdWzOK3rkA/Xh6BkN3bydxpksaJlWuFTuPfJ",
+	"glV982FakKjEvYT0yzwLrsMwaTl5v8RgvH/vXh9Y6OY3+kh6Yg/9YAe1xgZSXZJIfuCtwZONpHVYaRGw",
+	"IkXK+D4Vk5EAJweoaDKu6kFWwA08HgeqXxKU6Pf0GlncEKmoChB1WTB9ukmr8w67mK4JG1/ciD5nTfim",
+	"nd1RlmheR9WJ2noPbS1HhME57ozVJcKDS4mruQB/BNEcxfsmVJq0StlP9W39YHRcXal76GWspPQRXKpu",
+	"MezOHRUUXPqBBhlySYW1S/zicCF9PshSTa6opB9vqWMyCAy+mnxSZss2vQ26kjqFsra5TR+MlTYoVGNJ",
+	"SUJ4XQD/C0f9nDlqY4M9U4lv9H4MttBZBlUbuy8d14D8ojcp199Siyuew5YVA8esKxI1M2gTTLD0lzIp",
+	"9ClWPnVc3KyNFctufQDq+luPYOiL43RVhu6sLrWKJd+mU/8SP8Z6k+jU0/kMPvb1bbGMJvwtsJrzDGEo",
+	"V8XvJ2L/vNLZaq22ELkubH1DE/3vd6rMWiXdk7RWSfeYNQT7np+P3zf+dFERA1seO7ZR9zCL0qb6MpgN",
+	"7XSkVRriQh3Usxvu3lSZrlp14QxLhQEy//x8CQI8xM5Y9TWSx7n+2J/K+S/qXTCTKm0RCSp/Erj3TGV3",
+	"LnzIwxcXgz+Pi8Hgfd+JK1NRgm0crTSHlWFe6VTQuM06ILGUPfAcdLUTuqJLpZ+O65T8PVa3a9nSEl6C",
+	"i0aZM6tjVru644QnxGQnpJiOTxgEy2IrX6D/QjCeFYKnkJJLKKan3Tcv4wZ1WVWZdtLCR4WnAK680Ikw",
+	"BlKpBQrDTaD5dnX5pD48IeAIcDULM5rNeHFlYM8vtsJZ1U4z7PaPP5s7HwFeEh43IxbbxNBbxWpI1QP1",
+	"sOk3EVx78pDsqJI4US16KmioWWNFDzC74aR3/9oQdXbx6mhBY768Zor3k1yNgCpQr5nerwptmU/g/u6C",
+	"+IS+nsklSmKKK21EolXaU42MY1XOzWwZGoVrMbCCgBPGODEO3PNEhVqub5xPWorxS4bZpsoNpugH+KKv",
+	"WhiM/HNVK6wzdqKVEcqUpioo5kzRIo2tAerl9s/1SqyqufQsGLuydVvNSiO2jdyHpWB8hyxTq3oZt+4N",
+	"UhX27S4O80pyp9LoorIBRI2ITYC89a0C7IaeZj2ASFMjmghHmhblBEVkjdV5DtzCTkpV9etD01tqfWr/",
+	"XrftEpfTnMOcLNXChH4IDvJLr/fmKsX6uA4OtuTnzlVh7vLudmGGwzhB/+HJJsqHY/kWWoVHYMshbatP",
+	"wuPfOGetw9Gi3yjR9RLBll3oW3BMYfNZak7b/ovXqB1tKqwC8flon6fB8SWXFkyjJIZM+MyKIqIJadXT",
+	"4tL6tBfYj1nt/IIZjuC4jhsHj0iYOw6gvuVLpnkzFZBI1zAEU32ni0Gx680gDi4tK5WVWZC/p3pofHrq",
+	"li9PqC9PqC9PqC9PqC9PqC9PqC9PqC9PqC9PqC9PqKs8oT5WuP/E82sfJ6W0migx51ZeiCoPwBcHmT9V",
+	"eGx10v2TDh+B8ARzybyvmA/ACp7hqmWGN3CuTW9exLNnpy+Y0WWRCJYATFKxPONSMStWtkom20xT7gsn",
+	"UEZqynzOjXj4gL394dSH9i1cCFqz7e1TV4DE2HUm7riMTlWZdO8+KRSg2WV24v4J7JPOuhS8MhPMAEKf",
+	"Yeun4kJkOhcFRQ0xeJB2n8hngmdPHG62vJAbhbBhtN/HjYe5Q9uS514u8mvlhnEMA23VsZ7xzPQXsqbx",
+	"ljyP5X2tmPmH8c5wWm5lwrji2dpI0wL2hElwH9cFSlroPGgs7qmxELmK8W1UBwMalqoA31hA+BjTBPuq",
+	"PZnWOfwfKGSnZSNcf4jNi/6Vbhlh7GOdrluMAEj1GKm2yQLqqEapeLGOhCt3Dn7nPFgNbNmdpq7G48Nh",
+	"wxzcFm3jX69p60598w/jUTwOtHsst53ImDhYCBPldJu4QmycmsA7Q1HM9Kx1rkaxJHbtIM1RBeAQlzY4",
+	"/3472Rvq93Ez/iBEjiXV190n49fTbFkxWWyrtPWs+nN1ofWIjx58ZBtjIOy0TATyP0dxA65jiKeBkeZC",
+	"TRzvmkx1up402P2ocWun0nBjxHK6/eYO7xtXGcJd1nYRWU7jXv841+7TYHF7XL0u3To+lqci83IT/GDK",
+	"aaFLKxVg4fTxc7YUdqHdW+kn9YQ0VZIyLa1ZKk0OzxRm9bijbu/e6vWmrPe82blS2nIrdr3YpV2w333n",
+	"38cITb08ZuRccetSUmgD25KJxOrCILJCXLXXDZCKVZ7pVHjVfQxwmqgBdhXr0A3rb0XX4YXikluMNt/g",
+	"IZ9YTdx13XOXU4aCYTd5dUBwRHeZB4fsui/0vpszBIG5KymuqNr5zhy70+uoJt16h+56eYbH4csF+pe/",
+	"QAOu3pIspXJRz+3L6OgaL9BiXZSq/+58thJJCcCF7OE2mhHQdggKwvBGSMW0nM+xUkrHmAhLEzie1Ooj",
+	"Xam03KGsdTcKosGrIK2r5nRtD9flLkF2idu6YPNCl/kd3A6u1mh1WeZcrb1tGhR8yzIjHFIe6sNyb0qK",
+	"EbvqvA69X/3+2rUIlcxOZGv+Tmhhl9ww2l+RslKlLqakPbFdqeFB8DT02UrVbHpjwCGtN7I6N++QK8Lv",
+	"cjMS0bBcFBO7UnSgmqWUKEUPndyjL4GIf41r4zWVXu5hsN10MzVDONDtUQR8Da+PerIgjUr46zEWuOr7",
+	"mFNJ7r7ogTC3ILU8qAtMZ/imJ0xQEJssvSLLGfe1vRKtjC3KxL5THC1NwcKOul4y3n7Wz/ye+CZxY2fE",
+	"FumGeqc46icr+1OUCc5ExLL8nRCex5pyPhcGGGlIQTMh3inXSipWKmlxLsyqMaHoRThgILwcUcslX7MZ",
+	"lC+ymv0hCs2mpQ3HdEU6KXaf3HJgGqZn7xS3LBPcWPZSAguG4bxqv/JHE/ZSF+cVFuLZ6OZCCSPNJK7h",
+	"+56+YsI3t3yveYf/u851oqabzfTmYZdpL+TPnwLcHBNXZtLY2pOjA/uNWfEh70GUyDC3Djm2tWmL3Qau",
+	"7AnoTu0q43b9nYLrz2pKxsLtfuTQtrZ2ziKdjhbVNDaiZZT1ax30/jsIl2ERJvPFwvknis4L6ABovNp4",
+	"NBW1935H2+bGOvOxry6nnG9ExwQvcYBbJGUh7RpVkDyXv50L+P+voK2icpeknSyLbHQyWlibnxwfY4X4",
+	"hTb2ePRhHH4zrY+/Vkt77xVqeSEvsKbMrx/+/wEA3gOrZ2qJAQA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -36,6 +36,9 @@ type ServerInterface interface {
 	// Append state proof keys to a participation key
 	// (POST /v2/participation/{participation-id})
 	AppendKeys(ctx echo.Context, participationId string) error
+	// Get the recent participation history of a participation key
+	// (GET /v2/participation/{participation-id}/history)
+	GetParticipationHistory(ctx echo.Context, participationId string) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -119,6 +122,24 @@ func (w *ServerInterfaceWrapper) AppendKeys(ctx echo.Context) error {
 	return err
 }
 
+// GetParticipationHistory converts echo context to params.
+func (w *ServerInterfaceWrapper) GetParticipationHistory(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "participation-id" -------------
+	var participationId string
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "participation-id", runtime.ParamLocationPath, ctx.Param("participation-id"), &participationId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter participation-id: %s", err))
+	}
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.GetParticipationHistory(ctx, participationId)
+	return err
+}
+
 // This is a simple interface which specifies echo.Route addition functions which
 // are present on both echo.Echo and echo.Group, since we want to allow using
 // either of them for path registration
@@ -152,181 +173,224 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 	router.DELETE(baseURL+"/v2/participation/:participation-id", wrapper.DeleteParticipationKeyByID, m...)
 	router.GET(baseURL+"/v2/participation/:participation-id", wrapper.GetParticipationKeyByID, m...)
 	router.POST(baseURL+"/v2/participation/:participation-id", wrapper.AppendKeys, m...)
+	router.GET(baseURL+"/v2/participation/:participation-id/history", wrapper.GetParticipationHistory, m...)
 
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+y9e3PcNrYg/lVQfW+VH79mS34kM1bV1P3JVpLR2vG4LCWzey1vgiZPd2PEBjgAKHXH",
-	"q+++hQOABEmQzZYUeWYrf9lq4nFwcHBw3vgyScW6EBy4VpOjL5OCSroGDRL/omkqSq4Tlpm/MlCpZIVm",
-	"gk+O/DeitGR8OZlOmPm1oHo1mU44XUPdxvSfTiT8s2QSssmRliVMJypdwZqagfW2MK2rkTbJUiRuiGM7",
-	"xOnJ5GbgA80yCUp1ofwbz7eE8TQvMyBaUq5oaj4pcs30iugVU8R1JowTwYGIBdGrRmOyYJBnauYX+c8S",
-	"5DZYpZu8f0k3NYiJFDl04Xwj1nPGwUMFFVDVhhAtSAYLbLSimpgZDKy+oRZEAZXpiiyE3AGqBSKEF3i5",
-	"nhx9mijgGUjcrRTYFf53IQF+g0RTuQQ9+TyNLW6hQSaarSNLO3XYl6DKXCuCbXGNS3YFnJheM/JjqTSZ",
-	"A6GcfPz+DXnx4sUrs5A11RoyR2S9q6pnD9dku0+OJhnV4D93aY3mSyEpz5Kq/cfv3+D8Z26BY1tRpSB+",
-	"WI7NF3J60rcA3zFCQoxrWOI+NKjf9IgcivrnOSyEhJF7Yhvf66aE83/VXUmpTleFYFxH9oXgV2I/R3lY",
-	"0H2Ih1UANNoXBlPSDPrpMHn1+cuz6bPDm//4dJz8t/vzmxc3I5f/php3BwaiDdNSSuDpNllKoHhaVpR3",
-	"8fHR0YNaiTLPyIpe4ebTNbJ615eYvpZ1XtG8NHTCUimO86VQhDoyymBBy1wTPzEpeW7YlBnNUTthihRS",
-	"XLEMsqnhvtcrlq5ISpUdAtuRa5bnhgZLBVkfrcVXN3CYbkKUGLhuhQ9c0L8uMup17cAEbJAbJGkuFCRa",
-	"7Lie/I1DeUbCC6W+q9R+lxU5XwHByc0He9ki7rih6TzfEo37mhGqCCX+apoStiBbUZJr3JycXWJ/txqD",
-	"tTUxSMPNadyj5vD2oa+DjAjy5kLkQDkiz5+7Lsr4gi1LCYpcr0Cv3J0nQRWCKyBi/g9Itdn2/3H2t/dE",
-	"SPIjKEWX8IGmlwR4KrL+PXaTxm7wfyhhNnytlgVNL+PXdc7WLALyj3TD1uWa8HI9B2n2y98PWhAJupS8",
-	"DyA74g46W9NNd9JzWfIUN7eetiGoGVJiqsjpdkZOF2RNN385nDpwFKF5TgrgGeNLoje8V0gzc+8GL5Gi",
-	"5NkIGUabDQtuTVVAyhYMMlKNMgCJm2YXPIzvB08tWQXg+EF6walm2QEOh02EZszRNV9IQZcQkMyM/OQ4",
-	"F37V4hJ4xeDIfIufCglXTJSq6tQDI049LF5zoSEpJCxYhMbOHDoM97BtHHtdOwEnFVxTxiEznBeBFhos",
-	"J+qFKZhwWJnpXtFzquDbl30XeP115O4vRHvXB3d81G5jo8Qeyci9aL66AxsXmxr9Ryh/4dyKLRP7c2cj",
-	"2fLcXCULluM18w+zfx4NpUIm0ECEv3gUW3KqSwlHF/yp+Ysk5ExTnlGZmV/W9qcfy1yzM7Y0P+X2p3di",
-	"ydIztuxBZgVrVJvCbmv7jxkvzo71Jqo0vBPisizCBaUNrXS+JacnfZtsx9yXMI8rVTbUKs43XtPYt4fe",
-	"VBvZA2Qv7gpqGl7CVoKBlqYL/GezQHqiC/mb+acoctNbF4sYag0du/sWbQPOZnBcFDlLqUHiR/fZfDVM",
-	"AKyWQOsWB3ihHn0JQCykKEBqZgelRZHkIqV5ojTVONJ/SlhMjib/cVAbVw5sd3UQTP7O9DrDTkYetTJO",
-	"QotijzE+GLlGDTALw6DxE7IJy/ZQImLcbqIhJWZYcA5XlOtZrY80+EF1gD+5mWp8W1HG4rulX/UinNiG",
-	"c1BWvLUNHykSoJ4gWgmiFaXNZS7m1Q+Pj4uixiB+Py4Kiw8UDYGh1AUbprR6gsun9UkK5zk9mZEfwrFR",
-	"zhY835rLwYoa5m5YuFvL3WKV4citoR7xkSK4nULOzNZ4NBgZ/j4oDnWGlciN1LOTVkzjv7q2IZmZ30d1",
-	"/vcgsRC3/cSFWpTDnFVg8JdAc3ncopwu4Thbzowct/vejmzMKHGCuRWtDO6nHXcAjxUKryUtLIDui71L",
-	"GUcNzDaysN6Rm45kdFGYgzMc0BpCdeuztvM8RCFBUmjB8DoX6eVfqVrdw5mf+7G6xw+nISugGUiyomo1",
-	"m8SkjPB41aONOWKmIWrvZB5MNauWeF/L27G0jGoaLM3BGxdLLOqxHzI9kBHd5W/4H5oT89mcbcP67bAz",
-	"co4MTNnj7DwImVHlrYJgZzIN0MQgyNpq78Ro3XtB+aaePL5Po/boO2swcDvkFoE7JDb3fgxei00Mhtdi",
-	"0zkCYgPqPujDjINipIa1GgHfiYNM4P479FEp6baLZBx7DJLNAo3oqvA08PDGN7PUltfjuZC34z4ttsJJ",
-	"bU8m1IwaMN9pC0nYtCwSR4oRm5Rt0BqoduENM4328DGMNbBwpunvgAVlRr0PLDQHum8siHXBcrgH0l9F",
-	"mf6cKnjxnJz99fibZ89/ef7Nt4YkCymWkq7JfKtBkcdONyNKb3N40l0ZakdlruOjf/vSWyGb48bGUaKU",
-	"Kaxp0R3KWjetCGSbEdOui7UmmnHVFYBjDuc5GE5u0U6s4d6AdsKUkbDW83vZjD6EZfUsGXGQZLCTmPZd",
-	"Xj3NNlyi3MryPlRZkFLIiH0Nj5gWqciTK5CKiYir5INrQVwLL94W7d8ttOSaKmLmRtNvyVGgiFCW3vDx",
-	"fN8Ofb7hNW4GOb9db2R1bt4x+9JEvrckKlKATPSGkwzm5bKhCS2kWBNKMuyId/QPoM+2PEWr2n0Qab+a",
-	"tmYcTfxqy9NAZzMblUO2bGzC3XWzNla8fc5O9UhFwDHoeIefUa0/gVzTe5df2hPEYH/jN9ICSzLTELXg",
-	"d2y50oGA+UEKsbh/GGOzxADFD1Y8z02frpD+XmRgFluqe7iM68FqWjd7GlI4nYtSE0q4yAAtKqWKX9M9",
-	"bnn0B6IbU4c3v15ZiXsOhpBSWprVlgVBJ12Hc9QdE5pa6k0QNarHi1G5n2wrO511+eYSaGa0euBEzJ2r",
-	"wDkxcJEUPYzaX3ROSIicpQZchRQpKAVZ4kwUO0Hz7SwT0QN4QsAR4GoWogRZUHlnYC+vdsJ5CdsE/eGK",
-	"PH77s3ryFeDVQtN8B2KxTQy9lcLn/EFdqMdNP0Rw7clDsqMSiOe5Rrs0DCIHDX0o3AsnvfvXhqizi3dH",
-	"yxVI9Mz8rhTvJ7kbAVWg/s70fldoy6InysspOudsjXY7TrlQkAqeqehgOVU62cWWTaOGNmZWEHDCGCfG",
-	"gXuEkndUaetNZDxDI4i9TnAeK6CYKfoB7hVIzcg/e1m0O3Zq7kGuSlUJpqosCiE1ZLE1cNgMzPUeNtVc",
-	"YhGMXUm/WpBSwa6R+7AUjO+QZVdiEUR1ZXR37vbu4tA0be75bRSVDSBqRAwBcuZbBdgNI116AGGqRrQl",
-	"HKZalFOF10wnSouiMNxCJyWv+vWh6cy2PtY/1W27xEV1fW9nAszs2sPkIL+2mLUxTitqVGgcmazppZE9",
-	"UCG2bs8uzOYwJorxFJIhyjfH8sy0Co/AjkPaY4twUZTBbK3D0aLfKNH1EsGOXehbcI9h5AOVmqWsQEnx",
-	"LWzvXXBuTxA115MMNGVGWQ8+WCG6CPsT68duj3k7QXqUDtsFv6PERpaTM4UXRhP4S9iixvLBBkidB2FV",
-	"96AJREY1p5tygoD6sAsjwIRNYENTnW/NNadXsCXXIIGocr5mWtuIt6aioEWRhANE7YMDMzpjuA0u8jsw",
-	"xjp/hkMFy+tuxXRiJaph+M5bYlUDHU6SKoTIR+jeHWREIRjlNyWFMLvOXIClj8LzlNQA0gkx6AmpmOcj",
-	"1UAzroD8L1GSlHIUWEsN1Y0gJLJZvH7NDOYCq+Z0HtIaQ5DDGqwcjl+ePm0v/OlTt+dMkQVc+6hk07CN",
-	"jqdPUQv+IJRuHK57sLSY43Ya4e1oODUXhZPh2jxlt4fOjTxmJz+0Bq+sreZMKeUI1yz/zgygdTI3Y9Ye",
-	"0sg47ySOO8omGgwdWzfuO5p5fh8bTT10DLruxIFTvf7Y51c38lW+vQc+bQciEgoJCk9VqJco+1UswsB1",
-	"d+zUVmlYd003tusvPYLNRy8WdKRMwXPGIVkLDttorhbj8CN+jPW2J7unM/LYvr5tsakBfwus5jxjqPCu",
-	"+MXdDkj5QxVQcg+b3x63ZbULQ/ZRK4W8IJSkOUOdVXClZZnqC05RKg7OcsTx5mX9fj3pjW8SV8wiepMb",
-	"6oJTdLpWsnLUWbCAiBb8PYBXl1S5XILSLflgAXDBXSvGScmZxrnWZr8Su2EFSPR+zWzLNd2SBc1RrfsN",
-	"pCDzUjdvTIwsVtpoXdaEaKYhYnHBqSY5GA30R8bPNzicN8F7muGgr4W8rLAwi56HJXBQTCVxB+EP9ivG",
-	"brjlr1wcB6Z52c/W6GTGr8OPtxoaqUv/+/F/HX06Tv6bJr8dJq/+v4PPX17ePHna+fH5zV/+8n+aP724",
-	"+cuT//rP2E552GNxrw7y0xMnTZ6eoMhQW506sD+YxWHNeBIlstC30qIt8tgIPp6AntRmPbfrF1xvuCGk",
-	"K5qzjOrbkUObxXXOoj0dLappbERLgfRr3fMivgOXIREm02KNt77Guz71eIQ5mkFd0Diel0XJ7VaWypli",
-	"MYDS+zbFYlplEdjs4SOCIeYr6h3z7s/n33w7mdah4dV3o1/br58jlMyyTSwBIINNTL5yBwQPxiNFCrpV",
-	"oOPcA2GPunGtNykcdg1GMFcrVjw8p1CazeMczoelOT1tw0+5jRcz5weNqltnqxGLh4dbS4AMCr2KZRU2",
-	"JAVsVe8mQMvRVUhxBXxK2AxmbT0pW4LyDuUc6AKz29AwKMaE2VbnwBKap4oA6+FCRikjMfpB4dZx65vp",
-	"xF3+6t7lcTdwDK72nJUF1f+tBXn0w3fn5MAxTPXI5qLYoYPsgYj9wQXINlyghpvZXGqbjHPBL/gJLBhn",
-	"5vvRBc+opgdzqliqDkoF8jXNKU9hthTkyMfcnlBNL3hH0uotdxBEO5OinOcsJZehRFyTp01h7Y5wcfGJ",
-	"5ktxcfG54w3qyq9uqih/sRMk10yvRKkTl6OXSLimMouArqocLRzZZtgOzTolbmzLil0OoBs/zvNoUah2",
-	"rkZ3+UWRm+UHZKhcJoLZMqK0kF4WMQKKhQb3971wF4Ok1z7Bs1SgyK9rWnxiXH8myUV5ePgCSCN54Vd3",
-	"5Rua3BbQsFTdKpekbaXChVu9BjZa0qSgS1DR5WugBe4+ystrtInmOcFujaQJHxSGQ9UL8Pjo3wALx94B",
-	"4Li4M9vLF1uILwE/4RZiGyNu1K6G2+5XkEZx6+1qpWJ0dqnUq8Sc7eiqlCFxvzNVDvbSCFne/6PYEmNs",
-	"XLr6HEi6gvQSMsychXWht9NGd+9idIKmZx1M2QxzGwSNaZBo1JsDKYuMOlGc8m07H02B1j7I5yNcwvZc",
-	"1FmU+ySgNfOhVN9BRUoNpEtDrOGxdWO0N9/5sTEHpCh8WhHGl3uyOKrowvfpP8hW5L2HQxwjika+Th8i",
-	"qIwgwhJ/DwpusVAz3p1IP7Y8o2XM7c0XSUj3vJ+4JrXy5FzO4WowDcl+XwOWqxDXisypkduFq7Rgc34C",
-	"LlYquoQeCTm0q47MrGnYYnGQXfde9KYTi/aF1rlvoiDbxolZc5RSwHwxpILKTCvQwM9kTfe4ghnBAkoO",
-	"YfMcxaQqIsMyHSob9m1bEaYPtDgBg+S1wOHBaGIklGxWVPkiEFgrw5/lUTLA75jDNpS5fBr4yIOCGFVe",
-	"sue57XPa0S5d/rJPWvaZyqFqOSLr2Ej4GJYX2w7BUQDKIIelXbht7AmlzqerN8jA8bfFImccSBJzt1Ol",
-	"RMpsFY/6mnFzgJGPnxJiTcBk9AgxMg7ARpcUDkzei/Bs8uU+QHKXD0j92OjMCv6GeOiyDUAzIo8oDAtn",
-	"vCfU0XMA6mI0qvurFSmEwxDGp8SwuSuaGzbnNL56kE4CLYqtrXRZ5xR90ifODljg7cWy15rsVXSb1YQy",
-	"kwc6LtANQDwXm8TmLkQl3vlmbug9GpOHmRSxg2lTlR8pMhcbdLTj1WJjwHbA0g+HByPQ8DdMIb1iv77b",
-	"3AIzNO2wNBWjQoUk48x5Fbn0iRNjpu6RYPrI5XGQfXwrAFrGjrpOn1N+dyqpTfGke5nXt9q0rqrhw51j",
-	"x7/vCEV3qQd/XStMlS/sTAgfIRUy67dTGEJluip82DUvuLKNhm+MzigeKMJ43NQ2vArR3bkef3ADnnqe",
-	"AUSc2GD9DiTfbQphpFsbzG8zux1SrJwoweYoKWuzUowvcycY9KEptmAfjeIxbpdcV2rxA46TnWOb26Pk",
-	"D8FSFHE49tFUPjr8DEDRc8prOFAOvyMkLrt7EJabfvr40BbtowelGVjRrCkQ6Fqx28GQT9eb2fWZKsgB",
-	"teekoW0klzEf98XFJwUomp35boGVDysXUL59EkTrSFgypaH2NhkJ1mP6oe34FAsmCbHoX50u5MKs76MQ",
-	"lTxnK3Jgx8YyH3wFV0JDsmBS6QRdddElmEbfK7Q+fW+axpWKZjyQrR3IsvglitNewjbJWF7G6dXN+/bE",
-	"TPu+kh1UOUfBhHECNF2ROda6jEYJDkxtA0kHF/zOLvgdvbf1jjsNpqmZWBpyac7xb3IuWjfdEDuIEGCM",
-	"OLq71ovSgQs0yI3rcsdAwbCHE6/T2ZCbonOYMj/2zvgqn6HXJ8zZkQbWgqFBvWGZkYAcspSiLCxTr8tc",
-	"R7PYuNBJw/gRQVdl4FGaXtpMjOYG82VlU4mHTVm9etTQru2OAfn48fju4ZwQnORwBfnu8FeKGPcGHIyM",
-	"sCNg6A3BQHIf47Fbqu/uQI2waqVtGKPU0pFuhhy3tWrkCk/VujUSrMGdSxkd7b0zEpqnt5q+u667okgy",
-	"yCGaoPH3IAODFgWmWfvGsWQFMxjjGWzi4NhP01gx6q7xvmRc28KF91UTrTXO+GWHlcPGoKCwNa72r7vW",
-	"r2MGuxSiuX9RPURZOQcGGTEOXml2QRn/NvX1XOO0KFi2afk97ai91vF7wRheUG6wHRgIaCOW+iNBNSvG",
-	"1cY8W7e4UbBlNgoz5826bqFME07FlK+630VUlRq4C1fnQPO3sP3ZtMXlTG6mk7u5SWO4diPuwPWHanuj",
-	"eMYwPOs2a0Q97IlyWhRSXNE8cc7kPtKU4sqRJjb3vucHltbiXO/8u+N3Hxz4N9NJmgOVSaXt9K4K2xX/",
-	"Nquyxel6Doiv6r2iurLPWW042PyqolbogL5egaugHCjUnVKPdXBBcBSdQ3oRjwbe6V52cRB2iQPxEFBU",
-	"4RC1q85GQzQjIOgVZbn3kXloeyJ3cXHj7sYoVwgHuHMkRXgX3Su76Zzu+OmoqWsHTwrnGqjxvLZlzBUR",
-	"vB0uZ7RgdL0hqa4pFmq0HpAuc+LlGr0GicpZGven8rkyxMFtnIxpTLBxjz5tRixZT9gVL1kwlmmmRhi1",
-	"W0AGc0SR6Yt+9uFuLtz7MyVn/yyBsAy4Np8knsrWQUX7qfOsd6/TuFTpBrbe+Hr4u8gYYZHS9o3nZK4h",
-	"ASOMyumAe1JZ/fxCK++T+SEIP9gjuC+csXMlDgTmOfpw1GwTFVbN6JrREvrOt2q8/c1VS+2ZI/r2DFPJ",
-	"QorfIG6qQgtfJC/Ql2VlGNH6G/BZRFxvs5jKk1M/oVPP3rvdfdJN6HFqBiT2UD3ufBCCg/UhvTeacrvV",
-	"9imIRlx7nGDCDJIDO35NMA7mTtZNTq/nNFY80wgZBqbA/dLwm2tBfGePe+ejYa5S7owEcWNVW2Yz5guQ",
-	"dcput/rOLQUGO+1oUaGWDJBqQ5lgamN9ciUiw5T8mnL7ogh6I/Aoud5GwfcGoWshsd6Firv4M0jZOmpc",
-	"urj4lKVdd27Glsy+p1EqCB5scAPZh4gsFblHL2w4XY2a0wU5nAZPwrjdyNgVU2yeA7Z4ZlvMqQJrVPGR",
-	"G76LWR5wvVLY/PmI5quSZxIyvVIWsUqQSqhD9aYKVJmDvgbg5BDbPXtFHmOIjmJX8MRg0d3Pk6Nnr9DB",
-	"av84jF0A7uGcIW6SITvx+n+cjjFGyY5hGLcbdRa1BtjXzvoZ18Bpsl3HnCVs6Xjd7rO0ppwuIR4Vut4B",
-	"k+2Lu4m+gBZeeGaf6lFaii1hOj4/aGr4U0+mmWF/FgySivWa6bUL5FBibeipfo3BTuqHs+/+uEK6Hi7/",
-	"EeOhCh8O0lIiH9bvY++32Koxau09XUMTrVNCbZGTnNWRir68Nzn1NZSwsnBVUNjixsxllo5iDgYuLkgh",
-	"GdeoWJR6kfyZpCsqaWrY36wP3GT+7ctINeVmVU++H+APjncJCuRVHPWyh+y9DOH6ksdc8GRtOEr2pM7s",
-	"DE5lb+BWPESnL05oeOixQpkZJeklt7JBbjTg1HciPD4w4B1JsVrPXvS498oenDJLGScPWpod+unjOydl",
-	"rIWMFUasj7uTOCRoyeAK4/Tjm2TGvONeyHzULtwF+q/rPPUiZyCW+bPcqwjs4/EJdAP0+YSRibfx9jQ9",
-	"PQ2ZK+r2QQ1nnAfEPha4y+9xl2dEGp33gcpz6HHQ9RgRGgmwLYztpwHf3cQQuHwaO9SHo+bSYpT5WkSW",
-	"7GvPVz4elzEZsVv1XSDmg2FQczfUlDTrfD98RI13i3QjO8wXDyv+0Qb2KzMbRLJfQc8mBm8QRLczq74H",
-	"wWWUvBabsZva4t1+Y/8FUBNFScny7Oe6NkjriQdJebqKBovMTcdf6sfoqsXZwxytjLminNtohK5tArWU",
-	"X7w2E9G3/iHGzrNmfGTb9qsTdrmtxdWAN8H0QPkJDXqZzs0EIVabZReqtL58KTKC89RlGOt7vftaSVBT",
-	"/p8lKB27F/GDTS1Ai/rCULEt7Q48QzvGjPxgH5NeAWlUiUP7AVuXua04ZgtsW1dPWeSCZlNixjn/7vgd",
-	"sbPaPvZJJVtSfWmv3cYq+uNz9wm0HYqtvY+MPrNqpbFoo9J0XcRKlJgW574B1kEJvUuoWIfYmZETa9NQ",
-	"XmO2kxh6WDC5hoxU0zmpGmnC/Edrmq7QWNBgqf0kP/4tAE+VKnh/s3pHqyq7iufOwO2eA7CvAUyJMJLD",
-	"NVP2DWG4gmZVlKpEkBMDfJWU5vJkybmllKhUPFTC6jZo98DZKEjvgIpC1kL8ntKLC1Pf82mEM+wVrWPY",
-	"fmeh8/CmrbFRvY/k34ZPKRecpVhFMHY1u/eIx3hnRxRcjGcGuHgbNYkcrujrDlWyhsNi73sPnhE6xHXd",
-	"Q8FXs6mWOuyfGh++XVFNlqCV42yQTf0jJc5CzbgCV0YXn6YO+KSQDY83cshoEEUtJ+9JRpic3WNy+N58",
-	"e+8MUpi1eMk4qp4+R8ImSFobMj6Xqo2+yjRZCsygcIciXNMn02eGxVoy2Hye+edVcQzrMDbLttER3aGO",
-	"fayEi00wbd+YtragXv1zIw/OTnpcFG7S/idsovKA3vBeBEd83lWgV4DcavxwtAFyGwxywvvUEBpcYYgE",
-	"FMSlxvQ859JKgjFCq6UobEFsfHS0jlY0TPQd41A//hu5INLolYAbg+e1p59KJdVWBBzF086B5hgXEWNo",
-	"Sjun2F2Ham2wiyct0omfo38b65doehhH1aAW3CjfVm8OG+oOhIk3+Ni5Q2T3XRmUqpwQ5ZJrmi/NxBiH",
-	"Ydz+LavmBdA9Bl2ZyHbXktqTs89N1FeqZF5mS9AJzbKYPeE1fiX4lWQlSg6wgbSs6jcXBUmxMl+zVGGX",
-	"2txEqeCqXA/M5Rvccbrg6aYINYTPR/kdxsDr+Rb/jRUv7t8ZFx60d4y9jwXKqvS5feTm5kgdqdfQdKLY",
-	"MhmPCbxT7o6OeurbEXrd/14pPRfLJiAPXKBsiMuFexTjb9+ZiyOs39WpyG2vlqq8FoaDCv/gJqqNVWGY",
-	"JlfyWaedOYMH/YYNEP1P803x8uvJawlsvdTer9av3ZfdkvYmY1Ht6idoSgZZUG9Ouo0rs9nnCEXcpt8X",
-	"S2ZDycznTu9xkmFHzsaxBxHqgxS7AL31EdCkoMwFbdTMootZl+7Vby4cOnT1BrcX4ZKoei12b6/6Ep58",
-	"HrDN7Gg9ZnYJrqhSIeGKidKHQ/h4Oa8S2l/dY9JBXnHv+rtxMzjV1zWD9hptz93DGXaZTid/+7ONriTA",
-	"tdz+C5hwO5veeQouVrO48RCcE66i9iY99q48qV6Tu7xK1iIbSph++zM58b6lUfeOJ+RYuSWRueeXosni",
-	"71zxf9/MSJ+jp/3RdTouiuGpezLEu5PbhvtO31dqypzPIavbB39+7QN6oQkhoqsE6cwcNjr+VE4nG/Ya",
-	"CGwKwFq3QWJzf/WMsQTlkhxRW01yoAoGMBxWbXNtRyL5fPPOtB+XbB9/wrC/5GxdZhaZZyEUq59lib1t",
-	"ODLk+ByfJww8ht2xfLzfFaRayEYckwTYp4CumSx4N/eP0rM9hpIqMtvT/0CZ2ekk5C3RREV3vGhdIge9",
-	"auhyjZSqt20izN51ZuaQlDD1Q5gfFjRX8VeqeoNdW5VPgoCVSKHn+MJOsxHVvt1ypkEMBMuGERnPBLDB",
-	"3/9vItPGtd8vOjuvNQ1rFZ3CC0HxEPuozmyPAJIqiholQ9yvJXD3pPIihprdWVGLBaSaXe0odPH3FfCg",
-	"iMLUW4IRlkVQ94JVWTZYUHR/P0cN0FAdikF4gsL+dwanL0f0EraPFGlQQ/SVn6kX7m9TSxIxgLeWETwK",
-	"oWJRitZ15QLHmKooA7Hgo4Jtd6ircvc+rxjIObecy5NkU+IZmPJKxGzfo+YyXfeqBIYJI321MLoPnPVb",
-	"PE7wPTlVPX3sa1GGdkFy2q3Yf+1qWWJZkspb66tagvK/+RpEdpacXUL4ACT6xrGEgmsRNfZ6O3IyICd1",
-	"sr/941xtoBfVzKzO4ejm+0ZqQGP0U5oLowQnfelOzbSJKszrkbLBoSim4MtxCNcCpHsoF2+GXChItPCh",
-	"dUNwDKHCRsDeCgmq990FC1xvNdSPdblXfH/GFsugLvA1XCCRsKYGOhkUZe2fcwjZb+x3n+Dqa3LttGlX",
-	"9JrsrKrqs3eY6iAxpPoFcbfl7sTZ25i3Gef2WX4ViynkBpWh/7WQIitTVwgmOBiVC2B0wbIBVhK1DKfd",
-	"VXaMfDlWA38XlCG4hO2Btb+kK8qXQXm1EHor2ts1BJXLWrt9r5b/uJEzX9oFLO8Fzq9pPZ9OCiHypMfh",
-	"etotNNs+A5csvTRidlnHvfc8sUgeo5+viqi5Xm19YdWiAA7Zkxkhx9xmGvngmuZLR63J+SM9NP8GZ81K",
-	"W/vZGfZnFzyesoFFfeQd+ZsfZpirKTDM745T2UF2lDHd9BS5lfQ68uBoN55udLhL+xHImqgsFDEp5Zal",
-	"ukad765xP0L6wSuIw9pPWMmvjmKW1keE0pL33LSFlx9r18+49xh9hx3ghcaa4EVGz40cOF851PjHCinB",
-	"UnopobH8XfYft8CaLwVbpDBr0izTFiC2YWrNfQmMe+pNZTOL47lrWsOyfYJjzd+uSU6hz9CWYQ0Ix5xL",
-	"eUXzhzerYT3HY8SHe1Y8vtBQ/w2RbFGpbhfv946OmjvQde9vav4BzYB/B7NHUWevG8o5f6qXML2LDEvc",
-	"05zkon4RF4ck1zim9Q4/+5bMXRZdISFlirUSjK/9qyaVuoePfNWvzQ/rl7vW+bPQdyBjpyCIgryvX0jQ",
-	"Au+HGsL6iH5lptJzcqNUHqO+DllE8BfjUWE5mx3XxWXDbWxfnGnFQwoJ9+w+DgLB9nQfdwv1jF2edZGa",
-	"S6dU0F3n6Nu6gdvIRV2vbWzsQxe5Q2X0x4QsxF/HMN0xZsIiBJ+WIQgq+fXZr0TCAt+OFOTpU5zg6dOp",
-	"a/rr8+Znc5yfPo2KcQ8WLWFx5MZw80YpxjnTOqkwsCmY7Cn699Exd3dho/uOYAeIV+fMIfoaDE7t40Yf",
-	"uBQ0ytw7Dfx2aa7xLn4WoMwvuZoohvuf+3IXbHx+T5pM6yyULM92HcpG0lP98i2m9fziEnK/ytu7v1hb",
-	"dpdNuvcP94mRax8ARExkrY3Jg6mCdKYRmUyuWyRvCYkrLSXTW6wT5k2f7JdoTM0PlbfEeYGryjJO7tDi",
-	"EqpKc7VvpVResvlB0BxlAaPPYISiFiKfke82dF3k4JjUXx7N/wQv/vwyO3zx7E/zPx9+c5jCy29eHR7S",
-	"Vy/ps1cvnsHzP3/z8hCeLb59NX+ePX/5fP7y+ctvv3mVvnj5bP7y21d/emTuAAOyBXTiq1JM/ic+UJ0c",
-	"fzhNzg2wNU5owd7C1r6FacjYv7JJU+SCsKYsnxz5n/5/z91mqVjXw/tfJy7pfbLSulBHBwfX19ezsMvB",
-	"Eo2piRZlujrw83Se4Tz+cFqlh9lYKNxRm/ljSAE31ZHCMX77+N3ZOTn+cDqrCWZyNDmcHc6eYS3jAjgt",
-	"2ORo8gJ/wtOzwn0/8EWEj77cTCcHK6A5+sTNH2vQkqX+k7qmyyXImXtu1Px09fzAi3EHX5wh+Wbo20H4",
-	"cs/Bl4a9PdvREwNdDr74IlbDrRtVopyfIegwEoqhZgdzzEAe2xRU0Lh/KajcqYMvqJ70/n7g0jLjH1FN",
-	"tGfgwDul4i0bWPqiNwbWVo+U6nRVFgdf8D9IkwFYNgi6C64NAzuw7/p3f97yNPpjd6DO+3JLiGZaYs4j",
-	"xTfQ48X7J3gK7AE6zZCv6bZj2j5WY03OeDieHx7u9e7uODN32x3evSm6LGFoZTfTycs9AR20ZzXCliPA",
-	"vKYZ8UmvOPezh5v7lKN32/A6Ynk5QvDy4SBoPnjyFrbkvdDke1T4bqaTbx5yJ065EYFoTrBlUAyse0R+",
-	"4pdcXHPf0ggB5XpN5Xb08dF0qdDgKtkVdSJYWFL+M1r4bSJ086gdZ1mH6K0wBEq/Ftl2AGNrtSxcklKN",
-	"tFoWZNwsoSv4dl/fX0EkssT6P72dm4sMJqGUpmUJN3fkCU1x2IBwGrFLoYEVn29b+PJ9AajRMIm2HdyO",
-	"POr98dbg1Ssy5XzNlBfC/+Apf/AUaad/8XDTn4G8YimQc1gXQlLJ8i35iVcp5rfmccdZFo0tax79nTxu",
-	"OtkkqchgCTxxDCyZi2zrC7w2JrgEq/Z1BJmDL82HZqwIOLGhf7G4GfN79VZ+dxHzLTk96Ug4tlub877e",
-	"YtPg9YOjT1+s3mSUglqtaYPY4Yxh4f02b/oc55pDZG8WshS6CoC0i/qDEf3BiO4k3Iw+PGPkm6j2YQu4",
-	"0M6dPfW1WGL14ajugjJGR/mqx/deNr6r/8T0HRujBxkJPtgkhzaa/2ARf7CIu7GIHyByGPHUOqYRIbr9",
-	"9KGxDAPDk7L2c47otvHNy5xKomCsmeMYR3TGjYfgGg+t1EVxZXU6yuunoSMbeL963h8s7w+W9+/D8o53",
-	"M5qmYHJnzegStmtaVPqQWpU6E9eBJwFhsVFVXTuwe1qy9ffBNWU6WQjpMj7wrYBuZw00P3AFpVq/1jUc",
-	"Ol+wMEXwY2Arj/96UNVJjX5sOyFiX50R3jeqvYyh1w55d+Wv+/TZ8F2s5O3Yeu2EOjo4wDDplVD6YHIz",
-	"/dJyUIUfP1d7/KW6DNxe33y++b8BAAD//7a0c3IEzwAA",
+	"H4sIAAAAAAAC/+x9+5PbNtLgv4LS91U58UkzfiW7cdXWd+NHkrnYicueZO8u9mUhsiVhhwK4ADgjxTf/",
+	"+1doACRIAhSlkce7W/nJHhGPRqPR6Ea/Pk4ysS4FB67V5OnHSUklXYMGiX/RLBMV1zOWm79yUJlkpWaC",
+	"T576b0RpyfhyMp0w82tJ9WoynXC6hsnTsP90IuEfFZOQT55qWcF0orIVrKkZWG9L07oeaTNbipkb4swO",
+	"cf5icjPwgea5BKX6UP7Eiy1hPCuqHIiWlCuamU+KXDO9InrFFHGdCeNEcCBiQfSq1ZgsGBS5OvGL/EcF",
+	"chus0k2eXtJNA+JMigL6cD4X6znj4KGCGqh6Q4gWJIcFNlpRTcwMBlbfUAuigMpsRRZC7gDVAhHCC7xa",
+	"T57+OlHAc5C4WxmwK/zvQgL8DjNN5RL05MM0triFBjnTbB1Z2rnDvgRVFVoRbItrXLIr4MT0OiGvK6XJ",
+	"HAjl5O23z8njx4+/MQtZU60hd0SWXFUze7gm233ydJJTDf5zn9ZosRSS8nxWt3/77XOc/51b4NhWVCmI",
+	"H5Yz84Wcv0gtwHeMkBDjGpa4Dy3qNz0ih6L5eQ4LIWHkntjGR92UcP7PuisZ1dmqFIzryL4Q/Ers5ygP",
+	"C7oP8bAagFb70mBKmkF/fTD75sPHh9OHD27+49ez2f91f371+Gbk8p/X4+7AQLRhVkkJPNvOlhIonpYV",
+	"5X18vHX0oFaiKnKyole4+XSNrN71JaavZZ1XtKgMnbBMirNiKRShjoxyWNCq0MRPTCpegFI4mqN2whQp",
+	"pbhiOeRTwji5XrFsRTKq7BDYjlyzojA0WCnIU7QWX93AYboJUWLgOggfuKB/XmQ069qBCdggN5hlhVAw",
+	"02LH9eRvHMpzEl4ozV2l9rusyMUKCE5uPtjLFnHHDU0XxZZo3NecUEUo8VfTlLAF2YqKXOPmFOwS+7vV",
+	"GKytiUEabk7rHjWHN4W+HjIiyJsLUQDliDx/7voo4wu2rCQocr0CvXJ3ngRVCq6AiPnfIdNm2//Xu59+",
+	"JEKS16AUXcIbml0S4JnI03vsJo3d4H9Xwmz4Wi1Lml3Gr+uCrVkE5Nd0w9bVmvBqPQdp9svfD1oQCbqS",
+	"PAWQHXEHna3ppj/phax4hpvbTNsS1AwpMVUWdHtCzhdkTTd/eTB14ChCi4KUwHPGl0RveFJIM3PvBm8m",
+	"RcXzETKMNhsW3JqqhIwtGOSkHmUAEjfNLngY3w+eRrIKwGF8BziMjwOHwyZCM+bomi+kpEsISOaE/Ow4",
+	"F37V4hJ4zeDIfIufSglXTFSq7pSAEaceFq+50DArJSxYhMbeOXQoQolt49jr2gk4meCaMg45YdwCLTRY",
+	"TpSEKZhwWJnpX9FzquDrJ5ObXV9H7v5CdHd9cMdH7TY2mtkjGbkXzVd3YONiU6v/COUvnFux5cz+3NtI",
+	"trwwV8mCFXjN/N3sn0dDpZAJtBDhLx7FlpzqSsLT9/y++YvMyDtNeU5lbn5Z259eV4Vm79jS/FTYn16J",
+	"JcvesWUCmTWsUW0Ku63tP2a8ODvWm6jS8EqIy6oMF5S1tNL5lpy/SG2yHXNfwjyrVdlQq7jYeE1j3x56",
+	"U29kAsgk7kpqGl7CVoKBlmYL/GezQHqiC/m7+acsC9Nbl4sYag0du/sW3wbcm8FZWRYsowaJb91n89Uw",
+	"AbBaAm1anOKF+vRjAGIpRQlSMzsoLctZITJazJSmGkf6TwmLydPJf5w2jyuntrs6DSZ/ZXq9w05GHrUy",
+	"zoyW5R5jvDFyjRpgFoZB4ydkE5btoUTEuN1EQ0rMsOACrijXJ5Np7Ew2B/hXN1ODbyvKWHx39Kskwolt",
+	"OAdlxVvb8J4iAeoJopUgWlHaXBZiXv/wxVlZNhjE72dlafGBoiEwlLpgw5RWX+LyaXOSwnnOX5yQ78Kx",
+	"Uc4W5u1oDk7UMHfDwt1a7harH47cGpoR7ymC22leYm6mNRqUAn0MikOdYSUKI/XspBXT+HvXNiQz8/uo",
+	"zv8aJBbiNk1cphVxmLMKDP4SaC5fdCinTzjuLeeEnHX7HkY2ZpQ4wRxEK4P7accdwGONwmtJSwug+2Lv",
+	"UsZRA7ONLKy35KYjGV0U5uZzSGsI1cFnbed5iEJiPnRheFaI7PJ7qlZHOPNzP1b/+OE0ZAU0B0lWVK1O",
+	"JjEpIzxezWhjjphpiNo7mQdTndRLPNbydiwtp5qeTLrwxsUSi3rsh0wPZER3+Qn/QwtiPpuzTbXXy82b",
+	"BMMjKgILQm5Ueasg2JlMA7PxWpC11d6J0br3gvJ5M3l8n0bt0Uv7YOB2yC0Cd0hsjn4MnolNDIZnYtM7",
+	"AmID6hj0ITb2P0zDWo2A74WDTOD+O/RRKem2j2QcewySzQKN6KrwNPDwxjezNC+vZ3MhD+M+HbbCSfOe",
+	"TKgZNWC+0w6SsGlVzhwpRt6kbIPOQI0Jb5hpdIePYayFhTdSiMXRia8zfmyf8IPjWLSgPANF1iAvCyBa",
+	"MiDAtdyetLfsnaafYMuUpgGmb7Fl7YGOvWViXbICjiGaclpsFdt5Qt9IsZR0feab30wnq+jlZh5DHj8i",
+	"774/++rho98effW12dbS9ibzrQZFvnA6KFF6W8CXfaSgFlgVOj7610/8a2t73Ng4SlQygzUt+0PZV1wr",
+	"6tlmxLTrI7y9Q7jqGsAxTOgCzI1ld4xYA4UB7QVcvRY54MvMETZyQNQvqAalmzemo4nyHmz/HOefc8IJ",
+	"7anO4QoKAy5ZixwIB30t5GWAhneclmol9KfFhIUoo6V5WapfNZWbO4ab6cR/nbHEoL4BYTlwIxmAHI3l",
+	"9vCH4jyF3xo0RDRTVClYz4/CN1IHNG9myYmj/ByOcjSnVpijnAtNNeQ7j+q+Z7SBfRueU7mV1THenUBK",
+	"ISOP4XjFaJGJYnYFUjERsWu+cS2Ia+F10bL7u4WWXFNFzNwGT6TieYskm4mNAWa0kGaHvtjwBjeDYppd",
+	"b2R1bt4x+9JGvqd5RUpjM95wksO8WraeLRZSrM2BwI4oMnwHGuX2C7aGd5quy58Wi+O86wgcKM4VNFuD",
+	"MrMR24rMQV8DcLMGBVml2RVY4V+h+VhBJrj1WdrBOdyst2HQOG8fxB2s+jvQ77Y8u4Mba804GjbVlmfB",
+	"SxXeLZAv92Cwt7rGcKp7KgKOQccr/IyPmS+g0PTognN3ghjsz/2JsMCS3DRE8ewVW650oFZ/GuE+OssO",
+	"Eb8wffpPEz8aMUBTXakjSPXNYA3TMHsasgo6F5UmlHBD5wobx+X9hDMSekGg84YOVQi9su8MczCElNHK",
+	"rNbYhUSMBTcdZzSz1DuzbCE+YWN0t63sdNbRpZBAc/OWCZyIuTOQOiEHF0nRr0L7u9VpG1GxJ4CrlCID",
+	"pcwbtH1Z3Amab2e5sR7AEwKOANezECXIgspbA3t5tRPOS9jO0AtIkS9++EV9+Rng1ULTYgdisU0MvfUz",
+	"F+MJqMdNP0Rw3clDsqMSiOe5RAvUcgrQkELhXjhJ7l8Xot4u3h4tVyDRHv1JKd5PcjsCqkH9xPR+W2ir",
+	"MuHb6l5MjHhmNoxTLpwwFB2soErPdrFl0yhcizIrCDhhjBPjwAmh5BVV2vpQMJ7j069ymm6t55op0gAn",
+	"JXsz8i9eqO+PjdIiV5WqJXxVlaWQLf2nWYNxvEnP9SNs6rnEIhi7ViO0IJWCXSOnsBSM75BlV2IRRHVt",
+	"anRORv3FoUHO3PPbKCpbQDSIGALknW8VYDf070sAwlSDaEs4THUop3YqnE6UFmVpuIWeVbzul0LTO9v6",
+	"TP/ctO0TF9XNvZ0LMLNrD5OD/Npi1np2rqgiDg6yppdG9sDnMevs0YfZHMaZYjyD2RDlo9ZkWoVHYMch",
+	"TTxqOt/xYLbO4ejQb5TokkSwYxdSC068sP7EC8aNBHkJLzclk9tj2ESq7BL0eIW7B8MzHKCveI8w9Adm",
+	"dUWuQQIxrj2GndPYu1fc/FUxro2jW9ce49Y1PYLOJXDNRJlFk6UUVWnPn7lqWMZKK7lfwpYAomTS3qvv",
+	"mdLiKJtlAZkhIKN3DM9HAM7ON5LWLEfDm7gCSSiRlDsXT+QSBpg3IRpvg6xBW0Fkkuijm6FOyIDrzvau",
+	"bB+rLfZ2vreOH+ATr+EHiMJ/RnLQlJmXzuBDDGrrXNcd8zA9dxQh9sHvEWJkOQVTKM/1UG5px3ptXwS+",
+	"3kdQ1COjEmYjLQyg3hcU8raTOWxopostoShhbC1LU9V8zbS2bvjt46xFOQsHiNoBB2Z0Fnrr8ex3YIzL",
+	"wDscKlhejH1bhWcYvouO1tNCh1N0SiGKEU9jPWREIRjlzEVKYXaduagPHxrgKakFpNMxiq0Hl4sc7qkW",
+	"mnEF5P+IimSUoz5ZaagFNiFRCjJ9cQamgjmd21aDIShgDVZNxi/373cXfv++23OmyAKufajU/ft9dNy/",
+	"j49Ub4TSrcN1hKvGHLfzyO2NVk7D4J2K1eUpu92G3MhjdvJNZ3A/KZ4ppRzhmuXfmgF0TuZmzNpDGhnn",
+	"MqU3I1cerCe6btz3t6Io5jS7tG+y/872WhuUIkVRtN/BiVm+QQU+SH+a1+Rm6Bj4/YkDp8fmY8rv0WiC",
+	"xfYIV5YdiEgoJShkMOELirJfxSIMLHQcSG2VhnX/kdl2/S1BE2+9AtPTh534uBYcttFYesbhNX6M9bZM",
+	"LtEZr5tU366C14K/A1Z7njFkelv84m5fiNKu33nDHoNVhY+Be2hwDoKERnDXypvfj66Ck3pnDTULtFSu",
+	"DdrN+GrqmWUD3EooiMiP+FJ8RQtWv8mmuNtemme9IdPanyKyuNuwRi1Kj4F6kfOtxQaSWXN5vLyC45AZ",
+	"XME+RNYFYbdh3o5/G7TYIWrBxMdzhuJjGzlvaqf7IzDg7rgdG18Y1oxv2FCUhJKsYPjCLbjSssr0e07x",
+	"DS2AOuLv518G06+qz32T+DNu5JXVDfWeU0Rh/bIW9dFYQOR4fgvgH1dVtVyC0h11ZQHwnrtWjJOKM7td",
+	"eHhnlmmW5lLfajixLdd0SxYmPFcL8jtIQeaVbgvwGH2ptHmjtQZHMw0Ri/ecalIAVZq8ZsZDxAznDfae",
+	"b3ufJI+FuLfVEjgopmZx58Lv7Ff0b3fLXzlfd/N/19maqMz4TYjmVkMrvcP/++K/npq0DnT2+4PZN//j",
+	"9MPHJzdf3u/9+OjmL3/5/+2fHt/85cv/+s/YTnnYWZ6E/PyFU27PX6AG09ioerDfmX3CBBQvIHEHeNeH",
+	"Dm2RL7jQNQF92RgB3a6/58Y7RwvL86k+jBy6YkbvLNrT0aGa1kZ0npv9WvfUC27BZUiEyXRY48GidJtV",
+	"mcXHo3DxMneBtaYVWVTcbmWlnOEWg8y8S5lYTOtIa5th6SnBMNwV9U697s9HX309mTbhs/X3yXTivn6I",
+	"UDLLN7Eg6Rw2MXXPHRA8GPcUKelWQcJXE2GPes9Z35Nw2DWYdwK1YuXdcwql2TzO4Xzojns22vBzbmNq",
+	"zPlBE+zWWXbE4u7h1hIgh1KvYplXWtI6tmp2E6DjFmOC64BPCTuBk+6zTb4E5f34CqALQ6BWZBRjQhHr",
+	"c2AJzVNFgPVwIaPeRmL0gwqm49btA/0WTPILVEiPcKzrIOX+mQ5dqKa9c4PWkynaBlsyuvkBQ2jsvWNa",
+	"ob8oGM8Am7VlSRlXemxUVLDe3mZY6PcJiMIeNoGJGZbQ/qIQ4U7aUkd/hHADx2DszlkbuP3fWpB73728",
+	"IKfuhlL3ECNu6CCkPfL+bD+0PdQ0oS7Bl9WX3vP3/AUsGGfm+9P3PKeans6pYpk6rRTIZzZ85mQpyFMf",
+	"CPqCavqe90TbZA6+gFpIWc0LlqHZJcIPbF6l/gjv3/9qyOT9+w89Z52+0u6mijJ0O8HMpDESlZ45RWMm",
+	"4ZrKPAK6qhOH4MjYe3DWKXFjtxQZN378kqFlqboJBPrLL8vCLD8gQ+XC482WEaWF9MIfUx4a3N8fhbuJ",
+	"Jb32WYcqBYr8bU3LXxnXH8jsffXgwWMgrYj6vzkZy9DktoSWpeKgBAfddwpcuNWvYaMlnZV0CSq6fA20",
+	"xN1HBWVttsBoFtgtxEkdwYNDNQvw+EhvgIVj76hkXNw728tnAIwvAT/hFmIbI981niCH7lcQ23/wdnXy",
+	"A/R2qdKrmTnb0VUpQ+J+Z+rEYJbfO/ccY/wyh8DlUJsDyVaQXUKO6ZxgXerttNVdLFqSvWcdTNm0ZzYy",
+	"F3PzoFHHpEMrc+p0H8q33SQpCrT2Dw1v4RK2F6JJ7bNPVpR2kg6VOqhIqYE4b4g1PLZujO7mOzdDAykt",
+	"S5/rAoOePVk8renC90kfZKtjHOEQx4iilUQihQgqI4jADikUHLBQM96tSD+2PKPWucDRSJY0z/t9bGmj",
+	"rTqPwHA1F6v6+xowh6K4VmROFeREuPR/NhFFwMUqRZeQUEnCt9CR6R5atjgcZNe9F73pjCW/faH17pso",
+	"yLbxzKw5SilgvhhSQe2x4wfqZ7KmW1zBCcGsvg5h8wLFpODJ2DAdKlvPxnw5BFqcgEHyRuDwYLQxEko2",
+	"K6p8ZsJ8GpzlUTLAJ0ysMpRO6zxwYQyyNNbJsjzP7Z7Tnjrvkmr5TFo+fVaoy49IhTWduKiJ2HYIjgJQ",
+	"DgUs7cJt447N4J4KNsjA8dNigc/ts5g3JFVKZMwqKc014+YAIx/fJ8RaXcjoEWJkHICNLgk4MPlRhGeT",
+	"L/cBkrskNdSPjc4Mwd8QD9Gz8QFG5BGlYeGMJyJRPAegzoW2vr86jtw4DGF8Sgybu6IFcO1V7GaQXlYn",
+	"FFs7OZycU8yXKXF2wOxoL5a91oQ9DlpNKDN5oOMC3QDEc7GZ2UDzqMQ738wNvUdDJkyv6MG0+bPuKaOQ",
+	"O0MZz21iWrUDljQcHowGAEyMZNaO/VK3uQVmaNphaSpGhYp8Ucs2DbmkxIkxUyckmBS5fBGkxDoIgK7J",
+	"sc6f55TfnUpqWzzpX+bNrdZYMetotNjxTx2h6C4l8Nd/kamTWLknhLeQCZmn3ykMoTJdZ+PvPy/YdjPD",
+	"N0anuRqoDHDW1ja8CtHfuYQ/UAueZp4BRLywsZQ9SF5uSqFAuVhLvOrd4E5OlGCj1pV9JFSMLwsnGKTQ",
+	"FFuw90b0GLdLbtKH+gHHyc6xzU0o+UOwlGUcjn00lbcOPwNQJE55A4dpcFtIXMqxQVhu0vTxpivaRw9K",
+	"q1Un0V2ga8VuB0M+ffNx30itoADUnmctbWN2Cdv4IwCgaPbOdwte+TCdHuXbLwNvTQlLpjQ05j2mGkzf",
+	"teGEYhZfIRbp1elSLsz63gpRy3PY0ZpNWsu88xVcCQ2zBZMm7MXYRqNLMI2+Vfj69K1pGlcqWptNbEJ7",
+	"lscvUZzWhP/lrKji9Orm/eGFmfbHWnZQ1RwFE8YJ0GxF5liAIeolPjC1jfMZXPAru+BX9GjrHXcaTFMz",
+	"sTTk0p7jX+RcdG0uA+wgQoAx4ujvWhKlAxdokLqgzx0DBcMeTrxOT4bMFL3DlPuxdzqV+gQKKWHOjjSw",
+	"FvSHTLrlR7wQw+ilpvZSNMkAF3rWevyIoKt+4LEhPowT3t5gvvTTxONmhdWrRw3t2u4YkI8fj+8ezgnB",
+	"s8LkH9kd/oAOiPUDDrqi2BHQ14lgnJ93qtkt1fd3oEFYvdIujFFq6Uk3Q5byRjVy2ZAb3RoJ1uDOSpnj",
+	"rXdGQvP01tB333RXljPz8BCNn/1rECBLS2se9o1jsaRmMGb8N+Lg2E97+6keK1F3Z5zxyw7TWY9BAYpz",
+	"6oBk4GkdM9ilEM3pRSWI0s84zIhx8Fqza6TTHvUlrnFalizfdOyedtTk6/hRMIYXlBtsBwYC2ohFZktQ",
+	"rX0PHvNsMZ1WFtGTUZi5aCcbD2WacCqmfCm4PqLqzA07vYGBFj/A9hfTFpczuZlObmcmjeHajbgD12/q",
+	"7Y3iGf1PrNms5fWwJ8ppabyJaDFzxuQUaUpx5UgTm3vb8x1La3Gud/Hy7JXLsYn2ugKonNXaTnJV2K78",
+	"l1mVzZieOCC+1NSK6vp9zmrDwebXaZ5DA/T1ClxZn0Ch7tUfaJwLmvG8QXoRd7/eaV52fhB2iQP+EFDW",
+	"7hCNqQ47dzwg6BVlhbeReWgTrtK4uHF3Y5QrhAPc2pMivIuOym56pzt+Ohrq2sGTwrkGCg+tbW0tRQTv",
+	"+icaLdjMYEnVuM3PwVlA+syJV2u0GsxUwbK4PZXPlSEObv1kTGOCjRP6tBmxYgm3K16xYCzTbExKwQ6Q",
+	"wRxRZKpo1sMGd3PhiqJWnP2jgiAxau2NGBxUfD91lvX+dRqXKt3A2CcY/jYyRlg5o3vjOZlrSMAIvXJ6",
+	"4L6oX/38QmvrE+VeWt/XuS+csXclDjjmOfpw1GwjQ1Zt75rREvrOAqr+/c2V8EjMES2IytRsIcXvEH+q",
+	"whe+SFy4mwiFKex9EhHXuyymtuQ0dV2b2ZPbnZJugo+k7ZCYoHrc+cAFB/Pcems05XarbX3CViBBnGCC",
+	"FurUjt8QjIO5F+ZU0GuM740KGQamwPzSsptrQXxnj3tno2GufMsJCfzG6rbMJjQqQTYpG/rJEQ8UGOy0",
+	"o0WFRjIwHVsygfWfpoUSkWEqfk25Bl+Uxh4l11uBfb83va6FxHRkKm7izyFj6+jj0vv3v+ZZ35ybsyWz",
+	"RR4rBUEVQTeQrY5rqchVYrTudA1qzhfkwTSoU+p2I2dXTLF5AdjioW1hbFq4Nn+W6y5mecD1SmHzRyOa",
+	"ryqeS8j1SlnEKkFqoQ7Vm9pRxafLfYDtHn5DvkAXHcWu4EuDRXc/T54+/AYNrPaPB7ELwFVzHeImObIT",
+	"r//H6Rh9lOwYhnG7UU+irwG2BHeacQ2cJtt1zFnClo7X7T5La8rpEuJeoesdMNm+uJtoC+jghWOjHJSW",
+	"YkuYjs8Pmhr+lAjtM+zPgkEysV4zvXaOHEqsDT01JQLtpH44W4zW3k01XP4j+kOV3h2ko0Terd3H3m+x",
+	"VaPX2o90DW20Tgm1OegK1ngq+ppT5NynuMRyN3WVG4sbM5dZOoo5ZguxBAPjGhWLSi9mfybZikqaGfZ3",
+	"kgJ3Nv/6SaTET7sEA98P8DvHuwQF8iqOepkgey9DuL4m2JHP1syw+i+bUNrgVCYdt6LT6pSf0PDQY4Uy",
+	"M8osSW5Vi9xowKlvRXh8YMBbkmK9nr3oce+V3TllVjJOHrQyO/Tz21dOylgLGctb3Rx3J3FI0JLBFeTJ",
+	"TTJj3nIvZDFqF24D/ec1nnqRMxDL/FlOKgL7WHwC3QBtPqFn4iHWnralpyVzxTYQP4y0gNgK9rvsHrep",
+	"bdnqvA9UrstI6BKPCK2I4w7G9tOAb//EEJh8WjuUwlF7aTHKfCYiS/YF0Wobj4uYjLxbpS4Q88EwqLkb",
+	"akraRZnu3qPGm0X6nh3mi4cV/+gC+5mZDSLZryCxiUFhvOh25vX3wLmMkmdiM3ZTO7zbb+w/AWqiKKlY",
+	"kf/SJGNpr3AuKc9WUWeRuen4W1MhvV6cPczRxOUryrn1RugNZ7WU37w2E9G3/i7GzrNmfGTbbupdu9zO",
+	"4hrA22B6oPyEBr1MF2aCEKvtPBd1WF+xFDnBeZos2c293g8W75cWTGVmsLUEBkr/OanFardEC3w4DfO7",
+	"F3QOxcn4a/MiCAQyi3SpIt19ItGlxGQgmBLhJrVFhediE5WLPOgzKYROxQU13oixlaJwarYJwxl8BENk",
+	"iXfLXYOV7Qh5EoswW6C1uXVLrTXrids2MKPBzGY0mOVsCSqBTfutVXzAosnC0s6M8E+J2J2VZToQNslZ",
+	"0PXRV2Po52roPJ8a4T4lAV3UApWh7sAs2o7Rqh3a9zkrris+w9QpJw5QZ6NumxeJQ1QDF2YZufu9vbya",
+	"JcE2zqSMh0el9u2wRsSaSYjN/vhWd7/YRNIds9a1WpryyrVoEe7U50phk/LjjIBb3xDY55+UjyTE36H1",
+	"4JkUsjnW5ocpEbKmO6cWTAcJ8DMLz+07t3dTxS+TFt+1zKVJEORoY0ggtwUJn1X5EvTPsfjoTgNvfxKl",
+	"2QIyx9+dwINuTSTDYLU8t4KNXtWNDKPXqpvGx300X7znSCa4qtYx54MBv8yuT5oBAw6xAVuAZriCiG5h",
+	"wU2sr0lA5CPxHUIS0oGdyq83OZtv4Ef1iBKL1iRN7heDTcY5yPpbwvcCG81KqleJ5wSovcDteK38id55",
+	"pN72XFzzwDrhoArZustBaj2OW+jpZqEPoBzM/hk6kbb2ro/f9AH4qXwjxYIVyQNQNwgqleKf1qLNXHa8",
+	"pl6C3SJkQviDquZSVJrxWBFuERMLf2odMVWiGLB1e1FPhzO4n5s56uPV/KQI00OkkEjj1ATOYHnN7vRE",
+	"UgsCj7QL4DG8NnkkzaWbRZUpxw8qrt3YkcW7nzEEpLsRbSjiU8ereP2VFsUsq+uKWvxPO7WCRiV5tFza",
+	"eWSUmavcM0CMO0jRfSZUa8nmVZOXVel62xHkkBDNqbRcocaPfbANCKRPmCIWO+nmV5H9qH+rZzUXg6R8",
+	"dAxj9zhGYilLc/SSYHUgsBdN+zflr6KaRbZuDVu/Fln57wzLT9WJbufVYuEcLJx5FoE5IW/8yFVZCJrb",
+	"/As+aBaD+22NB16jjUn//TNEHTZ7vnt7g8affnc7h0dgwLfd8DbY6dPzFv5RRdVs98FKi6YzXki2sDIB",
+	"nls1nnyH6enMMls1XPCssHVV2Hog4aOA3fMpMeMYD2FiZ7V9JOhKusLOS2sUab0x3TIjexBpnop8Pka+",
+	"JVtRYVZXWI5l7DUtLnwDwjq+v+j2EGLnhLywHifKSwx2EpIJvmDSiDv1dM7miS925j9a08yoLVq0Dk/6",
+	"QbJMsVTPNUL2MA03Lsla7RnexU/7JpzxxdH982Xjc0f9/7OmfCKeaoNCVx/dlkefEmFMTNfMFJVYUQ1X",
+	"0M5XXPM0d6Z9/uI2pmXFuSXa6IPMUIGHQyjAA+ce4PgAZB0a2FPId6x3z1rx77BX7Hz0Cs93XIl99luX",
+	"seOEvHZuYRnlgrMMyw3FbDiY6nOcG/+IykzpcgMut0bvnEfL3ddZPRwWkwXwp5MW4hICjf1qNtVSh/1T",
+	"w8YplkvQzcU6RXM/K8C5MjKuwJXDNETUUk/adzsy6x065Z5khFn8Er4p35pvPzrPJXMEySWzooiXC7T1",
+	"50RnQ5ORylA7J0yTpQDl1tPOgat+NX1OMI1yDpsPJ6/EkmXv2BLHsJEFZtk2jKY/1JkPqvGitZDkuWlr",
+	"y800P7cSJtlJz8rSTRoVKeod7n3SG55E8JAyFyC3Hj8cbYDcBqPh8Go3hGYSBBOloSQuh0qbMEDKmI3y",
+	"pU0rbCgKWxAbSB9DSjye+BXj3vn1UFUo2k9l0jwFja+zAbTAAJoYQ1PaeU/fdqjOBrvAY1SD7BzpbbzY",
+	"mGpkVaFTjKNu0Fj4KN8SfygMdQdyzXPzQuFFV5TH2n48PK/lOZeFxabsthJinHEYxj1bg1I+Uqr7ihEc",
+	"g754ZrsHssnuKyiQnOsBtKQZ7HuVpZLi7vH8lVdmbQQ2kFX1y4d/CupUITnC49dRppNQCql3LMyQQvfN",
+	"rZ7JfJxv7cNXV49s3lf22Irm8TWyHTlTVClYz4uI+eVF/RHymrLNCTMAFu7BYzxFuvi5vZNQ+GC5vM4v",
+	"tY/q0h6pp3iYszwzGSTHYwLv0tujo5n6sAPe9D/0hDcjHPWIF2LZXsodW6yG7odwl2M3w0tz5YYp8ntF",
+	"T+2lXGewx4hrgd990sc693Kbn/vEbr053fZHNr0DvG8YBfyKFonUMYE7JbWSibWephLIZMl8R1S7FKWa",
+	"kkFmmEz7aEM38buFIu42mwrXtNGa5nOv94EF0XDsQYT6OOA+QD/UhuiSMhcX1bCbPmadjTttkhw6dM0G",
+	"dxfh8hQlbXA/XKVyCvlUe/i9W7PsElze8lLCFROV27D6ocIr0/ZX6+8Tpu5Lrj9q4//cnoaDhmFTkcgu",
+	"071m/PCL8z1Ad6t/Ai/J3qbbwqtDGaWee5nWPTY6sTT6aKjH3rYv7B1tfTrWIh/KSfjDL+SFd98ede94",
+	"Qo5lNBc5+vckUq2+cvWVfTMjt4+e9rXrdFaWw1MnkjD2J7cN950+lc3dnM+hp9M3/vzOa3OTf3yJaHlB",
+	"xkAOm5jFzjycdBPOXQOBTQlYvyvIHZhOUDuWoFweMdTzZwVQBQMYDgsjuLYjkXyxeWXaj8tn+YotVxrL",
+	"TH2PzhJvdpTRakpnIfMshWKNJbcwg7Uc8E7GRvVfoHUscMrvj+VNTVeQaSFboYISYJ+iYGYy7xjzRzmt",
+	"9BNTnfzA0/9A6azpJOQt0Vxg7njRJgs1Oq5jVEPE0m/bRJi9hLrouzR+/W4I88OCFgqitoRkPHknuXAQ",
+	"ExYpXhdf2Hm+G5d+OdMgzIjlw4iMJ9s4s8E5/5bItKkjjovOSCXmKEewKQutk0o7a+GB3uWu0QG5hVI5",
+	"PMLRnc9bry4zbHw5C3QU21nSYtfD/e6stRe1S0mvZnU/U+xQ8tZRoBR0GJKCfmpAdqeFTyVaDWBPUyqS",
+	"6MtNyeT2WZVdQoIOuqWpk/W3wQxltXtbGZ/yJdI5Ikmd7JGXvJ2L4kAI8AIyMBxAARanA/EIIR2Kxa3m",
+	"KujwVAU91kw7C7G7s+7mUQ3OB06/Nbrf9vynzaDhVnRK7aZqr08nrYzo3zOlhUzo0RIy6B3ble3h3LW6",
+	"hLYHmz6rE8hYr3xzjy6Bo1dA3knFOLremOAK39uvYLZmSkE+M4d+5zHCRsT2cMlr6/rN5htZ0xxIpYLH",
+	"jANozKo0kBt9qBSKFjvhsuzBEJhPWtngyuaZtn69dkBoSqIeDtsofI2Fywz2qVlMJq4giHFy9Im+llhH",
+	"HePvuXCt26VWtweleEhde5ewvadI63ydv9i7mHiHr9398tzB8URq4VQDsWcRQnBHiRI/ypDP9C6A+vXm",
+	"WgB+qlOEFfhzlvN7t8Aiyhy3wqA/Q0fG3tHP+W3QlRTnkgw9yk17bKx7RcZK0keJPUJk0S3toHPnffsD",
+	"bAdfGyJXalCbBAgXOXzmOxYWC8hwQwY1kr8avtTUaJh6/6HA+92yLFYn8cR6pQdcXTVABT0QnoIeD5zb",
+	"3w7OsHFIqUrEgI288pSbcnh0eWmYqikDseCTju0WKfx0wRvvgXN5kmy/9g5MaU7bgXMlRJI0C0KekSq1",
+	"8cbK9q3y8ylr7wvQlBXKpeChtV4QOoMYx7huJflrVyoTq57U7sa+aCYo/5svcWRnKdglNFWZnI8wVmhw",
+	"LaIuQt77aGzcGjYjLA70op6ZNSki++nE+3tsk6tkhTAC92zoJaa5quosMveUzT2FT7TXIB1cC5CyCYIz",
+	"Y8NMi8gDUQ+OndHbhyEhkV8M048b4JLFVt821WQbtdMitbNAImFNDXQyqPmannMI2c/td58/uw6O2+XI",
+	"VNPr7gwGPjkoUz0khlS/IO623J2X+xDnIBtj6D2kuzGGvaDCUoq8yuwFHR6M2u9rtHvVACuJesVk/VX2",
+	"HBwKLDb+KqhycAnbU2t7zlbmqaSp3hZCb80adg1BYbTObh/Vbyru4FEs7QKWR4Hzc3oOTSelEMUs4aZ7",
+	"3q9j2z0Dl8xUgSfm7vBp9bjI4V77tJhJyBfoHVrHYVyvtr5ua1kCh/zLE0LOuE1k6kMywkq6vcn5PT00",
+	"/wZnzStbWto5NZ285/GMkFgzSN6Sv/lhhrmaAp7feio7yPBEepOooWuKsisMdUjwSidLjA6S6MgpAVFZ",
+	"KKJSivUuPOO02CoWrQhCNcsIdQ1q25HgWoqCLApxTZaSlqtWlGYdSIh1VFxQkc/vnOEoYcBRX+CYG76/",
+	"K+WcmwwrhnNBCiFKZdMnZJVU7Ap8GKQSviTAZoYxUHOnvWKiNZXIFYy4S+nmQLMVBgSBlL21jA4BN/xN",
+	"pEr22hxS/uBjmfzs0p2jfngwk8QErZdUr5qkBA0mghDmvm/vbjA95iIbIoyql1FV51JADKOlbovgEL2S",
+	"olqu2uGsTWH3ZHg3+atPkOl3miG/ccQxbWLakPa8T1jFvT+zpQgr4LZIIn5UzSLRl3+WMP6/trmtyQrQ",
+	"/8HVr8wuMVcmksRJytclu5wZoKU5MCrlYdFQkXtVMfomB8httk68nZEceGduk4WwTie6xaOG4EC+5z4P",
+	"RtRedDbKxsFbF4bmQGTuIWKUDPCuHq5mQRGoKl4PP/TqGwvglhXvsRvChSVMiyN1m6wNnlEFR6RPSDHO",
+	"iyppYFMcsjA5W561gTshAKxli6Z8IG2fmdpn7I7B6oAnjl2i/G3f/1qr8tPF0HtgictRRNv32I3QLAKQ",
+	"cNhqPeuFFXCb7J/SOn4jg/Tu2N0tft34c+/UYhAS32EHeKEHVtOuFrMdOJ85y9DrGinBUpKU0Fr+Lqcu",
+	"t8BG4A62yMoxZpm2cL+N2m3vS+Cxp57XjnBxPPf95Ww+Qo618vt+dqpxFQgJxxwmeUU/Q5orrIN8hviA",
+	"/O04w1yIZItKdVj48ys6au6CfoKpTYDLFfC/oiwQjeBwQzmPbumJzMs8nOpK0oIUYhkkpLgCTq5xTNxp",
+	"8vBrMnfZ50sJGVOsU5jjWlRF7l+l8R0TJFs4o4BxoR1+ON21zl+EvgUZL3yiJPJj44qiBSo+DYTNEf3M",
+	"TCVxcqNUHqO+HllE8BflUX0xaIwmFmZjmhLB8e4Q17EYT7lUQzmRatGypSkY8dPFD1opr5E6nYSNImCi",
+	"NOYoHS6Y7ROpcYeoLj5dRBvCMUoL+kFXkn8yDcMYCa6A2OJglk14LSnAJds/S9XF4bmnuhYSQ2zTcaIx",
+	"whI7EmFlxB0S1GUrPMrsQNuUYENojhwmFYSK7xkm1a/5OHZ5uA6UwyoF/XWOFmBbuI3Irs3axsb4RV5w",
+	"kqF5ej4mNM/+EOuOsYEWIabRCUFQyd8e/o1IwLRWWpD793GC+/enrunfHrU/mxvu/v3o6bizqECLIzeG",
+	"mzdKMc0z38ur6B18FrMZOkMXDXN0I19dC58qtPeka14NY4n9jm+rYR3QDpBNht7N3SudEjwOTA8LZrAQ",
+	"MC50FLgwIUjKoh9OFjXldwgBR4ruvAsX6uXTR6/dROXwt07SddoLBig5N994if/Cz9GJdsKOLqfIHWd2",
+	"w5f1nSEMdmmu8S4kByjzS64niuH+l1ReK5u7KZFrv8MFTVr+Xey4VTnBGCqBg2IKawP85qr63C36PQSW",
+	"vvsXpIV1rzwCXdaHiImstTV5MFVQE2FEOQTXLVL8AIkrqyTTWyw27B0c2G/RqOHvap8oF+dWl6d0SpgW",
+	"l1CXq248qBqP4O8ELZCRUJ7bLA7a8FjyckPXZeFedclf7s3/BI///CR/8Pjhn+Z/fvDVgwyefPXNgwf0",
+	"myf04TePH8KjP3/15AE8XHz9zfxR/ujJo/mTR0++/uqb7PGTh/MnX3/zp3uT6YQZkC2gE1/abvK/Z2fF",
+	"UszO3pzPLgywDU5oyYzb2c0NWrcXwrF6TTO8YmBNWTF56n/6n54Vn2Ri3Qzvf524ylmTldalenp6en19",
+	"fRJ2OV2iy8RMiypbnfp5bqYdjJ+9Oa+zGNpob9xRmxXOW1g8KZzht7cv312QszfnJw3BTJ5OHpw8OHlo",
+	"xhclcFqyydPJY/wJT88K9/3UEdvk6ceb6eR0BbTQK/fHGrRkmf+krulyCfIEE5nZn64enXqd9vSjcxe5",
+	"Gfp2Gghr5ufmrxnLd/TEUN7Tj74S7nDrVqlZJxkEHUZCMdTsdC42ezQFFTROLwVfutTpRxQlkr+futou",
+	"8Y/4ZmbPwKl3PYu3bGHpo7mDbw7oIcEUcm26NHnMm3446iCxDPQavw04SFWefmxGC6awaW36yM3hai1y",
+	"8GsVi4WNbBz6fPrR/pse5iOuNfJdcVqqldBq4NPpR//fmUXxFcgAJJtI4NQZA2q04gW/3dlMizLVxltL",
+	"2h+lKApje+2jzjXA0oX9idWWZ9Ef+wO1fHsNY1pCNPMrpvKkpHBx6P1wrsl0UnPK8xwvMN31MzaNfF4Z",
+	"5IKPHjzwrN9pFwGZnTqON1F1bf5xXkudWSMiQZ/3D63sZjp5siegg1acVgaeCDDPaE58El6c++HdzX3O",
+	"0VnZXGrEXtoIwZO7g6C1feQH2JIfhSbfovp3M518dZc7cc41SE4Lgi2D0tH9I/Izv+TGD8K1RJP2ek3l",
+	"dvTx0dQ8lf46KSW7ok7Wrpvx5eQDKp72PbF91M7yvEf0VuoFpZ+JfDuAMVcSpI20Ruhn3Cyhr+HcTCPa",
+	"Z29ZxLqzerclLnKYhOK4lhXc3JIntPUeA8J5RDlGs6KRjL3BowXqGFXZjdxX2HaR8PmL4DFzzZTXtv7g",
+	"KX/wFGmnf3x3078DecUyIBewLoWkkhVb8jOv80wfzOPO8jwaKtQ++jt5nHl5y0QOS+Azx8Bmc5FvXY7g",
+	"SWuCS7D6fU+QOf3Y+tPJ+hObxSIWBmF+J5QsMXV9fxHzLTl/0ZNwbLcu5322xaa1um7W+9EqyEb7a/TX",
+	"Log9zjgN9rzLmz7EueYQ2ZuFLIWuc3nYRf3BiP5gRLcSbkYfnjHyTVT7sAUlaO/OnvoSA7Fq4jSS3GOM",
+	"jvJZj+9RNr6v/8T0HRtyZbICNx9iGRT+YBF/sIjbsojvIJpphy+EYxoRottPHxrLMDDaJG95sGEuGi3q",
+	"5lVBJVEw9pnjDEd0jxt3wTXuWqmL4irPfVzNhll/xMgGHlfP+4Pl/cHy/nVY3tluRtMWTG6tGV3Cdk3L",
+	"0frQ6arJs3SY1IVZNzFLEeb89FkpIs8rsfwcaxNS4CoS6Cazk01i0QQSCefjZnNanOyU4Hz2qH8fCc6v",
+	"KMGeD8iI9Qd3+4O73V6g0wcS3xjBzvEwtaq0qYjbmKuQn9p4iL4ty3ysVPfv02vKtHHicklI6EKD7HfW",
+	"QItTVxmr82tTUqH3BetEBD8GZtr4r6dwBVynPiLPSn7smtNjX51x2Ddq/GVC/xNkiLXnya8fDDNTIK88",
+	"r2zcKZ6enmJY/0oofTq5mX7suFqEHz/UO/ux5rBuh28+3Pz3AIqPsQuoEAEA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
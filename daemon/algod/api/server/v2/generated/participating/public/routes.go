@@ -27,6 +27,9 @@ type ServerInterface interface {
 	// Broadcasts a raw transaction to the network.
 	// (POST /v2/transactions)
 	RawTransaction(ctx echo.Context) error
+	// Wait for pending transactions to be committed or removed from the pool.
+	// (GET /v2/transactions/events)
+	WaitForTransactionEvents(ctx echo.Context, params WaitForTransactionEventsParams) error
 	// Get a list of unconfirmed transactions currently in the transaction pool.
 	// (GET /v2/transactions/pending)
 	GetPendingTransactions(ctx echo.Context, params GetPendingTransactionsParams) error
@@ -85,6 +88,40 @@ func (w *ServerInterfaceWrapper) RawTransaction(ctx echo.Context) error {
 	return err
 }
 
+// WaitForTransactionEvents converts echo context to params.
+func (w *ServerInterfaceWrapper) WaitForTransactionEvents(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(Api_keyScopes, []string{""})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params WaitForTransactionEventsParams
+	// ------------- Optional query parameter "txid" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "txid", ctx.QueryParams(), &params.Txid)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter txid: %s", err))
+	}
+
+	// ------------- Optional query parameter "address" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "address", ctx.QueryParams(), &params.Address)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter address: %s", err))
+	}
+
+	// ------------- Optional query parameter "timeout" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "timeout", ctx.QueryParams(), &params.Timeout)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter timeout: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshalled arguments
+	err = w.Handler.WaitForTransactionEvents(ctx, params)
+	return err
+}
+
 // GetPendingTransactions converts echo context to params.
 func (w *ServerInterfaceWrapper) GetPendingTransactions(ctx echo.Context) error {
 	var err error
@@ -169,6 +206,7 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 
 	router.GET(baseURL+"/v2/accounts/:address/transactions/pending", wrapper.GetPendingTransactionsByAddress, m...)
 	router.POST(baseURL+"/v2/transactions", wrapper.RawTransaction, m...)
+	router.GET(baseURL+"/v2/transactions/events", wrapper.WaitForTransactionEvents, m...)
 	router.GET(baseURL+"/v2/transactions/pending", wrapper.GetPendingTransactions, m...)
 	router.GET(baseURL+"/v2/transactions/pending/:txid", wrapper.PendingTransactionInformation, m...)
 
@@ -177,183 +215,230 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9/XPbOJLov4Knu6okPlF2vmY3rpq65yQzs75JMqnYO7t3cd4sRLYkrCmAC4C2NHn5",
-	"31+hAZAgCVKU7Ul29uWnxCI+Go1Go9GfHyepWBeCA9dqcvxxUlBJ16BB4l80TUXJdcIy81cGKpWs0Ezw",
-	"ybH/RpSWjC8n0wkzvxZUrybTCadrqNuY/tOJhH+UTEI2OdayhOlEpStYUzOw3hamdTXSJlmKxA1xYoc4",
-	"fTn5NPCBZpkEpbpQ/sTzLWE8zcsMiJaUK5qaT4pcM70iesUUcZ0J40RwIGJB9KrRmCwY5Jma+UX+owS5",
-	"DVbpJu9f0qcaxESKHLpwvhDrOePgoYIKqGpDiBYkgwU2WlFNzAwGVt9QC6KAynRFFkLuANUCEcILvFxP",
-	"jt9PFPAMJO5WCuwK/7uQAL9Coqlcgp58mMYWt9AgE83WkaWdOuxLUGWuFcG2uMYluwJOTK8ZeV0qTeZA",
-	"KCfvvn9BHj9+/MwsZE21hswRWe+q6tnDNdnuk+NJRjX4z11ao/lSSMqzpGr/7vsXOP+ZW+DYVlQpiB+W",
-	"E/OFnL7sW4DvGCEhxjUscR8a1G96RA5F/fMcFkLCyD2xje90U8L5v+iupFSnq0IwriP7QvArsZ+jPCzo",
-	"PsTDKgAa7QuDKWkGfX+UPPvw8eH04dGnf3t/kvyP+/Pp408jl/+iGncHBqIN01JK4Ok2WUqgeFpWlHfx",
-	"8c7Rg1qJMs/Iil7h5tM1snrXl5i+lnVe0bw0dMJSKU7ypVCEOjLKYEHLXBM/MSl5btiUGc1RO2GKFFJc",
-	"sQyyqeG+1yuWrkhKlR0C25FrlueGBksFWR+txVc3cJg+hSgxcN0IH7igf15k1OvagQnYIDdI0lwoSLTY",
-	"cT35G4fyjIQXSn1Xqf0uK3K+AoKTmw/2skXccUPTeb4lGvc1I1QRSvzVNCVsQbaiJNe4OTm7xP5uNQZr",
-	"a2KQhpvTuEfN4e1DXwcZEeTNhciBckSeP3ddlPEFW5YSFLlegV65O0+CKgRXQMT875Bqs+3/dfbTGyIk",
-	"eQ1K0SW8peklAZ6KrH+P3aSxG/zvSpgNX6tlQdPL+HWdszWLgPyabti6XBNerucgzX75+0ELIkGXkvcB",
-	"ZEfcQWdruulOei5LnuLm1tM2BDVDSkwVOd3OyOmCrOnm26OpA0cRmuekAJ4xviR6w3uFNDP3bvASKUqe",
-	"jZBhtNmw4NZUBaRswSAj1SgDkLhpdsHD+H7w1JJVAI4fpBecapYd4HDYRGjGHF3zhRR0CQHJzMifHefC",
-	"r1pcAq8YHJlv8VMh4YqJUlWdemDEqYfFay40JIWEBYvQ2JlDh+Eeto1jr2sn4KSCa8o4ZIbzItBCg+VE",
-	"vTAFEw4/ZrpX9Jwq+OZJ3wVefx25+wvR3vXBHR+129gosUcyci+ar+7AxsWmRv8Rj79wbsWWif25s5Fs",
-	"eW6ukgXL8Zr5u9k/j4ZSIRNoIMJfPIotOdWlhOMLfmD+Igk505RnVGbml7X96XWZa3bGluan3P70SixZ",
-	"esaWPcisYI2+prDb2v5jxouzY72JPhpeCXFZFuGC0sardL4lpy/7NtmOuS9hnlRP2fBVcb7xL419e+hN",
-	"tZE9QPbirqCm4SVsJRhoabrAfzYLpCe6kL+af4oiN711sYih1tCxu29RN+B0BidFkbOUGiS+c5/NV8ME",
-	"wL4SaN3iEC/U448BiIUUBUjN7KC0KJJcpDRPlKYaR/p3CYvJ8eTfDmvlyqHtrg6DyV+ZXmfYycijVsZJ",
-	"aFHsMcZbI9eoAWZhGDR+QjZh2R5KRIzbTTSkxAwLzuGKcj2r3yMNflAd4PduphrfVpSx+G69r3oRTmzD",
-	"OSgr3tqG9xQJUE8QrQTRitLmMhfz6of7J0VRYxC/nxSFxQeKhsBQ6oINU1o9wOXT+iSF85y+nJEfwrFR",
-	"zhY835rLwYoa5m5YuFvL3WKV4sitoR7xniK4nULOzNZ4NBgZ/i4oDt8MK5EbqWcnrZjGf3JtQzIzv4/q",
-	"/PsgsRC3/cSFryiHOfuAwV+Cl8v9FuV0CcfpcmbkpN33ZmRjRokTzI1oZXA/7bgDeKxQeC1pYQF0X+xd",
-	"yji+wGwjC+stuelIRheFOTjDAa0hVDc+azvPQxQSJIUWDM9zkV7+iarVHZz5uR+re/xwGrICmoEkK6pW",
-	"s0lMygiPVz3amCNmGuLrncyDqWbVEu9qeTuWllFNg6U5eONiiUU99kOmBzLydvkJ/0NzYj6bs21Yvx12",
-	"Rs6RgSl7nJ0FITNPeftAsDOZBqhiEGRtX+/EvLr3gvJFPXl8n0bt0XdWYeB2yC0Cd0hs7vwYPBebGAzP",
-	"xaZzBMQG1F3QhxkHxUgNazUCvpcOMoH779BHpaTbLpJx7DFINgs0oqvC08DDG9/MUmteT+ZC3oz7tNgK",
-	"J7U+mVAzasB8py0kYdOySBwpRnRStkFroNqEN8w02sPHMNbAwpmmvwEWlBn1LrDQHOiusSDWBcvhDkh/",
-	"FWX6c6rg8SNy9qeTpw8f/fLo6TeGJAsplpKuyXyrQZH77m1GlN7m8KC7MnwdlbmOj/7NE6+FbI4bG0eJ",
-	"UqawpkV3KKvdtCKQbUZMuy7WmmjGVVcAjjmc52A4uUU7sYp7A9pLpoyEtZ7fyWb0ISyrZ8mIgySDncS0",
-	"7/LqabbhEuVWlnfxlAUphYzo1/CIaZGKPLkCqZiImEreuhbEtfDibdH+3UJLrqkiZm5U/ZYcBYoIZekN",
-	"H8/37dDnG17jZpDz2/VGVufmHbMvTeR7TaIiBchEbzjJYF4uGy+hhRRrQkmGHfGO/gH02ZanqFW7CyLt",
-	"f6atGUcVv9ryNHizmY3KIVs2NuH2b7M2Vrx+zk51T0XAMeh4hZ/xWf8Sck3vXH5pTxCD/YXfSAssyUxD",
-	"fAW/YsuVDgTMt1KIxd3DGJslBih+sOJ5bvp0hfQ3IgOz2FLdwWVcD1bTutnTkMLpXJSaUMJFBqhRKVX8",
-	"mu4xy6M9EM2YOrz59cpK3HMwhJTS0qy2LAga6Tqco+6Y0NRSb4KoUT1WjMr8ZFvZ6azJN5dAM/OqB07E",
-	"3JkKnBEDF0nRwqj9ReeEhMhZasBVSJGCUpAlTkWxEzTfzjIRPYAnBBwBrmYhSpAFlbcG9vJqJ5yXsE3Q",
-	"Hq7I/R9/Vg++ALxaaJrvQCy2iaG3evA5e1AX6nHTDxFce/KQ7KgE4nmueV0aBpGDhj4U7oWT3v1rQ9TZ",
-	"xduj5QokWmZ+U4r3k9yOgCpQf2N6vy20ZdHj5eUeOudsjXo7TrlQkAqeqehgOVU62cWWTaPGa8ysIOCE",
-	"MU6MA/cIJa+o0taayHiGShB7neA8VkAxU/QD3CuQmpF/9rJod+zU3INclaoSTFVZFEJqyGJr4LAZmOsN",
-	"bKq5xCIYu5J+tSClgl0j92EpGN8hy67EIojqSunuzO3dxaFq2tzz2ygqG0DUiBgC5My3CrAberr0AMJU",
-	"jWhLOEy1KKdyr5lOlBZFYbiFTkpe9etD05ltfaL/XLftEhfV9b2dCTCzaw+Tg/zaYtb6OK2oeULjyGRN",
-	"L43sgQ9ia/bswmwOY6IYTyEZonxzLM9Mq/AI7DikPboI50UZzNY6HC36jRJdLxHs2IW+BfcoRt5SqVnK",
-	"CpQUf4TtnQvO7Qmi6nqSgabMPNaDD1aILsL+xNqx22PeTJAe9Ybtgt95xEaWkzOFF0YT+EvY4ovlrXWQ",
-	"Og/cqu7gJRAZ1ZxuygkC6t0ujAATNoENTXW+NdecXsGWXIMEosr5mmltPd6aDwUtiiQcIKofHJjRKcOt",
-	"c5HfgTHa+TMcKlhedyumEytRDcN33hKrGuhwklQhRD7i7d1BRhSCUXZTUgiz68w5WHovPE9JDSCdEIOW",
-	"kIp53lMNNOMKyH+LkqSUo8BaaqhuBCGRzeL1a2YwF1g1p7OQ1hiCHNZg5XD8cnDQXvjBgdtzpsgCrr1X",
-	"smnYRsfBAb6C3wqlG4frDjQt5ridRng7Kk7NReFkuDZP2W2hcyOP2cm3rcErbas5U0o5wjXLvzUDaJ3M",
-	"zZi1hzQyzjqJ447SiQZDx9aN+45qnt9GR1MPHYOuO3FgVK8/9tnVjXyVb++AT9uBiIRCgsJTFb5LlP0q",
-	"FqHjujt2aqs0rLuqG9v1lx7B5p0XCzpSpuA545CsBYdtNFaLcXiNH2O97cnu6Yw8tq9vW2xqwN8CqznP",
-	"GCq8LX5xtwNSfls5lNzB5rfHbWntQpd9fJVCXhBK0pzhm1VwpWWZ6gtOUSoOznLE8OZl/f530gvfJP4w",
-	"i7yb3FAXnKLRtZKVo8aCBURewd8D+OeSKpdLULolHywALrhrxTgpOdM419rsV2I3rACJ1q+ZbbmmW7Kg",
-	"OT7rfgUpyLzUzRsTPYuVNq8uq0I00xCxuOBUkxzMC/Q14+cbHM6r4D3NcNDXQl5WWJhFz8MSOCimkriB",
-	"8Af7FX033PJXzo8Dw7zsZ6t0MuPX7sdbDY3Qpf9z/z+P358k/0OTX4+SZ/9x+OHjk08PDjo/Pvr07bf/",
-	"t/nT40/fPvjPf4/tlIc95vfqID996aTJ05coMtRapw7sn03jsGY8iRJZaFtp0Ra5bwQfT0AParWe2/UL",
-	"rjfcENIVzVlG9c3Ioc3iOmfRno4W1TQ2ovWA9Gvd8yK+BZchESbTYo03vsa7NvW4hzmqQZ3TOJ6XRcnt",
-	"VpbKqWLRgdLbNsViWkUR2OjhY4Iu5ivqDfPuz0dPv5lMa9fw6rt5X9uvHyKUzLJNLAAgg01MvnIHBA/G",
-	"PUUKulWg49wDYY+aca01KRx2DUYwVytWfH5OoTSbxzmcd0tz77QNP+XWX8ycH1Sqbp2uRiw+P9xaAmRQ",
-	"6FUsqrAhKWCrejcBWoauQoor4FPCZjBrv5OyJShvUM6BLjC6DRWDYoybbXUOLKF5qgiwHi5k1GMkRj8o",
-	"3Dpu/Wk6cZe/unN53A0cg6s9Z6VB9X9rQe798N05OXQMU92zsSh26CB6IKJ/cA6yDROo4WY2ltoG41zw",
-	"C/4SFowz8/34gmdU08M5VSxVh6UC+ZzmlKcwWwpy7H1uX1JNL3hH0upNdxB4O5OinOcsJZehRFyTpw1h",
-	"7Y5wcfGe5ktxcfGhYw3qyq9uqih/sRMk10yvRKkTF6OXSLimMouArqoYLRzZRtgOzTolbmzLil0MoBs/",
-	"zvNoUah2rEZ3+UWRm+UHZKhcJILZMqK0kF4WMQKKhQb3941wF4Ok1z7As1SgyN/WtHjPuP5Akovy6Ogx",
-	"kEbwwt/clW9ocltAQ1N1o1iStpYKF27fNbDRkiYFXYKKLl8DLXD3UV5eo040zwl2awRNeKcwHKpegMdH",
-	"/wZYOPZ2AMfFndlePtlCfAn4CbcQ2xhxozY13HS/gjCKG29XKxSjs0ulXiXmbEdXpQyJ+52pYrCXRsjy",
-	"9h/Fluhj48LV50DSFaSXkGHkLKwLvZ02unsToxM0PetgykaYWydoDINEpd4cSFlk1InilG/b8WgKtPZO",
-	"Pu/gErbnoo6i3CcArRkPpfoOKlJqIF0aYg2PrRujvfnOjo0xIEXhw4rQv9yTxXFFF75P/0G2Iu8dHOIY",
-	"UTTidfoQQWUEEZb4e1Bwg4Wa8W5F+rHlmVfG3N58kYB0z/uJa1I/npzJOVwNhiHZ72vAdBXiWpE5NXK7",
-	"cJkWbMxPwMVKRZfQIyGHetWRkTUNXSwOsuvei950YtG+0Dr3TRRk2zgxa45SCpgvhlTwMdNyNPAzWdU9",
-	"rmBGMIGSQ9g8RzGp8siwTIfKhn7bZoTpAy1OwCB5LXB4MJoYCSWbFVU+CQTmyvBneZQM8BvGsA1FLp8G",
-	"NvIgIUYVl+x5bvucdl6XLn7ZBy37SOXwaTki6thI+OiWF9sOwVEAyiCHpV24bewJpY6nqzfIwPHTYpEz",
-	"DiSJmdupUiJlNotHfc24OcDIxweEWBUwGT1CjIwDsNEkhQOTNyI8m3y5D5DcxQNSPzYas4K/Ie66bB3Q",
-	"jMgjCsPCGe9xdfQcgDofjer+ankK4TCE8SkxbO6K5obNuRdfPUgngBbF1la4rDOKPugTZwc08PZi2WtN",
-	"9iq6yWpCmckDHRfoBiCei01iYxeiEu98Mzf0HvXJw0iK2MG0ocr3FJmLDRra8WqxPmA7YOmHw4MRvPA3",
-	"TCG9Yr++29wCMzTtsDQVo0KFJOPUeRW59IkTY6bukWD6yOV+EH18IwBayo46T597/O58pDbFk+5lXt9q",
-	"0zqrhnd3jh3/viMU3aUe/HW1MFW8sFMhvINUyKxfT2EIlekq8WFXveDSNhq+MTqieCAJ40nzteGfEN2d",
-	"67EHN+Cp5xlAxEvrrN+B5LtNIYx0a535bWS3Q4qVEyXYGCVldVaK8WXuBIM+NMUW7L1RPMbtkutMLX7A",
-	"cbJzbHN7HvlDsBRFHI59XirvHH4GoOg55TUcKIffEhIX3T0Iy6d++njbFu2jB6XpWNHMKRC8tWK3gyGf",
-	"rjWzazNVkAO+npPGayO5jNm4Ly7eK0DR7Mx3C7R8mLmA8u2DwFtHwpIpDbW1yUiwHtOfW49PMWGSEIv+",
-	"1elCLsz63glRyXM2Iwd2bCzzs6/gSmhIFkwqnaCpLroE0+h7hdqn703T+KOi6Q9kcweyLH6J4rSXsE0y",
-	"lpdxenXz/vjSTPumkh1UOUfBhHECNF2ROea6jHoJDkxtHUkHF/zKLvgVvbP1jjsNpqmZWBpyac7xOzkX",
-	"rZtuiB1ECDBGHN1d60XpwAUaxMZ1uWPwwLCHE6/T2ZCZonOYMj/2Tv8qH6HXJ8zZkQbWgq5BvW6ZEYcc",
-	"spSiLCxTr9NcR6PYuNBJQ/kRQVel4FGaXtpIjOYG82WlU4m7Tdl39aihXdsdA/Lx4/HdwzkhOMnhCvLd",
-	"7q8UMe4VOOgZYUdA1xuCjuTex2O3VN/dgRph1UrbMEappSPdDBlu66eRSzxVv62RYA3uXMjoaOudkdA8",
-	"vdX03TXdFUWSQQ7RAI2/BBEYtCgwzNo3jgUrmMEYz2ATB8d+msaSUXeV9yXj2iYuvKucaK1xxi87zBw2",
-	"BgWFzXG1f961/jdmsEshmvsX1UOUlXFgkBHj4NXLLkjj36a+nmucFgXLNi27px21Vzt+JxjDC8oNtgMD",
-	"AW3EQn8kqGbGuFqZZ/MWNxK2zEZh5ryZ1y2UacKpmPJZ97uIqkIDd+HqHGj+I2x/Nm1xOZNP08ntzKQx",
-	"XLsRd+D6bbW9UTyjG541mzW8HvZEOS0KKa5onjhjch9pSnHlSBObe9vzZ5bW4lzv/LuTV28d+J+mkzQH",
-	"KpPqtdO7KmxX/G5WZZPT9RwQn9V7RXWln7Ov4WDzq4xaoQH6egUug3LwoO6keqydC4Kj6AzSi7g38E7z",
-	"svODsEsc8IeAonKHqE111hui6QFBryjLvY3MQ9vjuYuLG3c3RrlCOMCtPSnCu+hO2U3ndMdPR01dO3hS",
-	"ONdAjue1TWOuiOBtdznzCkbTG5LqmmKiRmsB6TInXq7RapConKVxeyqfK0Mc3PrJmMYEG/e8p82IJetx",
-	"u+IlC8YyzdQIpXYLyGCOKDJ90s8+3M2Fqz9TcvaPEgjLgGvzSeKpbB1U1J86y3r3Oo1LlW5ga42vh7+N",
-	"jBEmKW3feE7mGhIwQq+cDrgvK62fX2hlfTI/BO4Hezj3hTN2rsQBxzxHH46abaDCquldM1pC31mrxuvf",
-	"XLbUnjmitWeYShZS/ApxVRVq+CJxgT4tK0OP1l+BzyLiepvFVJacuoROPXvvdvdJN6HFqemQ2EP1uPOB",
-	"Cw7mh/TWaMrtVttSEA2/9jjBhBEkh3b8mmAczJ2om5xez2kseaYRMgxMgfmlYTfXgvjOHvfORsNcptwZ",
-	"CfzGqrbMRswXIOuQ3W72nRsKDHba0aJCLRkg1YYywdT6+uRKRIYp+TXltqIIWiPwKLne5oHvFULXQmK+",
-	"CxU38WeQsnVUuXRx8T5Lu+bcjC2ZradRKggKNriBbCEiS0Wu6IV1p6tRc7ogR9OgJIzbjYxdMcXmOWCL",
-	"h7bFnCqwShXvueG7mOUB1yuFzR+NaL4qeSYh0ytlEasEqYQ6fN5Ujipz0NcAnBxhu4fPyH100VHsCh4Y",
-	"LLr7eXL88BkaWO0fR7ELwBXOGeImGbIT//6P0zH6KNkxDON2o86i2gBb7ayfcQ2cJtt1zFnClo7X7T5L",
-	"a8rpEuJeoesdMNm+uJtoC2jhhWe2VI/SUmwJ0/H5QVPDn3oizQz7s2CQVKzXTK+dI4cSa0NPdTUGO6kf",
-	"ztb9cYl0PVz+I/pDFd4dpPWI/Lx2H3u/xVaNXmtv6BqaaJ0SapOc5Kz2VPTpvcmpz6GEmYWrhMIWN2Yu",
-	"s3QUc9BxcUEKybjGh0WpF8kfSbqikqaG/c36wE3m3zyJZFNuZvXk+wH+2fEuQYG8iqNe9pC9lyFcX3Kf",
-	"C56sDUfJHtSRncGp7HXcirvo9PkJDQ89VigzoyS95FY2yI0GnPpWhMcHBrwlKVbr2Yse917ZZ6fMUsbJ",
-	"g5Zmh/787pWTMtZCxhIj1sfdSRwStGRwhX768U0yY95yL2Q+ahduA/2XNZ56kTMQy/xZ7n0I7GPxCd4G",
-	"aPMJPRNvYu1pWnoaMlfU7IMvnHEWEFsscJfd4zZlRBqd94HKc+hx0PUoERoBsC2M7fcCvr2KITD5NHao",
-	"D0fNpcUo87mILNnnnq9sPC5iMqK36rtAzAfDoOZuqClp5vn+/B413izS9ewwXzys+Ecb2C/MbBDJfgU9",
-	"mxjUIIhuZ1Z9D5zLKHkuNmM3tcW7/cb+E6AmipKS5dnPdW6QVokHSXm6ijqLzE3HX+pidNXi7GGOZsZc",
-	"Uc6tN0JXN4GvlF/8ayby3vq7GDvPmvGRbdtVJ+xyW4urAW+C6YHyExr0Mp2bCUKsNtMuVGF9+VJkBOep",
-	"0zDW93q3WkmQU/4fJSgduxfxgw0tQI36wlCxTe0OPEM9xoz8YItJr4A0ssSh/oCty9xmHLMJtq2ppyxy",
-	"QbMpMeOcf3fyithZbR9bUsmmVF/aa7exin7/3H0cbYd8a+8ios+sWmlM2qg0XRexFCWmxblvgHlQQusS",
-	"PqxD7MzIS6vTUP7FbCcx9LBgcg0ZqaZzUjXShPmP1jRdobKgwVL7SX58LQBPlSqov1nV0arSruK5M3C7",
-	"cgC2GsCUCCM5XDNlawjDFTSzolQpgpwY4LOkNJcnS84tpUSl4qEUVjdBuwfOekF6A1QUshbi95RenJv6",
-	"nqURzrBXNI9hu85Cp/CmzbFR1UfyteFTygVnKWYRjF3Nrh7xGOvsiISL8cgA52+jJpHDFa3uUAVrOCz2",
-	"1nvwjNAhrmseCr6aTbXUYf/UWPh2RTVZglaOs0E29UVKnIaacQUujS6Wpg74pJANizdyyKgTRS0n70lG",
-	"GJzdo3L43nx74xRSGLV4yTg+PX2MhA2QtDpkLJeqzXuVabIUGEHhDkW4pvemzwyTtWSw+TDz5VVxDGsw",
-	"Nsu23hHdoU68r4TzTTBtX5i2NqFe/XMjDs5OelIUbtL+EjZReUBveC+CIzbvytErQG41fjjaALkNOjnh",
-	"fWoIDa7QRQIK4kJjesq5tIJgjNBqKQpbEOsfHc2jFXUTfcU41MV/IxdEGr0ScGPwvPb0U6mk2oqAo3ja",
-	"OdAc/SJiDE1pZxS77VCtDXb+pEU68XP0b2NdiaaHcVQNasGN8m1Vc9hQdyBMvMBi5w6R3boyKFU5IcoF",
-	"1zQrzcQYh2HcvpZV8wLoHoOuTGS7a0ntydnnJupLVTIvsyXohGZZTJ/wHL8S/EqyEiUH2EBaVvmbi4Kk",
-	"mJmvmaqwS21uolRwVa4H5vINbjldULopQg1h+Si/w+h4Pd/iv7Hkxf0749yD9vax975AWRU+t4/c3Byp",
-	"I/Uamk4UWybjMYF3yu3RUU99M0Kv+98ppedi2QTkMycoG+Jy4R7F+Nt35uII83d1MnLbq6VKr4XuoMIX",
-	"3MRnY5UYpsmVfNRpZ86goN+wAqK/NN8UL7+euJZA10vt/Wrt2n3RLWlvMBbVLn+CpmSQBfXGpFu/Mht9",
-	"jlDEdfp9vmTWlcx87vQeJxl25GwcexCh3kmxC9CP3gOaFJQ5p42aWXQx68K9+tWFQ4eu3uD2IlwQVa/G",
-	"7servoAnHwdsIztaxcwuwSVVKiRcMVF6dwjvL+efhPZXV0w6iCvuXX/Xbwan+rJq0F6l7bkrnGGX6d7k",
-	"P/5svSsJcC23/wQq3M6md0rBxXIWNwrBOeEqqm/SY+/Kl1U1ucurZC2yoYDpH38mL71tadS94wk5lm5J",
-	"ZK78UjRY/JVL/u+bGelz9LSvXaeTohieuidCvDu5bbjv9H2ppsz5HNK6vfXn1xbQC1UIkbdKEM7MYaPj",
-	"pXI60bDXQGBTAOa6DQKb+7NnjCUoF+SIr9UkB6pgAMNh1jbXdiSSzzevTPtxwfbxEob9KWfrNLPIPAuh",
-	"WF2WJVbbcKTL8TmWJwwsht2xvL/fFaRayIYfkwTYJ4GumSyom/s19WyPoqTyzPb0P5BmdjoJeUs0UNEd",
-	"L1qnyEGrGppcI6nqbZsIs3edmTkkJUz9EOaHBc1VvEpVr7NrK/NJ4LASSfQcX9hpNiLbt1vONPCBYNkw",
-	"IuORANb5+18Tmdav/W7R2anWNPyq6CReCJKH2KI6sz0cSCovapQMcb+WwF1J5UUMNbujohYLSDW72pHo",
-	"4i8r4EEShanXBCMsiyDvBauibDCh6P52jhqgoTwUg/AEif1vDU5fjOglbO8p0qCGaJWfqRfub5JLEjGA",
-	"t5YRPAqhYl6K1nTlHMeYqigDseC9gm13qLNy95ZXDOScG87lSbIp8QxMeSViuu9Rc5mue2UCw4CRvlwY",
-	"3QJn/RqPl1hPTlWlj30uylAvSE67GfuvXS5LTEtSWWt9VktQ/jefg8jOkrNLCAtAom0cUyi4FlFlr9cj",
-	"JwNyUif62xfnagO9qGZmdQxHN943kgMavZ/SXJhHcNIX7tQMm6jcvO4p6xyKYgpWjkO4FiBdoVy8GXKh",
-	"INHCu9YNwTGECusBeyMkqN66Cxa43myo7+p0r1h/xibLoM7xNVwgkbCmBjoZJGXtn3MI2S/sdx/g6nNy",
-	"7dRpV/Sa7Myq6qN3mOogMaT6BXG35e7A2Zuotxnntiy/ivkUcoPK0P5aSJGVqUsEExyMygQwOmHZACuJ",
-	"aobT7io7Sr4cs4G/CtIQXML20Opf0hXlyyC9Wgi9Fe3tGoLMZa3dvlPNf1zJmS/tApZ3AueX1J5PJ4UQ",
-	"edJjcD3tJpptn4FLll4aMbus/d57SiyS+2jnqzxqrldbn1i1KIBD9mBGyAm3kUbeuaZZ6ag1Ob+nh+bf",
-	"4KxZaXM/O8X+7ILHQzYwqY+8JX/zwwxzNQWG+d1yKjvIjjSmm54kt5JeRwqOdv3pRru7tItA1kRloYhJ",
-	"KTdM1TXqfHeV+xHSD6ogDr9+wkx+tReztDYilJa85aYtvLyuTT/j6jH6DjvAC5U1QUVGz40cOF/Y1fh1",
-	"hZRgKb2U0Fj+Lv2PW2DNl4ItUhg1aZZpExBbN7XmvgTKPfWi0pnF8dxVrWHaPsEx529XJafQZmjTsAaE",
-	"Y86lvKL551erYT7HE8SHKyseX2j4/g2RbFGpbubv94qOmjt4697d1PwtqgH/AmaPosZeN5Qz/lSVML2J",
-	"DFPc05zkoq6Ii0OSaxzTWocffkPmLoqukJAyxVoBxte+qkn13MMiX3W1+eH35a51/iz0LcjYPRBEQd7U",
-	"FRK0wPuhhrA+ol+YqfSc3CiVx6ivQxYR/MV4VJjOZsd1cdkwG9uKMy1/SCHhjs3HgSPYnubjbqKescuz",
-	"JlJz6ZQKuuscfVs3cBu5qOu1jfV96CJ3KI3+GJeFeHUM0x19JixCsLQMQVDJ3x7+jUhYYO1IQQ4OcIKD",
-	"g6lr+rdHzc/mOB8cRMW4z+YtYXHkxnDzRinGGdM6oTCwKZjsSfr3zjF3d2Gj+Y5gB4hn58whWg0Gp/Z+",
-	"o585FTTK3DsV/HZprvEufhagzC+5miiG+5/7Yhesf35PmEzrLJQsz3YdykbQU135FsN6fnEBuV+k9u4v",
-	"VpfdZZOu/uE+PnLtA4CIiay1MXkwVRDONCKSyXWLxC0hcaWlZHqLecK86pP9EvWp+aGyljgrcJVZxskd",
-	"WlxClWmutq2Uyks2Pwiaoyxg3jPooaiFyGfkuw1dFzk4JvXtvfkf4PEfn2RHjx/+Yf7Ho6dHKTx5+uzo",
-	"iD57Qh8+e/wQHv3x6ZMjeLj45tn8UfboyaP5k0dPvnn6LH385OH8yTfP/nDP3AEGZAvoxGelmPwVC1Qn",
-	"J29Pk3MDbI0TWrAfYWtrYRoy9lU2aYpcENaU5ZNj/9P/9txtlop1Pbz/deKC3icrrQt1fHh4fX09C7sc",
-	"LlGZmmhRpqtDP0+nDOfJ29MqPMz6QuGO2sgfQwq4qY4UTvDbu+/OzsnJ29NZTTCT48nR7Gj2EHMZF8Bp",
-	"wSbHk8f4E56eFe77oU8ifPzx03RyuAKao03c/LEGLVnqP6lrulyCnLlyo+anq0eHXow7/OgUyZ+Gvh2G",
-	"lXsOPzb07dmOnujocvjRJ7Eabt3IEuXsDGa5y5hB9wdw94Rz/YjYJRSqN+3oU6KEdNq2QjJhTtLURren",
-	"EijSvZAYnqVlyVOr8LZTAMf/vj75K1o6Xp/8lXxLjqYuak/hMy82vdUlVSRwmlmwuypT9Xx7UpcsqVPc",
-	"Hr+PPEmiZVDxCBn6CCi8GrHmYGitDotHV/zY8Nij5NmHj0//+Cl2J3XL73skBcaMEPVa+ERPiLQ13Xzb",
-	"h7KNPR24hn+UILf1ItZ0MwkB7tq/Il5tC7YsJWoQ6xj9yl/XVcNkivzX2U9viJDE6RTe0vQydOCLgePu",
-	"sxAiX5zMhYOt1bJoxk5UOPyAmV8QCjzFj46O9ioQ3HIu6lKRKytPvX9dV4OnCGxoqvMtoXj/bK2pSZXz",
-	"OktTUxTQokjCAaKv5IEZfX2jmGP7vkrESHAf1hEahq+dpb2BDucdhfXUdptXO8iIQvAhdnuHW+tp5Ovu",
-	"/mvsblcYIIUwZ5ph8Gh9n+RdN0UVFO9w4PbYR2bkv0WJIputYwmxVJM4A9qS/JzOwBv4t+VYRbTCzsFB",
-	"e+EHB27PmSILuEYOSjk2bKPj4AALnz/Zk5UNquYbERijzs4+w3U26zXdVBn+KFaw4Fhm8QpI8Nh8cvTw",
-	"d7vCU47eRUbWJFaW/jSdPP0db9kpN1ILzQm2tKt5/LtdzRnIK5YCOYd1ISSVLN+SP/MqQD9IF9llf3/m",
-	"l1xcc48I80ws12sqt05CphXPKXmQMmGQ/3QMs7UUjVyULhXa8FD+nDTKCfPl5MMnL+CPfDUMNTucY8ag",
-	"sU1BBY37nx5ojFGHH9Gc0Pv7oUujEv+IZh37Zj30TmTxlo1XzUe9MbC2eqRUp6uyOPyI/8E3ZACWDVrs",
-	"gmvDNg4xedy2+/OWp9EfuwO160HHfj782Cyz1ECoWpU6E9dBXzRYWGtbd76qQm/j78NryrSREJwnIOaQ",
-	"7XbWQPNDl2ig9Wsd29f5ggGLwY8tmaIQNhdM8632jl6HEoqVFkDp5yLbDnCbTTJnHI9gyCJqVZj92H0f",
-	"dBjD+Qps6nVvyY0IYFqQuRQ0S6nC1KQuJUfn1ffplo+Plty4OY3Y6RBMfEh3ncrMYdpdEBPHHSNhBfsS",
-	"ZPRGSVdZFdpvLJV0IHpOM+KTByXkNc3NhkOGZbgkhswFIP/WEsWXFwG+8J392S7Z5/7wKULRbaZ1OIM0",
-	"OWMuT/NaMmd9CTxx3CaZi2zrc9FLeq031p+mzccOq4x/0Y93oE7759ah7VKdfdVYfdVYfdVpfNVYfd3d",
-	"rxqrr/qcr/qc/2/1OfsocWIypFNi9IuSmBuVNua1bzRaR4RVLD5sNiVMVwJXN0E70zNCzjHehppbAq5A",
-	"0hyL2KgggG6NnpeqTFOA7PiCJw1IrH+jmfh+/V/rWHpRHh09BnL0oN1HaZbnIW/u9kVhFj/Z/EDfkovJ",
-	"xaQzkoS1uILMhpeH8Qe2185h/1c17k+dUCaMAF3RK6giJogqFwuWMovyXPAloUtR+1gZvk24wC9YrNgl",
-	"KiBMT12aF6bItVm8y1DbDJNoiuVdCeC03sKdhu0WucRt2obw9jRo/8cYa/a/rgh+09it23LJwbE7LPMr",
-	"y/gcLOOLM43fu6kw0PH9S8qQT46e/G4XFGqE3whNvkdv/tvJWlVK71jQ+2gpqnY7Dd048Q6sHDjffzCc",
-	"Hks7ueux9ko8PjzEuNmVUPpwYi6vpsdi+PFDBZSvrDApJLvCRI0fPv2/AAAA//9Yvh6rFd0AAA==",
+	"H4sIAAAAAAAC/+y9e3cbN5Io/lXw4+45TrykJD+SneicnP3JcZLRxk58bE0ye2PfGbAbJDFqAj0AWiLH",
+	"V9/9nioA3ehuoNmkaHsyN3/ZYuNRKBQKhXq+n2RyXUrBhNGT8/eTkiq6ZoYp/ItmmayEmfEc/sqZzhQv",
+	"DZdicu6/EW0UF8vJdMLh15Ka1WQ6EXTNJudh/+lEsb9XXLF8cm5UxaYTna3YmsLAZltC63qkzWwpZ26I",
+	"CzvE5fPJ3cAHmueKad2H8idRbAkXWVHljBhFhaYZfNLklpsVMSuuietMuCBSMCIXxKxajcmCsyLXJ36R",
+	"f6+Y2gardJOnl3TXgDhTsmB9OL+R6zkXzEPFaqDqDSFGkpwtsNGKGgIzAKy+oZFEM6qyFVlItQNUC0QI",
+	"LxPVenL+60QzkTOFu5UxfoP/XSjG/sFmhqolM5N309jiFoapmeHryNIuHfYV01VhNMG2uMYlv2GCQK8T",
+	"8rLShswZoYK8/u4b8uTJk69gIWtqDMsdkSVX1cwersl2n5xPcmqY/9ynNVospaIin9XtX3/3Dc7/xi1w",
+	"bCuqNYsflgv4Qi6fpxbgO0ZIiAvDlrgPLeqHHpFD0fw8Zwup2Mg9sY2Puinh/J90VzJqslUpuTCRfSH4",
+	"ldjPUR4WdB/iYTUArfYlYErBoL+ezb569/7R9NHZ3b/9ejH7X+7PL57cjVz+N/W4OzAQbZhVSjGRbWdL",
+	"xSielhUVfXy8dvSgV7IqcrKiN7j5dI2s3vUl0NeyzhtaVEAnPFPyolhKTagjo5wtaFUY4icmlSiY1jia",
+	"o3bCNSmVvOE5y6eEC3K74tmKZFTbIbAdueVFATRYaZanaC2+uoHDdBeiBOA6CB+4oH9eZDTr2oEJtkFu",
+	"MMsKqdnMyB3Xk79xqMhJeKE0d5Xe77IiVytGcHL4YC9bxJ0Ami6KLTG4rzmhmlDir6Yp4QuylRW5xc0p",
+	"+DX2d6sBrK0JIA03p3WPwuFNoa+HjAjy5lIWjApEnj93fZSJBV9Wimlyu2Jm5e48xXQphWZEzv/GMgPb",
+	"/t9vfvqRSEVeMq3pkr2i2TVhIpN5eo/dpLEb/G9awoav9bKk2XX8ui74mkdAfkk3fF2tiajWc6Zgv/z9",
+	"YCRRzFRKpACyI+6gszXd9Ce9UpXIcHObaVuCGpAS12VBtyfkckHWdPP12dSBowktClIykXOxJGYjkkIa",
+	"zL0bvJmSlchHyDAGNiy4NXXJMr7gLCf1KAOQuGl2wcPFfvA0klUADhc7wOFiHDiCbSI0A0cXvpCSLllA",
+	"MifkT45z4Vcjr5moGRyZb/FTqdgNl5WuOyVgxKmHxWshDZuVii14hMbeOHRoQolt49jr2gk4mRSGcsFy",
+	"woUFWhpmOVESpmDC4cdM/4qeU82+fDq52/V15O4vZHfXB3d81G5jo5k9kpF7Eb66AxsXm1r9Rzz+wrk1",
+	"X87sz72N5MsruEoWvMBr5m+wfx4NlUYm0EKEv3g0XwpqKsXO34qH8BeZkTeGipyqHH5Z259eVoXhb/gS",
+	"firsTy/kkmdv+DKBzBrW6GsKu63tPzBenB2bTfTR8ELK66oMF5S1XqXzLbl8ntpkO+a+hHlRP2XDV8XV",
+	"xr809u1hNvVGJoBM4q6k0PCabRUDaGm2wH82C6QnulD/gH/KsoDeplzEUAt07O5b1A04ncFFWRY8o4DE",
+	"1+4zfAUmwOwrgTYtTvFCPX8fgFgqWTJluB2UluWskBktZtpQgyP9u2KLyfnk304b5cqp7a5Pg8lfQK83",
+	"2AnkUSvjzGhZ7jHGK5Br9ACzAAaNn5BNWLaHEhEXdhOBlDiw4ILdUGFOJtPYmWwO8K9upgbfVpSx+O68",
+	"r5IIJ7bhnGkr3tqGDzQJUE8QrQTRitLmspDz+ofPLsqywSB+vyhLiw8UDRlHqYttuDb6c1w+bU5SOM/l",
+	"8xPyfTg2ytkSdEdz5kQNuBsW7tZyt1itOHJraEZ8oAluJ2hi7qY1GrRm5hgUh2+GlSxA6tlJK9D4j65t",
+	"SGbw+6jOvw0SC3GbJi5oRRzm7AMGfwleLp91KKdPOE6Xc0Iuun0PIxsYJU4wB9HK4H7acQfwWKPwVtHS",
+	"Aui+2LuUC3yB2UYW1nty05GMLgpz8zmkNYTq4LO28zxEIYEPXRieFTK7/iPVqyOc+bkfq3/8cBqyYjRn",
+	"iqyoXp1MYlJGeLya0cYcMWiIr3cyD6Y6qZd4rOXtWFpODT2ZdOGNiyUW9dgPmR5TkbfLT/gfWhD4DGeb",
+	"Gv8uB50ExyMqAwtCDk95+0CwM0ED2Hgjydq+3gm8uveC8ptm8vg+jdqjb63CwO2QWwTukNwc/Rg8k5sY",
+	"DM/kpncE5IbpY9CH3Nj/cMPWegR8zx1kEvffoY8qRbd9JOPYY5AMCwTRVeNpEOGND7M0mteLuVSHcZ8O",
+	"WxGk0ScTCqMGzHfaQRI2rcqZI8WITso26AzUmPCGmUZ3+BjGWlh4paRcHJ34OuPH9gk/OI5FCyoypsma",
+	"qeuCEaM4I0wYtT1pb9kbQz/AlmlDA0zfY8vaAx17y+S65AU7hmgqaLHVfOcJfaXkUtH1hW9+N52sopcb",
+	"KEOePCZv/njxxaPHf3n8xZewraXtTeZbwzT5zL1BiTbbgn3eRwq+AqvCxEf/8qnXtrbHjY2jZaUytqZl",
+	"fyirxbWinm1GoF0f4e0dwlXXAI5hQlcMbiy7Y8QaKAC05+zmpcwZamaOsJEDon5BDdOm0TEdTZT3YHt1",
+	"nFfnhBPaU52zG1YAuGQtc0YEM7dSXQdoeCNoqVfSfFhMWIgyWoJmqdZqajd3DDfTif8644lBfQPCcyZA",
+	"MmBqNJbbwx+K8xR+a9AQ0VxTrdl6fhS+kTqgeTNLThzl5+woR3NqhTkqhDTUsHznUd33jDawb8Nzqraq",
+	"OobeiSklVUQZjleMkZksZjdMaS4jds1XrgVxLfxbtOz+bqElt1QTmBvwRCqRt0iymRgMMKOFNDv01UY0",
+	"uBkU0+x6I6tz847ZlzbyPc1rUoLNeCNIzubVsqW2WCi5hgOBHVFk+J4ZlNuv+Jq9MXRd/rRYHEevI3Gg",
+	"OFcwfM00zEZsKzJn5pYxAWvQLKsMv2FW+NdoPtYsk8L6LO3gHG7W+zBonLcP4g5W/T0zb7Yi+wg31poL",
+	"NGzqrcgCTRXeLSxf7sFg73WN4VQPdAQcQMcL/IzKzOesMPTognN3ghjs3/gTYYElOTRE8ewFX65M8Kz+",
+	"MMJ9dJYdIn4BffqqiR9BDDDUVPoIUn0zWMM0YE9DVkHnsjKEEgF0rrFxXN5POCOhFwQ6b5jwCWFWVs8w",
+	"Z0BIGa1gtWAXkjEW3HSc0cxS78yyhfiEjdHdtrLTWUeXQjGagy6TCSLnzkDqhBxcJEW/CuPvVvfaiIo9",
+	"AVylkhnTGnTQVrO4EzTfznJjM4AnBBwBrmchWpIFVfcG9vpmJ5zXbDtDLyBNPvvhZ/35J4DXSEOLHYjF",
+	"NjH01mouLhJQj5t+iOC6k4dkRxUjnucSI/GVUzDDUijcCyfJ/etC1NvF+6Plhim0R39QiveT3I+AalA/",
+	"ML3fF9qqTPi2Oo0JiGewYYIK6YSh6GAF1Wa2iy1Do3AtGlYQcMIYJ8aBE0LJC6qN9aHgIkfVr3Yv3fqd",
+	"C1OkAU5K9jDyz16o74+N0qLQla4lfF2VpVSt90+zBnC8Sc/1I9vUc8lFMHb9jDCSVJrtGjmFpWB8hyy7",
+	"EosgampTo3My6i8ODXJwz2+jqGwB0SBiCJA3vlWA3dC/LwEI1w2iLeFw3aGc2qlwOtFGliVwCzOrRN0v",
+	"haY3tvWF+VPTtk9c1DT3di4ZzG48TA7yW4tZ69m5opo4OMiaXoPsgeox6+zRhxkO40xzkbHZEOXjqwla",
+	"hUdgxyFNKDWd73gwW+dwdOg3SnRJItixC6kFJzSsP4mCC5Agr9m3m5Kr7TFsIlV2zcz4B3cPhmc4QP/h",
+	"PcLQH5jVNbllihFw7QF2TmN6r7j5q+LCgKNb1x7j1jU9wptL4pqJhkWTpZJVac8fXDU846WV3K/ZljBE",
+	"yaS9V3/k2sijbJYFZIaAjN4xPB8BODt1JK1ZjoY3ecMUoURR4Vw8kUsAMK9CNN4HWYO2gsgkUaUbUCfL",
+	"mDCd7V3ZPva12Nv53jp+YB94DT+wKPwXJGeGctB0Bh9iUFvnuu6Yh71zRxFiH/weIUaWU3CN8lwP5ZZ2",
+	"rNf2VeDrfYSHemRUwm2kBQDqfUFZ3nYyZxuamWJLKEoYW8vSdDVfc2OsG377OBtZzsIBonbAgRmdhd56",
+	"PPsdGOMy8AaHCpYXY9/2wTMM31Xn1dNCh3volFIWI1RjPWREIRjlzEVKCbvOXdSHDw3wlNQC0r0xiq0H",
+	"V8icPdAtNOMKyP/IimRU4HuyMqwW2KRCKQj64gxcB3M6t60GQ6xga2afyfjl4cPuwh8+dHvONVmwWx8q",
+	"9fBhHx0PH6KS6pXUpnW4jnDVwHG7jNzeaOUEBu+eWF2estttyI08ZidfdQb3k+KZ0toRLiz/3gygczI3",
+	"Y9Ye0sg4lymzGbnyYD3RdeO+v5ZFMafZtdXJ/ivba21QipJF0daDE1g+oAIV0h9Gm9wMHQO/P3Hg9Nh8",
+	"TPk9wkuw2B7hyrIDEcVKxTQymFCDou1XuQgDCx0H0ltt2LqvZLZd/5Kgidf+AdN7DzvxcS0F20Zj6blg",
+	"L/FjrLdlconOeN2k+nYfeC34O2C15xlDpvfFL+72lSzt+p037DFYVagM3OMF5yBIvAg+9uPN70f3gZPS",
+	"s4YvC7RUrgHtML6eembZALeSmkXkR9QU39CC1zrZFHfb6+VZb8i09qeILO4+rNHI0mOgXuR8a7GBZNZc",
+	"Ht/esOOQGbth+xBZF4Tdhnk7/n3QYoeoBRMfzxmKj23kvKqd7o/AgLvjdmx8YVgz6rBZURJKsoKjhlsK",
+	"bVSVmbeCog4tgDri7+c1g2mt6je+SVyNG9GyuqHeCooorDVrUR+NBYscz+8Y88pVXS2XTJvOc2XB2Fvh",
+	"WnFBKsHtduHhnVmmWcKlvjXsxLZc0y1ZQHiukeQfTEkyr0xbgMfoS21AR2sNjjANkYu3ghpSMKoNecnB",
+	"QwSG8wZ7z7e9T5LHQtzbaskE01zP4s6F39uv6N/ulr9yvu7wf9fZmqhg/CZEc2tYK73D//7sv84hrQOd",
+	"/eNs9tV/nL57//Tu84e9Hx/fff31/2n/9OTu68//699jO+Vh53kS8svn7nF7+RxfMI2Nqgf7R7NPQEDx",
+	"giXuAO/60KEt8pmQpiagzxsjoNv1twK8c4y0PJ+aw8ihK2b0zqI9HR2qaW1ER93s17rnu+AeXIZEmEyH",
+	"NR4sSrdZFSw+HoWLl7kLrIVWZFEJu5WVdoZbDDLzLmVyMa0jrW2GpXOCYbgr6p163Z+Pv/hyMm3CZ+vv",
+	"k+nEfX0XoWSeb2JB0jnbxJ577oDgwXigSUm3miV8NRH2qPec9T0Jh10z0BPoFS8/PqfQhs/jHM6H7ji1",
+	"0UZcChtTA+cHTbBbZ9mRi48Pt1GM5aw0q1jmlZa0jq2a3WSs4xYDwXVMTAk/YSddtU2+ZNr78RWMLoBA",
+	"rcgox4Qi1ufAEpqnigDr4UJG6UZi9IMPTMet2wf6NYPkF/ggPcKxroOU+2c6dKGa9s4NWk+maBtsyejw",
+	"A4bQ2HsHWqG/KAPPAJu1ZUm50GZsVFSw3t5mWOj3CYjCHjaBCQxLaH9RiHAnbemjKyHcwDEYu3PWBm7/",
+	"t5HkwfffXpFTd0PpB4gRN3QQ0h7RP9sPbQ81Q6hL8GXfS2/FW/GcLbjg8P38rcipoadzqnmmTyvN1DMb",
+	"PnOylOTcB4I+p4a+FT3RNpmDL6AWUlbzgmdodonwA5tXqT/C27e/Apm8ffuu56zTf7S7qaIM3U4wgzRG",
+	"sjIz99CYKXZLVR4BXdeJQ3Bk7D0465S4sVsPGTd+/JKhZam7CQT6yy/LApYfkKF24fGwZUQbqbzwx7WH",
+	"Bvf3R+luYkVvfdahSjNN/rqm5a9cmHdk9rY6O3vCSCui/q9OxgKa3JasZak4KMFBV0+BC7fva7Yxis5K",
+	"umQ6unzDaIm7jw+UNWwBvCywW4iTOoIHh2oW4PGR3gALx95Rybi4N7aXzwAYXwJ+wi3ENiDfNZ4gh+5X",
+	"ENt/8HZ18gP0dqkyqxmc7eiqNJC435k6MZjl9849B4xfcAhcDrU5I9mKZdcsx3RObF2a7bTVXS5akr1n",
+	"HVzbtGc2Mhdz86BRB9KhlTl1bx8qtt0kKZoZ4xUNr9k1217JJrXPPllR2kk6dOqgIqUG4jwQa3hs3Rjd",
+	"zXduhgApLUuf6wKDnj1ZnNd04fukD7J9YxzhEMeIopVEIoUIqiKIwA4pFBywUBjvXqQfWx4861zgaCRL",
+	"muf9Pra0ea06j8BwNVer+vuaYQ5FeavJnGqWE+nS/9lEFAEXqzRdssSTJNSFjkz30LLF4SC77r3oTQeW",
+	"/PaF1rtvoiDbxjNYc5RSGHwBUsHXY8cP1M9kTbe4ghOCWX0dwuYFikmByhiYDlUttbFYDoEWJ2CmRCNw",
+	"eDDaGAklmxXVPjNhPg3O8igZ4AMmVhlKp3UZuDAGWRrrZFme53bPae8575Jq+UxaPn1W+JYfkQprOnFR",
+	"E7HtkAIFoJwVbGkXbht3bAYPdLBBAMdPiwWq22cxb0iqtcy4faQ014ybg4F8/JAQa3Uho0eIkXEANrok",
+	"4MDkRxmeTbHcB0jhktRQPzY6MwR/s3iIno0PAJFHlsDCuUhEongOQJ0LbX1/dRy5cRjCxZQAm7uhBRPG",
+	"P7GbQXpZnVBs7eRwck4xn6fE2QGzo71Y9loT9jhoNaHM5IGOC3QDEM/lZmYDzaMS73wzB3qPhkxAr+jB",
+	"tPmzHmh4kDtDmchtYlq9A5Y0HB6MBgBMjARrx36p29wCMzTtsDQVo0JNPqtlm4ZcUuLEmKkTEkyKXD4L",
+	"UmIdBEDX5Fjnz3OP352P1LZ40r/Mm1utsWLW0Wix4586QtFdSuCvr5Gpk1g5FcJrlkmVp/UUQKjc1Nn4",
+	"++oF224GfGN0mquBygAX7deGf0L0dy7hD9SCp5lnABHPbSxlD5JvN6XUTLtYS7zq3eBOTlTMRq1rqyTU",
+	"XCwLJxik0BRbsPdG9Bi3S27Sh/oBx8nOsc1NPPKHYCnLOBz7vFReO/wMQJE45Q0c0OC+kLiUY4Ow3KXp",
+	"41VXtI8elFarTqK74K0Vux2AfPrm476RWrOC4et51nptzK7ZNq4EYCiavfHdAi0fptOjYvt54K2p2JJr",
+	"wxrzHtcNpj+24YRiFl8pF+nVmVItYH2vpazlOexozSatZX70FdxIw2YLriDsBWyj0SVAo+80ap++g6bx",
+	"R0Vrs4lNaM/z+CWK00L4X86LKk6vbt4fnsO0P9ayg67mKJhwQRjNVmSOBRiiXuIDU9s4n8EFv7ALfkGP",
+	"tt5xpwGawsQKyKU9x2/kXHRtLgPsIEKAMeLo71oSpQMXaJC6oM8dgweGPZx4nZ4MmSl6hyn3Y+90KvUJ",
+	"FFLCnB1pYC3oD5l0y494IYbRS03tpWiSASHNrKX8iKCrVvDYEB8uiGhvsFj6aeJxs9K+q0cN7druGFCM",
+	"H0/sHs4JwbMC8o/sDn9AB8RagYOuKHYE9HUiGOfnnWp2S/X9HWgQVq+0C2OUWnrSzZClvHkauWzIzdsa",
+	"CRZwZ6XM8dY7kNA8vTX03TfdleUMFA/R+NlfggBZWlrzsG8ciyWFwTj4b8TBsZ/29lM9VqLuzjjjlx2m",
+	"sx6DAhTn9AHJwNNvzGCXQjSnF5UgSj/jMCPGweuXXSOd9qgvcY3TsuT5pmP3tKMmteNHwRheUG6wHRgI",
+	"aCMWma2Ybu17oMyzxXRaWURPRmHmqp1sPJRpwqm49qXg+oiqMzfs9AZmtPiBbX+Gtricyd10cj8zaQzX",
+	"bsQduH5Vb28Uz+h/Ys1mLa+HPVFOS/AmosXMGZNTpKnkjSNNbO5tzx9ZWotzvatvL164HJtorysYVbP6",
+	"tZNcFbYrfzOrshnTEwfEl5paUVPr5+xrONj8Os1zaIC+XTFX1id4UPfqDzTOBc143iC9iLtf7zQvOz8I",
+	"u8QBfwhW1u4QjakOO3c8IOgN5YW3kXloE67SuLhxd2OUK4QD3NuTIryLjspueqc7fjoa6trBk8K5BgoP",
+	"rW1tLU2k6PonwisYZrCkCm7zc+YsIH3mJKo1Wg1muuBZ3J4q5hqIQ1g/GWhMsHHiPQ0jVjzhdiUqHowF",
+	"zcakFOwAGcwRRaaOZj1scDeXrihqJfjfKxYkRq29EYODivpTZ1nvX6dxqdINjH2C4e8jY4SVM7o3npO5",
+	"hgSM0CunB+7zWuvnF1pbn6jw0vq+zn3hjL0rccAxz9GHo2YbGbJqe9eMltB3FlD1+jdXwiMxR7QgKtez",
+	"hZL/YHFVFWr4InHhbiIUprD3SURc77KY2pLT1HVtZk9ud0q6CT6StkNigupx5wMXHMxz663RVNittvUJ",
+	"W4EEcYIJWuhTO35DMA7mXphTQW8xvjcqZABMgfmlZTc3kvjOHvfORsNd+ZYTEviN1W25TWhUMtWkbOgn",
+	"RzxQYLDTjhYVGskAOrZkAus/TQstI8NU4pYKw3xRGnuUXG/NrP4eet1KhenIdNzEn7OMr6PKpbdvf82z",
+	"vjk350tuizxWmgVVBN1AtjqupSJXidG60zWouVyQs2lQp9TtRs5vuObzgmGLR7YF2LRwbf4s111geUyY",
+	"lcbmj0c0X1UiVyw3K20RqyWphTp83tSOKj5d7hm2e/QV+QxddDS/YZ8DFt39PDl/9BUaWO0fZ7ELwFVz",
+	"HeImObIT//6P0zH6KNkxgHG7UU+i2gBbgjvNuAZOk+065ixhS8frdp+lNRV0yeJeoesdMNm+uJtoC+jg",
+	"RWCjnGmj5JZwE5+fGQr8KRHaB+zPgkEyuV5zs3aOHFqugZ6aEoF2Uj+cLUZr76YaLv8R/aFK7w7SeUR+",
+	"XLuPvd9iq0avtR/pmrXROiXU5qAreOOp6GtOkUuf4hLL3dRVbixuYC5YOoo5sIVYgoELgw+LyixmfyDZ",
+	"iiqaAfs7SYE7m3/5NFLip12CQewH+EfHu2KaqZs46lWC7L0M4fpCsKOYrTmw+s+bUNrgVCYdt6LTmpSf",
+	"0PDQY4UyGGWWJLeqRW404NT3IjwxMOA9SbFez170uPfKPjplVipOHrSCHfrT6xdOylhLFctb3Rx3J3Eo",
+	"ZhRnNyxPbhKMec+9UMWoXbgP9J/WeOpFzkAs82c5+RDYx+ITvA3Q5hN6Jh5i7WlbeloyV2wD8cNIC4it",
+	"YL/L7nGf2patzvtA5bqMhC6hRGhFHHcwtt8L+P4qhsDk09qhFI7aS4tR5jMZWbIviFbbeFzEZERvlbpA",
+	"4AMwqLkbakraRZk+vkeNN4v0PTvgi4cV/+gC+4mZDSLZryCxiUFhvOh25vX3wLmMkmdyM3ZTO7zbb+w/",
+	"AWqiKKl4kf/cJGNpr3CuqMhWUWeROXT8S1MhvV6cPczRxOUrKoT1RugNZ18pf/Gvmch7629y7DxrLka2",
+	"7abetcvtLK4BvA2mB8pPCOjlpoAJQqy281zUYX3FUuYE52myZDf3ej9YvF9aMJWZwdYSGCj956QW+7ol",
+	"RqLiNMzvXtA5K07GX5tXQSAQLNKlinT3iUKXEshAMCXSTWqLCs/lJioXedBnSkqTigtqvBFjK0XhFLYJ",
+	"wxl8BENkiR+XuwYr2xHyJBdhtkBrc+uWWmvWE7dtYEaDmc1oMMv5kukENu23VvEBiyYLSzszwj8lYndW",
+	"lulA2CRnQddHX42hn6uhoz4F4T4lAV3VAhVQd2AWbcdo1Q7t+5wV1xXVMHXKiQOes1G3zavEIaqBC7OM",
+	"fPy9vb6ZJcEGZ1IuwqNS+3ZYI2LNJORmf3zrj7/YRNIdWOtaL6G8ci1ahDv1qVLYpPw4I+DWNwT2+Sfl",
+	"Iwnxd2g9eCalao41/DAlUtV0554F00EC/MTCc/vO7d1U8cukxXctc2kSBDnaGBLIbUHCZ1W+ZOZPsfjo",
+	"TgNvf5IlbAGZ4+9O4EG3JpJhsFqeW8HGrOpGwOiN7qbxcR/hi/ccyaTQ1TrmfDDgl9n1SQMw2CE2YAvQ",
+	"DFcQeVtYcBPraxIQ+Uh8h5CEdGCn8utNzuYb+FE9ouSiNUmT+wWwyYVgqv6W8L3ARrOSmlVCncBqL3A7",
+	"Xit/onceqbc9l7cisE44qEK27nKQWo/jFnq6WegDKAezf4ZOpK296+M3fQB+Kl8pueBF8gDUDYJKpfin",
+	"tWhzlx2vqZdgtwiZEP6gq7mSleEiVoRbxsTCn1pHTJcoBmzdXtTT4Qzu52aO+ng1P2nCzRApJNI4NYEz",
+	"WF6zOz1R1IIgIu0CeIDXJo8kXLpZ9DHl+EEljBs7snj3M4aAdDeiDUV86ngVr19oUcyyuq6oxf+0Uyto",
+	"VJJHy6WdR0aZuco9A8S4gxTdZ0KNUXxeNXlZtam3HUEOCRFOpeUKNX6swjYgkD5hyljspJtfR/aj/q2e",
+	"FS4GRcXoGMbucYzEUpZw9JJgdSCwF037N+2voppFtm4NW78WWfk/OJafqhPdzqvFwjlYOPMsAnNCXvmR",
+	"q7KQNLf5F3zQLAb32xoPokYbV/77J4g6bPZ89/YGjT/87nYOj8SAb7vhbbDTp+c1+3sVfWa7D1ZahM54",
+	"IdnCyoSJ3D7jyfeYng6W2arhgmeFr6vC1gMJlQJ2z6cExgEPYWJntX0UM5VyhZ2X1ijS0jHdMyN7EGme",
+	"inw+Rr4lW1FhVldYjmXshRZXvgHhHd9fdHsIsXNCnluPE+0lBjsJyaRYcAXiTj2ds3mixg7+YwzN4Nli",
+	"ZOvwpBWSZYqleq4RsodpuHFJ1mrP8C5+2jfhjC+O7tWXjc8d9f/PmvKJeKoBha4+ui2PPiUSTEy3HIpK",
+	"rKhhN6ydr7jmae5M+/zFbUyrSghLtFGFzFCBh0MowAPnFHBiALIODewp5DvWu2et+DfYK3Y+eoXnO67E",
+	"Pvuty9hxQl46t7CMCil4huWGYjYcTPU5zo1/RGWmdLkBl1ujd86j5e7rrB4Oi8kC+NNJC3EJgcZ+hU21",
+	"1GH/NGzjHpZLZpqLdYrmfl4w58rIhWauHCYQUet50r7bkVnveFPuSUaYxS/hm/IdfPvReS7BESTX3Ioi",
+	"Xi4w1p8TnQ0hIxVQuyDckKVk2q2nnQNX/wp9TjCNcs42705eyCXP3vAljmEjC2DZNoymP9SFD6rxorVU",
+	"5Btoa8vNND+3EibZSS/K0k0aFSnqHe59MhuRRPDQYy5Abj1+ONoAuQ1Gw+HVDoQGCYKJNqwkLodKmzCY",
+	"UjEb5bc2rTBQFLYgNpA+hpR4PPELLrzz66FPoWg/nSlQBY2vs8FogQE0MYamjfOevu9QnQ12gcf4DLJz",
+	"pLfxagPVyKrCpBhH3aCx8FGxJf5QAHUHcs03oKHwoivKY20/HpHX8pzLwmJTdlsJMc44gHHP1kxrHynV",
+	"1WIEx6AvntnugWyy+woKJOd6AKNoxva9ylJJcfdQf+UVrI2wDcuqWvPhVUGdKiRHUH4dZTrFSqnMjoUB",
+	"KXR1bvVM8HG+tYqv7juy0a/ssRWN8jWyHTnXVGu2nhcR88vz+iPLa8qGEwYAFk7hMZ4iXfzc3kkofLBc",
+	"XueX2ufp0h6p9/CAszyDDJLjMYF36f3R0Ux92AFv+h96wpsRjnrEC7lsL+UjW6yG7odwl2M3w7dw5YYp",
+	"8ntFT+2lXGewx4hrid990sc693Kbn/vEbr053fZHNr0DvG8YBfyGFonUMYE7JbWSibWephLIZMl8R9S4",
+	"FKWGkkFmmEz7aEM38buFIu42mwrXtNGa8LnX+8CCaDj2IEJ9HHAfoB9qQ3RJuYuLathNH7POxp02SQ4d",
+	"umaDu4tweYqSNrgfblI5hXyqPfzerVl2zVze8lKxGy4rt2G1osI/pu2v1t8nTN2XXH/Uxv+pPQ0HDcNQ",
+	"kcgu02kzfvjZ+R6gu9U/gZdkb9Nt4dWhjFLfeJnWKRudWBpVGpqxt+1ze0dbn461zIdyEv7wM3nu3bdH",
+	"3TuekGMZzWWO/j2JVKsvXH1l3wzk9tHTvnSdLspyeOpEEsb+5LbhvtOnsrnD+RxSnb7y53dem5u88iXy",
+	"ygsyBgq2iVnsQHHSTTh3ywjblAzrdwW5A9MJascSlMsjhu/8WcGoZgMYDgsjuLYjkXy1eQHtx+WzfMGX",
+	"K4Nlpv6IzhKvdpTRakpnIfMspeaNJbeAwVoOeCdjo/qv0DoWOOX3x/KmphuWGalaoYKKsX2KgsFk3jHm",
+	"93JaaRVTnfzA0/9A6azpJOQt0Vxg7njRJgs1Oq5jVEPE0m/bRJi9YnXRdwV+/W4I+GFBC82itoRkPHkn",
+	"uXAQExYpXhdf2GW+G5d+OdMgzIjnw4iMJ9u4sME5/5LItKkjjovOSCXmKEewKQutk0o7a+GB3uWu0QG5",
+	"hVI5PMLRnc9bry4z2/hyFugotrOkxS7F/e6stVe1S0mvZnU/U+xQ8tZRoBR0GJKCfmhAdqeFTyVaDWBP",
+	"UyqS6Lebkqvtsyq7Zgk66JamTtbfZjCUfd3byvhULJHOEUn6ZI+85O1cFAdCgBcQwHAABVicDsQjhHQo",
+	"F/eaq6DDUxX0WDPtLMTuzrqbRzc4Hzj91uh+3/OfNoOGW9EptZuqvT6dtDKi/5FrI1XiHa1YxnrHdmV7",
+	"OHetLqHtwaYv6gQy1isf7tElE+gVkHdSMY6uNyaFRn37DZutudYsn8Gh33mMsBGxPVzy2rp+M3wja5oz",
+	"UulAmXEAjdknDcvhPVRKTYudcFn2AATmk1Y2uLJ5pq1frx2QNSVRD4dtFL7GwgWDfWgWk8kbFsQ4OfpE",
+	"X0uso47x90K61u1Sq9uDUjykrr1rtn2gSet8XT7fu5h4h699/OW5g+OJ1MKpB2LPIoTgjhIlfpQhn+ld",
+	"APXrzbUA/FCnCCvw5zwXD+6BRZQ57oVBf4aOjL2jn/P7oCspziUZepSb9thY94qMlaSPEnuEyKJb2kHn",
+	"zvv2B7Yd1DZErtSgNgkjQubsE9+xbLFgGW7I4IvkF+BLTY2GqfcfCrzfLcvidRJPrFd6wNVVA1TQA+Ep",
+	"6PHAuf/t4Awbh5SqRAzYyCtPuSmHR5eXhuuaMhALPunYbpHCTxfoeA+cy5NkW9s7MCWctgPnSogkaRaE",
+	"PCNVauOVle1b5edT1t7nzFBeaJeCh9bvgtAZBBzjupXkb12pTKx6Ursb+6KZTPvffIkjO0vBr1lTlcn5",
+	"CGOFBtci6iLkvY/Gxq1hM8LjQC/qmXmTIrKfTry/xza5SlZIELhnQ5qY5qqqs8g80Db3FKpob5lycC2Y",
+	"Uk0QHIzNZkZGFEQ9OHZGbx+GhER+MUw/DsAli62+bqrJNs9Oi9TOAoliawrQqaDma3rOIWR/Y7/7/Nl1",
+	"cNwuR6aaXndnMPDJQbnuITGk+gVxt+XuvNyHOAfZGEPvId2NMewFFZZK5lVmL+jwYNR+X6PdqwZYSdQr",
+	"JuuvsufgUGCx8RdBlYNrtj21tudsBaqSpnpbCL01a9g1BIXROrt9VL+puINHsbQLWB4Fzk/pOTSdlFIW",
+	"s4Sb7mW/jm33DFxzqAJP4O7wafWEzNmD9mmBSchn6B1ax2Hcrra+bmtZMsHyz08IuRA2kakPyQgr6fYm",
+	"Fw/M0PwbnDWvbGlp59R08lbEM0JizSB1T/7mhxnmapqJ/N5T2UGGJzKbRA1dKMquMdQhwSudLDE6SKIj",
+	"pwREZaGISinWu/BC0GKrebQiCDU8I9Q1qG1HUhglC7Io5C1ZKlquWlGadSAh1lFxQUU+v3OGo4QBR32B",
+	"Yw58f1fKOTcZVgwXkhRSltqmT8gqpfkN82GQWvqSAJsZxkDN3esVE63pRK5gxF3qbc5otsKAIKZUby2j",
+	"Q8CBv8lUyV6bQ8offCyTn127c9QPD+aKQNB6Sc2qSUrQYCIIYe779u4G02MusiESnnoZ1XUuBcQwWuq2",
+	"CA4xKyWr5aodztoUdk+Gd5NffIJMv9Mc+Y0jjmkT04a0533CKuH9mS1FWAG3RRLxowqLRF/+WcL4/9Lm",
+	"tiYrhv4Prn5ldo25MpEkTlK+Ltn1DIBWcGB0ysOioSKnVYH3pmAst9k68XZGchCduSELYZ1OdItHDcFh",
+	"+Z77PBhRe9XZKBsHb10YmgOROUXEKBngTT1czYIiUFWiHn5I6xsL4FaV6LEbIqQlTIsjfZ+sDZ5RBUek",
+	"T0gxzotP0sCmOGRhcrY8awN3QgCzli2a8oG0fWZ6n7E7BqsDVBy7RPn76v9aq/LTxdB7YInLUUTb99iN",
+	"0CwCkHDYaqn1wgq4TfZPZR2/kUF6d+zuFr9s/Ll3vmIQEt9hB3ihB1bTrhazHTifOMvQyxopwVKSlNBa",
+	"/i6nLrfARuAOtsjKMbBMW7jfRu229yXw2NPf1I5wcTz3/eVsPkKBtfL7fna6cRUICQcOk7qhnyDNFdZB",
+	"vkB8sPz1OMNciGSLSn1Y+PMLOmrugn6AqSHA5YaJX1AWiEZwuKGcR7fyROZlHkFNpWhBCrkMElLcMEFu",
+	"cUzcafLoSzJ32edLxTKueacwx62sitxrpVGPyRRfOKMAuNAOK053rfNnae5BxgufKIn82LiiGIkPnwbC",
+	"5oh+YqaSOLlRKo9RX48sIviL8qi+GDTmJRZmY5oSKfDukLexGE+11EM5kWrRsvVSAPHTxQ9aKa+ROp2E",
+	"jSJgojTmqDdcMNsHesYd8nTx6SLaEI55tKAfdKXEB3thgJHghhFbHMyyCf9KCnDJ989SdXV47qmuhQSI",
+	"bTpONEZYYkcirIy4Q4K6boVHwQ60TQk2hObIYVJBqPieYVL9mo9jl4frQDms0qy/ztECbAu3Edm1WdvY",
+	"GL+IBicZmmfmY0Lz7A+x7hgbaBECjU4Igkr++uivRDFMa2UkefgQJ3j4cOqa/vVx+zPccA8fRk/HR4sK",
+	"tDhyY7h5oxTTqPm+vYnewRcxm6EzdNEwRzfy1bX0qUJ7Kl3QGsYS+x3fVsM7oB0gmwzpzZ2WTksRB6aH",
+	"BRgsBExIEwUuTAiSsuiHk0VN+R1CwJGiO+/ChXr59NFrN1E5/LWTdN3rBQOUnJtvvMR/4efoRDthR5dT",
+	"5CNndkPN+s4QBrs013gXkgOU+SXXE8Vw/3Mqr5XN3ZTItd/hgpCWfxc7blVOAEMlE0xzjbUB/uKq+nxc",
+	"9HsILH33L0gL6155BLqsDxETWWtr8mCqoCbCiHIIrluk+AESV1YpbrZYbNg7OPC/RKOGv699olycW12e",
+	"0j3CjLxmdbnqxoOq8Qj+XtICGQkVuc3iYIDHkm83dF0WTqtLvn4w/0/25A9P87Mnj/5z/oezL84y9vSL",
+	"r87O6FdP6aOvnjxij//wxdMz9mjx5Vfzx/njp4/nTx8//fKLr7InTx/Nn3751X8+mEwnHEC2gE58abvJ",
+	"n2cXxVLOLl5dzq4A2AYntOTgdnZ3h9bthXSs3tAMrxi2pryYnPuf/n/Pik8yuW6G979OXOWsycqYUp+f",
+	"nt7e3p6EXU6X6DIxM7LKVqd+nrtpB+MXry7rLIY22ht31GaF8xYWTwoX+O31t2+uyMWry5OGYCbnk7OT",
+	"s5NHML4smaAln5xPnuBPeHpWuO+njtgm5+/vppPTFaOFWbk/1swonvlP+pYul0ydYCIz+9PN41P/pj19",
+	"79xF7oa+nQbCGvzc/DXj+Y6eGMp7+t5Xwh1u3So16yQDWO4yFq/yPXP3hAtujUgSGp0Y7OjwDlPOpl4q",
+	"LuEkTW2JrAwuXOgqFabuM6oSGR6HQGlNDXl58Wf0Z3p58WfyNRQ8tTkKNeq8YtNbi3FNApe5BbvvGKGf",
+	"bS9q/6z6qOrJ+a8R/UwdfVTNC54RK7fjEQL6CCi8HrHhYBiPN2kKqjf8GHjs2eyrd++/+MNd7E7qyQs1",
+	"kgKXpRD1RvpqsYi0Nd18nULZxtkfYdy/V0xtm0Ws6WYSAtx/w0Xi9hd8WSn0E2he7HVGEstRCdfkv9/8",
+	"9CORijgF66sgt30KHHefhRAxUa3hanCpAl2S/Mm7Pg7fTSceCjzFj8/OPOty0nFwtE7diQ1m6oRP96kI",
+	"FkUFoT6DQN9OD3FTNAO/O6qt0Q0dynQ1bwTGtihgZDkLB4iqDAdmdPiOWqv2dRXoP/nQGLQDvqtOWcwW",
+	"OriopegRKoIeMqIQvIvd3uHWehr5fXf/NXa3LwyQUsKZ5phYtLlPin4iBu1FwKKu45Lwgjoh/yMrFNlA",
+	"GK8Mi9Wrxxm4DuZ0bpwNhlz9jRo7Dx92F/7wodtzrsmC3SIHpQIbdtHx8OEJ7NTTPVnZoJ2ylWNq1NnZ",
+	"Z7jeZr2km7pMOCVCiplgSwr+9yR4bD49e/SbXeGlwBgCkDWJlaXvppMvfsNbdikMU4IWBFva1Tz5za7m",
+	"DVM3PGPkiq1LqajixZb8SdTJm4Oa83329ydxLcCByiECfWHWa6q2VtQktOY5lQgyew/yn577ZSNFIxel",
+	"YJT5dWLlTyuw+qANsZy8u/MC/shXw1Cz07nc7NGU6aBx+umBlml9+h5Vf8nfT10txvhHtHHbN+upDxWJ",
+	"t2y9at6DzuzugB6KgSjddGnqDjX9cNTBx91Ar/HbgINU5en7ZrRgCpuGso/cnN2sZc78WuViYTORDH0+",
+	"fW//TQ/zHtca+a4FLfVKGj3w6fS9/+/MoviGqQAkm/jr1Dnv1GhFhdx2ZzMjy1Qb793U/qhkUYCvZB91",
+	"rgGWGu9PrLcii/7YH6gVi5f4+fR968/2ydnV8nRVx9+7HnpVGahLFPxiqGFIdBEqgY+V7v59eku5AeHR",
+	"hYLRhWGq39kwWpy6/OSdX5vElr0vmK0z+LEjbpbOCNt+xr+mt6HwagVJps0zmW8HLqLNbM4Fcufw9mi0",
+	"pPZj/+l4N42o60GnWns8RWRzI8lcSZpnVBv4w2Xy7ykE7u75Lu08KTaXEfMCgok6ln5UEfDZMcaGy3yU",
+	"8H3VsmIEpuA111a7+oEF1h5Ez2hOfPmTGXlJC9hwSHTnnkUtbHxoYfPTS4efWJz7aPLXM3/4NKEYN9E5",
+	"nEF1jTFyFTyk4awvmZg5bjOby3zrCiBMFL2FeIm7CB87ZWB/1Uld6i+UG00qYXiB/u++lp6txRMMhGka",
+	"ghZRpWurd61+5fvac8M6MK6Eg4/3cdhbgzGDtSePGkida3lpNcDooGZCQ6vXlNSdpS3zaycPKv/1l9sy",
+	"pJGret0toJrQTVooRvPtDlT0YVboM0NvQZtaRzp50d7uLmC4BpgviJBmBcDWSJuzhXTRLIavmazQ9ah9",
+	"rwElfCdV13S/Uy/dNx+jBhjubYdNQ9ZSG/Ll0xOb1qCQOfMK6ZiqFY3LoaK11i/1ldb/MSZcTZtt4XW4",
+	"k7tpTNHV06xPfQKqGJ0H60vpixsd/FF07uAFCq5WLY2XK4bXRrcNxMiDAj9wmDIqhDSQ0ImxnHx5lgLb",
+	"kUcL7DUX4OM1OT+LKNLeHVWEaLjVOL+gDrHutOK68ccIE/7e6GZ/dkdugA1+SinjdxniX0WG+MWd6CQH",
+	"mrMRd8k+apu2ERZ4vo4/jo5iof3nNsvuvPV+N4L+bgT93Uz2uxH099393Qj6u4nwdxPh/6smwsMFTMvi",
+	"nZUrLUpaXVJHxeAiyX0qsZrFh82mhJta4GoVHMSsZdycENDaKIb6Fg0mIFqQjGorOjk3/DWqX3SVwcP5",
+	"/K2YtSBpBPDPmv/auIK31dnZE0bOPu/20YYXRcib+31RmMVPtqjW1+Tt5O2kN1JL4g8T19heO4f9/+px",
+	"f+rlwMJYOiygXmuRdLVY8IxblBdSLAldysZtH/i2jYcTS4bPEVvdg3DjE3xAQVZYvN2VTn6dtljelwAu",
+	"my3c6SvZIZe4m6TTM+3jI/kfY5Q1/7oi+KFJv+7LJQfHvpv+zjI+Acv45Ezjt+599hG1dp9Ehnx69vQ3",
+	"u6DQkvyjNOQ7OAz3lLVcFrssmi11tBTVRDKFkUF4B9YxQb++A06vmbrx12MT6HJ+eooJF1dSm1M0hrSD",
+	"YMKP72qg3vvrp1T8Bqubvrv7vwMAq90MM0IiAQA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -42,8 +42,10 @@ import (
 	"github.com/algorand/go-algorand/data/account"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/pools"
 	"github.com/algorand/go-algorand/data/transactions"
 	"github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/ledger"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
 	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-algorand/node"
@@ -61,6 +63,19 @@ const maxTealSourceBytes = 200_000
 // become quite large, allow up to 1mb
 const maxTealDryrunBytes = 1_000_000
 
+// maxOnlineStakeHistoryRounds is the maximal number of rounds covered by a single online stake history query.
+const maxOnlineStakeHistoryRounds = 1000
+
+// defaultTopOnlineAccounts and maxTopOnlineAccounts are the default and maximal number of accounts returned by a
+// single top online accounts query.
+const defaultTopOnlineAccounts = 100
+const maxTopOnlineAccounts = 1000
+
+// maxTransactionEventsTxids is the maximal number of transactions a single transaction events query can wait for,
+// and maxTransactionEventsTimeout is the maximal time it waits for.
+const maxTransactionEventsTxids = 64
+const maxTransactionEventsTimeout = 1 * time.Minute
+
 // Handlers is an implementation to the V2 route handler interface defined by the generated code.
 type Handlers struct {
 	Node     NodeInterface
@@ -87,6 +102,13 @@ type LedgerForAPI interface {
 	Block(rnd basics.Round) (blk bookkeeping.Block, err error)
 	AddressTxns(id basics.Address, r basics.Round) ([]transactions.SignedTxnWithAD, error)
 	GetStateDeltaForRound(rnd basics.Round) (ledgercore.StateDelta, error)
+	CatchpointAccountProof(round basics.Round, addr basics.Address) (ledger.CatchpointProof, error)
+	CatchpointResourceProof(round basics.Round, addr basics.Address, cidx basics.CreatableIndex) (ledger.CatchpointProof, error)
+	CatchpointKvProof(round basics.Round, key string) (ledger.CatchpointProof, error)
+	OnlineStakeHistory(first basics.Round, last basics.Round) ([]basics.MicroAlgos, error)
+	TopOnlineAccounts(rnd basics.Round, n uint64) ([]*ledgercore.OnlineAccount, basics.MicroAlgos, error)
+	OnlineStakeExpiry(rnd basics.Round, bucketSize basics.Round) ([]ledger.OnlineStakeExpiryBucket, error)
+	ReplayState(rnd basics.Round, txid transactions.Txid) (ledgercore.ReplayState, error)
 }
 
 // NodeInterface represents node fns used by the handlers.
@@ -98,8 +120,10 @@ type NodeInterface interface {
 	BroadcastSignedTxGroup(txgroup []transactions.SignedTxn) error
 	GetPendingTransaction(txID transactions.Txid) (res node.TxnWithStatus, found bool)
 	GetPendingTxnsFromPool() ([]transactions.SignedTxn, error)
+	WaitForTxnEvents(ctx context.Context, txids []transactions.Txid, address *basics.Address) []pools.TxnEvent
 	SuggestedFee() basics.MicroAlgos
 	StartCatchup(catchpoint string) error
+	StartCatchupFromFile(catchpoint string, catchpointFile string) error
 	AbortCatchup(catchpoint string) error
 	Config() config.Local
 	InstallParticipationKey(partKeyBinary []byte) (account.ParticipationID, error)
@@ -107,9 +131,16 @@ type NodeInterface interface {
 	GetParticipationKey(account.ParticipationID) (account.ParticipationRecord, error)
 	RemoveParticipationKey(account.ParticipationID) error
 	AppendParticipationKeys(id account.ParticipationID, keys account.StateProofKeys) error
+	GetParticipationHistory(id account.ParticipationID) (node.ParticipationHistory, error)
 	SetSyncRound(rnd uint64) error
 	GetSyncRound() uint64
 	UnsetSyncRound()
+	SetBlockTimeStampOffset(offset int64) error
+	GetBlockTimeStampOffset() (int64, error)
+	GenerateDevModeBlocks(count uint64) (basics.Round, error)
+	CreateDevModeSnapshot() (uint64, basics.Round, error)
+	RevertDevModeSnapshot(id uint64) (basics.Round, error)
+	RollbackLedger(rnd basics.Round) (basics.Round, error)
 }
 
 func roundToPtrOrNil(value basics.Round) *uint64 {
@@ -328,6 +359,44 @@ func (v2 *Handlers) AppendKeys(ctx echo.Context, participationID string) error {
 	return nil
 }
 
+// GetParticipationHistory Get the recent participation history of a participation key
+// (GET /v2/participation/{participation-id}/history)
+func (v2 *Handlers) GetParticipationHistory(ctx echo.Context, participationID string) error {
+	decodedParticipationID, err := account.ParseParticipationID(participationID)
+	if err != nil {
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+
+	history, err := v2.Node.GetParticipationHistory(decodedParticipationID)
+	if err != nil {
+		if errors.Is(err, account.ErrParticipationIDNotFound) {
+			return notFound(ctx, account.ErrParticipationIDNotFound, account.ErrParticipationIDNotFound.Error(), v2.Log)
+		}
+		return internalError(ctx, err, err.Error(), v2.Log)
+	}
+
+	response := model.ParticipationHistoryResponse{
+		Id:                     history.ParticipationID.String(),
+		Address:                history.Address.String(),
+		FirstRound:             uint64(history.FirstRound),
+		LastRound:              uint64(history.LastRound),
+		ExpectedVotes:          history.ExpectedVotes,
+		MissedVotes:            history.MissedVotes,
+		ExpectedProposals:      history.ExpectedProposals,
+		MissedProposals:        history.MissedProposals,
+		ConsecutiveMissedVotes: history.ConsecutiveMissedVotes,
+		MissedVoteRounds:       make([]uint64, 0, len(history.MissedVoteRounds)),
+		MissedProposalRounds:   make([]uint64, 0, len(history.MissedProposalRounds)),
+	}
+	for _, rnd := range history.MissedVoteRounds {
+		response.MissedVoteRounds = append(response.MissedVoteRounds, uint64(rnd))
+	}
+	for _, rnd := range history.MissedProposalRounds {
+		response.MissedProposalRounds = append(response.MissedProposalRounds, uint64(rnd))
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
+
 // ShutdownNode shuts down the node.
 // (POST /v2/shutdown)
 func (v2 *Handlers) ShutdownNode(ctx echo.Context, params model.ShutdownNodeParams) error {
@@ -661,6 +730,47 @@ func (v2 *Handlers) GetBlockHash(ctx echo.Context, round uint64) error {
 	return ctx.JSON(http.StatusOK, response)
 }
 
+// GetTransactionReplayState returns the state that the transaction group of a
+// transaction in a block was evaluated against.
+// (GET /v2/blocks/{round}/transactions/{txid}/replay)
+func (v2 *Handlers) GetTransactionReplayState(ctx echo.Context, round uint64, txid string, params model.GetTransactionReplayStateParams) error {
+	handle, contentType, err := getCodecHandle((*model.Format)(params.Format))
+	if err != nil {
+		return badRequest(ctx, err, errFailedParsingFormatOption, v2.Log)
+	}
+
+	var txID transactions.Txid
+	err = txID.UnmarshalText([]byte(txid))
+	if err != nil {
+		return badRequest(ctx, err, errNoValidTxnSpecified, v2.Log)
+	}
+
+	state, err := v2.Node.LedgerForAPI().ReplayState(basics.Round(round), txID)
+	if err != nil {
+		switch err.(type) {
+		case ledgercore.ErrNoEntry, ledgercore.ErrTxnNotInBlock:
+			return notFound(ctx, err, err.Error(), v2.Log)
+		case ledgercore.ErrReplayStateUnavailable:
+			return badRequest(ctx, err, err.Error(), v2.Log)
+		default:
+			return internalError(ctx, err, err.Error(), v2.Log)
+		}
+	}
+
+	response := struct {
+		State ledgercore.ReplayState `codec:"state"`
+	}{
+		State: state,
+	}
+
+	data, err := encode(handle, response)
+	if err != nil {
+		return internalError(ctx, err, errFailedToEncodeResponse, v2.Log)
+	}
+
+	return ctx.Blob(http.StatusOK, contentType, data)
+}
+
 // GetTransactionProof generates a Merkle proof for a transaction in a block.
 // (GET /v2/blocks/{round}/transactions/{txid}/proof)
 func (v2 *Handlers) GetTransactionProof(ctx echo.Context, round uint64, txid string, params model.GetTransactionProofParams) error {
@@ -761,6 +871,111 @@ func (v2 *Handlers) GetSupply(ctx echo.Context) error {
 	return ctx.JSON(http.StatusOK, supply)
 }
 
+// GetOnlineStakeHistory gets the total online stake over a range of rounds.
+// (GET /v2/ledger/online-stake)
+func (v2 *Handlers) GetOnlineStakeHistory(ctx echo.Context, params model.GetOnlineStakeHistoryParams) error {
+	if params.MinRound > params.MaxRound {
+		return badRequest(ctx, nil, errInvalidRoundRange, v2.Log)
+	}
+	if params.MaxRound-params.MinRound >= maxOnlineStakeHistoryRounds {
+		err := fmt.Errorf(errOnlineStakeHistoryTooLong, maxOnlineStakeHistoryRounds)
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+	ledger := v2.Node.LedgerForAPI()
+	if params.MaxRound > uint64(ledger.Latest()) {
+		return badRequest(ctx, nil, errRoundGreaterThanTheLatest, v2.Log)
+	}
+	stakes, err := ledger.OnlineStakeHistory(basics.Round(params.MinRound), basics.Round(params.MaxRound))
+	if err != nil {
+		return internalError(ctx, err, errFailedRetrievingOnlineStake, v2.Log)
+	}
+
+	response := model.OnlineStakeHistoryResponse{
+		OnlineStake: make([]model.RoundOnlineStake, len(stakes)),
+	}
+	for i, stake := range stakes {
+		response.OnlineStake[i] = model.RoundOnlineStake{
+			Round:       params.MinRound + uint64(i),
+			OnlineStake: stake.Raw,
+		}
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// GetTopOnlineAccounts gets the online accounts with the largest stake at a given round.
+// (GET /v2/ledger/online-accounts/top)
+func (v2 *Handlers) GetTopOnlineAccounts(ctx echo.Context, params model.GetTopOnlineAccountsParams) error {
+	limit := uint64(defaultTopOnlineAccounts)
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+	if limit > maxTopOnlineAccounts {
+		err := fmt.Errorf(errTooManyTopOnlineAccounts, maxTopOnlineAccounts)
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+	ledger := v2.Node.LedgerForAPI()
+	rnd := ledger.Latest()
+	if params.Round != nil {
+		if *params.Round > uint64(rnd) {
+			return badRequest(ctx, nil, errRoundGreaterThanTheLatest, v2.Log)
+		}
+		rnd = basics.Round(*params.Round)
+	}
+	accounts, totalStake, err := ledger.TopOnlineAccounts(rnd, limit)
+	if err != nil {
+		return internalError(ctx, err, errFailedRetrievingOnlineAccounts, v2.Log)
+	}
+
+	response := model.TopOnlineAccountsResponse{
+		Round:            uint64(rnd),
+		TotalOnlineStake: totalStake.Raw,
+		Accounts:         make([]model.OnlineAccountStake, len(accounts)),
+	}
+	for i, acct := range accounts {
+		response.Accounts[i] = model.OnlineAccountStake{
+			Address:        acct.Address.String(),
+			Amount:         acct.MicroAlgos.Raw,
+			VoteFirstValid: uint64(acct.VoteFirstValid),
+			VoteLastValid:  uint64(acct.VoteLastValid),
+		}
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
+
+// GetOnlineStakeExpiry gets the online stake at a given round, grouped by participation key expiry.
+// (GET /v2/ledger/online-accounts/expiry)
+func (v2 *Handlers) GetOnlineStakeExpiry(ctx echo.Context, params model.GetOnlineStakeExpiryParams) error {
+	if params.BucketSize == 0 {
+		return badRequest(ctx, nil, errInvalidBucketSize, v2.Log)
+	}
+	ledger := v2.Node.LedgerForAPI()
+	rnd := ledger.Latest()
+	if params.Round != nil {
+		if *params.Round > uint64(rnd) {
+			return badRequest(ctx, nil, errRoundGreaterThanTheLatest, v2.Log)
+		}
+		rnd = basics.Round(*params.Round)
+	}
+	buckets, err := ledger.OnlineStakeExpiry(rnd, basics.Round(params.BucketSize))
+	if err != nil {
+		return internalError(ctx, err, errFailedRetrievingOnlineAccounts, v2.Log)
+	}
+
+	response := model.OnlineStakeExpiryResponse{
+		Round:   uint64(rnd),
+		Buckets: make([]model.OnlineStakeExpiryBucket, len(buckets)),
+	}
+	for i, bucket := range buckets {
+		response.Buckets[i] = model.OnlineStakeExpiryBucket{
+			FirstRound: uint64(bucket.FirstRound),
+			LastRound:  uint64(bucket.LastRound),
+			Accounts:   bucket.Accounts,
+			Stake:      bucket.Stake.Raw,
+		}
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
+
 // GetStatus gets the current node status.
 // (GET /v2/status)
 func (v2 *Handlers) GetStatus(ctx echo.Context) error {
@@ -983,6 +1198,90 @@ func (v2 *Handlers) GetSyncRound(ctx echo.Context) error {
 	return ctx.JSON(http.StatusOK, model.GetSyncRoundResponse{Round: rnd})
 }
 
+// GenerateDevModeBlocks generates blocks on a developer mode network.
+// (POST /v2/devmode/blocks/{count})
+func (v2 *Handlers) GenerateDevModeBlocks(ctx echo.Context, count uint64) error {
+	if count > node.MaxDevModeBlocks {
+		err := fmt.Errorf(errDevModeTooManyBlocks, node.MaxDevModeBlocks)
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+	rnd, err := v2.Node.GenerateDevModeBlocks(count)
+	if err != nil {
+		return v2.wrapDevModeError(ctx, err, errFailedGeneratingDevModeBlocks)
+	}
+	return ctx.JSON(http.StatusOK, model.DevModeRoundResponse{Round: uint64(rnd)})
+}
+
+// GetBlockTimeStampOffset gets the block timestamp offset of a developer mode network.
+// (GET /v2/devmode/blocks/offset)
+func (v2 *Handlers) GetBlockTimeStampOffset(ctx echo.Context) error {
+	offset, err := v2.Node.GetBlockTimeStampOffset()
+	if err != nil {
+		return v2.wrapDevModeError(ctx, err, errFailedRetrievingTimeStampOffset)
+	}
+	return ctx.JSON(http.StatusOK, model.GetBlockTimeStampOffsetResponse{Offset: uint64(offset)})
+}
+
+// SetBlockTimeStampOffset sets the block timestamp offset of a developer mode network.
+// (POST /v2/devmode/blocks/offset/{offset})
+func (v2 *Handlers) SetBlockTimeStampOffset(ctx echo.Context, offset uint64) error {
+	if offset > math.MaxInt64 {
+		return badRequest(ctx, errors.New(errInvalidTimeStampOffset), errInvalidTimeStampOffset, v2.Log)
+	}
+	err := v2.Node.SetBlockTimeStampOffset(int64(offset))
+	if err != nil {
+		return v2.wrapDevModeError(ctx, err, errFailedSettingTimeStampOffset)
+	}
+	return ctx.NoContent(http.StatusOK)
+}
+
+// CreateDevModeSnapshot creates a snapshot of a developer mode network.
+// (POST /v2/devmode/snapshots)
+func (v2 *Handlers) CreateDevModeSnapshot(ctx echo.Context) error {
+	id, rnd, err := v2.Node.CreateDevModeSnapshot()
+	if err != nil {
+		return v2.wrapDevModeError(ctx, err, errFailedCreatingDevModeSnapshot)
+	}
+	return ctx.JSON(http.StatusOK, model.DevModeSnapshotResponse{SnapshotId: id, Round: uint64(rnd)})
+}
+
+// RevertDevModeSnapshot reverts a developer mode network to a snapshot.
+// (POST /v2/devmode/snapshots/{snapshot-id}/revert)
+func (v2 *Handlers) RevertDevModeSnapshot(ctx echo.Context, snapshotID uint64) error {
+	rnd, err := v2.Node.RevertDevModeSnapshot(snapshotID)
+	if err != nil {
+		return v2.wrapDevModeError(ctx, err, errFailedRevertingDevModeSnapshot)
+	}
+	return ctx.JSON(http.StatusOK, model.DevModeRoundResponse{Round: uint64(rnd)})
+}
+
+func (v2 *Handlers) wrapDevModeError(ctx echo.Context, err error, internalErrMsg string) error {
+	if errors.Is(err, node.ErrDevModeDisabled) || errors.Is(err, node.ErrDevModeSnapshotNotFound) {
+		return notFound(ctx, err, err.Error(), v2.Log)
+	}
+	if errors.Is(err, node.ErrDevModeTimestampOverflow) {
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+	return internalError(ctx, err, internalErrMsg, v2.Log)
+}
+
+// RollbackLedger rolls the ledger back to an earlier round.
+// (POST /v2/ledger/rollback/{round})
+func (v2 *Handlers) RollbackLedger(ctx echo.Context, round uint64) error {
+	if round > uint64(v2.Node.LedgerForAPI().Latest()) {
+		return badRequest(ctx, nil, errRoundGreaterThanTheLatest, v2.Log)
+	}
+	rnd, err := v2.Node.RollbackLedger(basics.Round(round))
+	if err != nil {
+		var beyondErr ledgercore.ErrRollbackBeyondDeltas
+		if errors.Is(err, node.ErrRollbackPublicNetwork) || errors.As(err, &beyondErr) {
+			return badRequest(ctx, err, err.Error(), v2.Log)
+		}
+		return internalError(ctx, err, errFailedRollingBackLedger, v2.Log)
+	}
+	return ctx.JSON(http.StatusOK, model.RollbackLedgerResponse{Round: uint64(rnd)})
+}
+
 // GetLedgerStateDelta returns the deltas for a given round.
 // This should be a representation of the ledgercore.StateDelta object.
 // (GET /v2/deltas/{round})
@@ -1116,6 +1415,78 @@ func (v2 *Handlers) PendingTransactionInformation(ctx echo.Context, txid string,
 	return ctx.Blob(http.StatusOK, contentType, data)
 }
 
+// WaitForTransactionEvents waits until any of the given pending transactions is committed or removed from the pool.
+// (GET /v2/transactions/events)
+func (v2 *Handlers) WaitForTransactionEvents(ctx echo.Context, params model.WaitForTransactionEventsParams) error {
+	stat, err := v2.Node.Status()
+	if err != nil {
+		return internalError(ctx, err, errFailedRetrievingNodeStatus, v2.Log)
+	}
+	if stat.Catchpoint != "" {
+		// node is currently catching up to the requested catchpoint.
+		return serviceUnavailable(ctx, fmt.Errorf("WaitForTransactionEvents failed as the node was catchpoint catchuping"), errOperationNotAvailableDuringCatchup, v2.Log)
+	}
+
+	var txids []transactions.Txid
+	if params.Txid != nil {
+		if len(*params.Txid) > maxTransactionEventsTxids {
+			err := fmt.Errorf(errTooManyTransactionsToWaitFor, maxTransactionEventsTxids)
+			return badRequest(ctx, err, err.Error(), v2.Log)
+		}
+		txids = make([]transactions.Txid, len(*params.Txid))
+		for i, txid := range *params.Txid {
+			if err := txids[i].UnmarshalText([]byte(txid)); err != nil {
+				return badRequest(ctx, err, errNoValidTxnSpecified, v2.Log)
+			}
+		}
+	}
+
+	var addrPtr *basics.Address
+	if params.Address != nil {
+		addr, err := basics.UnmarshalChecksumAddress(*params.Address)
+		if err != nil {
+			return badRequest(ctx, err, errFailedToParseAddress, v2.Log)
+		}
+		addrPtr = &addr
+	}
+
+	if len(txids) == 0 && addrPtr == nil {
+		return badRequest(ctx, nil, errNoTransactionsToWaitFor, v2.Log)
+	}
+
+	timeout := maxTransactionEventsTimeout
+	if params.Timeout != nil {
+		if *params.Timeout > uint64(maxTransactionEventsTimeout/time.Second) {
+			err := fmt.Errorf(errTransactionEventsTimeoutTooLong, uint64(maxTransactionEventsTimeout/time.Second))
+			return badRequest(ctx, err, err.Error(), v2.Log)
+		}
+		timeout = time.Duration(*params.Timeout) * time.Second
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx.Request().Context(), timeout)
+	defer cancel()
+	go func() {
+		select {
+		case <-v2.Shutdown:
+			cancel()
+		case <-waitCtx.Done():
+		}
+	}()
+	events := v2.Node.WaitForTxnEvents(waitCtx, txids, addrPtr)
+
+	response := model.TransactionEventsResponse{
+		Events: make([]model.TransactionEvent, len(events)),
+	}
+	for i, event := range events {
+		response.Events[i] = model.TransactionEvent{
+			Txid:           event.Txid.String(),
+			ConfirmedRound: roundToPtrOrNil(event.ConfirmedRound),
+			PoolError:      strOrNil(event.PoolError),
+		}
+	}
+	return ctx.JSON(http.StatusOK, response)
+}
+
 // getPendingTransactions returns to the provided context a list of uncomfirmed transactions currently in the transaction pool with optional Max/Address filters.
 func (v2 *Handlers) getPendingTransactions(ctx echo.Context, max *uint64, format *string, addrFilter *string) error {
 
@@ -1192,8 +1563,9 @@ func (v2 *Handlers) getPendingTransactions(ctx echo.Context, max *uint64, format
 	return ctx.Blob(http.StatusOK, contentType, data)
 }
 
-// startCatchup Given a catchpoint, it starts catching up to this catchpoint
-func (v2 *Handlers) startCatchup(ctx echo.Context, catchpoint string) error {
+// startCatchup Given a catchpoint, it starts catching up to this catchpoint, loading the catchpoint file from the
+// given path if it isn't empty
+func (v2 *Handlers) startCatchup(ctx echo.Context, catchpoint string, catchpointFile string) error {
 	_, _, err := ledgercore.ParseCatchpointLabel(catchpoint)
 	if err != nil {
 		return badRequest(ctx, err, errFailedToParseCatchpoint, v2.Log)
@@ -1201,7 +1573,11 @@ func (v2 *Handlers) startCatchup(ctx echo.Context, catchpoint string) error {
 
 	// Select 200/201, or return an error
 	var code int
-	err = v2.Node.StartCatchup(catchpoint)
+	if catchpointFile != "" {
+		err = v2.Node.StartCatchupFromFile(catchpoint, catchpointFile)
+	} else {
+		err = v2.Node.StartCatchup(catchpoint)
+	}
 	switch err.(type) {
 	case nil:
 		code = http.StatusCreated
@@ -1397,8 +1773,12 @@ func (v2 *Handlers) GetPendingTransactionsByAddress(ctx echo.Context, addr strin
 
 // StartCatchup Given a catchpoint, it starts catching up to this catchpoint
 // (POST /v2/catchup/{catchpoint})
-func (v2 *Handlers) StartCatchup(ctx echo.Context, catchpoint string) error {
-	return v2.startCatchup(ctx, catchpoint)
+func (v2 *Handlers) StartCatchup(ctx echo.Context, catchpoint string, params model.StartCatchupParams) error {
+	catchpointFile := ""
+	if params.File != nil {
+		catchpointFile = *params.File
+	}
+	return v2.startCatchup(ctx, catchpoint, catchpointFile)
 }
 
 // AbortCatchup Given a catchpoint, it aborts catching up to this catchpoint
@@ -1457,6 +1837,14 @@ func (v2 *Handlers) TealCompile(ctx echo.Context, params model.TealCompileParams
 		},
 		sourcemap,
 	}
+
+	if params.Analyze != nil && *params.Analyze {
+		analysis, err := ops.Analyze()
+		if err != nil {
+			return badRequest(ctx, err, err.Error(), v2.Log)
+		}
+		response.Analysis = convertProgramAnalysis(analysis)
+	}
 	return ctx.JSON(http.StatusOK, response)
 }
 
@@ -1537,9 +1925,100 @@ func (v2 *Handlers) GetLightBlockHeaderProof(ctx echo.Context, round uint64) err
 	return ctx.JSON(http.StatusOK, response)
 }
 
+// GetCatchpointAccountProof returns a proof of an account state captured by a catchpoint.
+// (GET /v2/catchpoints/{round}/proof/accounts/{address})
+func (v2 *Handlers) GetCatchpointAccountProof(ctx echo.Context, round uint64, address string, params model.GetCatchpointAccountProofParams) error {
+	addr, err := basics.UnmarshalChecksumAddress(address)
+	if err != nil {
+		return badRequest(ctx, err, errFailedToParseAddress, v2.Log)
+	}
+	if params.AssetId != nil && params.ApplicationId != nil {
+		return badRequest(ctx, errors.New(errCatchpointProofMultipleResources), errCatchpointProofMultipleResources, v2.Log)
+	}
+
+	lg := v2.Node.LedgerForAPI()
+	var proof ledger.CatchpointProof
+	switch {
+	case params.AssetId != nil:
+		proof, err = lg.CatchpointResourceProof(basics.Round(round), addr, basics.CreatableIndex(*params.AssetId))
+	case params.ApplicationId != nil:
+		proof, err = lg.CatchpointResourceProof(basics.Round(round), addr, basics.CreatableIndex(*params.ApplicationId))
+	default:
+		proof, err = lg.CatchpointAccountProof(basics.Round(round), addr)
+	}
+	if err != nil {
+		return v2.wrapCatchpointProofError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, catchpointProofResponse(&proof))
+}
+
+// GetCatchpointBoxProof returns a proof of a box captured by a catchpoint.
+// (GET /v2/catchpoints/{round}/proof/applications/{application-id}/box)
+func (v2 *Handlers) GetCatchpointBoxProof(ctx echo.Context, round uint64, applicationID uint64, params model.GetCatchpointBoxProofParams) error {
+	boxNameBytes, err := logic.NewAppCallBytes(params.Name)
+	if err != nil {
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+	boxName, err := boxNameBytes.Raw()
+	if err != nil {
+		return badRequest(ctx, err, err.Error(), v2.Log)
+	}
+
+	lg := v2.Node.LedgerForAPI()
+	proof, err := lg.CatchpointKvProof(basics.Round(round), logic.MakeBoxKey(basics.AppIndex(applicationID), string(boxName)))
+	if err != nil {
+		return v2.wrapCatchpointProofError(ctx, err)
+	}
+	return ctx.JSON(http.StatusOK, catchpointProofResponse(&proof))
+}
+
+func (v2 *Handlers) wrapCatchpointProofError(ctx echo.Context, err error) error {
+	var noEntry ledgercore.ErrNoEntry
+	if errors.As(err, &noEntry) {
+		return notFound(ctx, err, errCatchpointFileNotFound, v2.Log)
+	}
+	if errors.Is(err, ledger.ErrCatchpointProofEntryNotFound) {
+		return notFound(ctx, err, err.Error(), v2.Log)
+	}
+	return internalError(ctx, err, errFailedLookingUpLedger, v2.Log)
+}
+
+func catchpointProofResponse(proof *ledger.CatchpointProof) model.CatchpointProofResponse {
+	response := model.CatchpointProofResponse{
+		Catchpoint:        proof.Catchpoint,
+		BalancesRound:     uint64(proof.BalancesRound),
+		BlockHeaderDigest: proof.BlockHeaderDigest[:],
+		BalancesRoot:      proof.BalancesRoot[:],
+		Totals:            protocol.EncodeReflect(&proof.Totals),
+		Key:               proof.Key,
+		Value:             proof.Value,
+		Proof:             protocol.Encode(&proof.Proof),
+	}
+	// the element the key was derived from, for clients to derive the key again
+	if proof.KvKey != "" {
+		kvKey := []byte(proof.KvKey)
+		response.KvKey = &kvKey
+		return response
+	}
+	address := proof.Address.String()
+	response.Address = &address
+	if proof.CreatableIndex != 0 {
+		cidx := uint64(proof.CreatableIndex)
+		response.CreatableIndex = &cidx
+	}
+	return response
+}
+
+// DisassembleResponseWithSourceMap overrides the sourcemap field in
+// the DisassembleResponse for JSON marshalling.
+type DisassembleResponseWithSourceMap struct {
+	model.DisassembleResponse
+	Sourcemap *logic.SourceMap `json:"sourcemap,omitempty"`
+}
+
 // TealDisassemble disassembles the program bytecode in base64 into TEAL code.
 // (POST /v2/teal/disassemble)
-func (v2 *Handlers) TealDisassemble(ctx echo.Context) error {
+func (v2 *Handlers) TealDisassemble(ctx echo.Context, params model.TealDisassembleParams) error {
 	// return early if teal compile is not allowed in node config
 	if !v2.Node.Config().EnableDeveloperAPI {
 		return ctx.String(http.StatusNotFound, "/teal/disassemble was not enabled in the configuration file by setting the EnableDeveloperAPI to true")
@@ -1551,12 +2030,31 @@ func (v2 *Handlers) TealDisassemble(ctx echo.Context) error {
 		return badRequest(ctx, err, err.Error(), v2.Log)
 	}
 	sourceProgram := buf.Bytes()
-	program, err := logic.Disassemble(sourceProgram)
+	if params.Annotate == nil || !*params.Annotate {
+		program, err := logic.Disassemble(sourceProgram)
+		if err != nil {
+			return badRequest(ctx, err, err.Error(), v2.Log)
+		}
+		response := model.DisassembleResponse{
+			Result: program,
+		}
+		return ctx.JSON(http.StatusOK, response)
+	}
+
+	var methods []string
+	if params.Method != nil {
+		methods = *params.Method
+	}
+	program, offsetToLine, err := logic.DisassembleAnnotated(sourceProgram, methods)
 	if err != nil {
 		return badRequest(ctx, err, err.Error(), v2.Log)
 	}
-	response := model.DisassembleResponse{
-		Result: program,
+	sourcemap := logic.GetSourceMap([]string{}, offsetToLine)
+	response := DisassembleResponseWithSourceMap{
+		model.DisassembleResponse{
+			Result: program,
+		},
+		&sourcemap,
 	}
 	return ctx.JSON(http.StatusOK, response)
 }
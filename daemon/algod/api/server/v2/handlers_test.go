@@ -20,6 +20,8 @@ import (
 	"math"
 	"testing"
 
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger"
 	"github.com/algorand/go-algorand/test/partitiontest"
 	"github.com/stretchr/testify/require"
 )
@@ -39,3 +41,31 @@ func TestApplicationBoxesMaxKeys(t *testing.T) {
 	// Response size _not_ limited
 	require.Equal(t, uint64(math.MaxUint64), applicationBoxesMaxKeys(0, 0))
 }
+
+func TestCatchpointProofResponse(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	// account resources name their account and creatable
+	proof := ledger.CatchpointProof{Address: basics.Address{1}, CreatableIndex: 7}
+	response := catchpointProofResponse(&proof)
+	require.NotNil(t, response.Address)
+	require.Equal(t, proof.Address.String(), *response.Address)
+	require.NotNil(t, response.CreatableIndex)
+	require.Equal(t, uint64(7), *response.CreatableIndex)
+	require.Nil(t, response.KvKey)
+
+	// account data name their account only
+	proof.CreatableIndex = 0
+	response = catchpointProofResponse(&proof)
+	require.Equal(t, proof.Address.String(), *response.Address)
+	require.Nil(t, response.CreatableIndex)
+
+	// boxes name their key-value store key only
+	proof = ledger.CatchpointProof{KvKey: "bx:box"}
+	response = catchpointProofResponse(&proof)
+	require.Nil(t, response.Address)
+	require.Nil(t, response.CreatableIndex)
+	require.NotNil(t, response.KvKey)
+	require.Equal(t, []byte("bx:box"), *response.KvKey)
+}
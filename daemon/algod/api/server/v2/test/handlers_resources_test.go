@@ -30,6 +30,7 @@ import (
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/bookkeeping"
 	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/ledger"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
 	ledgertesting "github.com/algorand/go-algorand/ledger/testing"
 	"github.com/algorand/go-algorand/logging"
@@ -139,6 +140,28 @@ func (l *mockLedger) Block(rnd basics.Round) (blk bookkeeping.Block, err error)
 	return l.blocks[0], nil
 }
 
+func (l *mockLedger) CatchpointAccountProof(round basics.Round, addr basics.Address) (ledger.CatchpointProof, error) {
+	return ledger.CatchpointProof{}, ledgercore.ErrNoEntry{Round: round}
+}
+func (l *mockLedger) CatchpointResourceProof(round basics.Round, addr basics.Address, cidx basics.CreatableIndex) (ledger.CatchpointProof, error) {
+	return ledger.CatchpointProof{}, ledgercore.ErrNoEntry{Round: round}
+}
+func (l *mockLedger) CatchpointKvProof(round basics.Round, key string) (ledger.CatchpointProof, error) {
+	return ledger.CatchpointProof{}, ledgercore.ErrNoEntry{Round: round}
+}
+func (l *mockLedger) OnlineStakeHistory(first basics.Round, last basics.Round) ([]basics.MicroAlgos, error) {
+	panic("not implemented")
+}
+func (l *mockLedger) TopOnlineAccounts(rnd basics.Round, n uint64) ([]*ledgercore.OnlineAccount, basics.MicroAlgos, error) {
+	panic("not implemented")
+}
+func (l *mockLedger) OnlineStakeExpiry(rnd basics.Round, bucketSize basics.Round) ([]ledger.OnlineStakeExpiryBucket, error) {
+	panic("not implemented")
+}
+func (l *mockLedger) ReplayState(rnd basics.Round, txid transactions.Txid) (ledgercore.ReplayState, error) {
+	panic("not implemented")
+}
+
 func (l *mockLedger) AddressTxns(id basics.Address, r basics.Round) ([]transactions.SignedTxnWithAD, error) {
 	blk := l.blocks[r]
 
@@ -23,6 +23,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"math"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -46,9 +47,11 @@ import (
 	"github.com/algorand/go-algorand/data/account"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/pools"
 	"github.com/algorand/go-algorand/data/stateproofmsg"
 	"github.com/algorand/go-algorand/data/transactions"
 	"github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
 	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-algorand/node"
 	"github.com/algorand/go-algorand/protocol"
@@ -223,6 +226,304 @@ func TestSyncRound(t *testing.T) {
 	mock.AssertExpectationsForObjects(t, mockNode)
 }
 
+func TestDevMode(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	numAccounts := 1
+	numTransactions := 1
+	offlineAccounts := true
+	mockLedger, _, _, _, releasefunc := testingenv(t, numAccounts, numTransactions, offlineAccounts)
+	defer releasefunc()
+	mockNode := makeMockNode(mockLedger, t.Name(), nil)
+	dummyShutdownChan := make(chan struct{})
+	handler := v2.Handlers{
+		Node:     mockNode,
+		Log:      logging.Base(),
+		Shutdown: dummyShutdownChan,
+	}
+
+	// GenerateDevModeBlocks 200
+	c, rec := newReq(t)
+	mockCall := mockNode.On("GenerateDevModeBlocks", uint64(3)).Return(3, nil)
+	err := handler.GenerateDevModeBlocks(c, 3)
+	require.NoError(t, err)
+	require.Equal(t, 200, rec.Code)
+	var roundResponse model.DevModeRoundResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &roundResponse))
+	require.Equal(t, uint64(3), roundResponse.Round)
+	mockCall.Unset()
+	// GenerateDevModeBlocks 400 too many blocks
+	c, rec = newReq(t)
+	err = handler.GenerateDevModeBlocks(c, node.MaxDevModeBlocks+1)
+	require.NoError(t, err)
+	require.Equal(t, 400, rec.Code)
+	// GenerateDevModeBlocks 404 not in developer mode
+	c, rec = newReq(t)
+	mockCall = mockNode.On("GenerateDevModeBlocks", uint64(1)).Return(0, node.ErrDevModeDisabled)
+	err = handler.GenerateDevModeBlocks(c, 1)
+	require.NoError(t, err)
+	require.Equal(t, 404, rec.Code)
+	mockCall.Unset()
+
+	// SetBlockTimeStampOffset 200
+	c, rec = newReq(t)
+	mockCall = mockNode.On("SetBlockTimeStampOffset", int64(3600)).Return(nil)
+	err = handler.SetBlockTimeStampOffset(c, 3600)
+	require.NoError(t, err)
+	require.Equal(t, 200, rec.Code)
+	mockCall.Unset()
+	// SetBlockTimeStampOffset 400 overflowing offset
+	c, rec = newReq(t)
+	err = handler.SetBlockTimeStampOffset(c, math.MaxUint64)
+	require.NoError(t, err)
+	require.Equal(t, 400, rec.Code)
+
+	// GetBlockTimeStampOffset 200
+	c, rec = newReq(t)
+	mockCall = mockNode.On("GetBlockTimeStampOffset").Return(3600, nil)
+	err = handler.GetBlockTimeStampOffset(c)
+	require.NoError(t, err)
+	require.Equal(t, 200, rec.Code)
+	var offsetResponse model.GetBlockTimeStampOffsetResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &offsetResponse))
+	require.Equal(t, uint64(3600), offsetResponse.Offset)
+	mockCall.Unset()
+
+	// CreateDevModeSnapshot 200
+	c, rec = newReq(t)
+	mockCall = mockNode.On("CreateDevModeSnapshot").Return(1, 3, nil)
+	err = handler.CreateDevModeSnapshot(c)
+	require.NoError(t, err)
+	require.Equal(t, 200, rec.Code)
+	var snapshotResponse model.DevModeSnapshotResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &snapshotResponse))
+	require.Equal(t, model.DevModeSnapshotResponse{SnapshotId: 1, Round: 3}, snapshotResponse)
+	mockCall.Unset()
+
+	// RevertDevModeSnapshot 200
+	c, rec = newReq(t)
+	mockCall = mockNode.On("RevertDevModeSnapshot", uint64(1)).Return(3, nil)
+	err = handler.RevertDevModeSnapshot(c, 1)
+	require.NoError(t, err)
+	require.Equal(t, 200, rec.Code)
+	mockCall.Unset()
+	// RevertDevModeSnapshot 404 unknown snapshot
+	c, rec = newReq(t)
+	mockCall = mockNode.On("RevertDevModeSnapshot", uint64(2)).Return(0, node.ErrDevModeSnapshotNotFound)
+	err = handler.RevertDevModeSnapshot(c, 2)
+	require.NoError(t, err)
+	require.Equal(t, 404, rec.Code)
+	mockCall.Unset()
+	// RevertDevModeSnapshot 500 InternalError
+	c, rec = newReq(t)
+	mockCall = mockNode.On("RevertDevModeSnapshot", uint64(1)).Return(0, fmt.Errorf("unknown error"))
+	err = handler.RevertDevModeSnapshot(c, 1)
+	require.NoError(t, err)
+	require.Equal(t, 500, rec.Code)
+	mockCall.Unset()
+}
+
+func TestRollbackLedger(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	numAccounts := 1
+	numTransactions := 1
+	offlineAccounts := true
+	mockLedger, _, _, _, releasefunc := testingenv(t, numAccounts, numTransactions, offlineAccounts)
+	defer releasefunc()
+	mockNode := makeMockNode(mockLedger, t.Name(), nil)
+	dummyShutdownChan := make(chan struct{})
+	handler := v2.Handlers{
+		Node:     mockNode,
+		Log:      logging.Base(),
+		Shutdown: dummyShutdownChan,
+	}
+	latest := mockLedger.Latest()
+
+	// RollbackLedger 200
+	c, rec := newReq(t)
+	mockCall := mockNode.On("RollbackLedger", latest).Return(int(latest), nil)
+	err := handler.RollbackLedger(c, uint64(latest))
+	require.NoError(t, err)
+	require.Equal(t, 200, rec.Code)
+	var response model.RollbackLedgerResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	require.Equal(t, uint64(latest), response.Round)
+	mockCall.Unset()
+	// RollbackLedger 400 round beyond the latest
+	c, rec = newReq(t)
+	err = handler.RollbackLedger(c, uint64(latest)+1)
+	require.NoError(t, err)
+	require.Equal(t, 400, rec.Code)
+	// RollbackLedger 400 public network
+	c, rec = newReq(t)
+	mockCall = mockNode.On("RollbackLedger", latest).Return(int(latest), node.ErrRollbackPublicNetwork)
+	err = handler.RollbackLedger(c, uint64(latest))
+	require.NoError(t, err)
+	require.Equal(t, 400, rec.Code)
+	mockCall.Unset()
+	// RollbackLedger 400 round before the state deltas
+	c, rec = newReq(t)
+	mockCall = mockNode.On("RollbackLedger", latest).Return(int(latest), ledgercore.ErrRollbackBeyondDeltas{Round: latest, Earliest: latest + 1})
+	err = handler.RollbackLedger(c, uint64(latest))
+	require.NoError(t, err)
+	require.Equal(t, 400, rec.Code)
+	mockCall.Unset()
+	// RollbackLedger 500 InternalError
+	c, rec = newReq(t)
+	mockCall = mockNode.On("RollbackLedger", latest).Return(int(latest), fmt.Errorf("unknown error"))
+	err = handler.RollbackLedger(c, uint64(latest))
+	require.NoError(t, err)
+	require.Equal(t, 500, rec.Code)
+	mockCall.Unset()
+}
+
+func TestGetParticipationHistory(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	numAccounts := 1
+	numTransactions := 1
+	offlineAccounts := true
+	mockLedger, _, _, _, releasefunc := testingenv(t, numAccounts, numTransactions, offlineAccounts)
+	defer releasefunc()
+	mockNode := makeMockNode(mockLedger, t.Name(), nil)
+	dummyShutdownChan := make(chan struct{})
+	handler := v2.Handlers{
+		Node:     mockNode,
+		Log:      logging.Base(),
+		Shutdown: dummyShutdownChan,
+	}
+	id := account.ParticipationID{1}
+	history := node.ParticipationHistory{
+		ParticipationID:        id,
+		Address:                basics.Address{2},
+		FirstRound:             10,
+		LastRound:              20,
+		ExpectedVotes:          5,
+		MissedVotes:            2,
+		ExpectedProposals:      1,
+		MissedProposals:        1,
+		ConsecutiveMissedVotes: 2,
+		MissedVoteRounds:       []basics.Round{18, 20},
+		MissedProposalRounds:   []basics.Round{15},
+	}
+
+	// GetParticipationHistory 200
+	c, rec := newReq(t)
+	mockCall := mockNode.On("GetParticipationHistory", id).Return(history, nil)
+	err := handler.GetParticipationHistory(c, id.String())
+	require.NoError(t, err)
+	require.Equal(t, 200, rec.Code)
+	var response model.ParticipationHistoryResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	require.Equal(t, id.String(), response.Id)
+	require.Equal(t, history.Address.String(), response.Address)
+	require.Equal(t, uint64(10), response.FirstRound)
+	require.Equal(t, uint64(20), response.LastRound)
+	require.Equal(t, uint64(2), response.MissedVotes)
+	require.Equal(t, []uint64{18, 20}, response.MissedVoteRounds)
+	require.Equal(t, []uint64{15}, response.MissedProposalRounds)
+	mockCall.Unset()
+	// GetParticipationHistory 400 malformed id
+	c, rec = newReq(t)
+	err = handler.GetParticipationHistory(c, "not-an-id")
+	require.NoError(t, err)
+	require.Equal(t, 400, rec.Code)
+	// GetParticipationHistory 404 unknown id
+	c, rec = newReq(t)
+	mockCall = mockNode.On("GetParticipationHistory", id).Return(node.ParticipationHistory{}, account.ErrParticipationIDNotFound)
+	err = handler.GetParticipationHistory(c, id.String())
+	require.NoError(t, err)
+	require.Equal(t, 404, rec.Code)
+	mockCall.Unset()
+	// GetParticipationHistory 500 InternalError
+	c, rec = newReq(t)
+	mockCall = mockNode.On("GetParticipationHistory", id).Return(node.ParticipationHistory{}, fmt.Errorf("unknown error"))
+	err = handler.GetParticipationHistory(c, id.String())
+	require.NoError(t, err)
+	require.Equal(t, 500, rec.Code)
+	mockCall.Unset()
+}
+
+func TestOnlineStakeAnalytics(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	numAccounts := 4
+	numTransactions := 1
+	offlineAccounts := false
+	mockLedger, _, _, _, releasefunc := testingenv(t, numAccounts, numTransactions, offlineAccounts)
+	defer releasefunc()
+	mockNode := makeMockNode(mockLedger, t.Name(), nil)
+	handler := v2.Handlers{
+		Node:     mockNode,
+		Log:      logging.Base(),
+		Shutdown: make(chan struct{}),
+	}
+	latest := uint64(mockLedger.Latest())
+	rnd := uint64(0)
+
+	// GetOnlineStakeHistory 200
+	c, rec := newReq(t)
+	err := handler.GetOnlineStakeHistory(c, model.GetOnlineStakeHistoryParams{MinRound: 0, MaxRound: latest})
+	require.NoError(t, err)
+	require.Equal(t, 200, rec.Code)
+	var historyResponse model.OnlineStakeHistoryResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &historyResponse))
+	require.Len(t, historyResponse.OnlineStake, int(latest)+1)
+	require.Equal(t, uint64(0), historyResponse.OnlineStake[0].Round)
+	require.NotZero(t, historyResponse.OnlineStake[0].OnlineStake)
+	// GetOnlineStakeHistory 400 invalid ranges
+	for _, params := range []model.GetOnlineStakeHistoryParams{
+		{MinRound: 1, MaxRound: 0},
+		{MinRound: 0, MaxRound: latest + 1},
+		{MinRound: 0, MaxRound: 2000},
+	} {
+		c, rec = newReq(t)
+		err = handler.GetOnlineStakeHistory(c, params)
+		require.NoError(t, err)
+		require.Equal(t, 400, rec.Code)
+	}
+
+	// GetTopOnlineAccounts 200
+	c, rec = newReq(t)
+	limit := uint64(2)
+	err = handler.GetTopOnlineAccounts(c, model.GetTopOnlineAccountsParams{Round: &rnd, Limit: &limit})
+	require.NoError(t, err)
+	require.Equal(t, 200, rec.Code)
+	var topResponse model.TopOnlineAccountsResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &topResponse))
+	require.Equal(t, rnd, topResponse.Round)
+	require.Len(t, topResponse.Accounts, 2)
+	require.GreaterOrEqual(t, topResponse.Accounts[0].Amount, topResponse.Accounts[1].Amount)
+	require.Equal(t, historyResponse.OnlineStake[0].OnlineStake, topResponse.TotalOnlineStake)
+	// GetTopOnlineAccounts 400 too many accounts
+	c, rec = newReq(t)
+	limit = 1001
+	err = handler.GetTopOnlineAccounts(c, model.GetTopOnlineAccountsParams{Limit: &limit})
+	require.NoError(t, err)
+	require.Equal(t, 400, rec.Code)
+
+	// GetOnlineStakeExpiry 200
+	c, rec = newReq(t)
+	err = handler.GetOnlineStakeExpiry(c, model.GetOnlineStakeExpiryParams{Round: &rnd, BucketSize: 1000})
+	require.NoError(t, err)
+	require.Equal(t, 200, rec.Code)
+	var expiryResponse model.OnlineStakeExpiryResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &expiryResponse))
+	require.Len(t, expiryResponse.Buckets, 1)
+	require.Equal(t, uint64(numAccounts), expiryResponse.Buckets[0].Accounts)
+	require.Equal(t, topResponse.TotalOnlineStake, expiryResponse.Buckets[0].Stake)
+	// GetOnlineStakeExpiry 400 zero bucket size
+	c, rec = newReq(t)
+	err = handler.GetOnlineStakeExpiry(c, model.GetOnlineStakeExpiryParams{BucketSize: 0})
+	require.NoError(t, err)
+	require.Equal(t, 400, rec.Code)
+}
+
 func addBlockHelper(t *testing.T) (v2.Handlers, echo.Context, *httptest.ResponseRecorder, transactions.SignedTxn, func()) {
 	handler, c, rec, _, _, releasefunc := setupTestForMethodGet(t)
 
@@ -321,6 +622,41 @@ func TestGetBlockHash(t *testing.T) {
 	require.Equal(t, 404, rec.Code)
 }
 
+func TestGetCatchpointProof(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	handler, c, rec, rootkeys, _, releasefunc := setupTestForMethodGet(t)
+	defer releasefunc()
+	address := rootkeys[0].Address().String()
+
+	err := handler.GetCatchpointAccountProof(c, 0, "malformed", model.GetCatchpointAccountProofParams{})
+	require.NoError(t, err)
+	require.Equal(t, 400, rec.Code)
+
+	assetID, appID := uint64(1), uint64(2)
+	c, rec = newReq(t)
+	err = handler.GetCatchpointAccountProof(c, 0, address, model.GetCatchpointAccountProofParams{AssetId: &assetID, ApplicationId: &appID})
+	require.NoError(t, err)
+	require.Equal(t, 400, rec.Code)
+
+	// the node doesn't hold any catchpoint files.
+	c, rec = newReq(t)
+	err = handler.GetCatchpointAccountProof(c, 0, address, model.GetCatchpointAccountProofParams{})
+	require.NoError(t, err)
+	require.Equal(t, 404, rec.Code)
+
+	c, rec = newReq(t)
+	err = handler.GetCatchpointBoxProof(c, 0, appID, model.GetCatchpointBoxProofParams{Name: "nope"})
+	require.NoError(t, err)
+	require.Equal(t, 400, rec.Code)
+
+	c, rec = newReq(t)
+	err = handler.GetCatchpointBoxProof(c, 0, appID, model.GetCatchpointBoxProofParams{Name: "str:box"})
+	require.NoError(t, err)
+	require.Equal(t, 404, rec.Code)
+}
+
 func TestGetBlockGetBlockHash(t *testing.T) {
 	partitiontest.PartitionTest(t)
 	t.Parallel()
@@ -588,6 +924,60 @@ func TestPendingTransactionsByAddress(t *testing.T) {
 	pendingTransactionsByAddressTest(t, -1, "json", 400)
 }
 
+func waitForTransactionEventsTest(t *testing.T, txids []string, address *string, timeout *uint64, events []pools.TxnEvent, expectedCode int) model.TransactionEventsResponse {
+	handler, c, rec, _, _, releasefunc := setupTestForMethodGet(t)
+	defer releasefunc()
+	handler.Node.(*mockNode).txnEvents = events
+	params := model.WaitForTransactionEventsParams{Address: address, Timeout: timeout}
+	if txids != nil {
+		params.Txid = &txids
+	}
+	err := handler.WaitForTransactionEvents(c, params)
+	require.NoError(t, err)
+	require.Equal(t, expectedCode, rec.Code)
+
+	var response model.TransactionEventsResponse
+	if rec.Code == http.StatusOK {
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	}
+	return response
+}
+
+func TestWaitForTransactionEvents(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	txid := transactions.Txid{1}
+	address := basics.Address{2}.String()
+	badAddress := "bad address"
+	noTimeout := uint64(0)
+	longTimeout := uint64(61)
+
+	// transactions or an address must be given, within limits
+	waitForTransactionEventsTest(t, nil, nil, nil, nil, 400)
+	waitForTransactionEventsTest(t, []string{"bad txid"}, nil, nil, nil, 400)
+	waitForTransactionEventsTest(t, nil, &badAddress, nil, nil, 400)
+	waitForTransactionEventsTest(t, make([]string, 65), nil, nil, nil, 400)
+	waitForTransactionEventsTest(t, []string{txid.String()}, nil, &longTimeout, nil, 400)
+
+	// nothing happens before the timeout
+	response := waitForTransactionEventsTest(t, []string{txid.String()}, &address, &noTimeout, nil, 200)
+	require.Empty(t, response.Events)
+
+	events := []pools.TxnEvent{
+		{Txid: txid, ConfirmedRound: 5},
+		{Txid: transactions.Txid{3}, PoolError: "txn dead"},
+	}
+	response = waitForTransactionEventsTest(t, []string{txid.String()}, &address, nil, events, 200)
+	require.Len(t, response.Events, 2)
+	require.Equal(t, txid.String(), response.Events[0].Txid)
+	require.Equal(t, uint64(5), *response.Events[0].ConfirmedRound)
+	require.Nil(t, response.Events[0].PoolError)
+	require.Equal(t, transactions.Txid{3}.String(), response.Events[1].Txid)
+	require.Nil(t, response.Events[1].ConfirmedRound)
+	require.Equal(t, "txn dead", *response.Events[1].PoolError)
+}
+
 func postTransactionTest(t *testing.T, txnToUse, expectedCode int) {
 	numAccounts := 5
 	numTransactions := 5
@@ -641,7 +1031,7 @@ func startCatchupTest(t *testing.T, catchpoint string, nodeError error, expected
 	req := httptest.NewRequest(http.MethodPost, "/", nil)
 	rec := httptest.NewRecorder()
 	c := e.NewContext(req, rec)
-	err := handler.StartCatchup(c, catchpoint)
+	err := handler.StartCatchup(c, catchpoint, model.StartCatchupParams{})
 	require.NoError(t, err)
 	require.Equal(t, expectedCode, rec.Code)
 }
@@ -665,6 +1055,42 @@ func TestStartCatchup(t *testing.T) {
 	startCatchupTest(t, badCatchPoint, nil, 400)
 }
 
+func TestStartCatchupFromFile(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	mockLedger, _, _, _, releasefunc := testingenv(t, 1, 1, true)
+	defer releasefunc()
+	mockNode := makeMockNode(mockLedger, t.Name(), nil)
+	handler := v2.Handlers{
+		Node:     mockNode,
+		Log:      logging.Base(),
+		Shutdown: make(chan struct{}),
+	}
+	catchpoint := "5894690#DVFRZUYHEFKRLK5N6DNJRR4IABEVN2D6H76F3ZSEPIE6MKXMQWQA"
+	catchpointFile := "/tmp/catchpoint.tar"
+	startCatchup := func() *httptest.ResponseRecorder {
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodPost, "/", nil)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		err := handler.StartCatchup(c, catchpoint, model.StartCatchupParams{File: &catchpointFile})
+		require.NoError(t, err)
+		return rec
+	}
+
+	mockCall := mockNode.On("StartCatchupFromFile", catchpoint, catchpointFile).Return(nil)
+	rec := startCatchup()
+	require.Equal(t, http.StatusCreated, rec.Code)
+	mockNode.AssertCalled(t, "StartCatchupFromFile", catchpoint, catchpointFile)
+	mockCall.Unset()
+
+	mockCall = mockNode.On("StartCatchupFromFile", catchpoint, catchpointFile).Return(errors.New("unable to use catchpoint file"))
+	rec = startCatchup()
+	require.Equal(t, http.StatusInternalServerError, rec.Code)
+	mockCall.Unset()
+}
+
 func abortCatchupTest(t *testing.T, catchpoint string, expectedCode int) {
 	numAccounts := 1
 	numTransactions := 1
@@ -760,6 +1186,12 @@ int 1`, logic.AssemblerMaxVersion)
 	params = model.TealCompileParams{Sourcemap: &paramValue}
 	tealCompileTest(t, goodProgramBytes, 200, true, params, nil)
 
+	// Test the analysis of the program.
+	analyze := true
+	analyzeParams := model.TealCompileParams{Analyze: &analyze}
+	analysis := tealCompileTest(t, goodProgramBytes, 200, true, analyzeParams, nil).Analysis
+	require.Equal(t, &model.ProgramAnalysis{MaxCost: 4, Bounded: true, MaxStackDepth: 1}, analysis)
+
 	// Test a program without the developer API flag.
 	tealCompileTest(t, goodProgramBytes, 404, false, params, nil)
 
@@ -770,7 +1202,7 @@ int 1`, logic.AssemblerMaxVersion)
 }
 
 func tealDisassembleTest(t *testing.T, program []byte, expectedCode int,
-	expectedString string, enableDeveloperAPI bool,
+	expectedString string, enableDeveloperAPI bool, params model.TealDisassembleParams,
 ) (response model.DisassembleResponse) {
 	numAccounts := 1
 	numTransactions := 1
@@ -789,7 +1221,7 @@ func tealDisassembleTest(t *testing.T, program []byte, expectedCode int,
 	req := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(program))
 	rec := httptest.NewRecorder()
 	c := e.NewContext(req, rec)
-	err := handler.TealDisassemble(c)
+	err := handler.TealDisassemble(c, params)
 	require.NoError(t, err)
 	require.Equal(t, expectedCode, rec.Code)
 
@@ -814,21 +1246,49 @@ func TestTealDisassemble(t *testing.T) {
 
 	// nil program works, but results in invalid version text.
 	testProgram := []byte{}
-	tealDisassembleTest(t, testProgram, 200, "// invalid version\n", true)
+	tealDisassembleTest(t, testProgram, 200, "// invalid version\n", true, model.TealDisassembleParams{})
 
 	// Test a valid program.
 	for ver := 1; ver <= logic.AssemblerMaxVersion; ver++ {
 		goodProgram := `int 1`
 		ops, _ := logic.AssembleStringWithVersion(goodProgram, uint64(ver))
 		disassembledProgram, _ := logic.Disassemble(ops.Program)
-		tealDisassembleTest(t, ops.Program, 200, disassembledProgram, true)
+		tealDisassembleTest(t, ops.Program, 200, disassembledProgram, true, model.TealDisassembleParams{})
 	}
 	// Test a nil program without the developer API flag.
-	tealDisassembleTest(t, testProgram, 404, "", false)
+	tealDisassembleTest(t, testProgram, 404, "", false, model.TealDisassembleParams{})
 
 	// Test bad program.
 	badProgram := []byte{1, 99}
-	tealDisassembleTest(t, badProgram, 400, "invalid opcode", true)
+	tealDisassembleTest(t, badProgram, 400, "invalid opcode", true, model.TealDisassembleParams{})
+
+	// Test an annotated program, with its ABI method labels and source map.
+	router := `#pragma version 8
+txna ApplicationArgs 0
+method "add(uint64,uint64)uint64"
+==
+bnz add
+err
+add:
+int 1
+return`
+	ops, err := logic.AssembleString(router)
+	require.NoError(t, err)
+	annotated, offsetToLine, err := logic.DisassembleAnnotated(ops.Program, []string{"add(uint64,uint64)uint64"})
+	require.NoError(t, err)
+	require.Contains(t, annotated, "method_add:")
+	annotate := true
+	methods := []string{"add(uint64,uint64)uint64"}
+	response := tealDisassembleTest(t, ops.Program, 200, annotated, true, model.TealDisassembleParams{Annotate: &annotate, Method: &methods})
+	require.NotNil(t, response.Sourcemap)
+	expectedMap := logic.GetSourceMap([]string{}, offsetToLine)
+	require.Equal(t, expectedMap.Mappings, (*response.Sourcemap)["mappings"])
+
+	// Without annotate, the source map is left out.
+	plain, err := logic.Disassemble(ops.Program)
+	require.NoError(t, err)
+	response = tealDisassembleTest(t, ops.Program, 200, plain, true, model.TealDisassembleParams{Method: &methods})
+	require.Nil(t, response.Sourcemap)
 }
 
 func tealDryrunTest(
@@ -1129,6 +1589,43 @@ func TestGetProofDefault(t *testing.T) {
 	a.NoError(err)
 }
 
+func TestGetTransactionReplayState(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	handler, c, rec, stx, releasefunc := addBlockHelper(t)
+	defer releasefunc()
+
+	format := model.GetTransactionReplayStateParamsFormatMsgpack
+	err := handler.GetTransactionReplayState(c, 1, stx.ID().String(), model.GetTransactionReplayStateParams{Format: &format})
+	require.NoError(t, err)
+	require.Equal(t, 200, rec.Code)
+
+	var response struct {
+		State ledgercore.ReplayState `codec:"state"`
+	}
+	require.NoError(t, protocol.DecodeReflect(rec.Body.Bytes(), &response))
+	require.Equal(t, basics.Round(1), response.State.Header.Round)
+	require.Len(t, response.State.Group, 1)
+	require.Equal(t, stx.ID(), response.State.Group[0].ID())
+	found := false
+	for _, record := range response.State.Accounts {
+		found = found || record.Addr == stx.Txn.Sender
+	}
+	require.True(t, found)
+
+	// a transaction that isn't in the block
+	c, rec = newReq(t)
+	err = handler.GetTransactionReplayState(c, 1, transactions.Txid{}.String(), model.GetTransactionReplayStateParams{})
+	require.NoError(t, err)
+	require.Equal(t, 404, rec.Code)
+
+	// a malformed transaction ID
+	c, rec = newReq(t)
+	err = handler.GetTransactionReplayState(c, 1, "bad", model.GetTransactionReplayStateParams{})
+	require.NoError(t, err)
+	require.Equal(t, 400, rec.Code)
+}
+
 func newEmptyBlock(a *require.Assertions, lastBlock bookkeeping.Block, genBlk bookkeeping.Block, l v2.LedgerForAPI) bookkeeping.Block {
 	totalsRound, totals, err := l.LatestTotals()
 	a.NoError(err)
@@ -17,6 +17,7 @@
 package test
 
 import (
+	"context"
 	"fmt"
 	"math/rand"
 	"strconv"
@@ -33,6 +34,7 @@ import (
 	"github.com/algorand/go-algorand/data/account"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/pools"
 	"github.com/algorand/go-algorand/data/transactions"
 	"github.com/algorand/go-algorand/data/transactions/logic"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
@@ -123,6 +125,7 @@ type mockNode struct {
 	id        account.ParticipationID
 	keys      account.StateProofKeys
 	usertxns  map[basics.Address][]node.TxnWithStatus
+	txnEvents []pools.TxnEvent
 }
 
 func (m *mockNode) InstallParticipationKey(partKeyBinary []byte) (account.ParticipationID, error) {
@@ -154,6 +157,41 @@ func (m *mockNode) GetSyncRound() uint64 {
 	return uint64(args.Int(0))
 }
 
+func (m *mockNode) SetBlockTimeStampOffset(offset int64) error {
+	args := m.Called(offset)
+	return args.Error(0)
+}
+
+func (m *mockNode) GetBlockTimeStampOffset() (int64, error) {
+	args := m.Called()
+	return int64(args.Int(0)), args.Error(1)
+}
+
+func (m *mockNode) GenerateDevModeBlocks(count uint64) (basics.Round, error) {
+	args := m.Called(count)
+	return basics.Round(args.Int(0)), args.Error(1)
+}
+
+func (m *mockNode) CreateDevModeSnapshot() (uint64, basics.Round, error) {
+	args := m.Called()
+	return uint64(args.Int(0)), basics.Round(args.Int(1)), args.Error(2)
+}
+
+func (m *mockNode) RevertDevModeSnapshot(id uint64) (basics.Round, error) {
+	args := m.Called(id)
+	return basics.Round(args.Int(0)), args.Error(1)
+}
+
+func (m *mockNode) RollbackLedger(rnd basics.Round) (basics.Round, error) {
+	args := m.Called(rnd)
+	return basics.Round(args.Int(0)), args.Error(1)
+}
+
+func (m *mockNode) GetParticipationHistory(id account.ParticipationID) (node.ParticipationHistory, error) {
+	args := m.Called(id)
+	return args.Get(0).(node.ParticipationHistory), args.Error(1)
+}
+
 func (m *mockNode) AppendParticipationKeys(id account.ParticipationID, keys account.StateProofKeys) error {
 	m.id = id
 	m.keys = keys
@@ -200,6 +238,14 @@ func (m *mockNode) GetPendingTxnsFromPool() ([]transactions.SignedTxn, error) {
 	return txnPoolGolden, m.err
 }
 
+func (m *mockNode) WaitForTxnEvents(ctx context.Context, txids []transactions.Txid, address *basics.Address) []pools.TxnEvent {
+	if m.txnEvents != nil {
+		return m.txnEvents
+	}
+	<-ctx.Done()
+	return nil
+}
+
 func (m *mockNode) SuggestedFee() basics.MicroAlgos {
 	return basics.MicroAlgos{Raw: 1}
 }
@@ -259,6 +305,11 @@ func (m *mockNode) StartCatchup(catchpoint string) error {
 	return m.err
 }
 
+func (m *mockNode) StartCatchupFromFile(catchpoint string, catchpointFile string) error {
+	args := m.Called(catchpoint, catchpointFile)
+	return args.Error(0)
+}
+
 func (m *mockNode) AbortCatchup(catchpoint string) error {
 	return m.err
 }
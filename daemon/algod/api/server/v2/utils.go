@@ -30,6 +30,7 @@ import (
 	"github.com/algorand/go-algorand/daemon/algod/api/server/v2/generated/model"
 	"github.com/algorand/go-algorand/data/basics"
 	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/transactions/logic"
 	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-algorand/node"
 	"github.com/algorand/go-algorand/protocol"
@@ -207,15 +208,15 @@ func computeAppIndexFromTxn(tx node.TxnWithStatus, l LedgerForAPI) *uint64 {
 
 // getCodecHandle converts a format string into the encoder + content type
 func getCodecHandle(formatPtr *model.Format) (codec.Handle, string, error) {
-	format := model.Json
+	format := model.PendingTransactionInformationParamsFormatJson
 	if formatPtr != nil {
 		format = model.PendingTransactionInformationParamsFormat(strings.ToLower(string(*formatPtr)))
 	}
 
 	switch format {
-	case model.Json:
+	case model.PendingTransactionInformationParamsFormatJson:
 		return protocol.JSONStrictHandle, "application/json", nil
-	case model.Msgpack:
+	case model.PendingTransactionInformationParamsFormatMsgpack:
 		fallthrough
 	case "msgp":
 		return protocol.CodecHandle, "application/msgpack", nil
@@ -308,6 +309,44 @@ func convertLogs(txn node.TxnWithStatus) *[][]byte {
 	return logItems
 }
 
+// pcsOrNil converts program locations, omitting empty ones.
+func pcsOrNil(pcs []int) *[]uint64 {
+	if len(pcs) == 0 {
+		return nil
+	}
+	converted := make([]uint64, len(pcs))
+	for i, pc := range pcs {
+		converted[i] = uint64(pc)
+	}
+	return &converted
+}
+
+func convertProgramAnalysis(analysis logic.ProgramAnalysis) *model.ProgramAnalysis {
+	converted := model.ProgramAnalysis{
+		MaxCost:         uint64(analysis.MaxCost),
+		Bounded:         analysis.Bounded,
+		MaxStackDepth:   uint64(analysis.MaxStackDepth),
+		Unreachable:     pcsOrNil(analysis.Unreachable),
+		Errors:          pcsOrNil(analysis.Errors),
+		Loops:           pcsOrNil(analysis.Loops),
+		StackUnderflows: pcsOrNil(analysis.StackUnderflows),
+	}
+	if len(analysis.Subroutines) > 0 {
+		subroutines := make([]model.SubroutineAnalysis, len(analysis.Subroutines))
+		for i, sub := range analysis.Subroutines {
+			subroutines[i] = model.SubroutineAnalysis{
+				Pc:            uint64(sub.PC),
+				MaxCost:       uint64(sub.MaxCost),
+				Bounded:       sub.Bounded,
+				MaxStackDepth: uint64(sub.MaxStackDepth),
+				Args:          uint64(sub.Args),
+			}
+		}
+		converted.Subroutines = &subroutines
+	}
+	return &converted
+}
+
 func convertInners(txn *node.TxnWithStatus) *[]PreEncodedTxInfo {
 	inner := make([]PreEncodedTxInfo, len(txn.ApplyData.EvalDelta.InnerTxns))
 	for i, itxn := range txn.ApplyData.EvalDelta.InnerTxns {
@@ -18,6 +18,7 @@ package algod
 
 import (
 	"context"
+	"crypto/tls"
 	"errors"
 	"fmt"
 	"io"
@@ -44,6 +45,7 @@ import (
 	"github.com/algorand/go-algorand/node"
 	"github.com/algorand/go-algorand/util"
 	"github.com/algorand/go-algorand/util/metrics"
+	"github.com/algorand/go-algorand/util/tlsutil"
 	"github.com/algorand/go-algorand/util/tokens"
 )
 
@@ -232,6 +234,12 @@ func (s *Server) Start() {
 		os.Exit(1)
 	}
 
+	scopedAPITokens, err := tokens.GetScopedAPITokens(s.RootPath, tokens.AlgodScopedTokensFilename)
+	if err != nil {
+		fmt.Printf("APIToken error: %v\n", err)
+		os.Exit(1)
+	}
+
 	s.stopping = make(chan struct{})
 
 	addr := cfg.EndpointAddress
@@ -254,9 +262,21 @@ func (s *Server) Start() {
 		WriteTimeout: time.Duration(cfg.RestWriteTimeoutSeconds) * time.Second,
 	}
 
+	certReloader, err := makeRestCertificateReloader(cfg, s.RootPath)
+	if err != nil {
+		fmt.Printf("Could not start node: %v\n", err)
+		os.Exit(1)
+	}
+
 	e := apiServer.NewRouter(
-		s.log, s.node, s.stopping, apiToken, adminAPIToken, listener,
+		s.log, s.node, s.stopping, apiToken, adminAPIToken, scopedAPITokens, listener,
 		cfg.RestConnectionsSoftLimit)
+	if certReloader != nil {
+		server.TLSConfig = certReloader.ServerConfig()
+		// echo serves over its TLS listener once the server has a TLS config, which has to wrap ours for the
+		// connection limits to apply.
+		e.TLSListener = tls.NewListener(listener, server.TLSConfig)
+	}
 
 	// Set up files for our PID and our listening address
 	// before beginning to listen to prevent 'goal node start'
@@ -293,9 +313,15 @@ func (s *Server) Start() {
 	// Handle signals cleanly
 	c := make(chan os.Signal, 1)
 	signal.Notify(c, os.Interrupt, syscall.SIGTERM, syscall.SIGINT)
-	signal.Ignore(syscall.SIGHUP)
+	protocolName := "HTTP"
+	if certReloader != nil {
+		protocolName = "HTTPS"
+		go s.reloadCertificatesOnHangup(certReloader)
+	} else {
+		signal.Ignore(syscall.SIGHUP)
+	}
 
-	fmt.Printf("Node running and accepting RPC requests over HTTP on port %v. Press Ctrl-C to exit\n", addr)
+	fmt.Printf("Node running and accepting RPC requests over %s on port %v. Press Ctrl-C to exit\n", protocolName, addr)
 	select {
 	case err := <-errChan:
 		if err != nil {
@@ -311,6 +337,42 @@ func (s *Server) Start() {
 	}
 }
 
+// makeRestCertificateReloader loads the REST API TLS certificates set in the config, if any.
+func makeRestCertificateReloader(cfg config.Local, dataDir string) (*tlsutil.CertificateReloader, error) {
+	certFile, keyFile, adminClientCAFile := cfg.RestTLSFiles(dataDir)
+	if certFile == "" && keyFile == "" {
+		if adminClientCAFile != "" {
+			return nil, errors.New("RestTLSAdminClientCAFile requires RestTLSCertFile and RestTLSKeyFile to be set")
+		}
+		return nil, nil
+	}
+	if certFile == "" || keyFile == "" {
+		return nil, errors.New("RestTLSCertFile and RestTLSKeyFile have to be set together")
+	}
+	return tlsutil.MakeCertificateReloader(certFile, keyFile, adminClientCAFile)
+}
+
+// reloadCertificatesOnHangup reloads the REST API TLS certificates each time a SIGHUP is received, until the server
+// is stopped.
+func (s *Server) reloadCertificatesOnHangup(certReloader *tlsutil.CertificateReloader) {
+	hangup := make(chan os.Signal, 1)
+	signal.Notify(hangup, syscall.SIGHUP)
+	defer signal.Stop(hangup)
+	for {
+		select {
+		case <-hangup:
+			err := certReloader.Reload()
+			if err != nil {
+				s.log.Warnf("Unable to reload the REST API TLS certificates, the previous ones are still used: %v", err)
+				continue
+			}
+			s.log.Info("Reloaded the REST API TLS certificates")
+		case <-s.stopping:
+			return
+		}
+	}
+}
+
 // Stop initiates a graceful shutdown of the node by shutting down the network server.
 func (s *Server) Stop() {
 	// close the s.stopping, which would signal the rest api router that any pending commands
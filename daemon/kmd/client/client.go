@@ -17,6 +17,7 @@
 package client
 
 import (
+	"crypto/tls"
 	"net/http"
 	"time"
 )
@@ -30,6 +31,7 @@ type KMDClient struct {
 	httpClient http.Client
 	apiToken   string
 	address    string
+	scheme     string
 }
 
 func makeHTTPClient() http.Client {
@@ -45,6 +47,16 @@ func MakeKMDClient(address string, apiToken string) (KMDClient, error) {
 		httpClient: makeHTTPClient(),
 		apiToken:   apiToken,
 		address:    address,
+		scheme:     "http",
 	}
 	return kcl, nil
 }
+
+// SetTLSConfig makes the client connect to kmd over TLS, with the given
+// configuration
+func (kcl *KMDClient) SetTLSConfig(tlsConfig *tls.Config) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+	kcl.httpClient.Transport = transport
+	kcl.scheme = "https"
+}
@@ -40,6 +40,11 @@ type KMDConfig struct {
 	SessionLifetimeSecs uint64       `json:"session_lifetime_secs"`
 	Address             string       `json:"address"`
 	AllowedOrigins      []string     `json:"allowed_origins"`
+	// TLSCertFile and TLSKeyFile are the PEM encoded certificate and key the
+	// API is served with over TLS, if both are set. Relative paths are
+	// resolved against the data directory.
+	TLSCertFile string `json:"tls_cert_file"`
+	TLSKeyFile  string `json:"tls_key_file"`
 }
 
 // DriverConfig contains config info specific to each wallet driver
@@ -95,9 +100,24 @@ func (k KMDConfig) Validate() error {
 			return ErrSQLiteWalletNotAbsolute
 		}
 	}
+	if (k.TLSCertFile == "") != (k.TLSKeyFile == "") {
+		return ErrTLSCertAndKeyRequired
+	}
 	return nil
 }
 
+// TLSFiles returns the paths of the TLS certificate and key files, with the
+// relative paths resolved against the data directory
+func (k KMDConfig) TLSFiles() (certFile string, keyFile string) {
+	resolve := func(path string) string {
+		if path == "" || filepath.IsAbs(path) {
+			return path
+		}
+		return filepath.Join(k.DataDir, path)
+	}
+	return resolve(k.TLSCertFile), resolve(k.TLSKeyFile)
+}
+
 // LoadKMDConfig tries to read the the kmd configuration from disk, merging the
 // default kmd configuration with what it finds
 func LoadKMDConfig(dataDir string) (cfg KMDConfig, err error) {
@@ -22,3 +22,6 @@ import (
 
 // ErrSQLiteWalletNotAbsolute is returned when the passed sqlite wallet directory is relative
 var ErrSQLiteWalletNotAbsolute = fmt.Errorf("sqlite wallets path must be absolute path")
+
+// ErrTLSCertAndKeyRequired is returned when only one of the TLS certificate and key files is passed
+var ErrTLSCertAndKeyRequired = fmt.Errorf("tls_cert_file and tls_key_file must be set together")
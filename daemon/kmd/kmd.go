@@ -64,6 +64,7 @@ func Start(startConfig StartConfig) (died chan error, sock string, err error) {
 	}
 
 	// Configure the wallet API server
+	tlsCertFile, tlsKeyFile := kmdCfg.TLSFiles()
 	serverCfg := server.WalletServerConfig{
 		APIToken:       apiToken,
 		DataDir:        startConfig.DataDir,
@@ -72,6 +73,8 @@ func Start(startConfig StartConfig) (died chan error, sock string, err error) {
 		SessionManager: session.MakeManager(kmdCfg),
 		Log:            startConfig.Log,
 		Timeout:        startConfig.Timeout,
+		TLSCertFile:    tlsCertFile,
+		TLSKeyFile:     tlsKeyFile,
 	}
 
 	// Instantiate the wallet API server
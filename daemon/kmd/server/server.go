@@ -18,10 +18,12 @@ package server
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"net"
 	"net/http"
 	"os"
+	"os/signal"
 	"path/filepath"
 	"syscall"
 	"time"
@@ -32,6 +34,7 @@ import (
 	"github.com/algorand/go-algorand/daemon/kmd/api"
 	"github.com/algorand/go-algorand/daemon/kmd/session"
 	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/util/tlsutil"
 	"github.com/algorand/go-algorand/util/tokens"
 )
 
@@ -57,6 +60,10 @@ type WalletServerConfig struct {
 	SessionManager *session.Manager
 	Log            logging.Logger
 	Timeout        *time.Duration
+	// TLSCertFile and TLSKeyFile, if set, are the certificate and key the API
+	// is served with over TLS. They are reloaded when kmd receives a SIGHUP.
+	TLSCertFile string
+	TLSKeyFile  string
 }
 
 // WalletServer deals with serving API requests
@@ -214,6 +221,15 @@ func (ws *WalletServer) start(kill chan os.Signal) (died chan error, sock string
 		Handler: api.Handler(ws.SessionManager, ws.Log, ws.AllowedOrigins, ws.APIToken, watchdogCB),
 	}
 
+	// Load the TLS certificate before listening, so we fail early if it's invalid
+	var certReloader *tlsutil.CertificateReloader
+	if ws.TLSCertFile != "" || ws.TLSKeyFile != "" {
+		certReloader, err = tlsutil.MakeCertificateReloader(ws.TLSCertFile, ws.TLSKeyFile, "")
+		if err != nil {
+			return
+		}
+	}
+
 	// Read the kill channel and shut down the server gracefully
 	go func() {
 		<-kill
@@ -254,6 +270,10 @@ func (ws *WalletServer) start(kill chan os.Signal) (died chan error, sock string
 		}
 	}
 
+	if certReloader != nil {
+		listener = tls.NewListener(listener, certReloader.ServerConfig())
+	}
+
 	// Write out our net file
 	addr := listener.Addr().String()
 	err = ws.writeStateFiles(addr)
@@ -263,6 +283,10 @@ func (ws *WalletServer) start(kill chan os.Signal) (died chan error, sock string
 
 	// We'll send something on this channel when we die
 	died = make(chan error)
+	stopped := make(chan struct{})
+	if certReloader != nil {
+		go ws.reloadCertificatesOnHangup(certReloader, stopped)
+	}
 
 	// Begin serving requests
 	go func() {
@@ -286,9 +310,32 @@ func (ws *WalletServer) start(kill chan os.Signal) (died chan error, sock string
 		// Release our file lock
 		ws.releaseFileLock()
 
+		close(stopped)
+
 		// Tell the main thread we died
 		died <- err
 	}()
 
 	return died, addr, nil
 }
+
+// reloadCertificatesOnHangup reloads the TLS certificate each time kmd
+// receives a SIGHUP, until the server is stopped
+func (ws *WalletServer) reloadCertificatesOnHangup(certReloader *tlsutil.CertificateReloader, stopped chan struct{}) {
+	hangup := make(chan os.Signal, 1)
+	signal.Notify(hangup, syscall.SIGHUP)
+	defer signal.Stop(hangup)
+	for {
+		select {
+		case <-hangup:
+			err := certReloader.Reload()
+			if err != nil {
+				ws.Log.Warnf("unable to reload the kmd TLS certificate, keeping the previous one: %s", err)
+				continue
+			}
+			ws.Log.Infof("reloaded the kmd TLS certificate")
+		case <-stopped:
+			return
+		}
+	}
+}
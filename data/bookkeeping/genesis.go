@@ -35,6 +35,16 @@ const (
 	// This value is used exclusively for the messagepack decoder, and has no affect on the network
 	// capabilities/capacity in any way.
 	MaxInitialGenesisAllocationSize = 100000000
+
+	// MaxInitialGenesisBoxes is the maximum number of boxes that are supported when bootstrapping a new network.
+	// This value is used exclusively for the messagepack decoder, and has no affect on the network
+	// capabilities/capacity in any way.
+	MaxInitialGenesisBoxes = 100000000
+
+	// maxGenesisBoxNameLength and maxGenesisBoxValueLength bound the box names and values for the messagepack
+	// decoder; the boxes are validated against the consensus parameters when the genesis is generated.
+	maxGenesisBoxNameLength  = 64
+	maxGenesisBoxValueLength = 32768
 )
 
 // A Genesis object defines an Algorand "universe" -- a set of nodes that can
@@ -81,6 +91,10 @@ type Genesis struct {
 	// default value for this field is "false", which makes this field empty from it's encoding, and
 	// therefore backward compatible.
 	DevMode bool `codec:"devmode"`
+
+	// Boxes are the initial boxes of the applications created by the allocation. The field is empty unless the
+	// allocation creates applications with boxes, which keeps it backward compatible.
+	Boxes []GenesisBox `codec:"boxes,allocbound=MaxInitialGenesisBoxes"`
 }
 
 // LoadGenesisFromFile attempts to load a Genesis structure from a (presumably) genesis.json file.
@@ -133,7 +147,9 @@ func (genesis Genesis) Balances() (GenesisBalances, error) {
 		return GenesisBalances{}, fmt.Errorf("cannot parse rewards pool addr %s: %w", genesis.RewardsPool, err)
 	}
 
-	return MakeTimestampedGenesisBalances(genalloc, feeSink, rewardsPool, genesis.Timestamp), nil
+	genBal := MakeTimestampedGenesisBalances(genalloc, feeSink, rewardsPool, genesis.Timestamp)
+	genBal.Boxes = genesis.Boxes
+	return genBal, nil
 }
 
 // Block computes the genesis block.
@@ -162,6 +178,16 @@ type GenesisAllocation struct {
 	State   basics.AccountData `codec:"state"`
 }
 
+// A GenesisBox object represents a box of an application created by the
+// genesis allocation.
+type GenesisBox struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	App   basics.AppIndex `codec:"app"`
+	Name  []byte          `codec:"name,allocbound=maxGenesisBoxNameLength"`
+	Value []byte          `codec:"value,allocbound=maxGenesisBoxValueLength"`
+}
+
 // ToBeHashed impements the crypto.Hashable interface.
 func (genesis Genesis) ToBeHashed() (protocol.HashID, []byte) {
 	return protocol.Genesis, protocol.Encode(&genesis)
@@ -173,6 +199,7 @@ type GenesisBalances struct {
 	FeeSink     basics.Address
 	RewardsPool basics.Address
 	Timestamp   int64
+	Boxes       []GenesisBox
 }
 
 // MakeGenesisBalances returns the information needed to bootstrap the ledger based on the current time
@@ -220,6 +247,9 @@ func MakeGenesisBlock(proto protocol.ConsensusVersion, genesisBal GenesisBalance
 				CurrentProtocol: proto,
 			},
 			UpgradeVote: UpgradeVote{},
+			// the assets and applications created by the allocation take the first creatable indices, so the
+			// creatables created by the following blocks are indexed after them.
+			TxnCounter: genesisBal.maxCreatableIndex(),
 		},
 	}
 
@@ -229,3 +259,20 @@ func MakeGenesisBlock(proto protocol.ConsensusVersion, genesisBal GenesisBalance
 
 	return blk, nil
 }
+
+// maxCreatableIndex returns the largest index of the assets and applications created by the genesis allocation.
+func (genesisBal GenesisBalances) maxCreatableIndex() (maxIndex uint64) {
+	for _, data := range genesisBal.Balances {
+		for aidx := range data.AssetParams {
+			if uint64(aidx) > maxIndex {
+				maxIndex = uint64(aidx)
+			}
+		}
+		for aidx := range data.AppParams {
+			if uint64(aidx) > maxIndex {
+				maxIndex = uint64(aidx)
+			}
+		}
+	}
+	return
+}
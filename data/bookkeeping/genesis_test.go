@@ -24,6 +24,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/test/partitiontest"
 )
 
@@ -155,3 +156,44 @@ func TestGenesis_Balances(t *testing.T) {
 		})
 	}
 }
+
+func TestGenesisBlockCreatables(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	var creator, holder, sink, pool basics.Address
+	creator[0], holder[0], sink[0], pool[0] = 1, 2, 3, 4
+	box := GenesisBox{App: 7, Name: []byte("box"), Value: []byte("value")}
+	genesis := Genesis{
+		Proto:       protocol.ConsensusCurrentVersion,
+		FeeSink:     sink.String(),
+		RewardsPool: pool.String(),
+		Allocation: []GenesisAllocation{
+			{Address: creator.String(), State: basics.AccountData{
+				MicroAlgos:  basics.MicroAlgos{Raw: 1000000000},
+				AssetParams: map[basics.AssetIndex]basics.AssetParams{5: {Total: 10}},
+				Assets:      map[basics.AssetIndex]basics.AssetHolding{5: {Amount: 10}},
+				AppParams:   map[basics.AppIndex]basics.AppParams{7: {ApprovalProgram: []byte{0x06, 0x81, 0x01}}},
+			}},
+			{Address: holder.String(), State: basics.AccountData{
+				MicroAlgos: basics.MicroAlgos{Raw: 1000000000},
+			}},
+		},
+		Boxes: []GenesisBox{box},
+	}
+
+	bals, err := genesis.Balances()
+	require.NoError(t, err)
+	require.Equal(t, []GenesisBox{box}, bals.Boxes)
+
+	blk, err := genesis.Block()
+	require.NoError(t, err)
+	require.Equal(t, uint64(7), blk.TxnCounter)
+
+	// genesis files without creatables keep their encoding, and hence their hash
+	genesis.Boxes = nil
+	require.NotContains(t, string(protocol.EncodeJSON(genesis)), "boxes")
+	genesis.Allocation = genesis.Allocation[1:]
+	blk, err = genesis.Block()
+	require.NoError(t, err)
+	require.Zero(t, blk.TxnCounter)
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/algorand/go-algorand/data/bookkeeping"
 	"github.com/algorand/go-algorand/data/committee"
 	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/transactions/logic"
 	"github.com/algorand/go-algorand/ledger"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
 	"github.com/algorand/go-algorand/logging"
@@ -106,6 +107,13 @@ func LoadLedger(
 		Accounts:    genesisBal.Balances,
 		GenesisHash: genesisHash,
 	}
+	if len(genesisBal.Boxes) > 0 {
+		genesisInitState.Boxes = make(map[string][]byte, len(genesisBal.Boxes))
+		for _, box := range genesisBal.Boxes {
+			// the value is copied into a non-nil slice, as a nil value stands for a deleted box.
+			genesisInitState.Boxes[logic.MakeBoxKey(box.App, string(box.Name))] = append([]byte{}, box.Value...)
+		}
+	}
 	l.log.Debugf("Initializing Ledger(%s)", dbFilenamePrefix)
 
 	ll, err := ledger.OpenLedger(log, dbFilenamePrefix, memory, genesisInitState, cfg)
@@ -118,6 +126,14 @@ func LoadLedger(
 	return l, nil
 }
 
+// Rollback reverts the ledger to the given round, dropping the cached values of the rounds following it.
+func (l *Ledger) Rollback(rnd basics.Round, replay bool) error {
+	err := l.Ledger.Rollback(rnd, replay)
+	l.lastRoundCirculation.Store(roundCirculation{})
+	l.lastRoundSeed.Store(roundSeed{})
+	return err
+}
+
 // AddressTxns returns the list of transactions to/from a given address in specific round
 func (l *Ledger) AddressTxns(id basics.Address, r basics.Round) ([]transactions.SignedTxnWithAD, error) {
 	blk, err := l.Block(r)
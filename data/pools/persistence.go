@@ -0,0 +1,112 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package pools
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/transactions/verify"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// persistedTxPool is the content of the file the pending transaction groups are saved to
+type persistedTxPool struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	TxGroups [][]transactions.SignedTxn `codec:"txgroups"`
+}
+
+// SavePending writes the pending transaction groups into the given file. The file is replaced atomically,
+// so that an interrupted write leaves the previously saved transaction groups in place.
+func (pool *TransactionPool) SavePending(filename string) error {
+	persisted := persistedTxPool{TxGroups: pool.PendingTxGroups()}
+
+	tmpFilename := filename + ".tmp"
+	f, err := os.Create(tmpFilename)
+	if err != nil {
+		return err
+	}
+	w := bufio.NewWriter(f)
+	protocol.EncodeStream(w, &persisted)
+	err = w.Flush()
+	if err == nil {
+		err = f.Sync()
+	}
+	closeErr := f.Close()
+	if err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(tmpFilename)
+		return err
+	}
+	return os.Rename(tmpFilename, filename)
+}
+
+// RestorePending reads the transaction groups saved by SavePending, and remembers the ones that are still valid.
+// The transaction groups are verified again before being remembered, as they would be when received from the network.
+// It returns the number of transaction groups that were remembered, out of the ones found in the file.
+// A missing file is not an error, as there is nothing to restore.
+func (pool *TransactionPool) RestorePending(filename string) (remembered int, total int, err error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		if os.IsNotExist(err) {
+			err = nil
+		}
+		return
+	}
+	defer f.Close()
+
+	var persisted persistedTxPool
+	err = protocol.DecodeStream(bufio.NewReader(f), &persisted)
+	if err != nil {
+		return 0, 0, fmt.Errorf("unable to decode transaction pool file %s: %w", filename, err)
+	}
+	total = len(persisted.TxGroups)
+
+	latest := pool.ledger.Latest()
+	latestHdr, err := pool.ledger.BlockHdr(latest)
+	if err != nil {
+		return 0, total, fmt.Errorf("could not get header for previous block %d: %w", latest, err)
+	}
+	cache := pool.ledger.VerifiedTransactionCache()
+	for _, txgroup := range persisted.TxGroups {
+		if len(txgroup) == 0 {
+			continue
+		}
+		_, verifyErr := verify.TxnGroup(txgroup, latestHdr, cache, pool.ledger)
+		if verifyErr != nil {
+			pool.log.Infof("RestorePending: dropping transaction group %v: %v", txgroup[0].ID(), verifyErr)
+			continue
+		}
+		rememberErr := pool.Remember(txgroup)
+		if rememberErr != nil {
+			pool.log.Debugf("RestorePending: dropping transaction group %v: %v", txgroup[0].ID(), rememberErr)
+			continue
+		}
+		err = cache.Pin(txgroup)
+		if err != nil {
+			pool.log.Infof("RestorePending: unable to pin transaction group %v: %v", txgroup[0].ID(), err)
+			err = nil
+		}
+		remembered++
+	}
+	return remembered, total, nil
+}
@@ -0,0 +1,110 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package pools
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestTransactionPoolPersistence(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	const numOfAccounts = 5
+	secrets := make([]*crypto.SignatureSecrets, numOfAccounts)
+	addresses := make([]basics.Address, numOfAccounts)
+	for i := 0; i < numOfAccounts; i++ {
+		secrets[i] = keypair()
+		addresses[i] = basics.Address(secrets[i].SignatureVerifier)
+	}
+
+	mockLedger := makeMockLedger(t, initAccFixed(addresses, 1<<32))
+	cfg := config.GetDefaultLocal()
+	cfg.TxPoolSize = testPoolSize
+	cfg.EnableProcessBlockStats = false
+	transactionPool := MakeTransactionPool(mockLedger, cfg, logging.Base())
+
+	var signedTxns []transactions.SignedTxn
+	for i, sender := range addresses {
+		tx := transactions.Transaction{
+			Type: protocol.PaymentTx,
+			Header: transactions.Header{
+				Sender:      sender,
+				Fee:         basics.MicroAlgos{Raw: proto.MinTxnFee},
+				FirstValid:  0,
+				LastValid:   basics.Round(proto.MaxTxnLife),
+				GenesisHash: mockLedger.GenesisHash(),
+			},
+			PaymentTxnFields: transactions.PaymentTxnFields{
+				Receiver: addresses[(i+1)%numOfAccounts],
+				Amount:   basics.MicroAlgos{Raw: 1},
+			},
+		}
+		signedTx := tx.Sign(secrets[i])
+		require.NoError(t, transactionPool.RememberOne(signedTx))
+		signedTxns = append(signedTxns, signedTx)
+	}
+
+	filename := filepath.Join(t.TempDir(), config.TxPoolFilename)
+	// there is nothing to restore before the pool was saved
+	remembered, total, err := transactionPool.RestorePending(filename)
+	require.NoError(t, err)
+	require.Zero(t, remembered)
+	require.Zero(t, total)
+
+	require.NoError(t, transactionPool.SavePending(filename))
+	_, err = os.Stat(filename + ".tmp")
+	require.True(t, os.IsNotExist(err))
+
+	restoredPool := MakeTransactionPool(mockLedger, cfg, logging.Base())
+	remembered, total, err = restoredPool.RestorePending(filename)
+	require.NoError(t, err)
+	require.Equal(t, numOfAccounts, remembered)
+	require.Equal(t, numOfAccounts, total)
+	require.Equal(t, transactionPool.PendingTxGroups(), restoredPool.PendingTxGroups())
+
+	// the transaction groups that are already pending, or don't verify, are not restored
+	badTxn := signedTxns[0]
+	badTxn.Txn.Note = []byte("bad")
+	persisted := persistedTxPool{TxGroups: append(restoredPool.PendingTxGroups(), []transactions.SignedTxn{badTxn})}
+	f, err := os.Create(filename)
+	require.NoError(t, err)
+	protocol.EncodeStream(f, &persisted)
+	require.NoError(t, f.Close())
+
+	remembered, total, err = restoredPool.RestorePending(filename)
+	require.NoError(t, err)
+	require.Zero(t, remembered)
+	require.Equal(t, numOfAccounts+1, total)
+	require.Equal(t, numOfAccounts, restoredPool.PendingCount())
+
+	// a corrupted file is reported
+	require.NoError(t, os.WriteFile(filename, []byte{0xc1}, 0600))
+	_, _, err = MakeTransactionPool(mockLedger, cfg, logging.Base()).RestorePending(filename)
+	require.Error(t, err)
+}
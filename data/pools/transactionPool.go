@@ -96,6 +96,10 @@ type TransactionPool struct {
 	// stateproofOverflowed indicates that a stateproof transaction was allowed to
 	// exceed the txPoolMaxSize. This flag is reset to false OnNewBlock
 	stateproofOverflowed bool
+
+	// txnEventsMu protects txnEventSubscriptions
+	txnEventsMu           deadlock.Mutex
+	txnEventSubscriptions map[*TxnEventSubscription]bool
 }
 
 // BlockEvaluator defines the block evaluator interface exposed by the ledger package.
@@ -126,6 +130,8 @@ func MakeTransactionPool(ledger *ledger.Ledger, cfg config.Local, log logging.Lo
 		txPoolMaxSize:        cfg.TxPoolSize,
 		proposalAssemblyTime: cfg.ProposalAssemblyTime,
 		log:                  log,
+
+		txnEventSubscriptions: make(map[*TxnEventSubscription]bool),
 	}
 	pool.cond.L = &pool.mu
 	pool.assemblyCond.L = &pool.assemblyMu
@@ -514,6 +520,7 @@ func (pool *TransactionPool) OnNewBlock(block bookkeeping.Block, delta ledgercor
 		}
 		pool.pendingMu.RUnlock()
 	}
+	pool.notifyCommitted(block.Round(), committedTxids)
 
 	pool.mu.Lock()
 	defer pool.mu.Unlock()
@@ -737,6 +744,7 @@ func (pool *TransactionPool) recomputeBlockEvaluator(committedTxIds map[transact
 			for _, tx := range txgroup {
 				pool.statusCache.put(tx, err.Error())
 			}
+			pool.notifyRejected(txgroup, err.Error())
 			// metrics here are duplicated for historic reasons. stats is hardly used and should be removed in favor of asmstats
 			switch terr := err.(type) {
 			case *ledgercore.TransactionInLedgerError:
@@ -0,0 +1,161 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package pools
+
+import (
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+)
+
+// TxnEvent describes a transaction leaving the pool, either because it was committed, in which case ConfirmedRound
+// is set, or because it was rejected when the pool was recomputed, in which case PoolError holds the reason, as it
+// is recorded in the status cache.
+type TxnEvent struct {
+	Txid           transactions.Txid
+	Txn            transactions.SignedTxn
+	ConfirmedRound basics.Round
+	PoolError      string
+}
+
+// TxnEventSubscription collects the events of the transactions a subscriber is interested in: the ones with the
+// given transaction ids, and the ones of the given address that were in the pool.
+type TxnEventSubscription struct {
+	txids   map[transactions.Txid]bool
+	address *basics.Address
+
+	mu     deadlock.Mutex
+	events []TxnEvent
+	ready  chan struct{}
+}
+
+// Ready returns a channel which can be read from once events are available.
+func (sub *TxnEventSubscription) Ready() <-chan struct{} {
+	return sub.ready
+}
+
+// Events returns the events collected since the previous call, if any.
+func (sub *TxnEventSubscription) Events() []TxnEvent {
+	sub.mu.Lock()
+	defer sub.mu.Unlock()
+	events := sub.events
+	sub.events = nil
+	return events
+}
+
+func (sub *TxnEventSubscription) matches(event *TxnEvent) bool {
+	if sub.txids[event.Txid] {
+		return true
+	}
+	// MatchAddress uses the special addresses to match the fee sink, we don't care about that here.
+	return sub.address != nil && event.Txn.Txn.MatchAddress(*sub.address, transactions.SpecialAddresses{})
+}
+
+func (sub *TxnEventSubscription) add(events []TxnEvent) {
+	sub.mu.Lock()
+	defer sub.mu.Unlock()
+	for i := range events {
+		if sub.matches(&events[i]) {
+			sub.events = append(sub.events, events[i])
+		}
+	}
+	if len(sub.events) > 0 {
+		select {
+		case sub.ready <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// SubscribeTxnEvents starts collecting the events of the given transactions, and of the transactions of the given
+// address, if not nil. The subscription has to be removed with UnsubscribeTxnEvents once it is no longer needed.
+func (pool *TransactionPool) SubscribeTxnEvents(txids []transactions.Txid, address *basics.Address) *TxnEventSubscription {
+	sub := &TxnEventSubscription{
+		txids:   make(map[transactions.Txid]bool, len(txids)),
+		address: address,
+		ready:   make(chan struct{}, 1),
+	}
+	for _, txid := range txids {
+		sub.txids[txid] = true
+	}
+
+	pool.txnEventsMu.Lock()
+	defer pool.txnEventsMu.Unlock()
+	pool.txnEventSubscriptions[sub] = true
+	return sub
+}
+
+// UnsubscribeTxnEvents stops collecting the events of the given subscription.
+func (pool *TransactionPool) UnsubscribeTxnEvents(sub *TxnEventSubscription) {
+	pool.txnEventsMu.Lock()
+	defer pool.txnEventsMu.Unlock()
+	delete(pool.txnEventSubscriptions, sub)
+}
+
+func (pool *TransactionPool) hasTxnEventSubscriptions() bool {
+	pool.txnEventsMu.Lock()
+	defer pool.txnEventsMu.Unlock()
+	return len(pool.txnEventSubscriptions) > 0
+}
+
+func (pool *TransactionPool) notifyTxnEvents(events []TxnEvent) {
+	if len(events) == 0 {
+		return
+	}
+	pool.txnEventsMu.Lock()
+	defer pool.txnEventsMu.Unlock()
+	for sub := range pool.txnEventSubscriptions {
+		sub.add(events)
+	}
+}
+
+// notifyCommitted notifies the subscribers about the transactions committed in the given round. The transactions
+// that were not in the pool are only reported to the subscribers of their transaction id.
+func (pool *TransactionPool) notifyCommitted(round basics.Round, committedTxids map[transactions.Txid]ledgercore.IncludedTransactions) {
+	if len(committedTxids) == 0 || !pool.hasTxnEventSubscriptions() {
+		return
+	}
+	events := make([]TxnEvent, 0, len(committedTxids))
+	pool.pendingMu.RLock()
+	for txid := range committedTxids {
+		events = append(events, TxnEvent{
+			Txid:           txid,
+			Txn:            pool.pendingTxids[txid],
+			ConfirmedRound: round,
+		})
+	}
+	pool.pendingMu.RUnlock()
+	pool.notifyTxnEvents(events)
+}
+
+// notifyRejected notifies the subscribers about a transaction group that was removed from the pool.
+func (pool *TransactionPool) notifyRejected(txgroup []transactions.SignedTxn, txErr string) {
+	if !pool.hasTxnEventSubscriptions() {
+		return
+	}
+	events := make([]TxnEvent, len(txgroup))
+	for i, tx := range txgroup {
+		events[i] = TxnEvent{
+			Txid:      tx.ID(),
+			Txn:       tx,
+			PoolError: txErr,
+		}
+	}
+	pool.notifyTxnEvents(events)
+}
@@ -0,0 +1,106 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package pools
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/agreement"
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestTxnEventSubscriptions(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	const numOfAccounts = 3
+	secrets := make([]*crypto.SignatureSecrets, numOfAccounts)
+	addresses := make([]basics.Address, numOfAccounts)
+	for i := 0; i < numOfAccounts; i++ {
+		secrets[i] = keypair()
+		addresses[i] = basics.Address(secrets[i].SignatureVerifier)
+	}
+
+	mockLedger := makeMockLedger(t, initAccFixed(addresses, 1<<32))
+	cfg := config.GetDefaultLocal()
+	cfg.TxPoolSize = testPoolSize
+	cfg.EnableProcessBlockStats = false
+	transactionPool := MakeTransactionPool(mockLedger, cfg, logging.Base())
+
+	// the first transaction expires before it gets committed, while the second one is committed
+	signedTxns := make([]transactions.SignedTxn, numOfAccounts-1)
+	for i := range signedTxns {
+		tx := transactions.Transaction{
+			Type: protocol.PaymentTx,
+			Header: transactions.Header{
+				Sender:      addresses[i],
+				Fee:         basics.MicroAlgos{Raw: proto.MinTxnFee},
+				FirstValid:  0,
+				LastValid:   basics.Round(1 + i*100),
+				GenesisHash: mockLedger.GenesisHash(),
+			},
+			PaymentTxnFields: transactions.PaymentTxnFields{
+				Receiver: addresses[i+1],
+				Amount:   basics.MicroAlgos{Raw: 1},
+			},
+		}
+		signedTxns[i] = tx.Sign(secrets[i])
+		require.NoError(t, transactionPool.RememberOne(signedTxns[i]))
+	}
+	committed, expired := signedTxns[1], signedTxns[0]
+
+	txidSub := transactionPool.SubscribeTxnEvents([]transactions.Txid{committed.ID()}, nil)
+	addrSub := transactionPool.SubscribeTxnEvents(nil, &addresses[1])
+	otherSub := transactionPool.SubscribeTxnEvents([]transactions.Txid{{1}}, nil)
+	removedSub := transactionPool.SubscribeTxnEvents([]transactions.Txid{committed.ID()}, nil)
+	transactionPool.UnsubscribeTxnEvents(removedSub)
+
+	eval := newBlockEvaluator(t, mockLedger)
+	require.NoError(t, eval.Transaction(committed, transactions.ApplyData{}))
+	blk, err := eval.GenerateBlock()
+	require.NoError(t, err)
+	require.NoError(t, mockLedger.AddValidatedBlock(*blk, agreement.Certificate{}))
+	transactionPool.OnNewBlock(blk.Block(), blk.Delta())
+
+	<-txidSub.Ready()
+	require.Equal(t, []TxnEvent{{Txid: committed.ID(), Txn: committed, ConfirmedRound: blk.Block().Round()}}, txidSub.Events())
+	require.Empty(t, txidSub.Events())
+
+	// both transactions involve the address, one as a receiver and the other one as a sender
+	<-addrSub.Ready()
+	events := addrSub.Events()
+	require.Len(t, events, 2)
+	require.Equal(t, TxnEvent{Txid: committed.ID(), Txn: committed, ConfirmedRound: blk.Block().Round()}, events[0])
+	require.Equal(t, expired.ID(), events[1].Txid)
+	require.Zero(t, events[1].ConfirmedRound)
+	require.Contains(t, events[1].PoolError, "txn dead")
+	_, txErr, found := transactionPool.Lookup(expired.ID())
+	require.True(t, found)
+	require.Equal(t, txErr, events[1].PoolError)
+
+	require.Empty(t, otherSub.Events())
+	require.Empty(t, removedSub.Events())
+	require.Len(t, otherSub.Ready(), 0)
+	require.Len(t, removedSub.Ready(), 0)
+}
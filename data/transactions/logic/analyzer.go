@@ -0,0 +1,466 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package logic
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"sort"
+)
+
+// ProgramAnalysis is the result of the static analysis of the control flow
+// graph of a program. Program locations are the pcs of instructions.
+type ProgramAnalysis struct {
+	// MaxCost is the worst-case opcode cost of any path through the program,
+	// including the subroutines it calls, with the opcodes whose cost depends
+	// on the length of their arguments applied to the longest values. When
+	// the program isn't Bounded, it's the cost of a single run of its loops
+	// and recursive calls.
+	MaxCost int
+	Bounded bool
+
+	// MaxStackDepth is the maximum height the stack can reach. It may exceed
+	// the height the evaluator allows.
+	MaxStackDepth int
+
+	// Subroutines are the subroutines called from reachable code, by pc.
+	Subroutines []SubroutineAnalysis
+
+	// Unreachable are the first instructions of the runs of instructions no
+	// path reaches.
+	Unreachable []int
+	// Errors are the reachable `err` instructions.
+	Errors []int
+	// Loops are the branches that go back to an instruction of their own
+	// path, and the recursive subroutine calls.
+	Loops []int
+	// StackUnderflows are the instructions which may need more values than
+	// the stack can hold when they are reached.
+	StackUnderflows []int
+}
+
+// SubroutineAnalysis is the analysis of a subroutine, on its own.
+type SubroutineAnalysis struct {
+	PC int
+
+	// MaxCost is the worst-case opcode cost of running the subroutine,
+	// including the subroutines it calls in turn.
+	MaxCost int
+	Bounded bool
+
+	// MaxStackDepth is the maximum height the stack can reach above its
+	// height when the subroutine is called.
+	MaxStackDepth int
+
+	// Args is the number of values the subroutine may consume from the
+	// stack of its caller.
+	Args int
+}
+
+// frameAnalysis is the analysis of the main program, or of a subroutine.
+type frameAnalysis struct {
+	entry *optInstruction
+	done  bool
+
+	maxCost int
+	bounded bool
+
+	// lowest and highest height of the stack, relative to the entry
+	minHeight int
+	maxHeight int
+
+	// heights of the stack after retsub, relative to the entry
+	returns bool
+	minRet  int
+	maxRet  int
+}
+
+// arrival is what is known of the paths that reach an instruction.
+type arrival struct {
+	cost   int
+	lo, hi int
+}
+
+type analyzer struct {
+	instructions []*optInstruction
+	next         map[*optInstruction]*optInstruction
+	frames       map[*optInstruction]*frameAnalysis
+	reached      map[*optInstruction]bool
+
+	errors     map[int]bool
+	loops      map[int]bool
+	underflows map[int]bool
+}
+
+// Analyze analyzes the assembled program.
+func (ops *OpStream) Analyze() (ProgramAnalysis, error) {
+	if ops.Program == nil {
+		return ProgramAnalysis{}, fmt.Errorf("can't analyze a program that failed to assemble")
+	}
+	return AnalyzeProgram(ops.Program)
+}
+
+// AnalyzeProgram builds the control flow graph of the program, and walks it
+// to find the worst-case opcode cost and stack depth of its paths, and the
+// code that is unreachable or could fail.
+func AnalyzeProgram(program []byte) (ProgramAnalysis, error) {
+	o, err := parseForOptimization(program, nil, nil)
+	if err != nil {
+		return ProgramAnalysis{}, err
+	}
+	a := &analyzer{
+		instructions: o.instructions,
+		next:         make(map[*optInstruction]*optInstruction),
+		frames:       make(map[*optInstruction]*frameAnalysis),
+		reached:      make(map[*optInstruction]bool),
+		errors:       make(map[int]bool),
+		loops:        make(map[int]bool),
+		underflows:   make(map[int]bool),
+	}
+	for i := 0; i+1 < len(o.instructions); i++ {
+		a.next[o.instructions[i]] = o.instructions[i+1]
+	}
+	if len(o.instructions) == 0 {
+		return ProgramAnalysis{Bounded: true}, nil
+	}
+
+	main := a.analyzeFrame(o.instructions[0], true)
+	analysis := ProgramAnalysis{
+		MaxCost:       main.maxCost,
+		Bounded:       main.bounded,
+		MaxStackDepth: main.maxHeight,
+	}
+	for entry, frame := range a.frames {
+		if frame == main {
+			continue
+		}
+		analysis.Subroutines = append(analysis.Subroutines, SubroutineAnalysis{
+			PC:            entry.pc,
+			MaxCost:       frame.maxCost,
+			Bounded:       frame.bounded,
+			MaxStackDepth: frame.maxHeight,
+			Args:          -frame.minHeight,
+		})
+	}
+	sort.Slice(analysis.Subroutines, func(i, j int) bool {
+		return analysis.Subroutines[i].PC < analysis.Subroutines[j].PC
+	})
+
+	unreached := false
+	for _, in := range o.instructions {
+		if !a.reached[in] && !unreached {
+			analysis.Unreachable = append(analysis.Unreachable, in.pc)
+		}
+		unreached = !a.reached[in]
+	}
+	analysis.Errors = sortedPCs(a.errors)
+	analysis.Loops = sortedPCs(a.loops)
+	analysis.StackUnderflows = sortedPCs(a.underflows)
+	return analysis, nil
+}
+
+func sortedPCs(pcs map[int]bool) []int {
+	var sorted []int
+	for pc := range pcs {
+		sorted = append(sorted, pc)
+	}
+	sort.Ints(sorted)
+	return sorted
+}
+
+// successors returns the instructions that may run after the instruction,
+// within its frame, where nil is the end of the program. It doesn't include
+// the instruction after a callsub, which depends on the subroutine returning.
+func (a *analyzer) successors(in *optInstruction) []*optInstruction {
+	switch in.spec.Name {
+	case "err", "return", "retsub", "callsub":
+		return nil
+	case "b":
+		return in.targets
+	}
+	next := a.next[in]
+	if in.isBranch() {
+		return append(append([]*optInstruction(nil), in.targets...), next)
+	}
+	return []*optInstruction{next}
+}
+
+// stackEffect returns the number of values the instruction needs on the
+// stack, and how it changes the height of the stack.
+func (in *optInstruction) stackEffect() (needs int, delta int) {
+	needs = len(in.spec.Arg.Types)
+	delta = len(in.spec.Return.Types) - needs
+	if in.spec.AlwaysExits() {
+		delta = -needs
+	}
+	n := 0
+	if len(in.immediates) > 0 {
+		n = int(in.immediates[0])
+	}
+	switch in.spec.Name {
+	case "dig":
+		return n + 1, 1
+	case "cover", "uncover":
+		return n + 1, 0
+	case "bury":
+		return n + 1, -1
+	case "popn":
+		return n, -n
+	case "dupn":
+		return 1, n
+	case "pushints", "pushbytess":
+		count, _ := binary.Uvarint(in.immediates)
+		return 0, int(count)
+	case "match":
+		return len(in.targets) + 1, -(len(in.targets) + 1)
+	}
+	return needs, delta
+}
+
+// worst is the highest cost of a linearCost, when it applies to the longest
+// byte string.
+func (lc *linearCost) worst() int {
+	if lc.chunkCost != 0 && lc.chunkSize != 0 {
+		return lc.baseCost + lc.chunkCost*divCeil(maxStringSize, lc.chunkSize)
+	}
+	return lc.baseCost
+}
+
+// worstCost is the highest cost the instruction can have.
+func (in *optInstruction) worstCost() int {
+	d := &in.spec.OpDetails
+	if d.FullCost.baseCost != 0 {
+		return d.FullCost.worst()
+	}
+	cost := 0
+	for i := range d.Immediates {
+		if d.Immediates[i].fieldCosts != nil && i < len(in.immediates) {
+			cost += d.Immediates[i].fieldCosts[in.immediates[i]].worst()
+		}
+	}
+	return cost
+}
+
+// analyzeFrame analyzes the main program, or the subroutine starting at the
+// entry instruction, after analyzing the subroutines it calls.
+func (a *analyzer) analyzeFrame(entry *optInstruction, main bool) *frameAnalysis {
+	frame := &frameAnalysis{entry: entry, bounded: true}
+	a.frames[entry] = frame
+
+	// A depth first walk of the frame finds the order to visit its
+	// instructions in, so that each is visited after all the instructions
+	// branching to it, except for the branches back, which make loops.
+	var postorder []*optInstruction
+	backEdges := make(map[*optInstruction]map[*optInstruction]bool)
+	state := make(map[*optInstruction]int) // 1 while walked, 2 once walked
+	calls := make(map[*optInstruction]*frameAnalysis)
+	var walk func(in *optInstruction)
+	walk = func(in *optInstruction) {
+		state[in] = 1
+		a.reached[in] = true
+		successors := a.successors(in)
+		if in.spec.Name == "callsub" {
+			sub := a.subroutine(in)
+			calls[in] = sub
+			if sub.returns || !sub.done {
+				successors = []*optInstruction{a.next[in]}
+			}
+		}
+		for _, s := range successors {
+			if s == nil {
+				continue
+			}
+			switch state[s] {
+			case 0:
+				walk(s)
+			case 1:
+				if backEdges[in] == nil {
+					backEdges[in] = make(map[*optInstruction]bool)
+				}
+				backEdges[in][s] = true
+				a.loops[in.pc] = true
+				frame.bounded = false
+			}
+		}
+		state[in] = 2
+		postorder = append(postorder, in)
+	}
+	walk(entry)
+
+	if entry.spec.Name == "proto" {
+		frame.minHeight = -int(entry.immediates[0])
+	}
+
+	arrivals := map[*optInstruction]*arrival{entry: {}}
+	for i := len(postorder) - 1; i >= 0; i-- {
+		in := postorder[i]
+		at := arrivals[in]
+		if at == nil {
+			continue
+		}
+		cost := at.cost + in.worstCost()
+		needs, delta := in.stackEffect()
+		lo, hi := at.lo+delta, at.hi+delta
+		peak := hi
+		var successors []*optInstruction
+		if sub, ok := calls[in]; ok {
+			cost += sub.maxCost
+			frame.bounded = frame.bounded && sub.bounded
+			needs = -sub.minHeight
+			lo, hi = at.lo+sub.minRet, at.hi+sub.maxRet
+			peak = at.hi + sub.maxHeight
+			if sub.returns || !sub.done {
+				successors = []*optInstruction{a.next[in]}
+			} else {
+				// the subroutine never returns, so its cost is the cost of the path
+				frame.maxCost = imax(frame.maxCost, cost)
+			}
+		} else {
+			successors = a.successors(in)
+		}
+
+		if at.lo-needs < frame.minHeight {
+			frame.minHeight = at.lo - needs
+		}
+		if main && at.lo < needs {
+			a.underflows[in.pc] = true
+		}
+		frame.maxHeight = imax(frame.maxHeight, imax(peak, at.hi))
+
+		switch in.spec.Name {
+		case "err":
+			a.errors[in.pc] = true
+		case "retsub":
+			if entry.spec.Name == "proto" {
+				lo = int(entry.immediates[1]) - int(entry.immediates[0])
+				hi = lo
+			}
+			if !frame.returns {
+				frame.minRet, frame.maxRet = lo, hi
+			}
+			frame.returns = true
+			frame.minRet = imin(frame.minRet, lo)
+			frame.maxRet = imax(frame.maxRet, hi)
+		}
+
+		if len(successors) == 0 {
+			frame.maxCost = imax(frame.maxCost, cost)
+		}
+		for _, s := range successors {
+			if s == nil {
+				frame.maxCost = imax(frame.maxCost, cost)
+				continue
+			}
+			if backEdges[in][s] {
+				continue
+			}
+			next := arrivals[s]
+			if next == nil {
+				arrivals[s] = &arrival{cost: cost, lo: lo, hi: hi}
+				continue
+			}
+			next.cost = imax(next.cost, cost)
+			next.lo = imin(next.lo, lo)
+			next.hi = imax(next.hi, hi)
+		}
+	}
+	frame.done = true
+	return frame
+}
+
+// subroutine returns the analysis of the subroutine the callsub calls.
+func (a *analyzer) subroutine(call *optInstruction) *frameAnalysis {
+	entry := call.targets[0]
+	if entry == nil {
+		// calling the end of the program ends it
+		return &frameAnalysis{bounded: true, done: true}
+	}
+	if frame, ok := a.frames[entry]; ok {
+		if !frame.done {
+			// a recursive call, which we can't tell the depth of
+			a.loops[call.pc] = true
+			return &frameAnalysis{bounded: false}
+		}
+		return frame
+	}
+	return a.analyzeFrame(entry, false)
+}
+
+func imin(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func imax(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// ReportAnalysis writes the analysis of the program, with the source lines of
+// the program locations it names.
+func (ops *OpStream) ReportAnalysis(fname string, analysis ProgramAnalysis, writer io.Writer) {
+	where := func(pc int) string {
+		line, ok := ops.OffsetToLine[pc]
+		if !ok {
+			return fmt.Sprintf("pc %d", pc)
+		}
+		source := ops.sourceName(ops.OffsetToSource[pc])
+		if source == "" {
+			source = fname
+		}
+		if source == "" {
+			return fmt.Sprintf("%d", line+1)
+		}
+		return fmt.Sprintf("%s: %d", source, line+1)
+	}
+	prefix := ""
+	if fname != "" {
+		prefix = fname + ": "
+	}
+	cost := fmt.Sprintf("%d", analysis.MaxCost)
+	if !analysis.Bounded {
+		cost = fmt.Sprintf("unbounded (%d without repeating loops)", analysis.MaxCost)
+	}
+	fmt.Fprintf(writer, "%smax cost %s, max stack depth %d\n", prefix, cost, analysis.MaxStackDepth)
+	for _, sub := range analysis.Subroutines {
+		cost := fmt.Sprintf("%d", sub.MaxCost)
+		if !sub.Bounded {
+			cost = fmt.Sprintf("unbounded (%d without repeating loops)", sub.MaxCost)
+		}
+		fmt.Fprintf(writer, "%s: subroutine: max cost %s, max stack depth %d, args %d\n", where(sub.PC), cost, sub.MaxStackDepth, sub.Args)
+	}
+	if analysis.MaxStackDepth > maxStackDepth {
+		fmt.Fprintf(writer, "%swarning: stack may exceed %d values\n", prefix, maxStackDepth)
+	}
+	for _, pc := range analysis.Unreachable {
+		fmt.Fprintf(writer, "%s: warning: unreachable code\n", where(pc))
+	}
+	for _, pc := range analysis.Errors {
+		fmt.Fprintf(writer, "%s: warning: err is reachable\n", where(pc))
+	}
+	for _, pc := range analysis.Loops {
+		fmt.Fprintf(writer, "%s: warning: unbounded loop\n", where(pc))
+	}
+	for _, pc := range analysis.StackUnderflows {
+		fmt.Fprintf(writer, "%s: warning: stack may underflow\n", where(pc))
+	}
+}
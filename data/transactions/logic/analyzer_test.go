@@ -0,0 +1,228 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package logic
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// testAnalyze assembles and analyzes the source, and returns the analysis with
+// a function to find the pc of the instruction on a line of the source.
+func testAnalyze(t *testing.T, source string) (ProgramAnalysis, func(line int) int) {
+	t.Helper()
+	ops := testProg(t, source, AssemblerMaxVersion)
+	analysis, err := ops.Analyze()
+	require.NoError(t, err)
+	pcOf := func(line int) int {
+		for pc, l := range ops.OffsetToLine {
+			if l == line {
+				return pc
+			}
+		}
+		require.Fail(t, "no instruction", "line %d", line)
+		return 0
+	}
+	return analysis, pcOf
+}
+
+func TestAnalyzeCost(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	analysis, _ := testAnalyze(t, `
+txn Fee
+bnz expensive
+pushint 1
+return
+expensive:
+pushbytes "x"
+sha256
+len
+`)
+	require.True(t, analysis.Bounded)
+	require.Equal(t, 1+1+1+35+1, analysis.MaxCost)
+	require.Equal(t, 1, analysis.MaxStackDepth)
+	require.Empty(t, analysis.Unreachable)
+	require.Empty(t, analysis.Errors)
+	require.Empty(t, analysis.StackUnderflows)
+
+	// costs that depend on the length of values assume the longest values
+	analysis, _ = testAnalyze(t, `
+txn Note
+base64_decode StdEncoding
+len
+`)
+	require.Equal(t, 1+(1+maxStringSize/16)+1, analysis.MaxCost)
+
+	// costs that depend on immediates
+	analysis, _ = testAnalyze(t, `
+pushbytes "x"
+dup
+dup
+dup
+dup
+ecdsa_verify Secp256r1
+`)
+	require.Equal(t, 5+ecdsaVerifyCosts[Secp256r1], analysis.MaxCost)
+	require.Equal(t, 5, analysis.MaxStackDepth)
+}
+
+func TestAnalyzeSubroutines(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	analysis, pcOf := testAnalyze(t, `
+pushint 1
+pushint 2
+callsub add
+pop
+pushint 1
+return
+add:
++
+dup
+retsub
+`)
+	require.True(t, analysis.Bounded)
+	require.Equal(t, 6+3, analysis.MaxCost)
+	require.Equal(t, 2, analysis.MaxStackDepth)
+	require.Equal(t, []SubroutineAnalysis{{PC: pcOf(8), MaxCost: 3, Bounded: true, MaxStackDepth: 0, Args: 2}}, analysis.Subroutines)
+	require.Empty(t, analysis.StackUnderflows)
+
+	analysis, pcOf = testAnalyze(t, `
+pushint 1
+pushint 2
+callsub add
+pushint 3
+==
+return
+add:
+proto 2 1
+frame_dig -2
+frame_dig -1
++
+retsub
+`)
+	require.Equal(t, 4, analysis.MaxStackDepth)
+	require.Equal(t, []SubroutineAnalysis{{PC: pcOf(8), MaxCost: 5, Bounded: true, MaxStackDepth: 2, Args: 2}}, analysis.Subroutines)
+	require.Empty(t, analysis.StackUnderflows)
+
+	// a subroutine that doesn't return ends the paths that call it
+	analysis, pcOf = testAnalyze(t, `
+callsub done
+pushbytes "x"
+sha256
+done:
+pushint 1
+return
+`)
+	require.Equal(t, 1+1+1, analysis.MaxCost)
+	require.Equal(t, []int{pcOf(2)}, analysis.Unreachable)
+}
+
+func TestAnalyzeLoops(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	analysis, pcOf := testAnalyze(t, `
+pushint 10
+loop:
+pushint 1
+-
+dup
+bnz loop
+`)
+	require.False(t, analysis.Bounded)
+	require.Equal(t, []int{pcOf(6)}, analysis.Loops)
+	require.Equal(t, 5, analysis.MaxCost)
+
+	analysis, pcOf = testAnalyze(t, `
+pushint 1
+callsub f
+return
+f:
+callsub f
+retsub
+`)
+	require.False(t, analysis.Bounded)
+	require.Equal(t, []int{pcOf(5)}, analysis.Loops)
+	require.Len(t, analysis.Subroutines, 1)
+	require.False(t, analysis.Subroutines[0].Bounded)
+}
+
+func TestAnalyzeProblems(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	analysis, pcOf := testAnalyze(t, `
+pushint 1
+bnz ok
+err
+ok:
+pushint 1
+return
+pushint 2
+pushint 3
+`)
+	require.Equal(t, []int{pcOf(3)}, analysis.Errors)
+	require.Equal(t, []int{pcOf(7)}, analysis.Unreachable)
+
+	analysis, pcOf = testAnalyze(t, `
+pushint 1
+txn Fee
+bz skip
+pushint 2
+skip:
++
+`)
+	require.Equal(t, []int{pcOf(6)}, analysis.StackUnderflows)
+
+	analysis, _ = testAnalyze(t, `
+pushint 1
+dupn 5
+popn 5
+`)
+	require.Equal(t, 6, analysis.MaxStackDepth)
+	require.Empty(t, analysis.StackUnderflows)
+}
+
+func TestReportAnalysis(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	ops := testProg(t, `pushint 1
+callsub sub
+return
+pushint 2
+sub:
+err
+`, AssemblerMaxVersion)
+	analysis, err := ops.Analyze()
+	require.NoError(t, err)
+	var out strings.Builder
+	ops.ReportAnalysis("prog.teal", analysis, &out)
+	require.Equal(t, `prog.teal: max cost 3, max stack depth 1
+prog.teal: 6: subroutine: max cost 1, max stack depth 0, args 0
+prog.teal: 3: warning: unreachable code
+prog.teal: 6: warning: err is reachable
+`, out.String())
+}
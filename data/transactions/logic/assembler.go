@@ -28,6 +28,7 @@ import (
 	"fmt"
 	"io"
 	"math"
+	"path/filepath"
 	"sort"
 	"strconv"
 	"strings"
@@ -48,6 +49,7 @@ type Writer interface {
 
 type labelReference struct {
 	sourceLine int
+	source     int
 
 	// position of the label reference
 	position int
@@ -107,7 +109,7 @@ func (ref intReference) length(ops *OpStream, assembled []byte) (int, error) {
 	case opIntc:
 		return 2, nil
 	default:
-		return 0, ops.lineErrorf(ops.OffsetToLine[ref.position], "Unexpected op at intReference: %d", assembled[ref.position])
+		return 0, ops.offsetErrorf(ref.position, "Unexpected op at intReference: %d", assembled[ref.position])
 	}
 }
 
@@ -176,7 +178,7 @@ func (ref byteReference) length(ops *OpStream, assembled []byte) (int, error) {
 	case opBytec:
 		return 2, nil
 	default:
-		return 0, ops.lineErrorf(ops.OffsetToLine[ref.position], "Unexpected op at byteReference: %d", assembled[ref.position])
+		return 0, ops.offsetErrorf(ref.position, "Unexpected op at byteReference: %d", assembled[ref.position])
 	}
 }
 
@@ -252,6 +254,24 @@ type OpStream struct {
 	// map opcode offsets to source line
 	OffsetToLine map[int]int
 
+	// map opcode offsets to the index of their source in Sources
+	OffsetToSource map[int]int
+
+	// Sources are the names of the files the program was assembled from:
+	// the main file, whose name is empty when assembling a string, followed
+	// by the files it #include'd.
+	Sources []string
+
+	// index in Sources of the source being assembled
+	source int
+
+	// reads the files of #include directives, which are only allowed when
+	// assembling a file
+	readSource func(name string) ([]byte, error)
+
+	// macros defined by #define, by name
+	macros map[string]macro
+
 	HasStatefulOps bool
 
 	// Need new copy for each opstream
@@ -262,11 +282,14 @@ type OpStream struct {
 // OpStream must be used for each call to assemble().
 func newOpStream(version uint64) OpStream {
 	o := OpStream{
-		labels:       make(map[string]int),
-		OffsetToLine: make(map[int]int),
-		typeTracking: true,
-		Version:      version,
-		known:        ProgramKnowledge{fp: -1},
+		labels:         make(map[string]int),
+		OffsetToLine:   make(map[int]int),
+		OffsetToSource: make(map[int]int),
+		Sources:        []string{""},
+		macros:         make(map[string]macro),
+		typeTracking:   true,
+		Version:        version,
+		known:          ProgramKnowledge{fp: -1},
 	}
 
 	for i := range o.known.scratchSpace {
@@ -364,11 +387,12 @@ func (ops *OpStream) createLabel(label string) {
 // recordSourceLine adds an entry to pc to line mapping
 func (ops *OpStream) recordSourceLine() {
 	ops.OffsetToLine[ops.pending.Len()] = ops.sourceLine - 1
+	ops.OffsetToSource[ops.pending.Len()] = ops.source
 }
 
 // referToLabel records an opcode label reference to resolve later
 func (ops *OpStream) referToLabel(pc int, label string, offsetPosition int) {
-	ops.labelReferences = append(ops.labelReferences, labelReference{ops.sourceLine, pc, label, offsetPosition})
+	ops.labelReferences = append(ops.labelReferences, labelReference{ops.sourceLine, ops.source, pc, label, offsetPosition})
 }
 
 type refineFunc func(pgm *ProgramKnowledge, immediates []string) (StackTypes, StackTypes, error)
@@ -1677,11 +1701,17 @@ func prepareVersionedPseudoTable(version uint64) map[string]map[int]OpSpec {
 }
 
 type lineError struct {
-	Line int
-	Err  error
+	// Source is the name of the included file the error is in, or empty for
+	// the main source.
+	Source string
+	Line   int
+	Err    error
 }
 
 func (le lineError) Error() string {
+	if le.Source != "" {
+		return fmt.Sprintf("%s: %d: %s", le.Source, le.Line, le.Err.Error())
+	}
 	return fmt.Sprintf("%d: %s", le.Line, le.Err.Error())
 }
 
@@ -1857,10 +1887,42 @@ func splitTokens(tokens []string) (current, rest []string) {
 
 // assemble reads text from an input and accumulates the program
 func (ops *OpStream) assemble(text string) error {
-	fin := strings.NewReader(text)
 	if ops.Version > LogicVersion && ops.Version != assemblerNoVersion {
 		return ops.errorf("Can not assemble version %d", ops.Version)
 	}
+	ops.assembleSource(text)
+
+	// backward compatibility: do not allow jumps past last instruction in v1
+	if ops.Version <= 1 {
+		for label, dest := range ops.labels {
+			if dest == ops.pending.Len() {
+				ops.errorf("label %#v is too far away", label)
+			}
+		}
+	}
+
+	if ops.Version >= optimizeConstantsEnabledVersion {
+		ops.optimizeIntcBlock()
+		ops.optimizeBytecBlock()
+	}
+
+	ops.resolveLabels()
+	program := ops.prependCBlocks()
+	if ops.Errors != nil {
+		l := len(ops.Errors)
+		if l == 1 {
+			return errors.New("1 error")
+		}
+		return fmt.Errorf("%d errors", l)
+	}
+	ops.Program = program
+	return nil
+}
+
+// assembleSource reads the lines of a source, the main one or an included one,
+// and accumulates them to the program
+func (ops *OpStream) assembleSource(text string) {
+	fin := strings.NewReader(text)
 	scanner := bufio.NewScanner(fin)
 	for scanner.Scan() {
 		ops.sourceLine++
@@ -1873,12 +1935,23 @@ func (ops *OpStream) assemble(text string) error {
 				case "pragma":
 					ops.pragma(tokens) //nolint:errcheck // report bad pragma line error, but continue assembling
 					ops.trace("%3d: #pragma line\n", ops.sourceLine)
+				case "define":
+					ops.define(tokens) //nolint:errcheck // report bad define line error, but continue assembling
+					ops.trace("%3d: #define line\n", ops.sourceLine)
+				case "include":
+					ops.trace("%3d: #include line\n", ops.sourceLine)
+					ops.include(tokens) //nolint:errcheck // report bad include line error, but continue assembling
 				default:
 					ops.errorf("Unknown directive: %s", directive)
 				}
 				continue
 			}
 		}
+		tokens, err := ops.expandMacros(tokens, nil, nil)
+		if err != nil {
+			ops.error(err)
+			continue
+		}
 		for current, next := splitTokens(tokens); len(current) > 0 || len(next) > 0; current, next = splitTokens(next) {
 			if len(current) == 0 {
 				continue
@@ -1942,31 +2015,271 @@ func (ops *OpStream) assemble(text string) error {
 		}
 		ops.error(err)
 	}
+}
 
-	// backward compatibility: do not allow jumps past last instruction in v1
-	if ops.Version <= 1 {
-		for label, dest := range ops.labels {
-			if dest == ops.pending.Len() {
-				ops.errorf("label %#v is too far away", label)
+// macro is a name defined by #define, which is replaced by its body wherever
+// it's used. A macro with params is used like a call, `name(arg1, arg2)`, and
+// its params are replaced by the args in its body.
+type macro struct {
+	params []string
+	call   bool
+	body   []string
+}
+
+// maxMacroExpansions limits the tokens a line may expand to, which would
+// otherwise grow exponentially with nested macros.
+const maxMacroExpansions = 10000
+
+func isMacroIdentifier(name string) bool {
+	if name == "" {
+		return false
+	}
+	for i, c := range name {
+		letter := c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+		if !letter && (i == 0 || c < '0' || c > '9') {
+			return false
+		}
+	}
+	return true
+}
+
+// reservedMacroName returns why a macro can't be named name, if it can't,
+// because it would be ambiguous with the opcodes or their immediates.
+func reservedMacroName(name string) string {
+	if _, ok := OpsByName[LogicVersion][name]; ok {
+		return "an opcode"
+	}
+	if _, ok := pseudoOps[name]; ok {
+		return "a pseudo-op"
+	}
+	switch name {
+	case "base64", "b64", "base32", "b32":
+		return "a byte encoding"
+	}
+	for _, spec := range OpsByName[LogicVersion] {
+		for _, imm := range spec.OpDetails.Immediates {
+			if imm.Group == nil {
+				continue
+			}
+			for _, fieldName := range imm.Group.Names {
+				if fieldName == name {
+					return "a field name"
+				}
 			}
 		}
 	}
+	return ""
+}
 
-	if ops.Version >= optimizeConstantsEnabledVersion {
-		ops.optimizeIntcBlock()
-		ops.optimizeBytecBlock()
+// define records a macro, which is either a named constant like
+// `#define FEE 1000`, or takes params like `#define add3(a,b,c) a; b; +; c; +`.
+func (ops *OpStream) define(tokens []string) error {
+	if len(tokens) < 2 {
+		return ops.error("#define needs a name")
+	}
+	header := tokens[1]
+	rest := tokens[2:]
+	var m macro
+	name := header
+	if open := strings.IndexByte(header, '('); open >= 0 {
+		// params may be separated by spaces, which split them into several tokens
+		for !strings.HasSuffix(header, ")") {
+			if len(rest) == 0 {
+				return ops.errorf("#define %s is missing the closing parenthesis of its params", header[:open])
+			}
+			header += rest[0]
+			rest = rest[1:]
+		}
+		name = header[:open]
+		m.call = true
+		if params := header[open+1 : len(header)-1]; params != "" {
+			m.params = strings.Split(params, ",")
+		}
+		for i, param := range m.params {
+			if !isMacroIdentifier(param) {
+				return ops.errorf("#define %s has an invalid param: %#v", name, param)
+			}
+			for _, previous := range m.params[:i] {
+				if param == previous {
+					return ops.errorf("#define %s has a duplicate param: %s", name, param)
+				}
+			}
+		}
+	}
+	if !isMacroIdentifier(name) {
+		return ops.errorf("invalid macro name: %#v", name)
 	}
+	if reason := reservedMacroName(name); reason != "" {
+		return ops.errorf("macro name %s is %s", name, reason)
+	}
+	if _, ok := ops.macros[name]; ok {
+		return ops.errorf("duplicate macro %s", name)
+	}
+	m.body = rest
+	ops.macros[name] = m
+	return nil
+}
 
-	ops.resolveLabels()
-	program := ops.prependCBlocks()
-	if ops.Errors != nil {
-		l := len(ops.Errors)
-		if l == 1 {
-			return errors.New("1 error")
+// expandMacros returns the tokens with the macros they use replaced by their
+// bodies, which are expanded as well. The macros being expanded can't be used
+// again within their own bodies. Within the body of a macro with params,
+// args binds its params to the expanded tokens of its args.
+func (ops *OpStream) expandMacros(tokens []string, expanding map[string]bool, args map[string][]string) ([]string, error) {
+	if len(ops.macros) == 0 {
+		return tokens, nil
+	}
+	var expanded []string
+	for i := 0; i < len(tokens); i++ {
+		token := tokens[i]
+		if arg, ok := args[token]; ok {
+			expanded = append(expanded, arg...)
+			continue
+		}
+		name := token
+		if open := strings.IndexByte(token, '('); open > 0 {
+			name = token[:open]
+		}
+		m, ok := ops.macros[name]
+		if !ok || m.call != (name != token) {
+			expanded = append(expanded, token)
+			continue
+		}
+		if expanding[name] {
+			return nil, fmt.Errorf("macro %s is used recursively", name)
+		}
+
+		var bound map[string][]string
+		if m.call {
+			callArgs, used, err := macroArgs(tokens[i:])
+			if err != nil {
+				return nil, fmt.Errorf("macro %s: %w", name, err)
+			}
+			i += used - 1
+			if len(callArgs) != len(m.params) {
+				return nil, fmt.Errorf("macro %s expects %d args but got %d", name, len(m.params), len(callArgs))
+			}
+			bound = make(map[string][]string, len(m.params))
+			for p, param := range m.params {
+				bound[param], err = ops.expandMacros(callArgs[p], expanding, args)
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		nested := make(map[string]bool, len(expanding)+1)
+		for n := range expanding {
+			nested[n] = true
+		}
+		nested[name] = true
+		body, err := ops.expandMacros(m.body, nested, bound)
+		if err != nil {
+			return nil, err
+		}
+		expanded = append(expanded, body...)
+		if len(expanded) > maxMacroExpansions {
+			return nil, fmt.Errorf("macros expand to more than %d tokens", maxMacroExpansions)
 		}
-		return fmt.Errorf("%d errors", l)
 	}
-	ops.Program = program
+	return expanded, nil
+}
+
+// macroArgs parses the args of a macro call starting at tokens[0], like
+// `name(a, "b, c")`. It returns the tokens of each arg, and how many of the
+// tokens the call spans, as args separated by spaces span several of them.
+func macroArgs(tokens []string) (args [][]string, used int, err error) {
+	var arg strings.Builder
+	depth := 0
+	inString := false
+	for used < len(tokens) {
+		token := tokens[used]
+		used++
+		if used == 1 {
+			token = token[strings.IndexByte(token, '(')+1:]
+			depth = 1
+		} else {
+			arg.WriteByte(' ')
+		}
+		for i := 0; i < len(token); i++ {
+			c := token[i]
+			switch {
+			case inString:
+				if c == '\\' {
+					arg.WriteByte(c)
+					i++
+					if i < len(token) {
+						arg.WriteByte(token[i])
+					}
+					continue
+				}
+				inString = c != '"'
+			case c == '"':
+				inString = true
+			case c == '(':
+				depth++
+			case c == ')':
+				depth--
+				if depth == 0 {
+					if i != len(token)-1 {
+						return nil, 0, fmt.Errorf("unexpected %#v after the args", token[i+1:])
+					}
+					args = appendMacroArg(args, arg.String())
+					if len(args) == 1 && len(args[0]) == 0 {
+						// no args, rather than a single empty one
+						args = nil
+					}
+					return args, used, nil
+				}
+			case c == ',' && depth == 1:
+				args = appendMacroArg(args, arg.String())
+				arg.Reset()
+				continue
+			}
+			arg.WriteByte(c)
+		}
+	}
+	return nil, 0, errors.New("missing the closing parenthesis of the args")
+}
+
+func appendMacroArg(args [][]string, arg string) [][]string {
+	return append(args, tokensFromLine(strings.TrimSpace(arg)))
+}
+
+// include assembles the file of an `#include "file"` directive, resolved
+// relative to the directory of the including file, at this point. Each file
+// is only assembled once, so the files already assembled, including the main
+// one, are skipped.
+func (ops *OpStream) include(tokens []string) error {
+	if len(tokens) != 2 {
+		return ops.error("#include expects a single file name")
+	}
+	name, err := parseStringLiteral(tokens[1])
+	if err != nil || len(tokens[1]) < 2 {
+		return ops.errorf("#include expects a quoted file name: %s", tokens[1])
+	}
+	if ops.readSource == nil {
+		return ops.error("#include is only allowed when assembling a file")
+	}
+	path := string(name)
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(filepath.Dir(ops.Sources[ops.source]), path)
+	}
+
+	for _, source := range ops.Sources {
+		if filepath.Clean(source) == path {
+			return nil
+		}
+	}
+	text, err := ops.readSource(path)
+	if err != nil {
+		return ops.errorf("#include %s: %w", path, err)
+	}
+	ops.Sources = append(ops.Sources, path)
+
+	savedSource, savedLine := ops.source, ops.sourceLine
+	ops.source, ops.sourceLine = len(ops.Sources)-1, 0
+	ops.assembleSource(string(text))
+	ops.source, ops.sourceLine = savedSource, savedLine
 	return nil
 }
 
@@ -2029,11 +2342,11 @@ func (ops *OpStream) pragma(tokens []string) error {
 }
 
 func (ops *OpStream) resolveLabels() {
-	saved := ops.sourceLine
+	saved, savedSource := ops.sourceLine, ops.source
 	raw := ops.pending.Bytes()
 	reported := make(map[string]bool)
 	for _, lr := range ops.labelReferences {
-		ops.sourceLine = lr.sourceLine // so errors get reported where the label was used
+		ops.sourceLine, ops.source = lr.sourceLine, lr.source // so errors get reported where the label was used
 		dest, ok := ops.labels[lr.label]
 		if !ok {
 			if !reported[lr.label] {
@@ -2058,7 +2371,7 @@ func (ops *OpStream) resolveLabels() {
 		raw[lr.position+1] = uint8(jump & 0x0ff)
 	}
 	ops.pending = *bytes.NewBuffer(raw)
-	ops.sourceLine = saved
+	ops.sourceLine, ops.source = saved, savedSource
 }
 
 // AssemblerDefaultVersion what version of code do we emit by default
@@ -2208,7 +2521,7 @@ func (ops *OpStream) optimizeConstants(refs []constReference, constBlock []inter
 			}
 		}
 		if !found {
-			err = ops.lineErrorf(ops.OffsetToLine[ref.getPosition()], "Value not found in constant block: %v", ref.getValue())
+			err = ops.offsetErrorf(ref.getPosition(), "Value not found in constant block: %v", ref.getValue())
 			return
 		}
 	}
@@ -2246,7 +2559,7 @@ func (ops *OpStream) optimizeConstants(refs []constReference, constBlock []inter
 			}
 		}
 		if newIndex == -1 {
-			return nil, ops.lineErrorf(ops.OffsetToLine[ref.getPosition()], "Value not found in constant block: %v", ref.getValue())
+			return nil, ops.offsetErrorf(ref.getPosition(), "Value not found in constant block: %v", ref.getValue())
 		}
 
 		newBytes := ref.makeNewReference(ops, singleton, newIndex)
@@ -2293,15 +2606,8 @@ func (ops *OpStream) optimizeConstants(refs []constReference, constBlock []inter
 			}
 		}
 
-		fixedOffsetsToLine := make(map[int]int, len(ops.OffsetToLine))
-		for pos, sourceLine := range ops.OffsetToLine {
-			if pos > position {
-				fixedOffsetsToLine[pos+positionDelta] = sourceLine
-			} else {
-				fixedOffsetsToLine[pos] = sourceLine
-			}
-		}
-		ops.OffsetToLine = fixedOffsetsToLine
+		ops.OffsetToLine = shiftOffsets(ops.OffsetToLine, position, positionDelta)
+		ops.OffsetToSource = shiftOffsets(ops.OffsetToSource, position, positionDelta)
 	}
 
 	ops.pending = *bytes.NewBuffer(raw)
@@ -2358,28 +2664,48 @@ func (ops *OpStream) prependCBlocks() []byte {
 	}
 
 	// fixup offset to line mapping
-	newOffsetToLine := make(map[int]int, len(ops.OffsetToLine))
-	for o, l := range ops.OffsetToLine {
-		newOffsetToLine[o+pbl] = l
-	}
-	ops.OffsetToLine = newOffsetToLine
+	ops.OffsetToLine = shiftOffsets(ops.OffsetToLine, -1, pbl)
+	ops.OffsetToSource = shiftOffsets(ops.OffsetToSource, -1, pbl)
 
 	return out
 }
 
+// shiftOffsets returns the mapping of opcode offsets with the offsets after
+// position shifted by delta.
+func shiftOffsets(offsets map[int]int, position int, delta int) map[int]int {
+	shifted := make(map[int]int, len(offsets))
+	for pos, value := range offsets {
+		if pos > position {
+			shifted[pos+delta] = value
+		} else {
+			shifted[pos] = value
+		}
+	}
+	return shifted
+}
+
 func (ops *OpStream) error(problem interface{}) error {
-	return ops.lineError(ops.sourceLine, problem)
+	return ops.lineError(ops.source, ops.sourceLine, problem)
 }
 
-func (ops *OpStream) lineError(line int, problem interface{}) error {
+// sourceName returns the name errors report for the source of the given
+// index, which is empty for the main source.
+func (ops *OpStream) sourceName(source int) string {
+	if source == 0 {
+		return ""
+	}
+	return ops.Sources[source]
+}
+
+func (ops *OpStream) lineError(source int, line int, problem interface{}) error {
 	var err lineError
 	switch p := problem.(type) {
 	case string:
-		err = lineError{Line: line, Err: errors.New(p)}
+		err = lineError{Source: ops.sourceName(source), Line: line, Err: errors.New(p)}
 	case error:
-		err = lineError{Line: line, Err: p}
+		err = lineError{Source: ops.sourceName(source), Line: line, Err: p}
 	default:
-		err = lineError{Line: line, Err: fmt.Errorf("%#v", p)}
+		err = lineError{Source: ops.sourceName(source), Line: line, Err: fmt.Errorf("%#v", p)}
 	}
 	ops.Errors = append(ops.Errors, err)
 	return err
@@ -2389,19 +2715,21 @@ func (ops *OpStream) errorf(format string, a ...interface{}) error {
 	return ops.error(fmt.Errorf(format, a...))
 }
 
-func (ops *OpStream) lineErrorf(line int, format string, a ...interface{}) error {
-	return ops.lineError(line, fmt.Errorf(format, a...))
+// offsetErrorf reports an error at the source line of the opcode at offset.
+func (ops *OpStream) offsetErrorf(offset int, format string, a ...interface{}) error {
+	return ops.lineError(ops.OffsetToSource[offset], ops.OffsetToLine[offset], fmt.Errorf(format, a...))
 }
 
 func (ops *OpStream) warn(problem interface{}) error {
 	var le *lineError
+	source := ops.sourceName(ops.source)
 	switch p := problem.(type) {
 	case string:
-		le = &lineError{Line: ops.sourceLine, Err: errors.New(p)}
+		le = &lineError{Source: source, Line: ops.sourceLine, Err: errors.New(p)}
 	case error:
-		le = &lineError{Line: ops.sourceLine, Err: p}
+		le = &lineError{Source: source, Line: ops.sourceLine, Err: p}
 	default:
-		le = &lineError{Line: ops.sourceLine, Err: fmt.Errorf("%#v", p)}
+		le = &lineError{Source: source, Line: ops.sourceLine, Err: fmt.Errorf("%#v", p)}
 	}
 	warning := fmt.Errorf("warning: %w", le)
 	ops.Warnings = append(ops.Warnings, warning)
@@ -2417,7 +2745,8 @@ func (ops *OpStream) ReportProblems(fname string, writer io.Writer) {
 		if i > 9 {
 			break
 		}
-		if fname == "" {
+		if fname == "" || e.Source != "" {
+			// errors in included files report their file
 			fmt.Fprintf(writer, "%s\n", e)
 		} else {
 			fmt.Fprintf(writer, "%s: %s\n", fname, e)
@@ -2453,6 +2782,18 @@ func AssembleStringWithVersion(text string, version uint64) (*OpStream, error) {
 	return &ops, err
 }
 
+// AssembleFile assembles the text of a program read from the given file like
+// AssembleString does. Unlike programs assembled from strings, it may
+// #include other files, which are resolved relative to the directory of the
+// file that includes them, and read with readFile.
+func AssembleFile(filename string, text string, readFile func(name string) ([]byte, error)) (*OpStream, error) {
+	ops := newOpStream(assemblerNoVersion)
+	ops.Sources[0] = filename
+	ops.readSource = readFile
+	err := ops.assemble(text)
+	return &ops, err
+}
+
 type disassembleState struct {
 	program []byte
 	pc      int
@@ -20,6 +20,7 @@ import (
 	"bytes"
 	"encoding/hex"
 	"fmt"
+	"path/filepath"
 	"regexp"
 	"strings"
 	"testing"
@@ -379,6 +380,11 @@ const boxNonsense = `
   box_get
 `
 
+const boxResizeNonsense = `
+  box_splice
+  box_resize
+`
+
 const randomnessNonsense = `
 pushint 0xffff
 block BlkTimestamp
@@ -427,7 +433,7 @@ pushbytess "1" "2" "1"
 
 const v8Nonsense = v7Nonsense + switchNonsense + frameNonsense + matchNonsense + boxNonsense
 
-const v9Nonsense = v8Nonsense + pairingNonsense
+const v9Nonsense = v8Nonsense + boxResizeNonsense + pairingNonsense
 
 const v6Compiled = "2004010002b7a60c26050242420c68656c6c6f20776f726c6421070123456789abcd208dae2087fbba51304eb02b91f656948397a7946390e8cb70fc9ea4d95f92251d047465737400320032013202320380021234292929292b0431003101310231043105310731083109310a310b310c310d310e310f3111311231133114311533000033000133000233000433000533000733000833000933000a33000b33000c33000d33000e33000f3300113300123300133300143300152d2e01022581f8acd19181cf959a1281f8acd19181cf951a81f8acd19181cf1581f8acd191810f082209240a220b230c240d250e230f2310231123122313231418191a1b1c28171615400003290349483403350222231d4a484848482b50512a632223524100034200004322602261222704634848222862482864286548482228246628226723286828692322700048482371004848361c0037001a0031183119311b311d311e311f312023221e312131223123312431253126312731283129312a312b312c312d312e312f447825225314225427042455220824564c4d4b0222382124391c0081e80780046a6f686e2281d00f23241f880003420001892224902291922494249593a0a1a2a3a4a5a6a7a8a9aaabacadae24af3a00003b003c003d816472064e014f012a57000823810858235b235a2359b03139330039b1b200b322c01a23c1001a2323c21a23c3233e233f8120af06002a494905002a49490700b400b53a03b6b7043cb8033a0c2349c42a9631007300810881088120978101c53a8101c6003a"
 
@@ -438,12 +444,14 @@ const v7Compiled = v6Compiled + "5e005f018120af060180070123456789abcd49490501988
 
 const boxCompiled = "b9babbbcbdbfbe"
 
+const boxResizeCompiled = "d2d3"
+
 const switchCompiled = "81018d02fff800008101"
 const matchCompiled = "83030102018e02fff500008203013101320131"
 
 const v8Compiled = v7Compiled + switchCompiled + frameCompiled + matchCompiled + boxCompiled
 
-const v9Compiled = v8Compiled + pairingCompiled
+const v9Compiled = v8Compiled + boxResizeCompiled + pairingCompiled
 
 var nonsense = map[uint64]string{
 	1: v1Nonsense,
@@ -3051,3 +3059,138 @@ func TestAssemblePushConsts(t *testing.T) {
 	source = `pushbytess "x" "y"; +`
 	testProg(t, source, AssemblerMaxVersion, Expect{1, "+ arg 1 wanted type uint64 got []byte"})
 }
+
+func TestMacros(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	// named constants are replaced wherever they're used, even as immediates
+	checkSame := func(macros string, expanded string) {
+		t.Helper()
+		ops := testProg(t, macros, AssemblerMaxVersion)
+		require.Equal(t, testProg(t, expanded, AssemblerMaxVersion).Program, ops.Program)
+	}
+	checkSame(`#define FEE 1000
+#define GREETING "hello world"
+#define SLOT 3
+int FEE; store SLOT; byte GREETING; load SLOT; itob; concat; len`,
+		`int 1000; store 3; byte "hello world"; load 3; itob; concat; len`)
+
+	// macros with params, whose bodies may hold several instructions and use other macros
+	checkSame(`#define ONE 1
+#define add(a, b) int a; int b; +
+#define add3(a,b,c) add(a, b); int c; +
+add3(ONE, 2, 0x03)
+add( 4 , ONE ); +
+#define noargs() int 5
+noargs(); +`,
+		`int 1; int 2; +; int 3; +
+int 4; int 1; +; +
+int 5; +`)
+
+	// args may hold spaces and commas within strings or parentheses
+	checkSame(`#define eq(x, y) byte x; byte y; ==
+eq("a, (b", base64(YQ==))`,
+		`byte "a, (b"; byte base64(YQ==); ==`)
+
+	// macros defined later apply to the lines after them only, and labels are left alone
+	checkSame(`int 1
+#define target done
+b target
+target:
+done:`,
+		`int 1; b done; target:; done:`)
+
+	testProg(t, "#define", AssemblerMaxVersion, Expect{1, "#define needs a name"})
+	testProg(t, "#define int 1", AssemblerMaxVersion, Expect{1, "macro name int is a pseudo-op"})
+	testProg(t, "#define pop 1", AssemblerMaxVersion, Expect{1, "macro name pop is an opcode"})
+	testProg(t, "#define Sender 1", AssemblerMaxVersion, Expect{1, "macro name Sender is a field name"})
+	testProg(t, "#define b64 1", AssemblerMaxVersion, Expect{1, "macro name b64 is a byte encoding"})
+	testProg(t, "#define 1x 1", AssemblerMaxVersion, Expect{1, `invalid macro name: "1x"`})
+	testProg(t, "#define X 1\n#define X 2", AssemblerMaxVersion, Expect{2, "duplicate macro X"})
+	testProg(t, "#define f(a,a) a", AssemblerMaxVersion, Expect{1, "#define f has a duplicate param: a"})
+	testProg(t, "#define f(a,) a", AssemblerMaxVersion, Expect{1, `#define f has an invalid param: ""`})
+	testProg(t, "#define f(a b", AssemblerMaxVersion, Expect{1, "#define f is missing the closing parenthesis..."})
+
+	testProg(t, "#define f(a) int a\nint 1\nf(1, 2)", AssemblerMaxVersion, Expect{3, "macro f expects 1 args but got 2"})
+	testProg(t, "#define f(a) int a\nint 1\nf(1", AssemblerMaxVersion, Expect{3, "macro f: missing the closing parenthesis of the args"})
+	testProg(t, "#define f(a) int a\nint 1\nf(1)x", AssemblerMaxVersion, Expect{3, `macro f: unexpected "x" after the args`})
+	testProg(t, "#define A B\n#define B int A\nint 1\nA", AssemblerMaxVersion, Expect{4, "macro A is used recursively"})
+	// a constant isn't called, and a macro with params must be
+	testProg(t, "#define X 1\nint X()", AssemblerMaxVersion, Expect{2, `strconv.ParseUint: parsing "X()": invalid syntax`})
+	testProg(t, "#define f(a) a\nint 1\nf", AssemblerMaxVersion, Expect{3, "unknown opcode: f"})
+
+	// errors in the expanded instructions are reported where the macro is used
+	testProg(t, "#define bad int; +\nint 1\nbad", AssemblerMaxVersion, Expect{3, "int needs one immediate argument..."})
+}
+
+func TestInclude(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	files := map[string]string{
+		filepath.Join("lib", "consts.teal"): "#define FEE 1000\n#define feeTimes(n) int FEE; int n; *\n",
+		filepath.Join("lib", "check.teal"):  "#include \"consts.teal\"\n\ncheck:\ntxn Fee\nfeeTimes(2)\n<=\nretsub\n",
+		filepath.Join("lib", "bad.teal"):    "int 1\npop 2\n",
+		filepath.Join("lib", "loop.teal"):   "#include \"../loop.teal\"\nint 2\n",
+		"loop.teal":                         "#include \"lib/loop.teal\"\nint 1\n+\n",
+	}
+	readFile := func(name string) ([]byte, error) {
+		text, ok := files[name]
+		if !ok {
+			return nil, fmt.Errorf("no file %s", name)
+		}
+		return []byte(text), nil
+	}
+
+	// consts.teal is only included once, by whichever file includes it first
+	main := `#pragma version 8
+#include "lib/consts.teal"
+callsub check
+assert
+int FEE
+return
+#include "lib/check.teal"`
+	ops, err := AssembleFile("main.teal", main, readFile)
+	require.NoError(t, err)
+	require.Equal(t, []string{"main.teal", filepath.Join("lib", "consts.teal"), filepath.Join("lib", "check.teal")}, ops.Sources)
+	expected := testProg(t, "callsub check; assert; int 1000; return; check:; txn Fee; int 1000; int 2; *; <=; retsub", 8)
+	require.Equal(t, expected.Program, ops.Program)
+
+	// each opcode maps to the line of the file it comes from
+	sourceLines := make(map[int][2]int)
+	for pc, line := range ops.OffsetToLine {
+		sourceLines[pc] = [2]int{ops.OffsetToSource[pc], line}
+	}
+	var lines [][2]int
+	for pc := 0; pc < len(ops.Program); pc++ {
+		if sourceLine, ok := sourceLines[pc]; ok {
+			lines = append(lines, sourceLine)
+		}
+	}
+	// callsub, assert, int, return, then txn, int, int, *, <= and retsub of check.teal
+	require.Equal(t, [][2]int{{0, 2}, {0, 3}, {0, 4}, {0, 5}, {2, 3}, {2, 4}, {2, 4}, {2, 4}, {2, 5}, {2, 6}}, lines)
+
+	// errors report the file and line they're in
+	ops, err = AssembleFile("main.teal", "int 1\n#include \"lib/bad.teal\"\nint 1\npop 3\n", readFile)
+	require.Error(t, err)
+	require.Len(t, ops.Errors, 2)
+	require.Equal(t, filepath.Join("lib", "bad.teal")+": 2: pop expects 0 immediate arguments", ops.Errors[0].Error())
+	require.Equal(t, "4: pop expects 0 immediate arguments", ops.Errors[1].Error())
+	var report strings.Builder
+	ops.ReportProblems("main.teal", &report)
+	require.Equal(t, filepath.Join("lib", "bad.teal")+": 2: pop expects 0 immediate arguments\nmain.teal: 4: pop expects 0 immediate arguments\n", report.String())
+
+	// files including each other are only assembled once as well
+	ops, err = AssembleFile("loop.teal", files["loop.teal"], readFile)
+	require.NoError(t, err)
+	require.Equal(t, testProg(t, "int 2; int 1; +", AssemblerDefaultVersion).Program, ops.Program)
+
+	ops, _ = AssembleFile("main.teal", `#include "missing.teal"`, readFile)
+	require.Equal(t, "1: #include missing.teal: no file missing.teal", ops.Errors[0].Error())
+	ops, _ = AssembleFile("main.teal", `#include missing.teal`, readFile)
+	require.Equal(t, "1: #include expects a quoted file name: missing.teal", ops.Errors[0].Error())
+
+	// strings can't include files, so that compiling them can't read arbitrary files
+	testProg(t, `#include "lib/consts.teal"`, AssemblerMaxVersion, Expect{1, "#include is only allowed when assembling a file"})
+}
@@ -28,6 +28,7 @@ const (
 	boxCreate = iota
 	boxRead
 	boxWrite
+	boxResize
 	boxDelete
 )
 
@@ -72,6 +73,13 @@ func (cx *EvalContext) availableBox(name string, operation int, createSize uint6
 			cx.available.dirtyBytes += writeSize
 		}
 		dirty = true
+	case boxResize:
+		// Only the new contents are written, whatever the old size.
+		if dirty {
+			cx.available.dirtyBytes -= uint64(len(content))
+		}
+		cx.available.dirtyBytes += createSize
+		dirty = true
 	case boxDelete:
 		if dirty {
 			cx.available.dirtyBytes -= uint64(len(content))
@@ -190,6 +198,86 @@ func opBoxReplace(cx *EvalContext) error {
 	return cx.Ledger.SetBox(cx.appID, name, bytes)
 }
 
+// spliceCarefully is used to make a NEW byteslice copy of original, with the
+// olen bytes at start replaced by replacement. The result is the same length as
+// original, so it is truncated or zero padded as needed.
+func spliceCarefully(original []byte, replacement []byte, start uint64, olen uint64) ([]byte, error) {
+	if start > uint64(len(original)) {
+		return nil, fmt.Errorf("replacement start %d beyond length: %d", start, len(original))
+	}
+	oEnd := start + olen
+	if oEnd < start {
+		return nil, fmt.Errorf("splice end exceeds uint64")
+	}
+	if oEnd > uint64(len(original)) {
+		return nil, fmt.Errorf("splice end %d beyond original length: %d", oEnd, len(original))
+	}
+
+	clone := make([]byte, len(original))
+	copy(clone, original[:start])
+	copied := copy(clone[start:], replacement)
+	if copied != len(replacement) {
+		return clone, nil // replacement ran past the end, nothing else fits
+	}
+	copy(clone[start+uint64(copied):], original[oEnd:])
+	return clone, nil
+}
+
+func opBoxSplice(cx *EvalContext) error {
+	last := len(cx.stack) - 1 // replacement
+	replacement := cx.stack[last].Bytes
+	length := cx.stack[last-1].Uint
+	start := cx.stack[last-2].Uint
+	name := string(cx.stack[last-3].Bytes)
+
+	err := argCheck(cx, name, 0)
+	if err != nil {
+		return err
+	}
+
+	contents, exists, err := cx.availableBox(name, boxWrite, 0 /* size is already known */)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return fmt.Errorf("no such box %#v", name)
+	}
+
+	bytes, err := spliceCarefully(contents, replacement, start, length)
+	if err != nil {
+		return err
+	}
+	cx.stack = cx.stack[:last-3]
+	return cx.Ledger.SetBox(cx.appID, name, bytes)
+}
+
+func opBoxResize(cx *EvalContext) error {
+	last := len(cx.stack) - 1 // size
+	prev := last - 1          // name
+
+	name := string(cx.stack[prev].Bytes)
+	size := cx.stack[last].Uint
+
+	err := argCheck(cx, name, size)
+	if err != nil {
+		return err
+	}
+
+	contents, exists, err := cx.availableBox(name, boxResize, size)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return fmt.Errorf("no such box %#v", name)
+	}
+
+	resized := make([]byte, size)
+	copy(resized, contents)
+	appAddr := cx.getApplicationAddress(cx.appID)
+	cx.stack = cx.stack[:prev]
+	return cx.Ledger.ResizeBox(cx.appID, name, resized, appAddr)
+}
+
 func opBoxDel(cx *EvalContext) error {
 	last := len(cx.stack) - 1 // name
 	name := string(cx.stack[last].Bytes)
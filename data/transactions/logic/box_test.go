@@ -139,6 +139,93 @@ func TestBoxReadWrite(t *testing.T) {
 		"invalid Box reference")
 }
 
+func TestBoxSplice(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	ep, txn, ledger := logic.MakeSampleEnv()
+
+	ledger.NewApp(txn.Sender, 888, basics.AppParams{})
+	logic.TestApp(t, `byte "self"; int 1; byte 0x30313233; box_replace; int 1`, ep, "no such box")
+	logic.TestApp(t, `byte "self"; byte 0x3031323334; box_put; int 1`, ep)
+
+	// Same size replacement is like box_replace
+	logic.TestApp(t, `byte "self"; int 1; int 2; byte 0x4142; box_splice;
+                      byte "self"; box_get; assert; byte 0x3041423334; ==`, ep)
+	// Insertion pushes the end of the box off
+	logic.TestApp(t, `byte "self"; int 1; int 0; byte 0x5051; box_splice;
+                      byte "self"; box_get; assert; byte 0x3050514142; ==`, ep)
+	// Removal pads the end with zeros
+	logic.TestApp(t, `byte "self"; int 0; int 2; byte 0x; box_splice;
+                      byte "self"; box_get; assert; byte 0x5141420000; ==`, ep)
+	// A long replacement is truncated
+	logic.TestApp(t, `byte "self"; int 3; int 1; byte 0x60616263; box_splice;
+                      byte "self"; box_get; assert; byte 0x5141426061; ==`, ep)
+	logic.TestApp(t, `byte "self"; int 5; int 0; byte 0x70; box_splice;
+                      byte "self"; box_get; assert; byte 0x5141426061; ==`, ep)
+
+	logic.TestApp(t, `byte "self"; int 6; int 0; byte 0x70; box_splice; int 1`, ep,
+		"replacement start 6 beyond length: 5")
+	logic.TestApp(t, `byte "self"; int 3; int 3; byte 0x70; box_splice; int 1`, ep,
+		"splice end 6 beyond original length: 5")
+	logic.TestApp(t, `byte "self"; int 3; int 0xffffffffffffffff; byte 0x70; box_splice; int 1`, ep,
+		"splice end exceeds uint64")
+	logic.TestApp(t, `byte "junk"; int 0; int 0; byte 0x70; box_splice; int 1`, ep,
+		"invalid Box reference")
+}
+
+func TestBoxResize(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	ep, txn, ledger := logic.MakeSampleEnv()
+
+	ledger.NewApp(txn.Sender, 888, basics.AppParams{})
+	logic.TestApp(t, `byte "self"; int 10; box_resize; int 1`, ep, "no such box")
+	logic.TestApp(t, `byte "self"; byte 0x3031323334; box_put; int 1`, ep)
+
+	// Growing pads with zeros, shrinking truncates
+	logic.TestApp(t, `byte "self"; int 7; box_resize;
+                      byte "self"; box_get; assert; byte 0x30313233340000; ==`, ep)
+	logic.TestApp(t, `byte "self"; int 2; box_resize;
+                      byte "self"; box_get; assert; byte 0x3031; ==`, ep)
+	logic.TestApp(t, `byte "self"; int 0; box_resize;
+                      byte "self"; box_len; assert; !`, ep)
+
+	// The app account's box bytes follow the size
+	logic.TestApp(t, `byte "self"; int 31; box_resize;
+                      int 888; app_params_get AppAddress; assert;
+	                  acct_params_get AcctTotalBoxBytes; pop; int 35; ==`, ep)
+	logic.TestApp(t, `int 888; app_params_get AppAddress; assert;
+	                  acct_params_get AcctTotalBoxes; pop; int 1; ==`, ep)
+
+	logic.TestApp(t, `byte "self"; int 1001; box_resize; int 1`, ep, "box size too large")
+	logic.TestApp(t, `byte "junk"; int 10; box_resize; int 1`, ep, "invalid Box reference")
+}
+
+// TestBoxResizeBudget ensures that resizing spends write budget on the new
+// size of the box, not the old one.
+func TestBoxResizeBudget(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	ep, _, ledger := logic.MakeSampleEnv()
+	ledger.NewApp(basics.Address{}, 888, basics.AppParams{})
+
+	// Sample tx[0] has two box refs, so write budget is 2*100
+	logic.TestApp(t, `byte "self"; int 150; box_create`, ep)
+	logic.TestApp(t, `byte "self"; int 201; box_resize; int 1`, ep, "write budget (200) exceeded")
+	logic.TestApp(t, `byte "self"; int 10; box_resize;
+                      byte "other"; int 190; box_create`, ep)
+	ledger.DelBoxes(888, "other")
+	// Writing the box first does not charge for both sizes
+	logic.TestApp(t, `byte "self"; int 0; byte 0x01; box_replace;
+                      byte "self"; int 200; box_resize; int 1`, ep)
+	logic.TestApp(t, `byte "self"; int 0; int 1; byte 0x02; box_splice;
+                      byte "self"; int 10; box_resize;
+                      byte "other"; int 191; box_create`, ep, "write budget (200) exceeded")
+}
+
 func TestBoxAcrossTxns(t *testing.T) {
 	partitiontest.PartitionTest(t)
 	t.Parallel()
@@ -215,6 +302,8 @@ func TestBoxUnavailableWithClearState(t *testing.T) {
 		"box_len":     `byte "self"; box_len`,
 		"box_put":     `byte "put"; byte "self"; box_put`,
 		"box_replace": `byte "self"; int 0; byte "new"; box_replace`,
+		"box_splice":  `byte "self"; int 0; int 2; byte "new"; box_splice`,
+		"box_resize":  `byte "self"; int 10; box_resize`,
 	}
 
 	for name, program := range tests {
@@ -519,6 +608,8 @@ func TestEarlyPanics(t *testing.T) {
 		"box_len":     `byte "%s"; box_len`,
 		"box_put":     `byte "%s"; byte "hello"; box_put`,
 		"box_replace": `byte "%s"; int 0; byte "new"; box_replace`,
+		"box_splice":  `byte "%s"; int 0; int 2; byte "new"; box_splice`,
+		"box_resize":  `byte "%s"; int 10; box_resize`,
 	}
 
 	ep, _, l := logic.MakeSampleEnv()
@@ -0,0 +1,171 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package logic
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// BudgetUse is the opcode budget that an app call contributed to the budget of
+// its group, and the budget that its program consumed. The budget consumed by
+// the inner app calls of an app call is in their own BudgetUse.
+type BudgetUse struct {
+	// GroupIndex is the index in the group of the top-level transaction of
+	// the app call, and InnerPath the indexes in the inner transactions of
+	// each app call down to it. InnerPath is empty for top-level app calls.
+	GroupIndex int
+	InnerPath  []int
+
+	AppID       basics.AppIndex
+	Contributed int
+	Consumed    int
+}
+
+func (bu BudgetUse) String() string {
+	var path strings.Builder
+	fmt.Fprintf(&path, "txn[%d]", bu.GroupIndex)
+	for _, i := range bu.InnerPath {
+		fmt.Fprintf(&path, ".itxn[%d]", i)
+	}
+	return fmt.Sprintf("%s (app %d) added %d, consumed %d", path.String(), bu.AppID, bu.Contributed, bu.Consumed)
+}
+
+// before tells whether the app call of bu is reported before the one of other:
+// the app calls are ordered as in their groups, each followed by its inner app
+// calls.
+func (bu BudgetUse) before(other BudgetUse) bool {
+	if bu.GroupIndex != other.GroupIndex {
+		return bu.GroupIndex < other.GroupIndex
+	}
+	for i := 0; i < len(bu.InnerPath) && i < len(other.InnerPath); i++ {
+		if bu.InnerPath[i] != other.InnerPath[i] {
+			return bu.InnerPath[i] < other.InnerPath[i]
+		}
+	}
+	return len(bu.InnerPath) < len(other.InnerPath)
+}
+
+func sortBudgetUses(uses []BudgetUse) {
+	sort.SliceStable(uses, func(i, j int) bool { return uses[i].before(uses[j]) })
+}
+
+// BudgetExceededError is returned by an app call that exceeds its opcode
+// budget, with the budget contributed and consumed by the app calls of its
+// group so far, to show how the pooled budget was spent.
+type BudgetExceededError struct {
+	pc   int
+	op   string
+	cost int
+
+	Uses []BudgetUse
+}
+
+func (e BudgetExceededError) Error() string {
+	msg := fmt.Sprintf("pc=%3d dynamic cost budget exceeded, executing %s: local program cost was %d",
+		e.pc, e.op, e.cost)
+	if len(e.Uses) == 0 {
+		return msg
+	}
+	uses := make([]string, len(e.Uses))
+	for i, use := range e.Uses {
+		uses[i] = use.String()
+	}
+	return msg + ". Group budget: " + strings.Join(uses, "; ")
+}
+
+// RecordBudgetUses makes the app calls of the group of the EvalParams record the
+// opcode budget they contribute and consume, for BudgetUses and the
+// BudgetExceededError of an app call. It must be called before any app call of
+// the group is evaluated, and does nothing without budget pooling, as there is
+// no group budget to report.
+func (ep *EvalParams) RecordBudgetUses() {
+	if ep.PooledApplicationBudget == nil || ep.budgetUses != nil {
+		return
+	}
+	uses := make([]BudgetUse, 0, len(ep.TxnGroup))
+	ep.budgetUses = &uses
+	ep.recordGroupBudgetUses(nil)
+}
+
+// BudgetUses returns the opcode budget contributed and consumed by each app
+// call of the group of the EvalParams, including inner app calls, if
+// RecordBudgetUses was called. Each app call is followed by its inner app
+// calls. The app calls that didn't run, because the group failed first, are
+// reported with no budget consumed.
+func (ep *EvalParams) BudgetUses() []BudgetUse {
+	if ep.budgetUses == nil {
+		return nil
+	}
+	uses := append([]BudgetUse(nil), *ep.budgetUses...)
+	sortBudgetUses(uses)
+	return uses
+}
+
+// recordGroupBudgetUses adds the BudgetUse of each app call of the group of ep.
+// The budget of all of them is added to the pool before any of them runs, so
+// they are recorded up front. caller is the app call that submitted the group
+// if it's an inner group, nil otherwise.
+func (ep *EvalParams) recordGroupBudgetUses(caller *EvalContext) {
+	ep.budgetUseIndexes = make([]int, len(ep.TxnGroup))
+	for i := range ep.TxnGroup {
+		ep.budgetUseIndexes[i] = -1
+		tx := &ep.TxnGroup[i].Txn
+		if tx.Type != protocol.ApplicationCallTx {
+			continue
+		}
+		use := BudgetUse{GroupIndex: i, AppID: tx.ApplicationID, Contributed: ep.Proto.MaxAppProgramCost}
+		if caller != nil {
+			parent := (*ep.budgetUses)[caller.budgetUse]
+			use.GroupIndex = parent.GroupIndex
+			// the inner transactions are appended after the inner group is done
+			index := len(caller.txn.EvalDelta.InnerTxns) + i
+			use.InnerPath = append(append([]int(nil), parent.InnerPath...), index)
+		}
+		ep.budgetUseIndexes[i] = len(*ep.budgetUses)
+		*ep.budgetUses = append(*ep.budgetUses, use)
+	}
+}
+
+// recordBudgetUse finds the BudgetUse of the app call of cx, which is updated
+// with its cost once it's evaluated.
+func (cx *EvalContext) recordBudgetUse() {
+	if cx.budgetUseIndexes == nil || cx.budgetUseIndexes[cx.groupIndex] < 0 {
+		return
+	}
+	cx.budgetUse = cx.budgetUseIndexes[cx.groupIndex]
+	// app creations only get their ID once they run
+	(*cx.budgetUses)[cx.budgetUse].AppID = cx.appID
+}
+
+// budgetExceeded returns the BudgetExceededError of executing spec, with the
+// budget consumed so far by the app calls being evaluated.
+func (cx *EvalContext) budgetExceeded(spec *OpSpec) error {
+	err := BudgetExceededError{pc: cx.pc, op: spec.Name, cost: cx.cost}
+	if cx.budgetUse >= 0 {
+		err.Uses = append([]BudgetUse(nil), *cx.budgetUses...)
+		for c := cx; c != nil; c = c.caller {
+			err.Uses[c.budgetUse].Consumed = c.cost
+		}
+		sortBudgetUses(err.Uses)
+	}
+	return err
+}
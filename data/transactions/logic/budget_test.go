@@ -0,0 +1,103 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package logic
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBudgetUses(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	ep, tx, ledger := makeSampleEnv()
+	ep.RecordBudgetUses()
+	ledger.NewApp(tx.Receiver, 222, basics.AppParams{
+		ApprovalProgram: testProg(t, "pushint 1", AssemblerMaxVersion).Program,
+	})
+	ledger.NewApp(tx.Receiver, 888, basics.AppParams{})
+	ledger.NewAccount(appAddr(888), 50_000)
+	tx.ForeignApps = []basics.AppIndex{222}
+
+	waste := `global CurrentApplicationAddress; keccak256; pop;`
+	buy := `itxn_begin
+int appl;    itxn_field TypeEnum
+int 222;     itxn_field ApplicationID
+itxn_submit;
+`
+	testApp(t, buy+buy+strings.Repeat(waste, 12)+"int 1", ep)
+	require.Equal(t, []BudgetUse{
+		{GroupIndex: 0, AppID: 888, Contributed: 700, Consumed: 1598},
+		{GroupIndex: 0, InnerPath: []int{0}, AppID: 222, Contributed: 700, Consumed: 1},
+		{GroupIndex: 0, InnerPath: []int{1}, AppID: 222, Contributed: 700, Consumed: 1},
+	}, ep.BudgetUses())
+
+	// the error of exceeding the budget has the budget consumed so far
+	ops := testProg(t, buy+strings.Repeat(waste, 12)+"int 1", AssemblerMaxVersion)
+	ep.reset()
+	_, err := EvalApp(ops.Program, 0, 888, ep)
+	var budgetErr BudgetExceededError
+	require.True(t, errors.As(err, &budgetErr), "%v", err)
+	require.Equal(t, []BudgetUse{
+		{GroupIndex: 0, AppID: 888, Contributed: 700, Consumed: 1327},
+		{GroupIndex: 0, InnerPath: []int{0}, AppID: 222, Contributed: 700, Consumed: 1},
+	}, budgetErr.Uses)
+	require.Equal(t, "pc= 54 dynamic cost budget exceeded, executing keccak256: local program cost was 1327. "+
+		"Group budget: txn[0] (app 888) added 700, consumed 1327; txn[0].itxn[0] (app 222) added 700, consumed 1",
+		err.Error())
+}
+
+func TestBudgetUsesPooling(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	txns := []transactions.SignedTxn{
+		{Txn: transactions.Transaction{Type: protocol.PaymentTx}},
+		{Txn: transactions.Transaction{Type: protocol.ApplicationCallTx, ApplicationCallTxnFields: transactions.ApplicationCallTxnFields{ApplicationID: 5}}},
+	}
+	proto := *makeTestProto()
+	ep := NewEvalParams(transactions.WrapSignedTxnsWithAD(txns), &proto, &transactions.SpecialAddresses{})
+	require.Nil(t, ep.BudgetUses())
+	ep.RecordBudgetUses()
+	require.Equal(t, []BudgetUse{{GroupIndex: 1, AppID: 5, Contributed: 700}}, ep.BudgetUses())
+
+	// without budget pooling, the app calls don't add to a group budget
+	proto.EnableAppCostPooling = false
+	ep = NewEvalParams(transactions.WrapSignedTxnsWithAD(txns), &proto, &transactions.SpecialAddresses{})
+	ep.RecordBudgetUses()
+	require.Nil(t, ep.BudgetUses())
+}
+
+func TestBudgetUseString(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	use := BudgetUse{GroupIndex: 2, InnerPath: []int{3, 0}, AppID: 12, Contributed: 700, Consumed: 450}
+	require.Equal(t, "txn[2].itxn[3].itxn[0] (app 12) added 700, consumed 450", use.String())
+
+	// a LogicSig has no group budget
+	err := BudgetExceededError{pc: 7, op: "sha256", cost: 701}
+	require.Equal(t, "pc=  7 dynamic cost budget exceeded, executing sha256: local program cost was 701", err.Error())
+}
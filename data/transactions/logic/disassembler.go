@@ -0,0 +1,319 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package logic
+
+import (
+	"crypto/sha512"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// annotator names the branch targets of a program after their use, for
+// DisassembleAnnotated.
+type annotator struct {
+	o   *optimizer
+	end int
+
+	// method signatures by their selector
+	methods map[string]string
+
+	labels map[int]string
+	used   map[string]bool
+
+	// comments written before the label of a pc, and before its instruction
+	headers map[int][]string
+	notes   map[int][]string
+}
+
+// DisassembleAnnotated produces a text form of program bytes for reading, like
+// Disassemble, with its branch targets named after their use. Subroutines are
+// named sub1, sub2... and preceded by a comment listing their call sites.
+// Branches that dispatch on the ABI method selector in ApplicationArgs 0, by
+// `match` or by `==` and `bnz`, are named after the method, when its
+// signature is one of methods, or else after the selector. Branches that
+// dispatch on the OnCompletion of the transaction, by `switch` or by `==` and
+// `bnz`, are named after the OnCompletion. Other targets are named label1,
+// label2... References to the constant blocks are followed by their values,
+// as in Disassemble. offsetToLine maps the pc of each instruction to its line
+// in text, for GetSourceMap. AssembleString(text) results in the same program
+// bytes.
+func DisassembleAnnotated(program []byte, methods []string) (text string, offsetToLine map[int]int, err error) {
+	o, err := parseForOptimization(program, nil, nil)
+	if err != nil {
+		return "", nil, err
+	}
+	a := &annotator{
+		o:       o,
+		end:     len(program),
+		methods: make(map[string]string, len(methods)),
+		labels:  make(map[int]string),
+		used:    make(map[string]bool),
+		headers: make(map[int][]string),
+		notes:   make(map[int][]string),
+	}
+	for _, method := range methods {
+		sum := sha512.Sum512_256([]byte(method))
+		a.methods[string(sum[:4])] = method
+	}
+	a.nameDispatches()
+	callers := a.nameSubroutines()
+	a.nameLabels()
+	a.commentCallers(callers)
+	return a.render(program)
+}
+
+// target returns the pc of the i'th target of the branch in.
+func (a *annotator) target(in *optInstruction, i int) int {
+	if in.targets[i] == nil {
+		return a.end
+	}
+	return in.targets[i].pc
+}
+
+// name names the pc, unless it's already named. Names that are already in use
+// are suffixed with the pc.
+func (a *annotator) name(pc int, label string) {
+	if _, ok := a.labels[pc]; ok {
+		return
+	}
+	if a.used[label] {
+		label = fmt.Sprintf("%s_%d", label, pc)
+	}
+	a.labels[pc] = label
+	a.used[label] = true
+}
+
+func isFirstAppArg(in *optInstruction) bool {
+	return in.spec.Name == "txna" && len(in.immediates) == 2 &&
+		in.immediates[0] == byte(ApplicationArgs) && in.immediates[1] == 0
+}
+
+func isOnCompletion(in *optInstruction) bool {
+	return in.spec.Name == "txn" && len(in.immediates) == 1 && in.immediates[0] == byte(OnCompletion)
+}
+
+// bytesConstants returns the byte constants pushed by in, if it only pushes
+// constants.
+func (a *annotator) bytesConstants(in *optInstruction) ([][]byte, bool) {
+	switch in.spec.Name {
+	case "pushbytes":
+		length, used := binary.Uvarint(in.immediates)
+		return [][]byte{in.immediates[used : used+int(length)]}, true
+	case "pushbytess":
+		constants, _, err := parseByteImmArgs(in.immediates, 0)
+		return constants, err == nil
+	}
+	if in.isBytecRef() && a.o.bytecBlock != nil && in.constIndex < len(a.o.bytec) {
+		return [][]byte{a.o.bytec[in.constIndex]}, true
+	}
+	return nil, false
+}
+
+// selector returns the method selector pushed by in, if it pushes one.
+func (a *annotator) selector(in *optInstruction) ([]byte, bool) {
+	constants, ok := a.bytesConstants(in)
+	if !ok || len(constants) != 1 || len(constants[0]) != 4 {
+		return nil, false
+	}
+	return constants[0], true
+}
+
+// nameMethod names the target of the dispatch at pc on the method selector.
+func (a *annotator) nameMethod(pc int, selector []byte, target int) {
+	method, known := a.methods[string(selector)]
+	if !known {
+		method = "0x" + hex.EncodeToString(selector)
+		a.name(target, "method_"+hex.EncodeToString(selector))
+	} else if paren := strings.IndexByte(method, '('); paren > 0 {
+		a.name(target, "method_"+method[:paren])
+	} else {
+		a.name(target, "method_"+hex.EncodeToString(selector))
+	}
+	a.notes[pc] = append(a.notes[pc], fmt.Sprintf("// method %s -> %s", method, a.labels[target]))
+}
+
+// nameOnCompletion names the target of the dispatch on the OnCompletion oc.
+func (a *annotator) nameOnCompletion(oc uint64, target int) {
+	if oc < uint64(len(OnCompletionNames)) {
+		a.name(target, "on_"+OnCompletionNames[oc])
+	}
+}
+
+// nameDispatches names the targets of the branches that dispatch on the method
+// selector or the OnCompletion of the transaction.
+func (a *annotator) nameDispatches() {
+	instructions := a.o.instructions
+	for i, in := range instructions {
+		switch in.spec.Name {
+		case "bnz":
+			if i < 3 || instructions[i-1].spec.Name != "==" {
+				continue
+			}
+			target := a.target(in, 0)
+			for _, pair := range [][2]*optInstruction{
+				{instructions[i-3], instructions[i-2]},
+				{instructions[i-2], instructions[i-3]},
+			} {
+				field, constant := pair[0], pair[1]
+				if isFirstAppArg(field) {
+					if selector, ok := a.selector(constant); ok {
+						a.nameMethod(in.pc, selector, target)
+					}
+				}
+				if isOnCompletion(field) {
+					if oc, ok := a.o.intConstant(constant); ok {
+						a.nameOnCompletion(oc, target)
+					}
+				}
+			}
+		case "match":
+			if i < 2 || !isFirstAppArg(instructions[i-1]) {
+				continue
+			}
+			// collect the constants matched against, which are pushed before
+			var selectors [][]byte
+			for j := i - 2; j >= 0 && len(selectors) < len(in.targets); j-- {
+				constants, ok := a.bytesConstants(instructions[j])
+				if !ok {
+					break
+				}
+				selectors = append(append([][]byte{}, constants...), selectors...)
+			}
+			if len(selectors) != len(in.targets) {
+				continue
+			}
+			for k, selector := range selectors {
+				if len(selector) == 4 {
+					a.nameMethod(in.pc, selector, a.target(in, k))
+				}
+			}
+		case "switch":
+			if i < 1 || !isOnCompletion(instructions[i-1]) {
+				continue
+			}
+			for k := range in.targets {
+				a.nameOnCompletion(uint64(k), a.target(in, k))
+			}
+		}
+	}
+}
+
+// nameSubroutines names the targets of callsub by their address, and returns
+// the pcs of their call sites.
+func (a *annotator) nameSubroutines() map[int][]int {
+	callers := make(map[int][]int)
+	var entries []int
+	for _, in := range a.o.instructions {
+		if in.spec.Name != "callsub" {
+			continue
+		}
+		entry := a.target(in, 0)
+		if callers[entry] == nil {
+			entries = append(entries, entry)
+		}
+		callers[entry] = append(callers[entry], in.pc)
+	}
+	sort.Ints(entries)
+	for i, entry := range entries {
+		a.name(entry, fmt.Sprintf("sub%d", i+1))
+	}
+	return callers
+}
+
+// nameLabels names the targets that aren't named yet by their address.
+func (a *annotator) nameLabels() {
+	targets := make(map[int]bool)
+	for _, in := range a.o.instructions {
+		for i := range in.targets {
+			targets[a.target(in, i)] = true
+		}
+	}
+	var pcs []int
+	for pc := range targets {
+		if _, ok := a.labels[pc]; !ok {
+			pcs = append(pcs, pc)
+		}
+	}
+	sort.Ints(pcs)
+	for i, pc := range pcs {
+		a.name(pc, fmt.Sprintf("label%d", i+1))
+	}
+}
+
+// commentCallers precedes each subroutine by the call sites, named after the
+// label they follow.
+func (a *annotator) commentCallers(callers map[int][]int) {
+	var labeled []int
+	for pc := range a.labels {
+		labeled = append(labeled, pc)
+	}
+	sort.Ints(labeled)
+	enclosing := func(pc int) string {
+		i := sort.SearchInts(labeled, pc+1)
+		if i == 0 {
+			return "main"
+		}
+		return a.labels[labeled[i-1]]
+	}
+	for entry, pcs := range callers {
+		var sites []string
+		for _, pc := range pcs {
+			sites = append(sites, fmt.Sprintf("%s (pc %d)", enclosing(pc), pc))
+		}
+		a.headers[entry] = append(a.headers[entry], "// called from "+strings.Join(sites, ", "))
+	}
+}
+
+func (a *annotator) render(program []byte) (string, map[int]int, error) {
+	out := strings.Builder{}
+	lines := 0
+	writeLine := func(line string) {
+		out.WriteString(line)
+		out.WriteByte('\n')
+		lines++
+	}
+	writeLabel := func(pc int) {
+		for _, header := range a.headers[pc] {
+			writeLine(header)
+		}
+		if label, ok := a.labels[pc]; ok {
+			writeLine(label + ":")
+		}
+	}
+
+	offsetToLine := make(map[int]int, len(a.o.instructions))
+	dis := disassembleState{program: program, out: &out, pendingLabels: a.labels}
+	writeLine(fmt.Sprintf("#pragma version %d", a.o.version))
+	for _, in := range a.o.instructions {
+		writeLabel(in.pc)
+		for _, note := range a.notes[in.pc] {
+			writeLine(note)
+		}
+		dis.pc = in.pc
+		line, err := disassemble(&dis, in.spec)
+		if err != nil {
+			return "", nil, err
+		}
+		offsetToLine[in.pc] = lines
+		writeLine(line)
+	}
+	writeLabel(a.end)
+	return out.String(), offsetToLine, nil
+}
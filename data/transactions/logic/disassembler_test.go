@@ -0,0 +1,184 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package logic
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+	"github.com/stretchr/testify/require"
+)
+
+const annotatedRouter = `#pragma version 8
+txn ApplicationID
+bz create
+txn OnCompletion
+int DeleteApplication
+==
+bnz delete
+txn OnCompletion
+switch main optin
+err
+main:
+pushbytess 0xfe6bdf69 0x01020304
+txna ApplicationArgs 0
+match add other
+txna ApplicationArgs 0
+method "sub(uint64,uint64)uint64"
+==
+bnz sub
+err
+add:
+callsub arg12
++
+callsub ret
+sub:
+callsub arg12
+-
+callsub ret
+other:
+optin:
+int 1
+return
+arg12:
+txna ApplicationArgs 1
+btoi
+txna ApplicationArgs 2
+btoi
+retsub
+ret:
+itob
+log
+int 1
+return
+create:
+delete:
+txn Sender
+global CreatorAddress
+==
+`
+
+func TestDisassembleAnnotated(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	ops := testProg(t, annotatedRouter, 8)
+	text, offsetToLine, err := DisassembleAnnotated(ops.Program, []string{"add(uint64,uint64)uint64", "sub(uint64,uint64)uint64"})
+	require.NoError(t, err)
+	require.Equal(t, `#pragma version 8
+intcblock 1
+txn ApplicationID
+bz on_DeleteApplication
+txn OnCompletion
+pushint 5
+==
+bnz on_DeleteApplication
+txn OnCompletion
+switch on_NoOp on_OptIn
+err
+on_NoOp:
+pushbytess 0xfe6bdf69 0x01020304
+txna ApplicationArgs 0
+// method add(uint64,uint64)uint64 -> method_add
+// method 0x01020304 -> on_OptIn
+match method_add on_OptIn
+txna ApplicationArgs 0
+pushbytes 0x78b488b7 // 0x78b488b7
+==
+// method sub(uint64,uint64)uint64 -> method_sub
+bnz method_sub
+err
+method_add:
+callsub sub1
++
+callsub sub2
+method_sub:
+callsub sub1
+-
+callsub sub2
+on_OptIn:
+intc_0 // 1
+return
+// called from method_add (pc 61), method_sub (pc 68)
+sub1:
+txna ApplicationArgs 1
+btoi
+txna ApplicationArgs 2
+btoi
+retsub
+// called from method_add (pc 65), method_sub (pc 72)
+sub2:
+itob
+log
+intc_0 // 1
+return
+on_DeleteApplication:
+txn Sender
+global CreatorAddress
+==
+`, text)
+
+	again := testProg(t, text, 8)
+	require.Equal(t, ops.Program, again.Program)
+
+	// each pc maps to the line of its instruction
+	lines := strings.Split(text, "\n")
+	require.Len(t, offsetToLine, 38)
+	for pc, line := range offsetToLine {
+		spec := opsByOpcode[8][ops.Program[pc]]
+		require.True(t, strings.HasPrefix(lines[line], spec.Name), "%d: %s", pc, lines[line])
+	}
+
+	// without the signatures, methods are named after their selector
+	text, _, err = DisassembleAnnotated(ops.Program, nil)
+	require.NoError(t, err)
+	require.Contains(t, text, "// method 0xfe6bdf69 -> method_fe6bdf69\n")
+	require.Contains(t, text, "\nmethod_78b488b7:\n")
+}
+
+func TestDisassembleAnnotatedCycle(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	for v, source := range nonsense {
+		if v > LogicVersion {
+			continue
+		}
+		v, source := v, source
+		t.Run(fmt.Sprintf("v=%d", v), func(t *testing.T) {
+			t.Parallel()
+			ops := testProg(t, source, v)
+			text, offsetToLine, err := DisassembleAnnotated(ops.Program, nil)
+			require.NoError(t, err)
+			again := testProg(t, notrack(text), v)
+			require.Equal(t, ops.Program, again.Program)
+			require.NotEmpty(t, offsetToLine)
+		})
+	}
+}
+
+func TestDisassembleAnnotatedErrors(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	_, _, err := DisassembleAnnotated([]byte{0x08, 0xff}, nil)
+	require.ErrorContains(t, err, "illegal opcode")
+	_, _, err = DisassembleAnnotated([]byte{0x08, 0x42, 0x00}, nil)
+	require.ErrorContains(t, err, "short of immediate")
+}
@@ -236,6 +236,7 @@ type LedgerForLogic interface {
 	NewBox(appIdx basics.AppIndex, key string, value []byte, appAddr basics.Address) error
 	GetBox(appIdx basics.AppIndex, key string) ([]byte, bool, error)
 	SetBox(appIdx basics.AppIndex, key string, value []byte) error
+	ResizeBox(appIdx basics.AppIndex, key string, value []byte, appAddr basics.Address) error
 	DelBox(appIdx basics.AppIndex, key string, appAddr basics.Address) (bool, error)
 
 	Perform(gi int, ep *EvalParams) error
@@ -285,6 +286,9 @@ type EvalParams struct {
 	// optional debugger
 	Debugger DebuggerHook
 
+	// optional profiler
+	Profiler *Profiler
+
 	// MinAvmVersion is the minimum allowed AVM version of this program.
 	// The program must reject if its version is less than this version. If
 	// MinAvmVersion is nil, we will compute it ourselves
@@ -304,6 +308,13 @@ type EvalParams struct {
 	// Total allowable inner txns in a group transaction (nil before inner pooling enabled)
 	pooledAllowedInners *int
 
+	// budgetUses records the budget of the app calls of a group with app
+	// calls, including inner app calls, once RecordBudgetUses is called
+	budgetUses *[]BudgetUse
+	// budgetUseIndexes are the indexes in budgetUses of the app calls of the
+	// group, -1 for the other transactions
+	budgetUseIndexes []int
+
 	// available contains resources that may be used even though they are not
 	// necessarily directly in the txn's "static arrays". Apps and ASAs go in if
 	// the app or asa was created earlier in the txgroup (empty until
@@ -456,11 +467,13 @@ func NewInnerEvalParams(txg []transactions.SignedTxnWithAD, caller *EvalContext)
 		SigLedger:               caller.SigLedger,
 		Ledger:                  caller.Ledger,
 		Debugger:                nil, // See #4438, where this becomes caller.Debugger
+		Profiler:                caller.Profiler,
 		MinAvmVersion:           &minAvmVersion,
 		FeeCredit:               caller.FeeCredit,
 		Specials:                caller.Specials,
 		PooledApplicationBudget: caller.PooledApplicationBudget,
 		pooledAllowedInners:     caller.pooledAllowedInners,
+		budgetUses:              caller.budgetUses,
 		available:               caller.available,
 		ioBudget:                caller.ioBudget,
 		readBudgetChecked:       true, // don't check for inners
@@ -468,6 +481,9 @@ func NewInnerEvalParams(txg []transactions.SignedTxnWithAD, caller *EvalContext)
 		// read comment in EvalParams declaration about txid caches
 		caller: caller,
 	}
+	if caller.budgetUse >= 0 {
+		ep.recordGroupBudgetUses(caller)
+	}
 	return ep
 }
 
@@ -580,6 +596,9 @@ type EvalContext struct {
 	cost    int                            // cost incurred so far
 	logSize int                            // total log size so far
 
+	// index of the BudgetUse of the app call in budgetUses, -1 if none
+	budgetUse int
+
 	// Set of PC values that branches we've seen so far might
 	// go. So, if checkStep() skips one, that branch is trying to
 	// jump into the middle of a multibyte instruction
@@ -704,6 +723,7 @@ func EvalContract(program []byte, gi int, aid basics.AppIndex, params *EvalParam
 		groupIndex:   gi,
 		txn:          &params.TxnGroup[gi],
 		appID:        aid,
+		budgetUse:    -1,
 	}
 
 	if cx.Proto.IsolateClearState && cx.txn.Txn.OnCompletion == transactions.ClearStateOC {
@@ -758,7 +778,11 @@ func EvalContract(program []byte, gi int, aid basics.AppIndex, params *EvalParam
 	if cx.Trace != nil && cx.caller != nil {
 		fmt.Fprintf(cx.Trace, "--- enter %d %s %v\n", aid, cx.txn.Txn.OnCompletion, cx.txn.Txn.ApplicationArgs)
 	}
+	cx.recordBudgetUse()
 	pass, err := eval(program, &cx)
+	if cx.budgetUse >= 0 {
+		(*cx.budgetUses)[cx.budgetUse].Consumed = cx.cost
+	}
 
 	if cx.Trace != nil && cx.caller != nil {
 		fmt.Fprintf(cx.Trace, "--- exit  %d accept=%t\n", aid, pass)
@@ -790,6 +814,7 @@ func EvalSignatureFull(gi int, params *EvalParams) (pass bool, pcx *EvalContext,
 		runModeFlags: modeSig,
 		groupIndex:   gi,
 		txn:          &params.TxnGroup[gi],
+		budgetUse:    -1,
 	}
 	pass, err = eval(cx.txn.Lsig.Logic, &cx)
 	return pass, &cx, err
@@ -858,6 +883,11 @@ func eval(program []byte, cx *EvalContext) (pass bool, err error) {
 		return false, verr
 	}
 
+	var profile *ProgramProfile
+	if cx.Profiler != nil {
+		profile = cx.Profiler.start(program)
+	}
+
 	for (err == nil) && (cx.pc < len(cx.program)) {
 		if cx.Debugger != nil {
 			if derr := cx.Debugger.Update(cx.refreshDebugState(err)); derr != nil {
@@ -865,7 +895,11 @@ func eval(program []byte, cx *EvalContext) (pass bool, err error) {
 			}
 		}
 
-		err = cx.step()
+		if profile != nil {
+			err = profile.step(cx)
+		} else {
+			err = cx.step()
+		}
 	}
 	if err != nil {
 		if cx.Trace != nil {
@@ -1093,8 +1127,7 @@ func (cx *EvalContext) step() error {
 		if cx.PooledApplicationBudget != nil {
 			*cx.PooledApplicationBudget += opcost
 		}
-		return fmt.Errorf("pc=%3d dynamic cost budget exceeded, executing %s: local program cost was %d",
-			cx.pc, spec.Name, cx.cost)
+		return cx.budgetExceeded(spec)
 	}
 
 	preheight := len(cx.stack)
@@ -2446,6 +2446,7 @@ func TestReturnTypes(t *testing.T) {
 
 		"box_create": "int 9; +; box_create",                 // make the size match the 10 in CreateBox
 		"box_put":    "byte 0x010203040506; concat; box_put", // make the 4 byte arg into a 10
+		"box_resize": "int 9; +; box_resize",                 // keep the size at the 10 in CreateBox
 	}
 
 	/* Make sure the specialCmd tests the opcode in question */
@@ -2474,6 +2475,13 @@ func TestReturnTypes(t *testing.T) {
 		"bn256_add":        true,
 		"bn256_scalar_mul": true,
 		"bn256_pairing":    true,
+
+		"ec_add":              true,
+		"ec_scalar_mul":       true,
+		"ec_pairing_check":    true,
+		"ec_multi_scalar_mul": true,
+		"ec_subgroup_check":   true,
+		"ec_map_to":           true,
 	}
 
 	byName := OpsByName[LogicVersion]
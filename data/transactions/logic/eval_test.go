@@ -177,6 +177,10 @@ func (ep *EvalParams) reset() {
 		}
 		ep.available.dirtyBytes = 0
 	}
+	if ep.budgetUses != nil {
+		*ep.budgetUses = nil
+		ep.recordGroupBudgetUses(nil)
+	}
 	ep.readBudgetChecked = false
 	ep.appAddrCache = make(map[basics.AppIndex]basics.Address)
 	if ep.Trace != nil {
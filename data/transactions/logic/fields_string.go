@@ -1,4 +1,4 @@
-// Code generated by "stringer -type=TxnField,GlobalField,AssetParamsField,AppParamsField,AcctParamsField,AssetHoldingField,OnCompletionConstType,EcdsaCurve,Base64Encoding,JSONRefType,VrfStandard,BlockField -output=fields_string.go"; DO NOT EDIT.
+// Code generated by "stringer -type=TxnField,GlobalField,AssetParamsField,AppParamsField,AcctParamsField,AssetHoldingField,OnCompletionConstType,EcdsaCurve,EcGroup,Base64Encoding,JSONRefType,VrfStandard,BlockField -output=fields_string.go"; DO NOT EDIT.
 
 package logic
 
@@ -265,6 +265,27 @@ func (i EcdsaCurve) String() string {
 	}
 	return _EcdsaCurve_name[_EcdsaCurve_index[i]:_EcdsaCurve_index[i+1]]
 }
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[BN254g1-0]
+	_ = x[BN254g2-1]
+	_ = x[BLS12_381g1-2]
+	_ = x[BLS12_381g2-3]
+	_ = x[invalidEcGroup-4]
+}
+
+const _EcGroup_name = "BN254g1BN254g2BLS12_381g1BLS12_381g2invalidEcGroup"
+
+var _EcGroup_index = [...]uint8{0, 7, 14, 25, 36, 50}
+
+func (i EcGroup) String() string {
+	if i < 0 || i >= EcGroup(len(_EcGroup_index)-1) {
+		return "EcGroup(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+	return _EcGroup_name[_EcGroup_index[i]:_EcGroup_index[i+1]]
+}
 func _() {
 	// An "invalid array index" compiler error signifies that the constant values have changed.
 	// Re-run the stringer command to generate them again.
@@ -462,6 +462,27 @@ func (l *Ledger) SetBox(appIdx basics.AppIndex, key string, value []byte) error
 	return nil
 }
 
+// ResizeBox sets a box value of any size through the boxMods mechanism
+func (l *Ledger) ResizeBox(appIdx basics.AppIndex, key string, value []byte, appAddr basics.Address) error {
+	if appIdx.Address() != appAddr {
+		panic(fmt.Sprintf("%d %v %v", appIdx, appIdx.Address(), appAddr))
+	}
+	_, ok, err := l.GetBox(appIdx, key)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return fmt.Errorf("no such box %d", appIdx)
+	}
+	params := l.applications[appIdx] // assured, based on above
+	if params.boxMods == nil {
+		params.boxMods = make(map[string][]byte)
+	}
+	params.boxMods[key] = value
+	l.applications[appIdx] = params
+	return nil
+}
+
 // DelBox deletes a value through boxMods mechanism
 func (l *Ledger) DelBox(appIdx basics.AppIndex, key string, appAddr basics.Address) (bool, error) {
 	if appIdx.Address() != appAddr {
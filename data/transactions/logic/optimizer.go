@@ -0,0 +1,834 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package logic
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"math/bits"
+	"sort"
+	"strings"
+)
+
+// optimizeEnabledVersion is the first version the optimizer rewrites. v1
+// programs can't branch to their end, which removing their last instructions
+// could lead to.
+const optimizeEnabledVersion = 2
+
+// pushEnabledVersion is the version that introduced pushint and pushbytes,
+// which folded constants are pushed with.
+const pushEnabledVersion = 3
+
+// Names of the rewrites counted by OptimizationReport.Rewrites
+const (
+	rewriteDeadCode      = "dead code"
+	rewriteDupPop        = "dup; pop"
+	rewritePushPop       = "push; pop"
+	rewriteConstantFold  = "constant folding"
+	rewriteJumpThreading = "jump threading"
+	rewriteBranchToNext  = "branch to next"
+	rewriteConstantBlock = "constant block layout"
+)
+
+// OptimizationReport describes the savings of OpStream.Optimize.
+type OptimizationReport struct {
+	SizeBefore int
+	SizeAfter  int
+
+	// CostBefore and CostAfter are the sums of the static costs of all the
+	// opcodes of the program, regardless of how often they are executed.
+	CostBefore int
+	CostAfter  int
+
+	// Rewrites counts the rewrites applied, by kind.
+	Rewrites map[string]int
+}
+
+// String summarizes the report.
+func (r OptimizationReport) String() string {
+	var kinds []string
+	for kind := range r.Rewrites {
+		kinds = append(kinds, kind)
+	}
+	sort.Strings(kinds)
+	var b strings.Builder
+	fmt.Fprintf(&b, "size: %d -> %d bytes, opcode cost: %d -> %d", r.SizeBefore, r.SizeAfter, r.CostBefore, r.CostAfter)
+	for _, kind := range kinds {
+		fmt.Fprintf(&b, "\n%s: %d", kind, r.Rewrites[kind])
+	}
+	return b.String()
+}
+
+// optInstruction is an instruction of a program being optimized or
+// analyzed.
+type optInstruction struct {
+	spec *OpSpec
+
+	// pc in the program as it was parsed
+	pc int
+
+	// encoded immediates, other than branch targets and constant indexes
+	immediates []byte
+
+	// branch targets, where nil targets the end of the program
+	targets []*optInstruction
+
+	// index in the constant block of intc and bytec references, -1 otherwise
+	constIndex int
+
+	hasLine bool
+	line    int
+	source  int
+
+	removed bool
+}
+
+func (in *optInstruction) isBranch() bool {
+	for _, imm := range in.spec.OpDetails.Immediates {
+		if imm.kind == immLabel || imm.kind == immLabels {
+			return true
+		}
+	}
+	return false
+}
+
+func (in *optInstruction) isIntcRef() bool {
+	return in.constIndex >= 0 && strings.HasPrefix(in.spec.Name, "intc")
+}
+
+func (in *optInstruction) isBytecRef() bool {
+	return in.constIndex >= 0 && strings.HasPrefix(in.spec.Name, "bytec")
+}
+
+// pushesUint64 reports whether the instruction leaves a uint64 on top of the
+// stack when it continues to the next one.
+func (in *optInstruction) pushesUint64() bool {
+	types := in.spec.Return.Types
+	return len(types) > 0 && types[len(types)-1] == StackUint64
+}
+
+// terminates reports whether the instruction never continues to the next one.
+func (in *optInstruction) terminates() bool {
+	switch in.spec.Name {
+	case "b", "retsub", "err", "return":
+		return true
+	}
+	return false
+}
+
+// staticCost is the cost of the instruction, given its immediates but not the
+// values it's applied to.
+func (in *optInstruction) staticCost() int {
+	d := &in.spec.OpDetails
+	if d.FullCost.baseCost != 0 {
+		return d.FullCost.baseCost
+	}
+	cost := 0
+	for i := range d.Immediates {
+		if d.Immediates[i].fieldCosts != nil && i < len(in.immediates) {
+			cost += d.Immediates[i].fieldCosts[in.immediates[i]].baseCost
+		}
+	}
+	return cost
+}
+
+// optimizer holds the instructions of a program being optimized.
+type optimizer struct {
+	version      uint64
+	instructions []*optInstruction
+
+	// the constant blocks, if they can be laid out again, and their values are
+	// known to the references
+	intcBlock  *optInstruction
+	intc       []uint64
+	bytecBlock *optInstruction
+	bytec      [][]byte
+
+	rewrites map[string]int
+}
+
+// Optimize rewrites the assembled program with peephole rewrites that preserve
+// its behavior: it removes dead code after unconditional branches, redundant
+// `dup; pop` and constants popped right away, folds constant arithmetic that
+// can't fail, threads jumps to unconditional branches, and lays out the
+// constant blocks by how often their constants are used. The source map is
+// updated accordingly. `dup; pop` is only removed after an instruction that
+// pushes a value, as the `dup` of an empty stack fails the program.
+func (ops *OpStream) Optimize() (OptimizationReport, error) {
+	if ops.Program == nil {
+		return OptimizationReport{}, fmt.Errorf("can't optimize a program that failed to assemble")
+	}
+	o, err := parseForOptimization(ops.Program, ops.OffsetToLine, ops.OffsetToSource)
+	if err != nil {
+		return OptimizationReport{}, err
+	}
+	report := OptimizationReport{
+		SizeBefore: len(ops.Program),
+		CostBefore: o.cost(),
+		Rewrites:   o.rewrites,
+	}
+	if o.version >= optimizeEnabledVersion {
+		o.optimize()
+	}
+
+	program, offsetToLine, offsetToSource, err := o.encode()
+	if err != nil {
+		return OptimizationReport{}, err
+	}
+	ops.Program = program
+	ops.OffsetToLine = offsetToLine
+	ops.OffsetToSource = offsetToSource
+	report.SizeAfter = len(program)
+	report.CostAfter = o.cost()
+	return report, nil
+}
+
+func parseForOptimization(program []byte, offsetToLine map[int]int, offsetToSource map[int]int) (*optimizer, error) {
+	version, vlen := binary.Uvarint(program)
+	if vlen <= 0 {
+		return nil, fmt.Errorf("invalid version")
+	}
+	if version > LogicVersion {
+		return nil, fmt.Errorf("unsupported version %d", version)
+	}
+	o := &optimizer{version: version, rewrites: make(map[string]int)}
+
+	type branch struct {
+		in      *optInstruction
+		targets []int
+	}
+	var branches []branch
+	atPC := make(map[int]*optInstruction)
+	for pc := vlen; pc < len(program); {
+		spec := &opsByOpcode[version][program[pc]]
+		if spec.op == nil {
+			return nil, fmt.Errorf("%d: illegal opcode 0x%02x", pc, program[pc])
+		}
+		in := &optInstruction{spec: spec, pc: pc, constIndex: -1}
+		in.line, in.hasLine = offsetToLine[pc]
+		in.source = offsetToSource[pc]
+
+		next := pc + 1
+		var targets []int
+		for _, imm := range spec.OpDetails.Immediates {
+			if next >= len(program) {
+				return nil, fmt.Errorf("%d: program ends short of immediate values", pc)
+			}
+			switch imm.kind {
+			case immByte, immInt8:
+				next++
+			case immLabel:
+				if next+2 > len(program) {
+					return nil, fmt.Errorf("%d: program ends short of immediate values", pc)
+				}
+				targets = append(targets, next+2+decodeBranchOffset(program, next))
+				next += 2
+			case immInt:
+				_, used := binary.Uvarint(program[next:])
+				if used <= 0 {
+					return nil, fmt.Errorf("%d: could not decode immediate %s for %s", pc, imm.Name, spec.Name)
+				}
+				next += used
+			case immBytes:
+				length, used := binary.Uvarint(program[next:])
+				if used <= 0 || length > uint64(len(program)-next-used) {
+					return nil, fmt.Errorf("%d: could not decode immediate %s for %s", pc, imm.Name, spec.Name)
+				}
+				next += used + int(length)
+			case immInts:
+				_, nextpc, err := parseIntImmArgs(program, next)
+				if err != nil {
+					return nil, err
+				}
+				next = nextpc
+			case immBytess:
+				_, nextpc, err := parseByteImmArgs(program, next)
+				if err != nil {
+					return nil, err
+				}
+				next = nextpc
+			case immLabels:
+				if next+1+2*int(program[next]) > len(program) {
+					return nil, fmt.Errorf("%d: program ends short of immediate values", pc)
+				}
+				switchTargets, nextpc, err := parseSwitch(program, next)
+				if err != nil {
+					return nil, err
+				}
+				targets = append(targets, switchTargets...)
+				next = nextpc
+			default:
+				return nil, fmt.Errorf("unknown immKind %d", imm.kind)
+			}
+		}
+		if targets == nil {
+			in.immediates = program[pc+1 : next]
+		} else {
+			branches = append(branches, branch{in, targets})
+		}
+
+		switch spec.Name {
+		case "intc", "bytec":
+			in.constIndex = int(program[pc+1])
+		case "intc_0", "intc_1", "intc_2", "intc_3", "bytec_0", "bytec_1", "bytec_2", "bytec_3":
+			in.constIndex = int(spec.Name[len(spec.Name)-1] - '0')
+		}
+
+		atPC[pc] = in
+		o.instructions = append(o.instructions, in)
+		pc = next
+	}
+
+	for _, b := range branches {
+		for _, target := range b.targets {
+			if target == len(program) {
+				b.in.targets = append(b.in.targets, nil)
+				continue
+			}
+			in, ok := atPC[target]
+			if !ok {
+				return nil, fmt.Errorf("branch target %d is not an instruction", target)
+			}
+			b.in.targets = append(b.in.targets, in)
+		}
+	}
+
+	o.findConstantBlocks()
+	return o, nil
+}
+
+// findConstantBlocks records the constant blocks which start the program, as
+// the assembler prepends them, and aren't branched to. Programs with other
+// constant blocks keep them as they are.
+func (o *optimizer) findConstantBlocks() {
+	var intcBlocks, bytecBlocks []int
+	for i, in := range o.instructions {
+		switch in.spec.Name {
+		case "intcblock":
+			intcBlocks = append(intcBlocks, i)
+		case "bytecblock":
+			bytecBlocks = append(bytecBlocks, i)
+		}
+	}
+	targets := o.targets()
+	if len(intcBlocks) == 1 && intcBlocks[0] < 2 && !targets[o.instructions[intcBlocks[0]]] {
+		o.intcBlock = o.instructions[intcBlocks[0]]
+		o.intc, _, _ = parseIntImmArgs(o.intcBlock.immediates, 0)
+	}
+	if len(bytecBlocks) == 1 && bytecBlocks[0] < 2 && !targets[o.instructions[bytecBlocks[0]]] {
+		o.bytecBlock = o.instructions[bytecBlocks[0]]
+		o.bytec, _, _ = parseByteImmArgs(o.bytecBlock.immediates, 0)
+	}
+}
+
+// targets returns the instructions that are branched to.
+func (o *optimizer) targets() map[*optInstruction]bool {
+	targets := make(map[*optInstruction]bool)
+	for _, in := range o.instructions {
+		for _, target := range in.targets {
+			if target != nil {
+				targets[target] = true
+			}
+		}
+	}
+	return targets
+}
+
+func (o *optimizer) cost() int {
+	cost := 0
+	for _, in := range o.instructions {
+		cost += in.staticCost()
+	}
+	return cost
+}
+
+// optimize applies the rewrites until none of them applies anymore.
+func (o *optimizer) optimize() {
+	for {
+		changed := o.removeDeadCode()
+		changed = o.threadJumps() || changed
+		changed = o.rewriteWindows() || changed
+		if !changed {
+			break
+		}
+	}
+	o.layoutConstantBlocks()
+}
+
+// compact drops the removed instructions, and makes the branches to them
+// target the next instruction that remains.
+func (o *optimizer) compact() {
+	replacement := make(map[*optInstruction]*optInstruction)
+	var next *optInstruction
+	for i := len(o.instructions) - 1; i >= 0; i-- {
+		in := o.instructions[i]
+		if in.removed {
+			replacement[in] = next
+		} else {
+			next = in
+		}
+	}
+	kept := o.instructions[:0]
+	for _, in := range o.instructions {
+		if in.removed {
+			continue
+		}
+		for i, target := range in.targets {
+			if r, ok := replacement[target]; ok {
+				in.targets[i] = r
+			}
+		}
+		kept = append(kept, in)
+	}
+	o.instructions = kept
+}
+
+// removeDeadCode removes the instructions after unconditional branches, up to
+// the next instruction that is branched to.
+func (o *optimizer) removeDeadCode() bool {
+	targets := o.targets()
+	changed := false
+	dead := false
+	for _, in := range o.instructions {
+		if targets[in] {
+			dead = false
+		}
+		if dead {
+			in.removed = true
+			o.rewrites[rewriteDeadCode]++
+			changed = true
+			continue
+		}
+		dead = in.terminates()
+	}
+	if changed {
+		o.compact()
+	}
+	return changed
+}
+
+// threadJumps makes the branches to unconditional branches target their
+// final destination, and removes the unconditional branches to the next
+// instruction.
+func (o *optimizer) threadJumps() bool {
+	changed := false
+	for _, in := range o.instructions {
+		for t, target := range in.targets {
+			final := target
+			seen := map[*optInstruction]bool{in: true}
+			for final != nil && final.spec.Name == "b" && !seen[final] {
+				seen[final] = true
+				final = final.targets[0]
+			}
+			if final != target {
+				in.targets[t] = final
+				o.rewrites[rewriteJumpThreading]++
+				changed = true
+			}
+		}
+	}
+
+	targets := o.targets()
+	for i, in := range o.instructions {
+		var next *optInstruction
+		if i+1 < len(o.instructions) {
+			next = o.instructions[i+1]
+		}
+		if len(in.targets) != 1 || in.targets[0] != next {
+			continue
+		}
+		switch in.spec.Name {
+		case "b":
+			in.removed = true
+		case "bz", "bnz":
+			// the condition still has to be popped, and a pop doesn't fail
+			// on bytes as bz and bnz do, so the condition must be known to
+			// be a uint64
+			if i == 0 || targets[in] || !o.instructions[i-1].pushesUint64() {
+				continue
+			}
+			o.replace(in, "pop", nil)
+		default:
+			continue
+		}
+		o.rewrites[rewriteBranchToNext]++
+		changed = true
+	}
+	if changed {
+		o.compact()
+	}
+	return changed
+}
+
+// replace turns the instruction into another opcode, keeping its line.
+func (o *optimizer) replace(in *optInstruction, name string, immediates []byte) {
+	spec := OpsByName[o.version][name]
+	in.spec = &spec
+	in.immediates = immediates
+	in.targets = nil
+	in.constIndex = -1
+}
+
+// intConstant returns the value the instruction pushes, if it's a known int.
+func (o *optimizer) intConstant(in *optInstruction) (uint64, bool) {
+	switch {
+	case in.spec.Name == "pushint":
+		value, _ := binary.Uvarint(in.immediates)
+		return value, true
+	case in.isIntcRef() && o.intcBlock != nil && in.constIndex < len(o.intc):
+		return o.intc[in.constIndex], true
+	}
+	return 0, false
+}
+
+// pushesConstant reports whether the instruction only pushes a constant,
+// without any other effect and without failing.
+func (o *optimizer) pushesConstant(in *optInstruction) bool {
+	switch in.spec.Name {
+	case "pushint", "pushbytes":
+		return true
+	}
+	if in.isIntcRef() {
+		return o.intcBlock != nil && in.constIndex < len(o.intc)
+	}
+	if in.isBytecRef() {
+		return o.bytecBlock != nil && in.constIndex < len(o.bytec)
+	}
+	return false
+}
+
+// rewriteWindows applies the rewrites of short sequences of instructions, whose
+// instructions other than the first aren't branched to.
+func (o *optimizer) rewriteWindows() bool {
+	targets := o.targets()
+	changed := false
+	for i := 0; i < len(o.instructions); i++ {
+		window := func(n int) []*optInstruction {
+			var w []*optInstruction
+			for j := i; j < len(o.instructions) && len(w) < n; j++ {
+				in := o.instructions[j]
+				if in.removed {
+					continue
+				}
+				if len(w) > 0 && targets[in] {
+					return nil
+				}
+				w = append(w, in)
+			}
+			if len(w) < n {
+				return nil
+			}
+			return w
+		}
+		first := o.instructions[i]
+		if first.removed {
+			continue
+		}
+
+		if w := window(2); w != nil && w[1].spec.Name == "pop" {
+			if w[0].spec.Name == "dup" && o.fedByPush(i, targets) {
+				w[0].removed, w[1].removed = true, true
+				o.rewrites[rewriteDupPop]++
+				changed = true
+				continue
+			}
+			if o.pushesConstant(w[0]) {
+				w[0].removed, w[1].removed = true, true
+				o.rewrites[rewritePushPop]++
+				changed = true
+				continue
+			}
+		}
+
+		if o.version < pushEnabledVersion {
+			continue
+		}
+		if w := window(2); w != nil {
+			if a, ok := o.intConstant(w[0]); ok {
+				if result, ok := foldUnary(w[1].spec.Name, a); ok {
+					o.replace(w[0], "pushint", appendUvarint(nil, result))
+					w[1].removed = true
+					o.rewrites[rewriteConstantFold]++
+					changed = true
+					continue
+				}
+			}
+		}
+		if w := window(3); w != nil {
+			a, aok := o.intConstant(w[0])
+			b, bok := o.intConstant(w[1])
+			if aok && bok {
+				if result, ok := foldBinary(w[2].spec.Name, a, b); ok {
+					o.replace(w[0], "pushint", appendUvarint(nil, result))
+					w[1].removed, w[2].removed = true, true
+					o.rewrites[rewriteConstantFold]++
+					changed = true
+				}
+			}
+		}
+	}
+	if changed {
+		o.compact()
+	}
+	return changed
+}
+
+// fedByPush reports whether the instruction at index i is only reached from the
+// instruction before it, and that instruction pushes a value, so that the stack
+// is known not to be empty when it runs.
+func (o *optimizer) fedByPush(i int, targets map[*optInstruction]bool) bool {
+	if targets[o.instructions[i]] {
+		return false
+	}
+	for j := i - 1; j >= 0; j-- {
+		prev := o.instructions[j]
+		if prev.removed {
+			continue
+		}
+		return !prev.terminates() && len(prev.spec.Return.Types) > 0
+	}
+	return false
+}
+
+// foldUnary computes the pure unary int opcodes that can't fail.
+func foldUnary(name string, a uint64) (uint64, bool) {
+	switch name {
+	case "!":
+		return boolToUint(a == 0), true
+	case "~":
+		return ^a, true
+	}
+	return 0, false
+}
+
+// foldBinary computes the pure binary int opcodes, unless they would fail,
+// which is left to happen when the program is evaluated.
+func foldBinary(name string, a, b uint64) (uint64, bool) {
+	switch name {
+	case "+":
+		sum, carry := bits.Add64(a, b, 0)
+		return sum, carry == 0
+	case "-":
+		diff, borrow := bits.Sub64(a, b, 0)
+		return diff, borrow == 0
+	case "*":
+		hi, lo := bits.Mul64(a, b)
+		return lo, hi == 0
+	case "/":
+		if b == 0 {
+			return 0, false
+		}
+		return a / b, true
+	case "%":
+		if b == 0 {
+			return 0, false
+		}
+		return a % b, true
+	case "&":
+		return a & b, true
+	case "|":
+		return a | b, true
+	case "^":
+		return a ^ b, true
+	case "<":
+		return boolToUint(a < b), true
+	case ">":
+		return boolToUint(a > b), true
+	case "<=":
+		return boolToUint(a <= b), true
+	case ">=":
+		return boolToUint(a >= b), true
+	case "==":
+		return boolToUint(a == b), true
+	case "!=":
+		return boolToUint(a != b), true
+	case "&&":
+		return boolToUint(a != 0 && b != 0), true
+	case "||":
+		return boolToUint(a != 0 || b != 0), true
+	case "shl":
+		if b >= 64 {
+			return 0, false
+		}
+		return a << b, true
+	case "shr":
+		if b >= 64 {
+			return 0, false
+		}
+		return a >> b, true
+	}
+	return 0, false
+}
+
+// layoutConstantBlocks drops the unused constants of the blocks, pushes the
+// constants used once instead where the assembler would, and orders the rest
+// by how often they're used, so the most used ones get the shortest references.
+func (o *optimizer) layoutConstantBlocks() {
+	if o.intcBlock != nil {
+		var values []interface{}
+		for _, v := range o.intc {
+			values = append(values, v)
+		}
+		o.layoutConstantBlock(o.intcBlock, values, (*optInstruction).isIntcRef, "intc", "pushint")
+	}
+	if o.bytecBlock != nil {
+		var values []interface{}
+		for _, v := range o.bytec {
+			values = append(values, v)
+		}
+		o.layoutConstantBlock(o.bytecBlock, values, (*optInstruction).isBytecRef, "bytec", "pushbytes")
+	}
+}
+
+func (o *optimizer) layoutConstantBlock(block *optInstruction, values []interface{}, isRef func(*optInstruction) bool, refName string, pushName string) {
+	counts := make([]int, len(values))
+	for _, in := range o.instructions {
+		if isRef(in) {
+			if in.constIndex >= len(values) {
+				// an invalid reference, which has to keep failing
+				return
+			}
+			counts[in.constIndex]++
+		}
+	}
+
+	inline := o.version >= optimizeConstantsEnabledVersion
+	order := make([]int, 0, len(values))
+	for i, count := range counts {
+		if count > 1 || (count == 1 && !inline) {
+			order = append(order, i)
+		}
+	}
+	sort.SliceStable(order, func(i, j int) bool { return counts[order[i]] > counts[order[j]] })
+	newIndex := make(map[int]int, len(order))
+	for i, index := range order {
+		newIndex[index] = i
+	}
+
+	changed := len(order) != len(values)
+	for i, index := range order {
+		changed = changed || i != index
+	}
+	if !changed {
+		return
+	}
+	o.rewrites[rewriteConstantBlock]++
+
+	for _, in := range o.instructions {
+		if !isRef(in) {
+			continue
+		}
+		index, ok := newIndex[in.constIndex]
+		if !ok {
+			o.replace(in, pushName, encodeConstant(values[in.constIndex]))
+			continue
+		}
+		if index < 4 {
+			o.replace(in, fmt.Sprintf("%s_%d", refName, index), nil)
+		} else {
+			o.replace(in, refName, []byte{byte(index)})
+		}
+		in.constIndex = index
+	}
+
+	if len(order) == 0 {
+		block.removed = true
+		o.compact()
+		return
+	}
+	immediates := appendUvarint(nil, uint64(len(order)))
+	for _, index := range order {
+		if v, ok := values[index].(uint64); ok {
+			immediates = appendUvarint(immediates, v)
+		} else {
+			immediates = append(immediates, encodeConstant(values[index])...)
+		}
+	}
+	block.immediates = immediates
+}
+
+// encodeConstant encodes the immediate of pushint or pushbytes.
+func encodeConstant(value interface{}) []byte {
+	switch v := value.(type) {
+	case uint64:
+		return appendUvarint(nil, v)
+	case []byte:
+		return append(appendUvarint(nil, uint64(len(v))), v...)
+	}
+	panic(fmt.Sprintf("unexpected constant %#v", value))
+}
+
+func (in *optInstruction) size() int {
+	size := 1 + len(in.immediates)
+	if in.isBranch() {
+		size += 2 * len(in.targets)
+		if in.spec.OpDetails.Immediates[0].kind == immLabels {
+			size++ // the number of targets
+		}
+	}
+	return size
+}
+
+// encode returns the bytecode of the instructions, and their source map.
+func (o *optimizer) encode() ([]byte, map[int]int, map[int]int, error) {
+	program := appendUvarint(nil, o.version)
+	pcs := make(map[*optInstruction]int, len(o.instructions))
+	pc := len(program)
+	for _, in := range o.instructions {
+		pcs[in] = pc
+		pc += in.size()
+	}
+	end := pc
+
+	offsetToLine := make(map[int]int)
+	offsetToSource := make(map[int]int)
+	for _, in := range o.instructions {
+		pc := len(program)
+		if in.hasLine {
+			offsetToLine[pc] = in.line
+			offsetToSource[pc] = in.source
+		}
+		program = append(program, in.spec.Opcode)
+		if !in.isBranch() {
+			program = append(program, in.immediates...)
+			continue
+		}
+		opEnd := pc + in.size()
+		if in.spec.OpDetails.Immediates[0].kind == immLabels {
+			program = append(program, byte(len(in.targets)))
+		}
+		for _, target := range in.targets {
+			dest := end
+			if target != nil {
+				dest = pcs[target]
+			}
+			offset := dest - opEnd
+			if offset > math.MaxInt16 || offset < math.MinInt16 {
+				return nil, nil, nil, fmt.Errorf("%s at %d can't branch %d bytes away", in.spec.Name, pc, offset)
+			}
+			program = append(program, byte(uint16(offset)>>8), byte(offset))
+		}
+	}
+	return program, offsetToLine, offsetToSource, nil
+}
+
+func appendUvarint(b []byte, v uint64) []byte {
+	var scratch [binary.MaxVarintLen64]byte
+	vlen := binary.PutUvarint(scratch[:], v)
+	return append(b, scratch[:vlen]...)
+}
@@ -0,0 +1,418 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package logic
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// testOptimize optimizes the source, and checks it assembles to the same
+// program as the expected source, and that it still passes.
+func testOptimize(t *testing.T, source string, expected string, v uint64) OptimizationReport {
+	t.Helper()
+	ops := testProg(t, source, v)
+	testLogicBytes(t, ops.Program, defaultEvalParamsWithVersion(v))
+	original := ops.Program
+
+	report, err := ops.Optimize()
+	require.NoError(t, err)
+	optimized := testProg(t, expected, v)
+	if !assert.Equal(t, optimized.Program, ops.Program) {
+		dis, _ := Disassemble(ops.Program)
+		t.Log(dis)
+		t.FailNow()
+	}
+	require.Equal(t, len(original), report.SizeBefore)
+	require.Equal(t, len(ops.Program), report.SizeAfter)
+	testLogicBytes(t, ops.Program, defaultEvalParamsWithVersion(v))
+	return report
+}
+
+func TestOptimizeDeadCode(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	report := testOptimize(t, `
+pushint 1
+b done
+pushint 2
+pop
+done:
+return
+pushint 3
+`, `
+pushint 1
+return
+`, AssemblerMaxVersion)
+	require.Equal(t, 3, report.Rewrites[rewriteDeadCode])
+	require.Equal(t, 1, report.Rewrites[rewriteBranchToNext])
+
+	// code that is branched to stays
+	testOptimize(t, `
+pushint 1
+bnz skip
+err
+skip:
+pushint 1
+return
+err
+`, `
+pushint 1
+bnz skip
+err
+skip:
+pushint 1
+return
+`, AssemblerMaxVersion)
+
+	// callsub returns to the next instruction
+	testOptimize(t, `
+callsub sub
+pushint 1
+return
+sub:
+retsub
+pushint 4
+`, `
+callsub sub
+pushint 1
+return
+sub:
+retsub
+`, AssemblerMaxVersion)
+}
+
+func TestOptimizePops(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	report := testOptimize(t, `
+pushint 1
+dup
+pop
+pushbytes "x"
+pop
+txn Fee
+pop
+`, `
+pushint 1
+txn Fee
+pop
+`, AssemblerMaxVersion)
+	require.Equal(t, 1, report.Rewrites[rewriteDupPop])
+	require.Equal(t, 1, report.Rewrites[rewritePushPop])
+
+	// dup fails on an empty stack, so it's only removed when the stack is
+	// known not to be empty
+	testOptimize(t, `
+pushint 1
+pushint 1
+bnz skip
+pushint 2
+skip:
+dup
+pop
+`, `
+pushint 1
+pushint 1
+bnz skip
+pushint 2
+skip:
+dup
+pop
+`, AssemblerMaxVersion)
+	report = testOptimize(t, `
+pushint 1
+pushint 2
+store 0
+dup
+pop
+`, `
+pushint 1
+pushint 2
+store 0
+dup
+pop
+`, AssemblerMaxVersion)
+	require.Zero(t, report.Rewrites[rewriteDupPop])
+
+	// a pop that is branched to pops something else
+	testOptimize(t, `
+pushint 1
+pushint 1
+bnz pop
+dup
+pop:
+pop
+pushint 1
+`, `
+pushint 1
+pushint 1
+bnz pop
+dup
+pop:
+pop
+pushint 1
+`, AssemblerMaxVersion)
+}
+
+func TestOptimizeConstantFolding(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	report := testOptimize(t, `
+pushint 2
+pushint 3
+*
+pushint 1
++
+pushint 7
+==
+`, `
+pushint 1
+`, AssemblerMaxVersion)
+	require.Equal(t, 3, report.Rewrites[rewriteConstantFold])
+	require.Less(t, report.CostAfter, report.CostBefore)
+
+	testOptimize(t, `
+pushint 0
+!
+pushint 0
+~
+pushint 1
+shl
+pushint 1
+-
+pushint 0xfffffffffffffffd
+==
+&&
+`, `
+pushint 1
+`, AssemblerMaxVersion)
+
+	// failures are left for the evaluation
+	for _, source := range []string{
+		"pushint 1; pushint 0; /",
+		"pushint 1; pushint 0; %",
+		"pushint 0; pushint 1; -",
+		"pushint 0xffffffffffffffff; pushint 1; +",
+		"pushint 0xffffffffffffffff; pushint 2; *",
+		"pushint 1; pushint 64; shl",
+	} {
+		ops := testProg(t, source, AssemblerMaxVersion)
+		program := ops.Program
+		_, err := ops.Optimize()
+		require.NoError(t, err)
+		require.Equal(t, program, ops.Program, source)
+	}
+
+	// v2 has no pushint to fold into
+	testOptimize(t, "int 2; int 3; +; int 5; ==", "int 2; int 3; +; int 5; ==", 2)
+}
+
+func TestOptimizeJumpThreading(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	report := testOptimize(t, `
+pushint 1
+bnz a
+err
+a:
+b b
+b:
+b c
+c:
+pushint 1
+`, `
+pushint 1
+bnz c
+err
+c:
+pushint 1
+`, AssemblerMaxVersion)
+	require.Equal(t, 2, report.Rewrites[rewriteJumpThreading])
+
+	// bz to the next instruction still pops its condition
+	testOptimize(t, `
+pushint 1
+pushint 0
+bz next
+next:
+`, `
+pushint 1
+`, AssemblerMaxVersion)
+
+	// unless the condition may be bytes, which bz and bnz reject
+	testOptimize(t, `
+pushint 1
+store 0
+load 0
+bnz next
+next:
+pushint 1
+`, `
+pushint 1
+store 0
+load 0
+bnz next
+next:
+pushint 1
+`, AssemblerMaxVersion)
+
+	// or where the condition may come from a branch
+	testOptimize(t, `
+pushint 1
+txn Fee
+dup
+bnz cond
+pop
+pushint 0
+cond:
+bz next
+next:
+`, `
+pushint 1
+txn Fee
+dup
+bnz cond
+pop
+pushint 0
+cond:
+bz next
+next:
+`, AssemblerMaxVersion)
+
+	// loops of branches are left alone
+	testOptimize(t, `
+pushint 1
+bnz done
+loop:
+b loop
+done:
+pushint 1
+`, `
+pushint 1
+bnz done
+loop:
+b loop
+done:
+pushint 1
+`, AssemblerMaxVersion)
+}
+
+func TestOptimizeConstantBlocks(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	// unused and single use constants go, and the most used ones come first
+	report := testOptimize(t, `
+intcblock 10 20 30 40
+bytecblock "a" "b"
+txn Fee
+intc 3
++
+intc 3
++
+intc 0
+>
+bytec_1
+bytec_1
+==
+&&
+`, `
+intcblock 40
+bytecblock "b"
+txn Fee
+intc_0
++
+intc_0
++
+pushint 10
+>
+bytec_0
+bytec_0
+==
+&&
+`, AssemblerMaxVersion)
+	require.Equal(t, 2, report.Rewrites[rewriteConstantBlock])
+
+	// a block of constants all used once goes away
+	testOptimize(t, `
+intcblock 1 2
+intc_1
+intc_0
+>
+`, `
+pushint 1
+`, AssemblerMaxVersion)
+
+	// v3 keeps the constants used once in the block
+	testOptimize(t, `
+intcblock 5 6 7
+txn Fee
+intc_2
++
+intc_2
+>=
+`, `
+intcblock 7
+txn Fee
+intc_0
++
+intc_0
+>=
+`, 3)
+}
+
+func TestOptimizeSourceMap(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	ops := testProg(t, `#pragma version 6
+pushint 1
+b done
+pushint 2
+done:
+return
+`, assemblerNoVersion)
+	_, err := ops.Optimize()
+	require.NoError(t, err)
+	require.Equal(t, []byte{0x06, 0x81, 0x01, 0x43}, ops.Program)
+	require.Equal(t, map[int]int{1: 1, 3: 5}, ops.OffsetToLine)
+}
+
+func TestOptimizeOldVersions(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	ops := testProg(t, "int 1; dup; pop", 1)
+	program := ops.Program
+	report, err := ops.Optimize()
+	require.NoError(t, err)
+	require.Equal(t, program, ops.Program)
+	require.Empty(t, report.Rewrites)
+
+	ops = &OpStream{}
+	_, err = ops.Optimize()
+	require.Error(t, err)
+}
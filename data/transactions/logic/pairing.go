@@ -18,10 +18,16 @@ package logic
 
 import (
 	"errors"
+	"fmt"
 	"math/big"
 
+	"github.com/consensys/gnark-crypto/ecc"
+	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
+	bls12381fp "github.com/consensys/gnark-crypto/ecc/bls12-381/fp"
+	bls12381fr "github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
 	"github.com/consensys/gnark-crypto/ecc/bn254"
 	"github.com/consensys/gnark-crypto/ecc/bn254/fp"
+	"github.com/consensys/gnark-crypto/ecc/bn254/fr"
 )
 
 func bytesToBN254Field(b []byte) (ret fp.Element) {
@@ -113,3 +119,575 @@ func opBn256Pairing(cx *EvalContext) error {
 	cx.stack[prev] = boolToSV(ok)
 	return nil
 }
+
+const (
+	bn254FpSize = 32
+	bn254G1Size = 2 * bn254FpSize
+	bn254G2Size = 4 * bn254FpSize
+
+	bls12381FpSize = 48
+	bls12381G1Size = 2 * bls12381FpSize
+	bls12381G2Size = 4 * bls12381FpSize
+
+	ecScalarSize = 32
+)
+
+var errEcPointNotOnCurve = errors.New("point not on curve")
+var errEcPointNotInSubgroup = errors.New("point not in subgroup")
+var errEcPairingPointCount = errors.New("pairing check of a different number of points in each group")
+
+// The ec_ opcodes encode a point as its X coordinate followed by its Y
+// coordinate, and the point at infinity as zeros. Coordinates of G2 points are
+// encoded as the real part followed by the imaginary part. Field elements are
+// big-endian, and must be less than the modulus of the field.
+
+func bn254FpFromBytes(b []byte) (ret fp.Element, err error) {
+	if new(big.Int).SetBytes(b).Cmp(fp.Modulus()) >= 0 {
+		return ret, errors.New("field element not less than modulus")
+	}
+	ret.SetBytes(b)
+	return ret, nil
+}
+
+func bn254G1FromBytes(b []byte) (ret bn254.G1Affine, err error) {
+	if len(b) != bn254G1Size {
+		return ret, fmt.Errorf("expect G1 in %d bytes", bn254G1Size)
+	}
+	if ret.X, err = bn254FpFromBytes(b[:32]); err != nil {
+		return
+	}
+	if ret.Y, err = bn254FpFromBytes(b[32:64]); err != nil {
+		return
+	}
+	if !ret.IsOnCurve() {
+		return ret, errEcPointNotOnCurve
+	}
+	return ret, nil
+}
+
+func bn254G1sFromBytes(b []byte) ([]bn254.G1Affine, error) {
+	if len(b)%bn254G1Size != 0 {
+		return nil, fmt.Errorf("expect G1s in multiples of %d bytes", bn254G1Size)
+	}
+	ret := make([]bn254.G1Affine, len(b)/bn254G1Size)
+	for i := range ret {
+		var err error
+		ret[i], err = bn254G1FromBytes(b[i*bn254G1Size : (i+1)*bn254G1Size])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return ret, nil
+}
+
+func bn254G2FromBytes(b []byte) (ret bn254.G2Affine, err error) {
+	if len(b) != bn254G2Size {
+		return ret, fmt.Errorf("expect G2 in %d bytes", bn254G2Size)
+	}
+	if ret.X.A0, err = bn254FpFromBytes(b[:32]); err != nil {
+		return
+	}
+	if ret.X.A1, err = bn254FpFromBytes(b[32:64]); err != nil {
+		return
+	}
+	if ret.Y.A0, err = bn254FpFromBytes(b[64:96]); err != nil {
+		return
+	}
+	if ret.Y.A1, err = bn254FpFromBytes(b[96:128]); err != nil {
+		return
+	}
+	if !ret.IsOnCurve() {
+		return ret, errEcPointNotOnCurve
+	}
+	return ret, nil
+}
+
+func bn254G2sFromBytes(b []byte) ([]bn254.G2Affine, error) {
+	if len(b)%bn254G2Size != 0 {
+		return nil, fmt.Errorf("expect G2s in multiples of %d bytes", bn254G2Size)
+	}
+	ret := make([]bn254.G2Affine, len(b)/bn254G2Size)
+	for i := range ret {
+		var err error
+		ret[i], err = bn254G2FromBytes(b[i*bn254G2Size : (i+1)*bn254G2Size])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return ret, nil
+}
+
+func bn254G2ToBytes(g2 *bn254.G2Affine) []byte {
+	ret := make([]byte, 0, bn254G2Size)
+	for _, e := range []fp.Element{g2.X.A0, g2.X.A1, g2.Y.A0, g2.Y.A1} {
+		b := e.Bytes()
+		ret = append(ret, b[:]...)
+	}
+	return ret
+}
+
+func bls12381FpFromBytes(b []byte) (ret bls12381fp.Element, err error) {
+	if new(big.Int).SetBytes(b).Cmp(bls12381fp.Modulus()) >= 0 {
+		return ret, errors.New("field element not less than modulus")
+	}
+	ret.SetBytes(b)
+	return ret, nil
+}
+
+func bls12381G1FromBytes(b []byte) (ret bls12381.G1Affine, err error) {
+	if len(b) != bls12381G1Size {
+		return ret, fmt.Errorf("expect G1 in %d bytes", bls12381G1Size)
+	}
+	if ret.X, err = bls12381FpFromBytes(b[:48]); err != nil {
+		return
+	}
+	if ret.Y, err = bls12381FpFromBytes(b[48:96]); err != nil {
+		return
+	}
+	if !ret.IsOnCurve() {
+		return ret, errEcPointNotOnCurve
+	}
+	return ret, nil
+}
+
+func bls12381G1sFromBytes(b []byte) ([]bls12381.G1Affine, error) {
+	if len(b)%bls12381G1Size != 0 {
+		return nil, fmt.Errorf("expect G1s in multiples of %d bytes", bls12381G1Size)
+	}
+	ret := make([]bls12381.G1Affine, len(b)/bls12381G1Size)
+	for i := range ret {
+		var err error
+		ret[i], err = bls12381G1FromBytes(b[i*bls12381G1Size : (i+1)*bls12381G1Size])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return ret, nil
+}
+
+func bls12381G1ToBytes(g1 *bls12381.G1Affine) []byte {
+	x := g1.X.Bytes()
+	y := g1.Y.Bytes()
+	return append(x[:], y[:]...)
+}
+
+func bls12381G2FromBytes(b []byte) (ret bls12381.G2Affine, err error) {
+	if len(b) != bls12381G2Size {
+		return ret, fmt.Errorf("expect G2 in %d bytes", bls12381G2Size)
+	}
+	if ret.X.A0, err = bls12381FpFromBytes(b[:48]); err != nil {
+		return
+	}
+	if ret.X.A1, err = bls12381FpFromBytes(b[48:96]); err != nil {
+		return
+	}
+	if ret.Y.A0, err = bls12381FpFromBytes(b[96:144]); err != nil {
+		return
+	}
+	if ret.Y.A1, err = bls12381FpFromBytes(b[144:192]); err != nil {
+		return
+	}
+	if !ret.IsOnCurve() {
+		return ret, errEcPointNotOnCurve
+	}
+	return ret, nil
+}
+
+func bls12381G2sFromBytes(b []byte) ([]bls12381.G2Affine, error) {
+	if len(b)%bls12381G2Size != 0 {
+		return nil, fmt.Errorf("expect G2s in multiples of %d bytes", bls12381G2Size)
+	}
+	ret := make([]bls12381.G2Affine, len(b)/bls12381G2Size)
+	for i := range ret {
+		var err error
+		ret[i], err = bls12381G2FromBytes(b[i*bls12381G2Size : (i+1)*bls12381G2Size])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return ret, nil
+}
+
+func bls12381G2ToBytes(g2 *bls12381.G2Affine) []byte {
+	ret := make([]byte, 0, bls12381G2Size)
+	for _, e := range []bls12381fp.Element{g2.X.A0, g2.X.A1, g2.Y.A0, g2.Y.A1} {
+		b := e.Bytes()
+		ret = append(ret, b[:]...)
+	}
+	return ret
+}
+
+// ecScalars splits concatenated scalars of ec_multi_scalar_mul, reducing them
+// modulo the order of the group with setBytes
+func ecScalars(b []byte, count int, setBytes func(i int, scalar []byte)) error {
+	if len(b) != count*ecScalarSize {
+		return fmt.Errorf("expect %d scalars of %d bytes for %d points", count, ecScalarSize, count)
+	}
+	for i := 0; i < count; i++ {
+		setBytes(i, b[i*ecScalarSize:(i+1)*ecScalarSize])
+	}
+	return nil
+}
+
+// ecMultiExpConfig runs multi scalar multiplications in the goroutine of the
+// evaluation. Scalars are in montgomery form, as set by Element.SetBytes.
+var ecMultiExpConfig = ecc.MultiExpConfig{NbTasks: 1, ScalarsMont: true}
+
+func ecGroup(cx *EvalContext) (EcGroup, error) {
+	group := EcGroup(cx.program[cx.pc+1])
+	fs, ok := ecGroupSpecByField(group)
+	if !ok {
+		return group, fmt.Errorf("invalid group %s", group)
+	}
+	return fs.field, nil
+}
+
+func opEcAdd(cx *EvalContext) error {
+	group, err := ecGroup(cx)
+	if err != nil {
+		return err
+	}
+	last := len(cx.stack) - 1
+	prev := last - 1
+	aBytes := cx.stack[prev].Bytes
+	bBytes := cx.stack[last].Bytes
+
+	var res []byte
+	switch group {
+	case BN254g1:
+		a, err := bn254G1FromBytes(aBytes)
+		if err != nil {
+			return err
+		}
+		b, err := bn254G1FromBytes(bBytes)
+		if err != nil {
+			return err
+		}
+		res = bN254G1ToBytes(new(bn254.G1Affine).Add(&a, &b))
+	case BN254g2:
+		a, err := bn254G2FromBytes(aBytes)
+		if err != nil {
+			return err
+		}
+		b, err := bn254G2FromBytes(bBytes)
+		if err != nil {
+			return err
+		}
+		res = bn254G2ToBytes(new(bn254.G2Affine).Add(&a, &b))
+	case BLS12_381g1:
+		a, err := bls12381G1FromBytes(aBytes)
+		if err != nil {
+			return err
+		}
+		b, err := bls12381G1FromBytes(bBytes)
+		if err != nil {
+			return err
+		}
+		res = bls12381G1ToBytes(new(bls12381.G1Affine).Add(&a, &b))
+	case BLS12_381g2:
+		a, err := bls12381G2FromBytes(aBytes)
+		if err != nil {
+			return err
+		}
+		b, err := bls12381G2FromBytes(bBytes)
+		if err != nil {
+			return err
+		}
+		res = bls12381G2ToBytes(new(bls12381.G2Affine).Add(&a, &b))
+	}
+	cx.stack = cx.stack[:last]
+	cx.stack[prev].Bytes = res
+	return nil
+}
+
+func opEcScalarMul(cx *EvalContext) error {
+	group, err := ecGroup(cx)
+	if err != nil {
+		return err
+	}
+	last := len(cx.stack) - 1
+	prev := last - 1
+	aBytes := cx.stack[prev].Bytes
+	kBytes := cx.stack[last].Bytes
+	if len(kBytes) > ecScalarSize {
+		return fmt.Errorf("scalar is longer than %d bytes", ecScalarSize)
+	}
+	k := new(big.Int).SetBytes(kBytes)
+
+	var res []byte
+	switch group {
+	case BN254g1:
+		a, err := bn254G1FromBytes(aBytes)
+		if err != nil {
+			return err
+		}
+		res = bN254G1ToBytes(new(bn254.G1Affine).ScalarMultiplication(&a, k))
+	case BN254g2:
+		a, err := bn254G2FromBytes(aBytes)
+		if err != nil {
+			return err
+		}
+		res = bn254G2ToBytes(new(bn254.G2Affine).ScalarMultiplication(&a, k))
+	case BLS12_381g1:
+		a, err := bls12381G1FromBytes(aBytes)
+		if err != nil {
+			return err
+		}
+		res = bls12381G1ToBytes(new(bls12381.G1Affine).ScalarMultiplication(&a, k))
+	case BLS12_381g2:
+		a, err := bls12381G2FromBytes(aBytes)
+		if err != nil {
+			return err
+		}
+		res = bls12381G2ToBytes(new(bls12381.G2Affine).ScalarMultiplication(&a, k))
+	}
+	cx.stack = cx.stack[:last]
+	cx.stack[prev].Bytes = res
+	return nil
+}
+
+func opEcMultiScalarMul(cx *EvalContext) error {
+	group, err := ecGroup(cx)
+	if err != nil {
+		return err
+	}
+	last := len(cx.stack) - 1
+	prev := last - 1
+	aBytes := cx.stack[prev].Bytes
+	kBytes := cx.stack[last].Bytes
+
+	var res []byte
+	switch group {
+	case BN254g1:
+		points, err := bn254G1sFromBytes(aBytes)
+		if err != nil {
+			return err
+		}
+		scalars := make([]fr.Element, len(points))
+		err = ecScalars(kBytes, len(points), func(i int, b []byte) { scalars[i].SetBytes(b) })
+		if err != nil {
+			return err
+		}
+		var p bn254.G1Affine
+		if _, err := p.MultiExp(points, scalars, ecMultiExpConfig); err != nil {
+			return err
+		}
+		res = bN254G1ToBytes(&p)
+	case BN254g2:
+		points, err := bn254G2sFromBytes(aBytes)
+		if err != nil {
+			return err
+		}
+		scalars := make([]fr.Element, len(points))
+		err = ecScalars(kBytes, len(points), func(i int, b []byte) { scalars[i].SetBytes(b) })
+		if err != nil {
+			return err
+		}
+		var p bn254.G2Affine
+		if _, err := p.MultiExp(points, scalars, ecMultiExpConfig); err != nil {
+			return err
+		}
+		res = bn254G2ToBytes(&p)
+	case BLS12_381g1:
+		points, err := bls12381G1sFromBytes(aBytes)
+		if err != nil {
+			return err
+		}
+		scalars := make([]bls12381fr.Element, len(points))
+		err = ecScalars(kBytes, len(points), func(i int, b []byte) { scalars[i].SetBytes(b) })
+		if err != nil {
+			return err
+		}
+		var p bls12381.G1Affine
+		if _, err := p.MultiExp(points, scalars, ecMultiExpConfig); err != nil {
+			return err
+		}
+		res = bls12381G1ToBytes(&p)
+	case BLS12_381g2:
+		points, err := bls12381G2sFromBytes(aBytes)
+		if err != nil {
+			return err
+		}
+		scalars := make([]bls12381fr.Element, len(points))
+		err = ecScalars(kBytes, len(points), func(i int, b []byte) { scalars[i].SetBytes(b) })
+		if err != nil {
+			return err
+		}
+		var p bls12381.G2Affine
+		if _, err := p.MultiExp(points, scalars, ecMultiExpConfig); err != nil {
+			return err
+		}
+		res = bls12381G2ToBytes(&p)
+	}
+	cx.stack = cx.stack[:last]
+	cx.stack[prev].Bytes = res
+	return nil
+}
+
+func opEcPairingCheck(cx *EvalContext) error {
+	group, err := ecGroup(cx)
+	if err != nil {
+		return err
+	}
+	last := len(cx.stack) - 1
+	prev := last - 1
+	aBytes := cx.stack[prev].Bytes
+	bBytes := cx.stack[last].Bytes
+
+	g1Bytes, g2Bytes := aBytes, bBytes
+	if group == BN254g2 || group == BLS12_381g2 {
+		g1Bytes, g2Bytes = bBytes, aBytes
+	}
+
+	var ok bool
+	switch group {
+	case BN254g1, BN254g2:
+		// Compare the point counts before paying to decode the points
+		if len(g1Bytes)/bn254G1Size != len(g2Bytes)/bn254G2Size {
+			return errEcPairingPointCount
+		}
+		g1, err := bn254G1sFromBytes(g1Bytes)
+		if err != nil {
+			return err
+		}
+		g2, err := bn254G2sFromBytes(g2Bytes)
+		if err != nil {
+			return err
+		}
+		for i := range g1 {
+			if !g1[i].IsInSubGroup() || !g2[i].IsInSubGroup() {
+				return errEcPointNotInSubgroup
+			}
+		}
+		ok, err = bn254.PairingCheck(g1, g2)
+		if err != nil {
+			return err
+		}
+	case BLS12_381g1, BLS12_381g2:
+		// Compare the point counts before paying to decode the points
+		if len(g1Bytes)/bls12381G1Size != len(g2Bytes)/bls12381G2Size {
+			return errEcPairingPointCount
+		}
+		g1, err := bls12381G1sFromBytes(g1Bytes)
+		if err != nil {
+			return err
+		}
+		g2, err := bls12381G2sFromBytes(g2Bytes)
+		if err != nil {
+			return err
+		}
+		for i := range g1 {
+			if !g1[i].IsInSubGroup() || !g2[i].IsInSubGroup() {
+				return errEcPointNotInSubgroup
+			}
+		}
+		ok, err = bls12381.PairingCheck(g1, g2)
+		if err != nil {
+			return err
+		}
+	}
+	cx.stack = cx.stack[:last]
+	cx.stack[prev] = boolToSV(ok)
+	return nil
+}
+
+func opEcSubgroupCheck(cx *EvalContext) error {
+	group, err := ecGroup(cx)
+	if err != nil {
+		return err
+	}
+	last := len(cx.stack) - 1
+	aBytes := cx.stack[last].Bytes
+
+	var ok bool
+	switch group {
+	case BN254g1:
+		a, err := bn254G1FromBytes(aBytes)
+		if err != nil {
+			return err
+		}
+		ok = a.IsInSubGroup()
+	case BN254g2:
+		a, err := bn254G2FromBytes(aBytes)
+		if err != nil {
+			return err
+		}
+		ok = a.IsInSubGroup()
+	case BLS12_381g1:
+		a, err := bls12381G1FromBytes(aBytes)
+		if err != nil {
+			return err
+		}
+		ok = a.IsInSubGroup()
+	case BLS12_381g2:
+		a, err := bls12381G2FromBytes(aBytes)
+		if err != nil {
+			return err
+		}
+		ok = a.IsInSubGroup()
+	}
+	cx.stack[last] = boolToSV(ok)
+	return nil
+}
+
+func opEcMapTo(cx *EvalContext) error {
+	group, err := ecGroup(cx)
+	if err != nil {
+		return err
+	}
+	last := len(cx.stack) - 1
+	aBytes := cx.stack[last].Bytes
+
+	var res []byte
+	switch group {
+	case BN254g1:
+		if len(aBytes) > bn254FpSize {
+			return fmt.Errorf("expect field element in at most %d bytes", bn254FpSize)
+		}
+		u, err := bn254FpFromBytes(aBytes)
+		if err != nil {
+			return err
+		}
+		p := bn254.MapToCurveG1Svdw(u)
+		res = bN254G1ToBytes(&p)
+	case BN254g2:
+		// the X coordinate of a point holds the quadratic field element
+		var u bn254.G2Affine
+		if len(aBytes) != 2*bn254FpSize {
+			return fmt.Errorf("expect field element in %d bytes", 2*bn254FpSize)
+		}
+		if u.X.A0, err = bn254FpFromBytes(aBytes[:32]); err != nil {
+			return err
+		}
+		if u.X.A1, err = bn254FpFromBytes(aBytes[32:]); err != nil {
+			return err
+		}
+		p := bn254.MapToCurveG2Svdw(u.X)
+		res = bn254G2ToBytes(&p)
+	case BLS12_381g1:
+		if len(aBytes) > bls12381FpSize {
+			return fmt.Errorf("expect field element in at most %d bytes", bls12381FpSize)
+		}
+		u, err := bls12381FpFromBytes(aBytes)
+		if err != nil {
+			return err
+		}
+		p := bls12381.MapToCurveG1Svdw(u)
+		res = bls12381G1ToBytes(&p)
+	case BLS12_381g2:
+		var u bls12381.G2Affine
+		if len(aBytes) != 2*bls12381FpSize {
+			return fmt.Errorf("expect field element in %d bytes", 2*bls12381FpSize)
+		}
+		if u.X.A0, err = bls12381FpFromBytes(aBytes[:48]); err != nil {
+			return err
+		}
+		if u.X.A1, err = bls12381FpFromBytes(aBytes[48:]); err != nil {
+			return err
+		}
+		p := bls12381.MapToCurveG2Svdw(u.X)
+		res = bls12381G2ToBytes(&p)
+	}
+	cx.stack[last].Bytes = res
+	return nil
+}
@@ -16,6 +16,21 @@
 
 package logic
 
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"testing"
+
+	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
+	"github.com/consensys/gnark-crypto/ecc/bn254"
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
 const pairingNonsense = `
  pushbytes 0x012345
  dup
@@ -24,6 +39,277 @@ const pairingNonsense = `
  bn256_scalar_mul
  dup
  bn256_pairing
+ dup
+ ec_add BN254g1
+ dup
+ ec_scalar_mul BLS12_381g2
+ dup
+ ec_pairing_check BN254g2
+ dup
+ ec_multi_scalar_mul BLS12_381g1
+ ec_subgroup_check BN254g1
+ ec_map_to BLS12_381g2
 `
 
-const pairingCompiled = "80030123454999499a499b"
+const pairingCompiled = "80030123454999499a499b49e00049e10349e20149e302e400e503"
+
+// ecGenerators returns the encodings of the generator of each group, along
+// with its negation.
+func ecGenerators() map[EcGroup][2][]byte {
+	_, _, bnG1, bnG2 := bn254.Generators()
+	_, _, blsG1, blsG2 := bls12381.Generators()
+	var bnG1Neg bn254.G1Affine
+	var bnG2Neg bn254.G2Affine
+	var blsG1Neg bls12381.G1Affine
+	var blsG2Neg bls12381.G2Affine
+	return map[EcGroup][2][]byte{
+		BN254g1:     {bN254G1ToBytes(&bnG1), bN254G1ToBytes(bnG1Neg.Neg(&bnG1))},
+		BN254g2:     {bn254G2ToBytes(&bnG2), bn254G2ToBytes(bnG2Neg.Neg(&bnG2))},
+		BLS12_381g1: {bls12381G1ToBytes(&blsG1), bls12381G1ToBytes(blsG1Neg.Neg(&blsG1))},
+		BLS12_381g2: {bls12381G2ToBytes(&blsG2), bls12381G2ToBytes(blsG2Neg.Neg(&blsG2))},
+	}
+}
+
+// ecPaired returns the group that is paired with g in ec_pairing_check
+func ecPaired(g EcGroup) EcGroup {
+	switch g {
+	case BN254g1:
+		return BN254g2
+	case BN254g2:
+		return BN254g1
+	case BLS12_381g1:
+		return BLS12_381g2
+	default:
+		return BLS12_381g1
+	}
+}
+
+// testEc evaluates source as a logicsig with enough budget for the most
+// expensive ec_ opcodes, and checks that it passes, or fails with problem.
+func testEc(t *testing.T, source string, problem string) {
+	t.Helper()
+	ops := testProg(t, source, pairingVersion)
+	var txn transactions.SignedTxn
+	txn.Lsig.Logic = ops.Program
+	ep := defaultEvalParams(txn)
+	ep.Proto.LogicSigMaxCost = 1_000_000
+	pass, err := EvalSignature(0, ep)
+	if problem == "" {
+		if !pass || err != nil {
+			t.Log(ep.Trace.String())
+		}
+		require.NoError(t, err)
+		require.True(t, pass)
+		return
+	}
+	require.False(t, pass)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), problem)
+}
+
+func TestEcAdd(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	for group, gen := range ecGenerators() {
+		group, g, neg := group, hex.EncodeToString(gen[0]), hex.EncodeToString(gen[1])
+		zero := hex.EncodeToString(make([]byte, len(gen[0])))
+		bad := hex.EncodeToString(append(make([]byte, len(gen[0])-1), 1))
+		ff := hex.EncodeToString(bytes.Repeat([]byte{0xff}, len(gen[0])))
+		t.Run(group.String(), func(t *testing.T) {
+			t.Parallel()
+			// G + G == 2G
+			testEc(t, fmt.Sprintf("byte 0x%s; dup; ec_add %s; byte 0x%s; byte 0x02; ec_scalar_mul %s; ==",
+				g, group, g, group), "")
+			// G + -G == infinity, and infinity + G == G
+			testEc(t, fmt.Sprintf("byte 0x%s; byte 0x%s; ec_add %s; byte 0x%s; ==", g, neg, group, zero), "")
+			testEc(t, fmt.Sprintf("byte 0x%s; byte 0x%s; ec_add %s; byte 0x%s; ==", zero, g, group, g), "")
+			// not a point on the curve
+			testEc(t, fmt.Sprintf("byte 0x%s; byte 0x%s; ec_add %s; len", g, bad, group), "not on curve")
+			// wrong length
+			testEc(t, fmt.Sprintf("byte 0x%s; byte 0x%s00; ec_add %s; len", g, g, group), "expect")
+			// a coordinate not less than the modulus
+			testEc(t, fmt.Sprintf("byte 0x%s; byte 0x%s; ec_add %s; len", g, ff, group), "modulus")
+		})
+	}
+}
+
+func TestEcScalarMul(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	for group, gen := range ecGenerators() {
+		group, g := group, hex.EncodeToString(gen[0])
+		zero := hex.EncodeToString(make([]byte, len(gen[0])))
+		t.Run(group.String(), func(t *testing.T) {
+			t.Parallel()
+			testEc(t, fmt.Sprintf("byte 0x%s; int 0; itob; ec_scalar_mul %s; byte 0x%s; ==", g, group, zero), "")
+			testEc(t, fmt.Sprintf("byte 0x%s; byte 0x01; ec_scalar_mul %s; byte 0x%s; ==", g, group, g), "")
+			testEc(t, fmt.Sprintf("byte 0x%s; int 33; bzero; ec_scalar_mul %s; len", g, group), "32 bytes")
+		})
+	}
+}
+
+func TestEcMultiScalarMul(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	for group, gen := range ecGenerators() {
+		group, g := group, hex.EncodeToString(gen[0])
+		t.Run(group.String(), func(t *testing.T) {
+			t.Parallel()
+			// 1G + 2G == 3G
+			scalars := hex.EncodeToString(append(append(make([]byte, 31), 1), append(make([]byte, 31), 2)...))
+			testEc(t, fmt.Sprintf("byte 0x%s%s; byte 0x%s; ec_multi_scalar_mul %s; byte 0x%s; byte 0x03; ec_scalar_mul %s; ==",
+				g, g, scalars, group, g, group), "")
+			// scalars must be exactly 32 bytes, one per point
+			testEc(t, fmt.Sprintf("byte 0x%s%s; byte 0x0102; ec_multi_scalar_mul %s; len", g, g, group), "scalars")
+		})
+	}
+}
+
+func TestEcSubgroupCheck(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	for group, gen := range ecGenerators() {
+		group, g := group, hex.EncodeToString(gen[0])
+		zero := hex.EncodeToString(make([]byte, len(gen[0])))
+		bad := hex.EncodeToString(append(make([]byte, len(gen[0])-1), 1))
+		t.Run(group.String(), func(t *testing.T) {
+			t.Parallel()
+			testEc(t, fmt.Sprintf("byte 0x%s; ec_subgroup_check %s", g, group), "")
+			testEc(t, fmt.Sprintf("byte 0x%s; ec_subgroup_check %s", zero, group), "")
+			testEc(t, fmt.Sprintf("byte 0x%s; ec_subgroup_check %s", bad, group), "not on curve")
+		})
+	}
+
+	// BN254 G2 has a cofactor, so there are points on the curve outside of the
+	// subgroup. Find one by solving for Y, with b taken from the generator.
+	_, _, _, gen := bn254.Generators()
+	var b, rhs bn254.G2Affine
+	b.X.Square(&gen.Y)
+	rhs.X.Square(&gen.X).Mul(&rhs.X, &gen.X)
+	b.X.Sub(&b.X, &rhs.X)
+	var p bn254.G2Affine
+	for x := uint64(1); ; x++ {
+		p.X.A0.SetUint64(x)
+		rhs.X.Square(&p.X).Mul(&rhs.X, &p.X).Add(&rhs.X, &b.X)
+		if rhs.X.Legendre() == 1 {
+			p.Y.Sqrt(&rhs.X)
+			break
+		}
+	}
+	require.True(t, p.IsOnCurve())
+	require.False(t, p.IsInSubGroup())
+	testEc(t, fmt.Sprintf("byte 0x%s; ec_subgroup_check BN254g2; !", hex.EncodeToString(bn254G2ToBytes(&p))), "")
+}
+
+func TestEcPairingCheck(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	gens := ecGenerators()
+	for group, gen := range gens {
+		group, g, neg := group, hex.EncodeToString(gen[0]), hex.EncodeToString(gen[1])
+		h := hex.EncodeToString(gens[ecPaired(group)][0])
+		t.Run(group.String(), func(t *testing.T) {
+			t.Parallel()
+			// e(G, H) * e(-G, H) == 1
+			testEc(t, fmt.Sprintf("byte 0x%s%s; byte 0x%s%s; ec_pairing_check %s", g, neg, h, h, group), "")
+			// e(G, H) != 1
+			testEc(t, fmt.Sprintf("byte 0x%s; byte 0x%s; ec_pairing_check %s; !", g, h, group), "")
+			testEc(t, fmt.Sprintf("byte 0x%s%s; byte 0x%s; ec_pairing_check %s", g, neg, h, group), "different number")
+			// the counts are compared before any point is decoded
+			bad := strings.Repeat("01", len(gens[ecPaired(group)][0]))
+			testEc(t, fmt.Sprintf("byte 0x%s%s; byte 0x%s; ec_pairing_check %s", g, neg, bad, group), "different number")
+			testEc(t, fmt.Sprintf("byte 0x%s; byte 0x%s; ec_pairing_check %s", g, bad, group), "not on curve")
+		})
+	}
+}
+
+func TestEcMapTo(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	// Mapped points are in the subgroup
+	testEc(t, "byte 0x07; ec_map_to BN254g1; ec_subgroup_check BN254g1", "")
+	testEc(t, "byte 0x07; ec_map_to BLS12_381g1; ec_subgroup_check BLS12_381g1", "")
+	testEc(t, "int 64; bzero; ec_map_to BN254g2; ec_subgroup_check BN254g2", "")
+	testEc(t, "int 96; bzero; ec_map_to BLS12_381g2; ec_subgroup_check BLS12_381g2", "")
+
+	testEc(t, "int 33; bzero; ec_map_to BN254g1; len", "at most 32 bytes")
+	testEc(t, "byte 0x07; ec_map_to BN254g2; len", "64 bytes")
+	testEc(t, "int 96; bzero; int 0; int 255; setbyte; ec_map_to BLS12_381g1; len", "at most 48 bytes")
+}
+
+func TestEcGroupValidation(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	testProg(t, "byte 0x00; ec_map_to BN254g3", pairingVersion, Expect{1, "ec_map_to unknown field: \"BN254g3\""})
+	testProg(t, "byte 0x00; ec_map_to BN254g1", pairingVersion-1, Expect{1, "ec_map_to opcode was introduced in v9"})
+}
+
+func benchmarkEc(b *testing.B, source string) {
+	ops, err := AssembleStringWithVersion(source, pairingVersion)
+	require.NoError(b, err)
+	var txn transactions.SignedTxn
+	txn.Lsig.Logic = ops.Program
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		ep := defaultEvalParams(txn)
+		ep.Proto.LogicSigMaxCost = 1_000_000
+		pass, err := EvalSignature(0, ep)
+		if err != nil || !pass {
+			require.NoError(b, err)
+			require.True(b, pass)
+		}
+	}
+}
+
+// BenchmarkEc runs each ec_ opcode on each group, so that their costs may be
+// calibrated against the time per unit of cost of the other opcodes. The
+// opcodes with a cost that depends on the input length are run on inputs of
+// different lengths, to calibrate the base and per chunk costs.
+func BenchmarkEc(b *testing.B) {
+	gens := ecGenerators()
+	// the time to evaluate a program that runs no ec_ opcode, to be
+	// subtracted from the others
+	b.Run("overhead", func(b *testing.B) {
+		benchmarkEc(b, "int 1")
+	})
+	for _, group := range []EcGroup{BN254g1, BN254g2, BLS12_381g1, BLS12_381g2} {
+		g := hex.EncodeToString(gens[group][0])
+		h := hex.EncodeToString(gens[ecPaired(group)][0])
+		k := hex.EncodeToString(bytes.Repeat([]byte{0x5a}, ecScalarSize))
+		b.Run(fmt.Sprintf("%s add", group), func(b *testing.B) {
+			benchmarkEc(b, fmt.Sprintf("byte 0x%s; dup; ec_add %s; len", g, group))
+		})
+		b.Run(fmt.Sprintf("%s scalar_mul", group), func(b *testing.B) {
+			benchmarkEc(b, fmt.Sprintf("byte 0x%s; byte 0x%s; ec_scalar_mul %s; len", g, k, group))
+		})
+		b.Run(fmt.Sprintf("%s subgroup_check", group), func(b *testing.B) {
+			benchmarkEc(b, fmt.Sprintf("byte 0x%s; ec_subgroup_check %s", g, group))
+		})
+		mapInput := "byte 0x07"
+		if group == BN254g2 || group == BLS12_381g2 {
+			mapInput = fmt.Sprintf("int %d; bzero", len(gens[group][0])/2)
+		}
+		b.Run(fmt.Sprintf("%s map_to", group), func(b *testing.B) {
+			benchmarkEc(b, fmt.Sprintf("%s; ec_map_to %s; len", mapInput, group))
+		})
+		for _, n := range []int{1, 2, 4, 8} {
+			b.Run(fmt.Sprintf("%s pairing_check %d", group, n), func(b *testing.B) {
+				benchmarkEc(b, fmt.Sprintf("byte 0x%s; byte 0x%s; ec_pairing_check %s; pop; int 1",
+					strings.Repeat(g, n), strings.Repeat(h, n), group))
+			})
+		}
+		for _, n := range []int{1, 4, 16} {
+			b.Run(fmt.Sprintf("%s multi_scalar_mul %d", group, n), func(b *testing.B) {
+				benchmarkEc(b, fmt.Sprintf("byte 0x%s; byte 0x%s; ec_multi_scalar_mul %s; len",
+					strings.Repeat(g, n), strings.Repeat(k, n), group))
+			})
+		}
+	}
+}
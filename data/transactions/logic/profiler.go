@@ -0,0 +1,414 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package logic
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+)
+
+// Profiler attributes the opcode cost and the wall-clock time of evaluations
+// to the instructions of the programs evaluated, and to the subroutines the
+// instructions run in. Set EvalParams.Profiler to profile the programs that
+// the params evaluate, including the programs of inner application calls. The
+// time of an instruction that evaluates inner application calls includes the
+// time of their programs. A Profiler must not be used by concurrent
+// evaluations.
+type Profiler struct {
+	programs map[string]*ProgramProfile
+	order    []*ProgramProfile
+}
+
+// ProgramProfile is the profile of the runs of a program.
+type ProgramProfile struct {
+	Program []byte
+	Runs    int
+
+	// Ops are the profiles of the instructions that ran, by pc.
+	Ops map[int]*OpProfile
+
+	// Subroutines are the profiles of the subroutines that ran, by the pc of
+	// their first instruction. They include the instructions of the
+	// subroutines they call.
+	Subroutines map[int]*OpProfile
+
+	// samples are the profiles of the instructions by call stack, keyed by
+	// the varint encoding of their stack
+	samples map[string]*profileSample
+	key     []byte
+}
+
+// OpProfile is the profile of an instruction, or of a subroutine.
+type OpProfile struct {
+	// Count is the number of times the instruction ran, or the number of
+	// times the subroutine was called.
+	Count uint64
+	Cost  uint64
+	Time  time.Duration
+}
+
+// profileSample is the profile of an instruction for one call stack.
+type profileSample struct {
+	// stack is the pc of the instruction, followed by the pcs of the
+	// callsub instructions that led to it, innermost first
+	stack []int
+	OpProfile
+}
+
+// NewProfiler creates an empty Profiler
+func NewProfiler() *Profiler {
+	return &Profiler{programs: make(map[string]*ProgramProfile)}
+}
+
+// Programs returns the profiles of the programs that ran, in the order they
+// first ran.
+func (p *Profiler) Programs() []*ProgramProfile {
+	return p.order
+}
+
+// Program returns the profile of program, or nil if it has not run.
+func (p *Profiler) Program(program []byte) *ProgramProfile {
+	return p.programs[GetProgramID(program)]
+}
+
+// start returns the profile to record a run of program in.
+func (p *Profiler) start(program []byte) *ProgramProfile {
+	id := GetProgramID(program)
+	pp, ok := p.programs[id]
+	if !ok {
+		pp = &ProgramProfile{
+			Program:     program,
+			Ops:         make(map[int]*OpProfile),
+			Subroutines: make(map[int]*OpProfile),
+			samples:     make(map[string]*profileSample),
+		}
+		p.programs[id] = pp
+		p.order = append(p.order, pp)
+	}
+	pp.Runs++
+	return pp
+}
+
+// subroutineEntry returns the first pc of the subroutine called by the
+// callsub at pc.
+func subroutineEntry(program []byte, pc int) int {
+	return pc + 3 + decodeBranchOffset(program, pc+1)
+}
+
+func (pp *ProgramProfile) subroutine(entry int) *OpProfile {
+	sub, ok := pp.Subroutines[entry]
+	if !ok {
+		sub = &OpProfile{}
+		pp.Subroutines[entry] = sub
+	}
+	return sub
+}
+
+// step runs cx.step(), and records its cost and time.
+func (pp *ProgramProfile) step(cx *EvalContext) error {
+	pc := cx.pc
+	depth := len(cx.callstack)
+	pp.key = appendUvarint(pp.key[:0], uint64(pc))
+	for i := depth - 1; i >= 0; i-- {
+		pp.key = appendUvarint(pp.key, uint64(cx.callstack[i].retpc-3))
+	}
+	sample, ok := pp.samples[string(pp.key)]
+	if !ok {
+		sample = &profileSample{stack: make([]int, 0, depth+1)}
+		sample.stack = append(sample.stack, pc)
+		for i := depth - 1; i >= 0; i-- {
+			sample.stack = append(sample.stack, cx.callstack[i].retpc-3)
+		}
+		pp.samples[string(pp.key)] = sample
+	}
+
+	cost := cx.cost
+	start := time.Now()
+	err := cx.step()
+	elapsed := time.Since(start)
+	spent := uint64(cx.cost - cost)
+
+	op, ok := pp.Ops[pc]
+	if !ok {
+		op = &OpProfile{}
+		pp.Ops[pc] = op
+	}
+	op.Count++
+	op.Cost += spent
+	op.Time += elapsed
+	sample.Count++
+	sample.Cost += spent
+	sample.Time += elapsed
+
+	// the subroutines on the stack before the step, once each when recursive
+	for i := 1; i < len(sample.stack); i++ {
+		entry := subroutineEntry(cx.program, sample.stack[i])
+		counted := false
+		for j := 1; j < i; j++ {
+			counted = counted || subroutineEntry(cx.program, sample.stack[j]) == entry
+		}
+		if !counted {
+			sub := pp.subroutine(entry)
+			sub.Cost += spent
+			sub.Time += elapsed
+		}
+	}
+	if err == nil && len(cx.callstack) > depth {
+		pp.subroutine(subroutineEntry(cx.program, pc)).Count++
+	}
+	return err
+}
+
+// protobuf is a minimal encoder of the protocol buffers wire format, enough
+// to write pprof profiles.
+type protobuf struct {
+	buf []byte
+}
+
+func (b *protobuf) uint64(tag int, x uint64) {
+	b.buf = appendUvarint(b.buf, uint64(tag)<<3)
+	b.buf = appendUvarint(b.buf, x)
+}
+
+func (b *protobuf) int64(tag int, x int64) {
+	b.uint64(tag, uint64(x))
+}
+
+func (b *protobuf) bytes(tag int, data []byte) {
+	b.buf = appendUvarint(b.buf, uint64(tag)<<3|2)
+	b.buf = appendUvarint(b.buf, uint64(len(data)))
+	b.buf = append(b.buf, data...)
+}
+
+func (b *protobuf) packed(tag int, xs []uint64) {
+	var packed []byte
+	for _, x := range xs {
+		packed = appendUvarint(packed, x)
+	}
+	b.bytes(tag, packed)
+}
+
+// Field numbers of the messages of the pprof profile.proto
+const (
+	pprofSampleType        = 1
+	pprofSample            = 2
+	pprofLocation          = 4
+	pprofFunction          = 5
+	pprofStringTable       = 6
+	pprofDurationNanos     = 10
+	pprofDefaultSampleType = 14
+
+	pprofValueTypeType = 1
+	pprofValueTypeUnit = 2
+
+	pprofSampleLocationID = 1
+	pprofSampleValue      = 2
+
+	pprofLocationID      = 1
+	pprofLocationAddress = 3
+	pprofLocationLine    = 4
+
+	pprofLineFunctionID = 1
+	pprofLineLine       = 2
+
+	pprofFunctionID         = 1
+	pprofFunctionName       = 2
+	pprofFunctionSystemName = 3
+	pprofFunctionFilename   = 4
+	pprofFunctionStartLine  = 5
+)
+
+// pprofWriter builds a pprof profile.
+type pprofWriter struct {
+	profile   protobuf
+	strings   map[string]int64
+	functions map[pprofFunctionKey]uint64
+	locations map[pprofLocationKey]uint64
+}
+
+type pprofFunctionKey struct {
+	program *ProgramProfile
+	entry   int
+}
+
+type pprofLocationKey struct {
+	pprofFunctionKey
+	pc int
+}
+
+// pprofProgram is what the profile shows of a program: its name, and the
+// source lines of its pcs when it has a SourceMap.
+type pprofProgram struct {
+	*ProgramProfile
+	name    string
+	sources []string
+	lines   map[int]sourceLine
+	// firstPC is the first pc with a source line, where main starts
+	firstPC int
+}
+
+func (w *pprofWriter) string(s string) int64 {
+	i, ok := w.strings[s]
+	if !ok {
+		i = int64(len(w.strings))
+		w.strings[s] = i
+		w.profile.bytes(pprofStringTable, []byte(s))
+	}
+	return i
+}
+
+func (w *pprofWriter) valueType(tag int, typ string, unit string) {
+	var vt protobuf
+	vt.int64(pprofValueTypeType, w.string(typ))
+	vt.int64(pprofValueTypeUnit, w.string(unit))
+	w.profile.bytes(tag, vt.buf)
+}
+
+// function returns the id of the function of the main program, when entry
+// is 0, or of the subroutine that starts at entry.
+func (w *pprofWriter) function(prog *pprofProgram, entry int) uint64 {
+	key := pprofFunctionKey{prog.ProgramProfile, entry}
+	id, ok := w.functions[key]
+	if ok {
+		return id
+	}
+	id = uint64(len(w.functions) + 1)
+	w.functions[key] = id
+
+	name := prog.name + ".main"
+	if entry != 0 {
+		name = fmt.Sprintf("%s.sub%d", prog.name, entry)
+	}
+	var f protobuf
+	f.uint64(pprofFunctionID, id)
+	f.int64(pprofFunctionName, w.string(name))
+	f.int64(pprofFunctionSystemName, w.string(name))
+	start := entry
+	if entry == 0 {
+		start = prog.firstPC
+	}
+	if line, ok := prog.lines[start]; ok && line.source < len(prog.sources) {
+		f.int64(pprofFunctionFilename, w.string(prog.sources[line.source]))
+		f.int64(pprofFunctionStartLine, int64(line.line+1))
+	}
+	w.profile.bytes(pprofFunction, f.buf)
+	return id
+}
+
+// location returns the id of the location of pc in the function that starts
+// at entry.
+func (w *pprofWriter) location(prog *pprofProgram, entry int, pc int) uint64 {
+	key := pprofLocationKey{pprofFunctionKey{prog.ProgramProfile, entry}, pc}
+	id, ok := w.locations[key]
+	if ok {
+		return id
+	}
+	id = uint64(len(w.locations) + 1)
+	w.locations[key] = id
+
+	var line protobuf
+	line.uint64(pprofLineFunctionID, w.function(prog, entry))
+	if l, ok := prog.lines[pc]; ok {
+		line.int64(pprofLineLine, int64(l.line+1))
+	}
+	var loc protobuf
+	loc.uint64(pprofLocationID, id)
+	loc.uint64(pprofLocationAddress, uint64(pc))
+	loc.bytes(pprofLocationLine, line.buf)
+	w.profile.bytes(pprofLocation, loc.buf)
+	return id
+}
+
+// WriteProfile writes the profile in the gzipped protocol buffers format of
+// pprof, with the executions, the cost and the time of each call stack. The
+// call stacks of a program start with a function named after the program,
+// followed by ".main". Its subroutines are functions named after their first
+// pc. The locations of programs with a SourceMap in sourceMaps, keyed by
+// program ID (see GetProgramID), have the file and line of their source.
+// Other programs are named after their ID, and their locations only have
+// their pc as address.
+func (p *Profiler) WriteProfile(w io.Writer, sourceMaps map[string]SourceMap) error {
+	pw := pprofWriter{
+		strings:   make(map[string]int64),
+		functions: make(map[pprofFunctionKey]uint64),
+		locations: make(map[pprofLocationKey]uint64),
+	}
+	pw.string("")
+	pw.valueType(pprofSampleType, "executions", "count")
+	pw.valueType(pprofSampleType, "cost", "units")
+	pw.valueType(pprofSampleType, "time", "nanoseconds")
+	pw.profile.int64(pprofDefaultSampleType, pw.string("cost"))
+
+	var duration time.Duration
+	for _, pp := range p.order {
+		id := GetProgramID(pp.Program)
+		prog := pprofProgram{ProgramProfile: pp, name: "program-" + id[:16]}
+		if sm, ok := sourceMaps[id]; ok {
+			lines, err := sm.pcLines()
+			if err != nil {
+				return fmt.Errorf("source map of program %s: %w", id, err)
+			}
+			prog.lines = lines
+			prog.sources = sm.Sources
+			prog.firstPC = len(pp.Program)
+			for pc := range lines {
+				if pc < prog.firstPC {
+					prog.firstPC = pc
+				}
+			}
+			if len(sm.File) > 0 {
+				prog.name = sm.File
+			} else if len(sm.Sources) > 0 {
+				prog.name = sm.Sources[0]
+			}
+		}
+
+		keys := make([]string, 0, len(pp.samples))
+		for key := range pp.samples {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			sample := pp.samples[key]
+			// the function of each pc of the stack is the subroutine called
+			// by the next callsub of the stack, or the main program
+			ids := make([]uint64, len(sample.stack))
+			for i, pc := range sample.stack {
+				entry := 0
+				if i+1 < len(sample.stack) {
+					entry = subroutineEntry(pp.Program, sample.stack[i+1])
+				}
+				ids[i] = pw.location(&prog, entry, pc)
+			}
+			var s protobuf
+			s.packed(pprofSampleLocationID, ids)
+			s.packed(pprofSampleValue, []uint64{sample.Count, sample.Cost, uint64(sample.Time)})
+			pw.profile.bytes(pprofSample, s.buf)
+			duration += sample.Time
+		}
+	}
+	pw.profile.int64(pprofDurationNanos, int64(duration))
+
+	zw := gzip.NewWriter(w)
+	if _, err := zw.Write(pw.profile.buf); err != nil {
+		return err
+	}
+	return zw.Close()
+}
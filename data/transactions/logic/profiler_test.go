@@ -0,0 +1,104 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package logic
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestProfiler(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	ops := testProg(t, `int 3
+callsub double
+callsub double
+int 12
+==
+return
+double:
+int 2
+*
+retsub`, fpVersion)
+	lineToPC := make(map[int]int)
+	for pc, line := range ops.OffsetToLine {
+		lineToPC[line] = pc
+	}
+	first, entry := lineToPC[1], lineToPC[7]
+
+	ep := defaultEvalParams()
+	ep.Profiler = NewProfiler()
+	ep.TxnGroup[0].Lsig.Logic = ops.Program
+	pass, cx, err := EvalSignatureFull(0, ep)
+	require.NoError(t, err)
+	require.True(t, pass)
+
+	require.Len(t, ep.Profiler.Programs(), 1)
+	profile := ep.Profiler.Program(ops.Program)
+	require.Equal(t, profile, ep.Profiler.Programs()[0])
+	require.Equal(t, 1, profile.Runs)
+	require.EqualValues(t, 1, profile.Ops[first].Count)
+	require.EqualValues(t, 1, profile.Ops[first].Cost)
+	require.EqualValues(t, 2, profile.Ops[entry].Count)
+	total := uint64(0)
+	for _, op := range profile.Ops {
+		total += op.Cost
+	}
+	require.EqualValues(t, cx.Cost(), total)
+
+	// the subroutine is called twice, and its cost includes its retsub
+	require.Len(t, profile.Subroutines, 1)
+	require.EqualValues(t, 2, profile.Subroutines[entry].Count)
+	require.EqualValues(t, 6, profile.Subroutines[entry].Cost)
+
+	_, err = EvalSignature(0, ep)
+	require.NoError(t, err)
+	require.Equal(t, 2, profile.Runs)
+	require.EqualValues(t, 4, profile.Subroutines[entry].Count)
+	require.Nil(t, ep.Profiler.Program([]byte{fpVersion}))
+
+	var buf bytes.Buffer
+	err = ep.Profiler.WriteProfile(&buf, map[string]SourceMap{
+		GetProgramID(ops.Program): GetSourceMap([]string{"double.teal"}, ops.OffsetToLine),
+	})
+	require.NoError(t, err)
+	zr, err := gzip.NewReader(&buf)
+	require.NoError(t, err)
+	data, err := io.ReadAll(zr)
+	require.NoError(t, err)
+	for _, s := range []string{"double.teal", "double.teal.main", fmt.Sprintf("double.teal.sub%d", entry), "cost", "nanoseconds"} {
+		require.Contains(t, string(data), s)
+	}
+
+	// without a source map, the functions are named after the program ID
+	buf.Reset()
+	err = ep.Profiler.WriteProfile(&buf, nil)
+	require.NoError(t, err)
+	zr, err = gzip.NewReader(&buf)
+	require.NoError(t, err)
+	data, err = io.ReadAll(zr)
+	require.NoError(t, err)
+	require.Contains(t, string(data), "program-"+GetProgramID(ops.Program)[:16]+".main")
+}
@@ -18,6 +18,7 @@ package logic
 
 import (
 	"bytes"
+	"fmt"
 	"strings"
 )
 
@@ -42,6 +43,15 @@ type SourceMap struct {
 // GetSourceMap returns a struct containing details about
 // the assembled file and encoded mappings to the source file.
 func GetSourceMap(sourceNames []string, offsetToLine map[int]int) SourceMap {
+	return GetMultiSourceMap(sourceNames, offsetToLine, nil)
+}
+
+// GetMultiSourceMap returns a struct containing details about the
+// assembled file and encoded mappings to its source files, like
+// GetSourceMap, with offsetToSource mapping each PC to the index of its
+// source in sourceNames. PCs missing from offsetToSource are in the first
+// source.
+func GetMultiSourceMap(sourceNames []string, offsetToLine map[int]int, offsetToSource map[int]int) SourceMap {
 	maxPC := 0
 	for pc := range offsetToLine {
 		if pc > maxPC {
@@ -51,11 +61,14 @@ func GetSourceMap(sourceNames []string, offsetToLine map[int]int) SourceMap {
 
 	// Array where index is the PC and value is the line for `mappings` field.
 	prevSourceLine := 0
+	prevSource := 0
 	pcToLine := make([]string, maxPC+1)
 	for pc := range pcToLine {
 		if line, ok := offsetToLine[pc]; ok {
-			pcToLine[pc] = MakeSourceMapLine(0, 0, line-prevSourceLine, 0)
+			source := offsetToSource[pc]
+			pcToLine[pc] = MakeSourceMapLine(0, source-prevSource, line-prevSourceLine, 0)
 			prevSourceLine = line
+			prevSource = source
 		} else {
 			pcToLine[pc] = ""
 		}
@@ -69,6 +82,62 @@ func GetSourceMap(sourceNames []string, offsetToLine map[int]int) SourceMap {
 	}
 }
 
+// sourceLine is a position in the sources of a SourceMap
+type sourceLine struct {
+	source int
+	line   int
+}
+
+// pcLines decodes the mappings of the SourceMap into the position of each pc
+// that has one.
+func (sm SourceMap) pcLines() (map[int]sourceLine, error) {
+	lines := make(map[int]sourceLine)
+	var current sourceLine
+	for pc, segment := range strings.Split(sm.Mappings, ";") {
+		if len(segment) == 0 {
+			continue
+		}
+		fields, err := vlqToInts(segment)
+		if err != nil {
+			return nil, fmt.Errorf("mapping of pc %d: %w", pc, err)
+		}
+		if len(fields) < 3 {
+			return nil, fmt.Errorf("mapping of pc %d has %d fields", pc, len(fields))
+		}
+		current.source += fields[1]
+		current.line += fields[2]
+		lines[pc] = current
+	}
+	return lines, nil
+}
+
+// vlqToInts decodes the values of a mapping segment, the reverse of intToVLQ
+func vlqToInts(segment string) ([]int, error) {
+	var values []int
+	v, shift := 0, 0
+	for i := 0; i < len(segment); i++ {
+		digit := strings.IndexByte(b64table, segment[i])
+		if digit < 0 {
+			return nil, fmt.Errorf("invalid character %q", segment[i])
+		}
+		v |= (digit & 31) << shift
+		if digit&32 != 0 {
+			shift += 5
+			continue
+		}
+		if v&1 != 0 {
+			values = append(values, -(v >> 1))
+		} else {
+			values = append(values, v>>1)
+		}
+		v, shift = 0, 0
+	}
+	if shift != 0 {
+		return nil, fmt.Errorf("truncated value")
+	}
+	return values, nil
+}
+
 // intToVLQ writes out value to bytes.Buffer
 func intToVLQ(v int, buf *bytes.Buffer) {
 	v <<= 1
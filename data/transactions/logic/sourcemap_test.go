@@ -53,6 +53,29 @@ func TestGetSourceMap(t *testing.T) {
 	}
 }
 
+func TestGetMultiSourceMap(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	a := require.New(t)
+
+	sourceNames := []string{"main.teal", "lib.teal"}
+	offsetToLine := map[int]int{
+		1: 1,
+		2: 4,
+		5: 2,
+	}
+	offsetToSource := map[int]int{
+		1: 0,
+		2: 1,
+		5: 0,
+	}
+	actualSourceMap := GetMultiSourceMap(sourceNames, offsetToLine, offsetToSource)
+	a.Equal(sourceNames, actualSourceMap.Sources)
+
+	// both the source index and the line are relative to the previous mapping
+	a.Equal([]string{"", "AACA", "ACGA", "", "", "ADFA"}, strings.Split(actualSourceMap.Mappings, ";"))
+	a.Equal(GetSourceMap(sourceNames, offsetToLine).Mappings, GetMultiSourceMap(sourceNames, offsetToLine, nil).Mappings)
+}
+
 func TestVLQ(t *testing.T) {
 	partitiontest.PartitionTest(t)
 	a := require.New(t)
@@ -63,4 +86,33 @@ func TestVLQ(t *testing.T) {
 	a.Equal("AAgBA", MakeSourceMapLine(0, 0, 16, 0))
 	a.Equal("AAggBA", MakeSourceMapLine(0, 0, 512, 0))
 	a.Equal("ADggBD", MakeSourceMapLine(0, -1, 512, -1))
+
+	for _, values := range [][]int{{0, 0, 0, 0}, {0, 0, 16, 0}, {0, -1, 512, -1}, {3, 70000, -70000, 15}} {
+		decoded, err := vlqToInts(MakeSourceMapLine(values[0], values[1], values[2], values[3]))
+		a.NoError(err)
+		a.Equal(values, decoded)
+	}
+	_, err := vlqToInts("Ag")
+	a.Error(err)
+	_, err = vlqToInts("A*")
+	a.Error(err)
+}
+
+func TestSourceMapPCLines(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	a := require.New(t)
+
+	offsetToLine := map[int]int{1: 1, 2: 6, 5: 3, 9: 3}
+	offsetToSource := map[int]int{2: 1, 9: 1}
+	lines, err := GetMultiSourceMap([]string{"main.teal", "lib.teal"}, offsetToLine, offsetToSource).pcLines()
+	a.NoError(err)
+	a.Equal(map[int]sourceLine{
+		1: {source: 0, line: 1},
+		2: {source: 1, line: 6},
+		5: {source: 0, line: 3},
+		9: {source: 1, line: 3},
+	}, lines)
+
+	_, err = SourceMap{Mappings: ";AA"}.pcLines()
+	a.Error(err)
 }
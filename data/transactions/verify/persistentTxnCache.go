@@ -0,0 +1,302 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package verify
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/util/db"
+)
+
+var persistentTxnCacheSchema = []string{
+	`CREATE TABLE IF NOT EXISTS verifiedtxns (
+		txid blob NOT NULL,
+		authhash blob NOT NULL,
+		lastvalid integer NOT NULL,
+		feesink blob NOT NULL,
+		rewardspool blob NOT NULL,
+		proto text NOT NULL,
+		minavmversion integer NOT NULL,
+		PRIMARY KEY (txid, authhash))`,
+	`CREATE INDEX IF NOT EXISTS verifiedtxns_lastvalid_idx ON verifiedtxns (lastvalid)`,
+}
+
+// persistedTxnKey identifies a verified transaction along with the signature, multisig, logicsig and
+// auth address that authorized it.
+type persistedTxnKey struct {
+	txid     transactions.Txid
+	authHash crypto.Digest
+}
+
+// persistedTxnEntry is the verification context of a persisted transaction
+type persistedTxnEntry struct {
+	lastValid        basics.Round
+	specAddrs        transactions.SpecialAddresses
+	consensusVersion protocol.ConsensusVersion
+	minAvmVersion    uint64
+}
+
+// PersistentVerifiedTransactionCache is a VerifiedTransactionCache which also writes the verified transactions
+// into a database, so that the transactions verified before a restart don't have to be verified again. The
+// persisted transactions are loaded when the cache is created, and are expired once their last valid round has passed.
+// The cache is a BlockListener; the transactions verified since the previous block are written, and the expired
+// ones deleted, whenever a block is added to the ledger.
+type PersistentVerifiedTransactionCache struct {
+	*verifiedTransactionCache
+
+	log       logging.Logger
+	dbs       db.Accessor
+	cacheSize int
+
+	// persistedLock synchronizes the access to the persisted and pending fields
+	persistedLock deadlock.Mutex
+	// persisted are the transactions loaded from the database when the cache was created
+	persisted map[persistedTxnKey]persistedTxnEntry
+	// pending are the transactions added to the cache since they were last written to the database
+	pending map[persistedTxnKey]persistedTxnEntry
+	// latest is the latest round the cache was notified of
+	latest basics.Round
+}
+
+// MakePersistentVerifiedTransactionCache opens the database of a persistent verified transaction cache, creating it if
+// needed, and loads the most recently verified transactions from it.
+func MakePersistentVerifiedTransactionCache(cacheSize int, dbFilename string, log logging.Logger) (*PersistentVerifiedTransactionCache, error) {
+	dbs, err := db.MakeAccessor(dbFilename, false, false)
+	if err != nil {
+		return nil, err
+	}
+	dbs.SetLogger(log)
+
+	c := &PersistentVerifiedTransactionCache{
+		verifiedTransactionCache: MakeVerifiedTransactionCache(cacheSize).(*verifiedTransactionCache),
+		log:                      log,
+		dbs:                      dbs,
+		cacheSize:                cacheSize,
+		persisted:                make(map[persistedTxnKey]persistedTxnEntry),
+		pending:                  make(map[persistedTxnKey]persistedTxnEntry),
+	}
+	err = dbs.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		for _, stmt := range persistentTxnCacheSchema {
+			if _, err := tx.ExecContext(ctx, stmt); err != nil {
+				return err
+			}
+		}
+		return c.load(ctx, tx)
+	})
+	if err != nil {
+		dbs.Close()
+		return nil, err
+	}
+	return c, nil
+}
+
+// load reads the most recently persisted transactions, up to the size of the cache
+func (c *PersistentVerifiedTransactionCache) load(ctx context.Context, tx *sql.Tx) error {
+	rows, err := tx.QueryContext(ctx, "SELECT txid, authhash, lastvalid, feesink, rewardspool, proto, minavmversion FROM verifiedtxns ORDER BY rowid DESC LIMIT ?", c.cacheSize)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var txid, authHash, feeSink, rewardsPool []byte
+		var entry persistedTxnEntry
+		err = rows.Scan(&txid, &authHash, &entry.lastValid, &feeSink, &rewardsPool, &entry.consensusVersion, &entry.minAvmVersion)
+		if err != nil {
+			return err
+		}
+		var key persistedTxnKey
+		copy(key.txid[:], txid)
+		copy(key.authHash[:], authHash)
+		copy(entry.specAddrs.FeeSink[:], feeSink)
+		copy(entry.specAddrs.RewardsPool[:], rewardsPool)
+		c.persisted[key] = entry
+	}
+	return rows.Err()
+}
+
+// Add adds a given transaction group and it's associated group context to the cache, and schedules it to be persisted.
+func (c *PersistentVerifiedTransactionCache) Add(txgroup []transactions.SignedTxn, groupCtx *GroupContext) {
+	c.verifiedTransactionCache.Add(txgroup, groupCtx)
+	c.persistedLock.Lock()
+	defer c.persistedLock.Unlock()
+	c.addPending(txgroup, groupCtx)
+}
+
+// AddPayset works in a similar way to Add, but is intended for adding an array of transaction groups, along with their corresponding contexts.
+func (c *PersistentVerifiedTransactionCache) AddPayset(txgroup [][]transactions.SignedTxn, groupCtxs []*GroupContext) error {
+	err := c.verifiedTransactionCache.AddPayset(txgroup, groupCtxs)
+	c.persistedLock.Lock()
+	defer c.persistedLock.Unlock()
+	for i := range txgroup {
+		c.addPending(txgroup[i], groupCtxs[i])
+	}
+	return err
+}
+
+func (c *PersistentVerifiedTransactionCache) addPending(txgroup []transactions.SignedTxn, groupCtx *GroupContext) {
+	if len(c.pending)+len(txgroup) > c.cacheSize {
+		// the transactions are added faster than blocks are; the transactions that don't fit are only kept in memory.
+		return
+	}
+	for i := range txgroup {
+		c.pending[makePersistedTxnKey(&txgroup[i])] = persistedTxnEntry{
+			lastValid:        txgroup[i].Txn.LastValid,
+			specAddrs:        groupCtx.specAddrs,
+			consensusVersion: groupCtx.consensusVersion,
+			minAvmVersion:    groupCtx.minAvmVersion,
+		}
+	}
+}
+
+// GetUnverifiedTransactionGroups compares the provided payset against the cached and persisted transactions and figure which transaction groups
+// aren't fully cached. The groups found among the persisted transactions are added back to the cache, so that they could be pinned.
+func (c *PersistentVerifiedTransactionCache) GetUnverifiedTransactionGroups(txnGroups [][]transactions.SignedTxn, currSpecAddrs transactions.SpecialAddresses, currProto protocol.ConsensusVersion) (unverifiedGroups [][]transactions.SignedTxn) {
+	unverifiedGroups = c.verifiedTransactionCache.GetUnverifiedTransactionGroups(txnGroups, currSpecAddrs, currProto)
+	if len(unverifiedGroups) == 0 {
+		return
+	}
+
+	c.persistedLock.Lock()
+	if len(c.persisted) == 0 {
+		c.persistedLock.Unlock()
+		return
+	}
+	var persistedGroups [][]transactions.SignedTxn
+	remaining := unverifiedGroups[:0]
+	for _, signedTxnGroup := range unverifiedGroups {
+		if c.isPersisted(signedTxnGroup, currSpecAddrs, currProto) {
+			persistedGroups = append(persistedGroups, signedTxnGroup)
+		} else {
+			remaining = append(remaining, signedTxnGroup)
+		}
+	}
+	c.persistedLock.Unlock()
+
+	verifiedTxnCachePersistedHits.AddUint64(uint64(len(persistedGroups)), nil)
+	for _, signedTxnGroup := range persistedGroups {
+		c.verifiedTransactionCache.Add(signedTxnGroup, &GroupContext{
+			specAddrs:        currSpecAddrs,
+			consensusVersion: currProto,
+			consensusParams:  config.Consensus[currProto],
+			minAvmVersion:    logic.ComputeMinAvmVersion(transactions.WrapSignedTxnsWithAD(signedTxnGroup)),
+			signedGroupTxns:  signedTxnGroup,
+		})
+	}
+	return remaining
+}
+
+// isPersisted returns true if all the transactions of the group were persisted with the given verification context
+func (c *PersistentVerifiedTransactionCache) isPersisted(signedTxnGroup []transactions.SignedTxn, currSpecAddrs transactions.SpecialAddresses, currProto protocol.ConsensusVersion) bool {
+	if len(signedTxnGroup) == 0 {
+		return false
+	}
+	minAvmVersion := logic.ComputeMinAvmVersion(transactions.WrapSignedTxnsWithAD(signedTxnGroup))
+	for i := range signedTxnGroup {
+		entry, has := c.persisted[makePersistedTxnKey(&signedTxnGroup[i])]
+		if !has || entry.specAddrs != currSpecAddrs || entry.consensusVersion != currProto || entry.minAvmVersion != minAvmVersion {
+			return false
+		}
+	}
+	return true
+}
+
+// OnNewBlock writes the transactions added to the cache since the previous block into the database, and deletes
+// the transactions that can no longer be included in a block.
+func (c *PersistentVerifiedTransactionCache) OnNewBlock(block bookkeeping.Block, delta ledgercore.StateDelta) {
+	c.persistedLock.Lock()
+	pending := c.pending
+	c.pending = make(map[persistedTxnKey]persistedTxnEntry, len(pending))
+	c.latest = block.Round()
+	for key, entry := range c.persisted {
+		if entry.lastValid < c.latest {
+			delete(c.persisted, key)
+		}
+	}
+	c.persistedLock.Unlock()
+
+	err := c.persist(pending, block.Round())
+	if err != nil {
+		c.log.Warnf("PersistentVerifiedTransactionCache.OnNewBlock(%d): unable to persist the verified transactions: %v", block.Round(), err)
+	}
+}
+
+// persist writes the pending transactions into the database, and deletes the transactions that expired before rnd.
+// The oldest transactions are deleted as well, so that no more than the size of the cache are kept.
+func (c *PersistentVerifiedTransactionCache) persist(pending map[persistedTxnKey]persistedTxnEntry, rnd basics.Round) error {
+	return c.dbs.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		insertStmt, err := tx.PrepareContext(ctx, "INSERT OR REPLACE INTO verifiedtxns (txid, authhash, lastvalid, feesink, rewardspool, proto, minavmversion) VALUES (?, ?, ?, ?, ?, ?, ?)")
+		if err != nil {
+			return err
+		}
+		defer insertStmt.Close()
+		for key, entry := range pending {
+			if entry.lastValid < rnd {
+				continue
+			}
+			_, err = insertStmt.ExecContext(ctx, key.txid[:], key.authHash[:], entry.lastValid, entry.specAddrs.FeeSink[:], entry.specAddrs.RewardsPool[:], entry.consensusVersion, entry.minAvmVersion)
+			if err != nil {
+				return err
+			}
+		}
+		_, err = tx.ExecContext(ctx, "DELETE FROM verifiedtxns WHERE lastvalid < ?", rnd)
+		if err != nil {
+			return err
+		}
+		_, err = tx.ExecContext(ctx, "DELETE FROM verifiedtxns WHERE rowid NOT IN (SELECT rowid FROM verifiedtxns ORDER BY rowid DESC LIMIT ?)", c.cacheSize)
+		return err
+	})
+}
+
+// Close writes the pending transactions into the database and closes it.
+func (c *PersistentVerifiedTransactionCache) Close() {
+	c.persistedLock.Lock()
+	pending := c.pending
+	c.pending = make(map[persistedTxnKey]persistedTxnEntry)
+	latest := c.latest
+	c.persistedLock.Unlock()
+
+	err := c.persist(pending, latest)
+	if err != nil {
+		c.log.Warnf("PersistentVerifiedTransactionCache.Close: unable to persist the verified transactions: %v", err)
+	}
+	c.dbs.Close()
+}
+
+func makePersistedTxnKey(stxn *transactions.SignedTxn) persistedTxnKey {
+	auth := transactions.SignedTxn{
+		Sig:      stxn.Sig,
+		Msig:     stxn.Msig,
+		Lsig:     stxn.Lsig,
+		AuthAddr: stxn.AuthAddr,
+	}
+	return persistedTxnKey{
+		txid:     stxn.ID(),
+		authHash: crypto.Hash(protocol.Encode(&auth)),
+	}
+}
@@ -0,0 +1,127 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package verify
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func makeTestBlock(rnd basics.Round) bookkeeping.Block {
+	return bookkeeping.Block{BlockHeader: bookkeeping.BlockHeader{Round: rnd}}
+}
+
+func TestPersistentVerifiedTransactionCache(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	dbFilename := filepath.Join(t.TempDir(), "verifiedtxns.sqlite")
+	log := logging.TestingLog(t)
+	cache, err := MakePersistentVerifiedTransactionCache(1000, dbFilename, log)
+	require.NoError(t, err)
+
+	// the transactions are valid up to round 50-80
+	_, signedTxn, secrets, addrs := generateTestObjects(200, 20, 50)
+	txnGroups := generateTransactionGroups(signedTxn, secrets, addrs)
+	require.Greater(t, len(txnGroups), 2)
+	persistedGroups := txnGroups[:len(txnGroups)-1]
+	unverifiedGroup := txnGroups[len(txnGroups)-1]
+
+	for _, txnGroup := range persistedGroups {
+		groupCtx, err := PrepareGroupContext(txnGroup, blockHeader, nil)
+		require.NoError(t, err)
+		cache.Add(txnGroup, groupCtx)
+	}
+	require.Len(t, cache.GetUnverifiedTransactionGroups(txnGroups, spec, protocol.ConsensusCurrentVersion), 1)
+
+	// the transactions are written once a block is added
+	cache.OnNewBlock(makeTestBlock(40), ledgercore.StateDelta{})
+	cache.Close()
+
+	cache, err = MakePersistentVerifiedTransactionCache(1000, dbFilename, log)
+	require.NoError(t, err)
+	require.Len(t, cache.persisted, len(signedTxn)-len(unverifiedGroup))
+
+	// the persisted transactions are only valid in the same verification context
+	require.Len(t, cache.GetUnverifiedTransactionGroups(txnGroups, spec, protocol.ConsensusV30), len(txnGroups))
+
+	initHits := verifiedTxnCachePersistedHits.GetUint64Value()
+	unverifiedGroups := cache.GetUnverifiedTransactionGroups(txnGroups, spec, protocol.ConsensusCurrentVersion)
+	require.Equal(t, [][]transactions.SignedTxn{unverifiedGroup}, unverifiedGroups)
+	require.Equal(t, uint64(len(persistedGroups)), verifiedTxnCachePersistedHits.GetUint64Value()-initHits)
+
+	// the groups found among the persisted transactions were added back to the cache, and can be pinned
+	for _, txnGroup := range persistedGroups {
+		require.NoError(t, cache.Pin(txnGroup))
+	}
+
+	// a transaction authorized differently is not verified
+	reauthorizedGroup := append([]transactions.SignedTxn(nil), txnGroups[0]...)
+	reauthorizedGroup[0].Sig[0]++
+	unverifiedGroups = cache.GetUnverifiedTransactionGroups([][]transactions.SignedTxn{reauthorizedGroup}, spec, protocol.ConsensusCurrentVersion)
+	require.Len(t, unverifiedGroups, 1)
+
+	// the transactions are expired once their last valid round has passed
+	cache.OnNewBlock(makeTestBlock(100), ledgercore.StateDelta{})
+	require.Empty(t, cache.persisted)
+	cache.Close()
+
+	cache, err = MakePersistentVerifiedTransactionCache(1000, dbFilename, log)
+	require.NoError(t, err)
+	defer cache.Close()
+	require.Empty(t, cache.persisted)
+	require.Len(t, cache.GetUnverifiedTransactionGroups(txnGroups, spec, protocol.ConsensusCurrentVersion), len(txnGroups))
+}
+
+func TestPersistentVerifiedTransactionCacheSize(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	dbFilename := filepath.Join(t.TempDir(), "verifiedtxns.sqlite")
+	log := logging.TestingLog(t)
+	const cacheSize = 100
+	cache, err := MakePersistentVerifiedTransactionCache(cacheSize, dbFilename, log)
+	require.NoError(t, err)
+
+	_, signedTxn, _, _ := generateTestObjects(3*cacheSize, 20, 50)
+	groupCtx, err := PrepareGroupContext(signedTxn[:1], blockHeader, nil)
+	require.NoError(t, err)
+	for rnd := 0; rnd < 3; rnd++ {
+		for i := rnd * cacheSize; i < (rnd+1)*cacheSize; i++ {
+			cache.Add(signedTxn[i:i+1], groupCtx)
+		}
+		cache.OnNewBlock(makeTestBlock(basics.Round(40+rnd)), ledgercore.StateDelta{})
+	}
+	cache.Close()
+
+	// only the most recently verified transactions are kept
+	cache, err = MakePersistentVerifiedTransactionCache(cacheSize, dbFilename, log)
+	require.NoError(t, err)
+	defer cache.Close()
+	require.Len(t, cache.persisted, cacheSize)
+	for i := 2 * cacheSize; i < 3*cacheSize; i++ {
+		require.Contains(t, cache.persisted, makePersistedTxnKey(&signedTxn[i]))
+	}
+}
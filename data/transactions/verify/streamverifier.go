@@ -0,0 +1,255 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package verify
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/util/execpool"
+)
+
+// waitForNextTxnDuration is the longest time the StreamVerifier waits, from the first group of a batch, for
+// more transaction groups to join the batch before verifying the groups it has accumulated so far.
+const waitForNextTxnDuration = 2 * time.Millisecond
+
+// streamVerifierBatchSize is the number of signatures that makes the StreamVerifier verify the groups it
+// has accumulated without waiting for more. Like the PaysetGroups worksets, such a batch takes about 2ms.
+const streamVerifierBatchSize = txnPerWorksetThreshold
+
+// streamVerifierMaxBatchGroups is the number of groups that makes the StreamVerifier verify the groups it
+// has accumulated without waiting for more, whatever their number of signatures: groups of escrow logicsigs
+// have no signature at all.
+const streamVerifierMaxBatchGroups = txnPerWorksetThreshold
+
+// UnverifiedElement is a transaction group submitted to the StreamVerifier, along with an opaque
+// message that is handed back with its verification result.
+type UnverifiedElement struct {
+	TxnGroup       []transactions.SignedTxn
+	BacklogMessage interface{}
+}
+
+// VerificationResult is the verification result of a transaction group submitted to the StreamVerifier.
+// Err is nil when the group is valid.
+type VerificationResult struct {
+	TxnGroup       []transactions.SignedTxn
+	BacklogMessage interface{}
+	Err            error
+}
+
+// LedgerForStreamVerifier defines the ledger methods used by the StreamVerifier, which verifies the
+// transaction groups in the context of the latest block.
+type LedgerForStreamVerifier interface {
+	logic.LedgerForSignature
+	Latest() basics.Round
+	BlockHdr(rnd basics.Round) (bookkeeping.BlockHeader, error)
+}
+
+// StreamVerifier verifies the transaction groups it receives, accumulating the signatures of consecutive
+// groups into a single batch until either streamVerifierBatchSize signatures or streamVerifierMaxBatchGroups
+// groups were collected, or waitForNextTxnDuration passed since the first group of the batch arrived. A batch
+// is verified on the verification pool, and the result of each of its groups is written to the results channel.
+type StreamVerifier struct {
+	stxnChan         <-chan *UnverifiedElement
+	resultChan       chan<- *VerificationResult
+	ledger           LedgerForStreamVerifier
+	verificationPool execpool.BacklogPool
+	cache            VerifiedTransactionCache
+	activeLoopWg     sync.WaitGroup
+}
+
+// MakeStreamVerifier creates a StreamVerifier that verifies the groups received from stxnChan, and writes
+// their results into resultChan. The valid groups are added to the cache, when one is provided.
+func MakeStreamVerifier(stxnChan <-chan *UnverifiedElement, resultChan chan<- *VerificationResult, ledger LedgerForStreamVerifier, verificationPool execpool.BacklogPool, cache VerifiedTransactionCache) *StreamVerifier {
+	return &StreamVerifier{
+		stxnChan:         stxnChan,
+		resultChan:       resultChan,
+		ledger:           ledger,
+		verificationPool: verificationPool,
+		cache:            cache,
+	}
+}
+
+// Start starts the StreamVerifier. It stops once ctx is canceled, or once stxnChan is closed and the
+// groups it had received were verified.
+func (sv *StreamVerifier) Start(ctx context.Context) {
+	sv.activeLoopWg.Add(1)
+	go sv.batchingLoop(ctx)
+}
+
+// WaitForStop waits until the StreamVerifier and the verification tasks it enqueued have stopped.
+func (sv *StreamVerifier) WaitForStop() {
+	sv.activeLoopWg.Wait()
+}
+
+func (sv *StreamVerifier) batchingLoop(ctx context.Context) {
+	defer sv.activeLoopWg.Done()
+	timer := time.NewTimer(waitForNextTxnDuration)
+	if !timer.Stop() {
+		<-timer.C
+	}
+	defer timer.Stop()
+
+	var elems []*UnverifiedElement
+	numSigs := 0
+	flush := func() {
+		if len(elems) > 0 {
+			sv.enqueueVerificationTask(ctx, elems)
+		}
+		elems = nil
+		numSigs = 0
+	}
+	for {
+		select {
+		case elem, ok := <-sv.stxnChan:
+			if !ok {
+				flush()
+				return
+			}
+			if len(elems) == 0 {
+				// the batch is verified at the latest once its first group waited for a while
+				timer.Reset(waitForNextTxnDuration)
+			}
+			elems = append(elems, elem)
+			numSigs += groupSignaturesCount(elem.TxnGroup)
+			if numSigs >= streamVerifierBatchSize || len(elems) >= streamVerifierMaxBatchGroups {
+				if !timer.Stop() {
+					<-timer.C
+				}
+				flush()
+			}
+		case <-timer.C:
+			flush()
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (sv *StreamVerifier) enqueueVerificationTask(ctx context.Context, elems []*UnverifiedElement) {
+	sv.activeLoopWg.Add(1)
+	err := sv.verificationPool.EnqueueBacklog(ctx, func(arg interface{}) interface{} {
+		defer sv.activeLoopWg.Done()
+		sv.verifyElements(ctx, arg.([]*UnverifiedElement))
+		return nil
+	}, elems, nil)
+	if err != nil {
+		// the context was canceled before the task could be enqueued
+		sv.activeLoopWg.Done()
+	}
+}
+
+// verifyElements verifies the given transaction groups using a single batch verifier, and reports their results.
+// The logicsig programs of a group are only executed once its signatures were verified.
+func (sv *StreamVerifier) verifyElements(ctx context.Context, elems []*UnverifiedElement) {
+	latest := sv.ledger.Latest()
+	latestHdr, err := sv.ledger.BlockHdr(latest)
+	if err != nil {
+		err = fmt.Errorf("could not get header for previous block %d: %w", latest, err)
+		for _, elem := range elems {
+			sv.sendResult(ctx, elem, err)
+		}
+		return
+	}
+
+	type preparedGroup struct {
+		elem     *UnverifiedElement
+		groupCtx *GroupContext
+		// the signatures of the group are the [firstSig, lastSig) entries of the batch
+		firstSig, lastSig int
+	}
+	prepared := make([]preparedGroup, 0, len(elems))
+	batchVerifier := crypto.MakeBatchVerifierWithHint(streamVerifierBatchSize)
+	for _, elem := range elems {
+		firstSig := batchVerifier.GetNumberOfEnqueuedSignatures()
+		groupCtx, err := txnGroupBatchPrep(elem.TxnGroup, latestHdr, sv.ledger, batchVerifier)
+		if err != nil {
+			// the signatures this group might have enqueued are verified along with the others, but do not
+			// belong to any of the prepared groups.
+			sv.sendResult(ctx, elem, err)
+			continue
+		}
+		prepared = append(prepared, preparedGroup{elem: elem, groupCtx: groupCtx, firstSig: firstSig, lastSig: batchVerifier.GetNumberOfEnqueuedSignatures()})
+	}
+
+	failed, err := batchVerifier.VerifyWithFeedback()
+	for _, group := range prepared {
+		var groupErr error
+		if err != nil {
+			for i := group.firstSig; i < group.lastSig; i++ {
+				if failed[i] {
+					groupErr = err
+					break
+				}
+			}
+		}
+		if groupErr != nil {
+			groupErr = &TxGroupError{err: groupErr, Reason: TxGroupErrorReasonSigNotWellFormed}
+		} else {
+			groupErr = txnGroupLogicSigs(group.elem.TxnGroup, group.groupCtx)
+		}
+		if groupErr == nil && sv.cache != nil {
+			sv.cache.Add(group.elem.TxnGroup, group.groupCtx)
+		}
+		sv.sendResult(ctx, group.elem, groupErr)
+	}
+}
+
+func (sv *StreamVerifier) sendResult(ctx context.Context, elem *UnverifiedElement, err error) {
+	result := &VerificationResult{
+		TxnGroup:       elem.TxnGroup,
+		BacklogMessage: elem.BacklogMessage,
+		Err:            err,
+	}
+	select {
+	case sv.resultChan <- result:
+	case <-ctx.Done():
+	}
+}
+
+// groupSignaturesCount returns the number of signatures the verification of a transaction group enqueues
+func groupSignaturesCount(stxs []transactions.SignedTxn) (count int) {
+	msigCount := func(msig crypto.MultisigSig) (n int) {
+		for _, subsig := range msig.Subsigs {
+			if (subsig.Sig != crypto.Signature{}) {
+				n++
+			}
+		}
+		return
+	}
+	for i := range stxs {
+		stxn := &stxs[i]
+		switch {
+		case stxn.Sig != (crypto.Signature{}):
+			count++
+		case !stxn.Msig.Blank():
+			count += msigCount(stxn.Msig)
+		case stxn.Lsig.Sig != (crypto.Signature{}):
+			count++
+		default:
+			count += msigCount(stxn.Lsig.Msig)
+		}
+	}
+	return
+}
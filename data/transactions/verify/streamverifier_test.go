@@ -0,0 +1,262 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package verify
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+	"github.com/algorand/go-algorand/util/execpool"
+)
+
+type dummyLedgerForStreamVerifier struct {
+	DummyLedgerForSignature
+	hdrErr error
+}
+
+func (d *dummyLedgerForStreamVerifier) Latest() basics.Round {
+	return 50
+}
+
+func (d *dummyLedgerForStreamVerifier) BlockHdr(rnd basics.Round) (bookkeeping.BlockHeader, error) {
+	if d.hdrErr != nil {
+		return bookkeeping.BlockHeader{}, d.hdrErr
+	}
+	return createDummyBlockHeader(), nil
+}
+
+// streamVerify submits the transaction groups to a StreamVerifier, and returns their verification errors
+func streamVerify(t *testing.T, txnGroups [][]transactions.SignedTxn, ledger LedgerForStreamVerifier, cache VerifiedTransactionCache) []error {
+	execPool := execpool.MakePool(t)
+	verificationPool := execpool.MakeBacklog(execPool, 64, execpool.LowPriority, t)
+	defer verificationPool.Shutdown()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stxnChan := make(chan *UnverifiedElement)
+	resultChan := make(chan *VerificationResult, len(txnGroups))
+	sv := MakeStreamVerifier(stxnChan, resultChan, ledger, verificationPool, cache)
+	sv.Start(ctx)
+
+	for i, txnGroup := range txnGroups {
+		stxnChan <- &UnverifiedElement{TxnGroup: txnGroup, BacklogMessage: i}
+	}
+	close(stxnChan)
+
+	errs := make([]error, len(txnGroups))
+	for range txnGroups {
+		select {
+		case result := <-resultChan:
+			i := result.BacklogMessage.(int)
+			require.Equal(t, txnGroups[i], result.TxnGroup)
+			errs[i] = result.Err
+		case <-time.After(10 * time.Second):
+			require.Fail(t, "timed out waiting for the verification results")
+		}
+	}
+	sv.WaitForStop()
+	require.Empty(t, resultChan)
+	return errs
+}
+
+func TestStreamVerifier(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	_, signedTxn, secrets, addrs := generateTestObjects(1000, 20, 50)
+	txnGroups := generateTransactionGroups(signedTxn, secrets, addrs)
+	require.Greater(t, len(txnGroups), 10)
+
+	// break the signature of a few groups, and make another one fail the checks done before the batch verification
+	badSigGroups := map[int]bool{1: true, 5: true, len(txnGroups) - 1: true}
+	for i := range badSigGroups {
+		txnGroups[i][len(txnGroups[i])-1].Sig[0]++
+	}
+	malformedGroup := 7
+	txnGroups[malformedGroup][0].Msig.Subsigs = append(txnGroups[malformedGroup][0].Msig.Subsigs, crypto.MultisigSubsig{})
+
+	cache := MakeVerifiedTransactionCache(5000)
+	errs := streamVerify(t, txnGroups, &dummyLedgerForStreamVerifier{}, cache)
+	for i, err := range errs {
+		switch {
+		case badSigGroups[i]:
+			require.ErrorIs(t, err, crypto.ErrBatchVerificationFailed, "group %d", i)
+		case i == malformedGroup:
+			require.Error(t, err)
+			require.Contains(t, err.Error(), "should only have one of Sig or Msig")
+		default:
+			require.NoError(t, err, "group %d", i)
+		}
+	}
+
+	// only the valid groups were added to the cache
+	unverifiedGroups := cache.GetUnverifiedTransactionGroups(txnGroups, spec, protocol.ConsensusCurrentVersion)
+	require.Len(t, unverifiedGroups, len(badSigGroups)+1)
+}
+
+func TestStreamVerifierMultiSig(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	_, signedTxn, _, _ := generateMultiSigTxn(100, 30, 50, t)
+	txnGroups := make([][]transactions.SignedTxn, len(signedTxn))
+	for i := range txnGroups {
+		txnGroups[i] = []transactions.SignedTxn{signedTxn[i]}
+	}
+	txnGroups[3][0].Msig.Subsigs[1].Sig[0]++
+
+	errs := streamVerify(t, txnGroups, &dummyLedgerForStreamVerifier{}, nil)
+	for i, err := range errs {
+		if i == 3 {
+			require.ErrorIs(t, err, crypto.ErrBatchVerificationFailed)
+		} else {
+			require.NoError(t, err, "group %d", i)
+		}
+	}
+}
+
+func TestStreamVerifierLogicSig(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	_, signedTxn, secrets, addrs := generateTestObjects(100, 20, 50)
+	ops, err := logic.AssembleString(`arg 0
+byte "ok"
+==`)
+	require.NoError(t, err)
+	program := logic.Program(ops.Program)
+	txnGroups := make([][]transactions.SignedTxn, len(signedTxn))
+	for i := range txnGroups {
+		s := i % len(secrets)
+		signedTxn[i].Sig = crypto.Signature{}
+		signedTxn[i].Txn.Sender = addrs[s]
+		signedTxn[i].Lsig.Logic = ops.Program
+		signedTxn[i].Lsig.Args = [][]byte{[]byte("ok")}
+		signedTxn[i].Lsig.Sig = secrets[s].Sign(program)
+		txnGroups[i] = []transactions.SignedTxn{signedTxn[i]}
+	}
+	// a bad signature fails before the logic is evaluated, and the logic of a good signature is evaluated
+	badSigGroup, badLogicGroup := 3, 8
+	txnGroups[badSigGroup][0].Lsig.Sig[0]++
+	txnGroups[badSigGroup][0].Lsig.Args = [][]byte{[]byte("bad")}
+	txnGroups[badLogicGroup][0].Lsig.Args = [][]byte{[]byte("bad")}
+
+	initCounter := logicRejTotal.GetUint64Value()
+	errs := streamVerify(t, txnGroups, &dummyLedgerForStreamVerifier{}, nil)
+	for i, err := range errs {
+		var txGroupErr *TxGroupError
+		switch i {
+		case badSigGroup:
+			require.ErrorIs(t, err, crypto.ErrBatchVerificationFailed)
+			require.ErrorAs(t, err, &txGroupErr)
+			require.Equal(t, TxGroupErrorReasonSigNotWellFormed, txGroupErr.Reason)
+		case badLogicGroup:
+			require.ErrorAs(t, err, &txGroupErr)
+			require.Equal(t, TxGroupErrorReasonLogicSigFailed, txGroupErr.Reason)
+			require.Contains(t, err.Error(), "rejected by logic")
+		default:
+			require.NoError(t, err, "group %d", i)
+		}
+	}
+	require.Equal(t, initCounter+1, logicRejTotal.GetUint64Value())
+}
+
+// TestStreamVerifierEscrowStream checks that a steady stream of groups without signatures, such as escrow logicsig
+// groups, is verified as it arrives rather than once it stops.
+func TestStreamVerifierEscrowStream(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	ops, err := logic.AssembleString("int 1")
+	require.NoError(t, err)
+	escrow := basics.Address(logic.HashProgram(ops.Program))
+	_, signedTxn, _, _ := generateTestObjects(1, 1, 50)
+	group := []transactions.SignedTxn{signedTxn[0]}
+	group[0].Sig = crypto.Signature{}
+	group[0].Txn.Sender = escrow
+	group[0].Lsig.Logic = ops.Program
+	require.Zero(t, groupSignaturesCount(group))
+
+	execPool := execpool.MakePool(t)
+	verificationPool := execpool.MakeBacklog(execPool, 64, execpool.LowPriority, t)
+	defer verificationPool.Shutdown()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stxnChan := make(chan *UnverifiedElement)
+	resultChan := make(chan *VerificationResult, 10*streamVerifierMaxBatchGroups)
+	sv := MakeStreamVerifier(stxnChan, resultChan, &dummyLedgerForStreamVerifier{}, verificationPool, nil)
+	sv.Start(ctx)
+	defer sv.WaitForStop()
+	defer cancel()
+
+	// keep sending groups, without any pause, until the first results arrive. The batches are verified
+	// while the groups keep coming, so sending stalls at the latest once the verification backlog is full.
+	deadline := time.After(10 * time.Second)
+	sent := 0
+	for {
+		select {
+		case result := <-resultChan:
+			require.NoError(t, result.Err)
+			require.Less(t, sent, 1000*streamVerifierMaxBatchGroups)
+			return
+		case stxnChan <- &UnverifiedElement{TxnGroup: group}:
+			sent++
+		case <-deadline:
+			require.Fail(t, "timed out waiting for the verification results")
+			return
+		}
+	}
+}
+
+func TestStreamVerifierBlockHeaderError(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	_, signedTxn, secrets, addrs := generateTestObjects(100, 20, 50)
+	txnGroups := generateTransactionGroups(signedTxn, secrets, addrs)
+
+	hdrErr := errors.New("no such block")
+	errs := streamVerify(t, txnGroups, &dummyLedgerForStreamVerifier{hdrErr: hdrErr}, nil)
+	for _, err := range errs {
+		require.ErrorIs(t, err, hdrErr)
+	}
+}
+
+func TestGroupSignaturesCount(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	_, signedTxn, _, _ := generateTestObjects(2, 2, 50)
+	_, multiSigTxn, _, _ := generateMultiSigTxn(1, 3, 50, t)
+	lsigTxn := transactions.SignedTxn{Lsig: transactions.LogicSig{Logic: []byte{1}}}
+	lsigWithSigTxn := transactions.SignedTxn{Lsig: transactions.LogicSig{Logic: []byte{1}, Sig: signedTxn[0].Sig}}
+	lsigWithMsigTxn := transactions.SignedTxn{Lsig: transactions.LogicSig{Logic: []byte{1}, Msig: multiSigTxn[0].Msig}}
+
+	require.Equal(t, 2, groupSignaturesCount(signedTxn))
+	// generateMultiSigTxn makes 2 out of 3 multisig accounts
+	require.Equal(t, 2, groupSignaturesCount(multiSigTxn))
+	require.Equal(t, 0, groupSignaturesCount([]transactions.SignedTxn{lsigTxn}))
+	require.Equal(t, 4, groupSignaturesCount([]transactions.SignedTxn{lsigTxn, lsigWithSigTxn, lsigWithMsigTxn, signedTxn[1]}))
+}
@@ -154,6 +154,7 @@ func (g *GroupContext) Equal(other *GroupContext) bool {
 
 // txnBatchPrep verifies a SignedTxn having no obviously inconsistent data.
 // Block-assembly time checks of LogicSig and accounting rules may still block the txn.
+// The logicsig programs are not executed.
 // It is the caller responsibility to call batchVerifier.Verify().
 func txnBatchPrep(s *transactions.SignedTxn, txnIdx int, groupCtx *GroupContext, verifier *crypto.BatchVerifier) *TxGroupError {
 	if !groupCtx.consensusParams.SupportRekeying && (s.AuthAddr != basics.Address{}) {
@@ -176,6 +177,10 @@ func TxnGroup(stxs []transactions.SignedTxn, contextHdr bookkeeping.BlockHeader,
 	}
 
 	if err := batchVerifier.Verify(); err != nil {
+		return nil, &TxGroupError{err: err, Reason: TxGroupErrorReasonSigNotWellFormed}
+	}
+
+	if err := txnGroupLogicSigs(stxs, groupCtx); err != nil {
 		return nil, err
 	}
 
@@ -187,7 +192,8 @@ func TxnGroup(stxs []transactions.SignedTxn, contextHdr bookkeeping.BlockHeader,
 }
 
 // txnGroupBatchPrep verifies a []SignedTxn having no obviously inconsistent data.
-// it is the caller responsibility to call batchVerifier.Verify()
+// it is the caller responsibility to call batchVerifier.Verify(), and then
+// txnGroupLogicSigs() to execute the logicsig programs of the group.
 func txnGroupBatchPrep(stxs []transactions.SignedTxn, contextHdr bookkeeping.BlockHeader, ledger logic.LedgerForSignature, verifier *crypto.BatchVerifier) (*GroupContext, error) {
 	groupCtx, err := PrepareGroupContext(stxs, contextHdr, ledger)
 	if err != nil {
@@ -229,6 +235,21 @@ func txnGroupBatchPrep(stxs []transactions.SignedTxn, contextHdr bookkeeping.Blo
 	return groupCtx, nil
 }
 
+// txnGroupLogicSigs executes the logicsig programs of a group prepared by txnGroupBatchPrep. It must only be called
+// once the signatures of the group were verified, so that the program budget isn't spent on bad signatures.
+func txnGroupLogicSigs(stxs []transactions.SignedTxn, groupCtx *GroupContext) error {
+	for i := range stxs {
+		if stxs[i].Lsig.Blank() {
+			continue
+		}
+		if err := logicSigVerify(&stxs[i], i, groupCtx); err != nil {
+			err = fmt.Errorf("transaction %+v invalid : %w", stxs[i], err)
+			return &TxGroupError{err: err, Reason: TxGroupErrorReasonLogicSigFailed}
+		}
+	}
+	return nil
+}
+
 // stxnCoreChecks runs signatures validity checks and enqueues signature into batchVerifier for verification.
 func stxnCoreChecks(s *transactions.SignedTxn, txnIdx int, groupCtx *GroupContext, batchVerifier *crypto.BatchVerifier) *TxGroupError {
 	numSigs := 0
@@ -285,7 +306,7 @@ func stxnCoreChecks(s *transactions.SignedTxn, txnIdx int, groupCtx *GroupContex
 		return nil
 	}
 	if hasLogicSig {
-		if err := logicSigVerify(s, txnIdx, groupCtx); err != nil {
+		if err := logicSigSanityCheckBatchPrep(s, txnIdx, groupCtx, batchVerifier); err != nil {
 			return &TxGroupError{err: err, Reason: TxGroupErrorReasonLogicSigFailed}
 		}
 		return nil
@@ -389,13 +410,9 @@ func logicSigSanityCheckBatchPrep(txn *transactions.SignedTxn, groupIndex int, g
 	return nil
 }
 
-// logicSigVerify checks that the signature is valid, executing the program.
+// logicSigVerify executes the program. The logicsig signature must have been verified beforehand, so that the program
+// budget isn't spent on transactions with bad signatures.
 func logicSigVerify(txn *transactions.SignedTxn, groupIndex int, groupCtx *GroupContext) error {
-	err := LogicSigSanityCheck(txn, groupIndex, groupCtx)
-	if err != nil {
-		return err
-	}
-
 	if groupIndex < 0 {
 		return errors.New("negative groupIndex")
 	}
@@ -473,6 +490,11 @@ func PaysetGroups(ctx context.Context, payset [][]transactions.SignedTxn, blkHea
 					if verifyErr != nil {
 						return verifyErr
 					}
+					for i, signTxnsGrp := range txnGroups {
+						if grpErr = txnGroupLogicSigs(signTxnsGrp, groupCtxs[i]); grpErr != nil {
+							return grpErr
+						}
+					}
 					cache.AddPayset(txnGroups, groupCtxs)
 					return nil
 				}, nextWorkset, worksDoneCh)
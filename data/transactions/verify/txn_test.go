@@ -58,7 +58,13 @@ func verifyTxn(s *transactions.SignedTxn, txnIdx int, groupCtx *GroupContext) er
 	if err := txnBatchPrep(s, txnIdx, groupCtx, batchVerifier); err != nil {
 		return err
 	}
-	return batchVerifier.Verify()
+	if err := batchVerifier.Verify(); err != nil {
+		return err
+	}
+	if !s.Lsig.Blank() {
+		return logicSigVerify(s, txnIdx, groupCtx)
+	}
+	return nil
 }
 
 type DummyLedgerForSignature struct {
@@ -685,6 +691,18 @@ byte base64 5rZMNsevs5sULO+54aN+OvU6lQ503z2X+SSYUABIx7E=
 	}
 	verifyGroup(t, txnGroups, blkHdr, breakSignatureFunc, restoreSignatureFunc, crypto.ErrBatchVerificationFailed.Error())
 
+	// the logic isn't evaluated when the signature is bad
+	badGroup := []transactions.SignedTxn{txnGroups[0][0]}
+	badGroup[0].Lsig.Sig[0]++
+	badGroup[0].Lsig.Args = [][]byte{[]byte("bad")}
+	initCounter := logicRejTotal.GetUint64Value()
+	_, err := TxnGroup(badGroup, blkHdr, nil, &DummyLedgerForSignature{})
+	require.ErrorIs(t, err, crypto.ErrBatchVerificationFailed)
+	var txGroupErr *TxGroupError
+	require.ErrorAs(t, err, &txGroupErr)
+	require.Equal(t, TxGroupErrorReasonSigNotWellFormed, txGroupErr.Reason)
+	require.Equal(t, initCounter, logicRejTotal.GetUint64Value())
+
 	// signature is correct and logic fails
 	breakSignatureFunc = func(txn *transactions.SignedTxn) {
 		txn.Lsig.Args[0][0]++
@@ -24,10 +24,15 @@ import (
 	"github.com/algorand/go-algorand/data/transactions"
 	"github.com/algorand/go-algorand/data/transactions/logic"
 	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/util/metrics"
 )
 
 const maxPinnedEntries = 500000
 
+var verifiedTxnCacheHits = metrics.MakeCounter(metrics.MetricName{Name: "algod_verified_txn_cache_hits", Description: "Number of transaction groups found in the verified transactions cache"})
+var verifiedTxnCacheMisses = metrics.MakeCounter(metrics.MetricName{Name: "algod_verified_txn_cache_misses", Description: "Number of transaction groups not found in the verified transactions cache"})
+var verifiedTxnCachePersistedHits = metrics.MakeCounter(metrics.MetricName{Name: "algod_verified_txn_cache_persisted_hits", Description: "Number of transaction groups not found in the verified transactions cache, but found among the persisted ones"})
+
 // VerifiedTxnCacheError helps to identify the errors of a cache error and diffrenciate these from a general verification errors.
 type VerifiedTxnCacheError struct {
 	inner error
@@ -167,6 +172,8 @@ func (v *verifiedTransactionCache) GetUnverifiedTransactionGroups(txnGroups [][]
 			unverifiedGroups = append(unverifiedGroups, signedTxnGroup)
 		}
 	}
+	verifiedTxnCacheHits.AddUint64(uint64(len(txnGroups)-len(unverifiedGroups)), nil)
+	verifiedTxnCacheMisses.AddUint64(uint64(len(unverifiedGroups)), nil)
 	return
 }
 
@@ -121,6 +121,9 @@ type TxHandler struct {
 	cacheConfig           txHandlerConfig
 	ctx                   context.Context
 	ctxCancel             context.CancelFunc
+	streamVerifier        *verify.StreamVerifier
+	streamVerifierChan    chan *verify.UnverifiedElement
+	streamResultChan      chan *verify.VerificationResult
 }
 
 // TxHandlerOpts is TxHandler configuration options
@@ -166,6 +169,10 @@ func MakeTxHandler(opts TxHandlerOpts) *TxHandler {
 		txCanonicalCache:      makeDigestCache(2 * txBacklogSize),
 		cacheConfig:           txHandlerConfig{opts.Config.TxFilterRawMsgEnabled(), opts.Config.TxFilterCanonicalEnabled()},
 	}
+	if opts.Config.EnableTxHandlerStreamVerifier {
+		handler.streamVerifierChan = make(chan *verify.UnverifiedElement)
+		handler.streamResultChan = make(chan *verify.VerificationResult, txBacklogSize)
+	}
 	return handler
 }
 
@@ -176,6 +183,13 @@ func (handler *TxHandler) Start() {
 	handler.net.RegisterHandlers([]network.TaggedMessageHandler{
 		{Tag: protocol.TxnTag, MessageHandler: network.HandlerFunc(handler.processIncomingTxn)},
 	})
+	if handler.streamVerifierChan != nil {
+		handler.streamVerifier = verify.MakeStreamVerifier(handler.streamVerifierChan, handler.streamResultChan,
+			handler.ledger, handler.txVerificationPool, handler.ledger.VerifiedTransactionCache())
+		handler.streamVerifier.Start(handler.ctx)
+		handler.backlogWg.Add(1)
+		go handler.processTxnStreamVerifiedResults()
+	}
 	handler.backlogWg.Add(2)
 	go handler.backlogWorker()
 	go handler.backlogGaugeThread()
@@ -185,6 +199,9 @@ func (handler *TxHandler) Start() {
 func (handler *TxHandler) Stop() {
 	handler.ctxCancel()
 	handler.backlogWg.Wait()
+	if handler.streamVerifier != nil {
+		handler.streamVerifier.WaitForStop()
+	}
 }
 
 func reencode(stxns []transactions.SignedTxn) []byte {
@@ -240,6 +257,16 @@ func (handler *TxHandler) backlogWorker() {
 				continue
 			}
 
+			if handler.streamVerifierChan != nil {
+				// hand the group over to the stream verifier, which batches it with the groups that follow.
+				select {
+				case handler.streamVerifierChan <- &verify.UnverifiedElement{TxnGroup: wi.unverifiedTxGroup, BacklogMessage: wi}:
+				case <-handler.ctx.Done():
+					return
+				}
+				continue
+			}
+
 			// enqueue the task to the verification pool.
 			handler.txVerificationPool.EnqueueBacklog(handler.ctx, handler.asyncVerifySignature, wi, nil)
 
@@ -427,6 +454,27 @@ func (handler *TxHandler) asyncVerifySignature(arg interface{}) interface{} {
 		_, tx.verificationErr = verify.TxnGroup(tx.unverifiedTxGroup, latestHdr, handler.ledger.VerifiedTransactionCache(), handler.ledger)
 	}
 
+	handler.enqueuePostVerification(tx)
+	return nil
+}
+
+// processTxnStreamVerifiedResults moves the transaction groups verified by the stream verifier to the postVerificationQueue.
+func (handler *TxHandler) processTxnStreamVerifiedResults() {
+	defer handler.backlogWg.Done()
+	for {
+		select {
+		case result := <-handler.streamResultChan:
+			tx := result.BacklogMessage.(*txBacklogMsg)
+			tx.verificationErr = result.Err
+			handler.enqueuePostVerification(tx)
+		case <-handler.ctx.Done():
+			return
+		}
+	}
+}
+
+// enqueuePostVerification adds a verified transaction group to the postVerificationQueue, or drops it when the queue is full.
+func (handler *TxHandler) enqueuePostVerification(tx *txBacklogMsg) {
 	select {
 	case handler.postVerificationQueue <- tx:
 	default:
@@ -439,7 +487,6 @@ func (handler *TxHandler) asyncVerifySignature(arg interface{}) interface{} {
 		handler.deleteFromCaches(tx.rawmsgDataHash, tx.unverifiedTxGroupHash)
 
 	}
-	return nil
 }
 
 func (handler *TxHandler) deleteFromCaches(msgKey *crypto.Digest, canonicalKey *crypto.Digest) {
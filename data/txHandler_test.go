@@ -1058,6 +1058,66 @@ func TestTxHandlerIncomingTxHandleDrops(t *testing.T) {
 	incomingTxHandlerProcessing(1, numberOfTransactionGroups, t)
 }
 
+// TestTxHandlerStreamVerifier checks that the transaction groups handed over to the stream verifier reach the
+// postVerificationQueue with the right verification result
+func TestTxHandlerStreamVerifier(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	const numUsers = 100
+	const numberOfTransactionGroups = 200
+	log := logging.TestingLog(t)
+
+	addresses, secrets, genesis := makeTestGenesisAccounts(t, numUsers)
+	genBal := bookkeeping.MakeGenesisBalances(genesis, sinkAddr, poolAddr)
+	const inMem = true
+	cfg := config.GetDefaultLocal()
+	cfg.Archival = true
+	cfg.EnableTxHandlerStreamVerifier = true
+	ledger, err := LoadLedger(log, t.Name(), inMem, protocol.ConsensusCurrentVersion, genBal, genesisID, genesisHash, nil, cfg)
+	require.NoError(t, err)
+	defer ledger.Close()
+
+	handler := makeTestTxHandler(ledger, cfg)
+	defer handler.txVerificationPool.Shutdown()
+
+	// since Start is not called, set the context and start the stream verifier here
+	handler.ctx, handler.ctxCancel = context.WithCancel(context.Background())
+	handler.streamVerifier = verify.MakeStreamVerifier(handler.streamVerifierChan, handler.streamResultChan,
+		handler.ledger, handler.txVerificationPool, handler.ledger.VerifiedTransactionCache())
+	handler.streamVerifier.Start(handler.ctx)
+	handler.backlogWg.Add(1)
+	go handler.processTxnStreamVerifiedResults()
+	defer handler.Stop()
+
+	signedTransactionGroups, badTxnGroups := makeSignedTxnGroups(numberOfTransactionGroups, numUsers, proto.MaxTxGroupSize, 0.5, addresses, secrets)
+	go func() {
+		for _, stxngrp := range signedTransactionGroups {
+			wi := &txBacklogMsg{unverifiedTxGroup: stxngrp}
+			handler.streamVerifierChan <- &verify.UnverifiedElement{TxnGroup: wi.unverifiedTxGroup, BacklogMessage: wi}
+		}
+	}()
+
+	for range signedTransactionGroups {
+		select {
+		case wi := <-handler.postVerificationQueue:
+			u, _ := binary.Uvarint(wi.unverifiedTxGroup[0].Txn.Note)
+			_, inBad := badTxnGroups[u]
+			if inBad {
+				require.ErrorIs(t, wi.verificationErr, crypto.ErrBatchVerificationFailed)
+			} else {
+				require.NoError(t, wi.verificationErr)
+			}
+		case <-time.After(10 * time.Second):
+			require.Fail(t, "timed out waiting for the verified transaction groups")
+		}
+	}
+
+	// the valid groups were added to the verified transaction cache
+	spec := transactions.SpecialAddresses{FeeSink: sinkAddr, RewardsPool: poolAddr}
+	unverifiedGroups := ledger.VerifiedTransactionCache().GetUnverifiedTransactionGroups(signedTransactionGroups, spec, protocol.ConsensusCurrentVersion)
+	require.Len(t, unverifiedGroups, len(badTxnGroups))
+}
+
 // incomingTxHandlerProcessing is a comprehensive transaction handling test
 // It handles the singed transactions by passing them to the backlog for verification
 func incomingTxHandlerProcessing(maxGroupSize, numberOfTransactionGroups int, t *testing.T) {
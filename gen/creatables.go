@@ -0,0 +1,332 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package gen
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+)
+
+// appAccountName returns the name under which the account of a genesis application is allocated
+func appAccountName(appIdx basics.AppIndex) string {
+	return fmt.Sprintf("App%d", appIdx)
+}
+
+// allocateCreatables adds the assets and applications of genData to the genesis records, which are keyed by
+// wallet name. Applications that own boxes get their own account, funded by their creator with the minimum
+// balance the boxes require; these accounts are added to records and addrs, and returned along with the boxes.
+func allocateCreatables(genData GenesisData, proto config.ConsensusParams, records map[string]basics.AccountData, addrs map[string]basics.Address) (appAccounts []genesisAllocation, boxes []bookkeeping.GenesisBox, err error) {
+	// the creatables of the forked accounts are already taken
+	creatables := make(map[basics.CreatableIndex]bool)
+	for _, ad := range records {
+		for aidx := range ad.AssetParams {
+			creatables[basics.CreatableIndex(aidx)] = true
+		}
+		for aidx := range ad.AppParams {
+			creatables[basics.CreatableIndex(aidx)] = true
+		}
+	}
+	touched := make(map[string]bool)
+
+	lookup := func(name string) (basics.AccountData, error) {
+		ad, ok := records[name]
+		if !ok {
+			return basics.AccountData{}, fmt.Errorf("unknown wallet '%s'", name)
+		}
+		touched[name] = true
+		return ad, nil
+	}
+	claim := func(cidx basics.CreatableIndex) error {
+		if cidx == 0 {
+			return fmt.Errorf("creatable index must be non-zero")
+		}
+		if creatables[cidx] {
+			return fmt.Errorf("creatable index %d is used more than once", cidx)
+		}
+		creatables[cidx] = true
+		return nil
+	}
+
+	for _, asset := range genData.Assets {
+		err = claim(basics.CreatableIndex(asset.Index))
+		if err != nil {
+			return nil, nil, fmt.Errorf("asset %d: %w", asset.Index, err)
+		}
+		err = allocateAsset(asset, proto, lookup, records)
+		if err != nil {
+			return nil, nil, fmt.Errorf("asset %d: %w", asset.Index, err)
+		}
+	}
+
+	for _, app := range genData.Applications {
+		err = claim(basics.CreatableIndex(app.Index))
+		if err != nil {
+			return nil, nil, fmt.Errorf("application %d: %w", app.Index, err)
+		}
+		var appBoxes []bookkeeping.GenesisBox
+		appBoxes, err = allocateApplication(app, proto, lookup, records)
+		if err != nil {
+			return nil, nil, fmt.Errorf("application %d: %w", app.Index, err)
+		}
+		if len(appBoxes) == 0 {
+			continue
+		}
+
+		// the boxes are paid for by the application account, which the creator funds.
+		name := appAccountName(app.Index)
+		if _, ok := records[name]; ok {
+			return nil, nil, fmt.Errorf("application %d: account name '%s' is already in use", app.Index, name)
+		}
+		var appAccount basics.AccountData
+		appAccount.Status = basics.Offline
+		for _, box := range appBoxes {
+			appAccount.TotalBoxes++
+			appAccount.TotalBoxBytes += uint64(len(box.Name) + len(box.Value))
+		}
+		appAccount.MicroAlgos = appAccount.MinBalance(&proto)
+
+		creator := records[app.Creator]
+		if creator.MicroAlgos.Raw < appAccount.MicroAlgos.Raw {
+			return nil, nil, fmt.Errorf("application %d: creator '%s' cannot fund the %d microalgos required by its boxes", app.Index, app.Creator, appAccount.MicroAlgos.Raw)
+		}
+		creator.MicroAlgos.Raw -= appAccount.MicroAlgos.Raw
+		records[app.Creator] = creator
+
+		records[name] = appAccount
+		addrs[name] = app.Index.Address()
+		appAccounts = append(appAccounts, genesisAllocation{Name: name, Stake: appAccount.MicroAlgos.Raw, Online: basics.Offline})
+		boxes = append(boxes, appBoxes...)
+	}
+
+	for name := range touched {
+		ad := records[name]
+		if minBalance := ad.MinBalance(&proto); ad.MicroAlgos.Raw < minBalance.Raw {
+			return nil, nil, fmt.Errorf("wallet '%s' balance %d is below its minimum balance of %d", name, ad.MicroAlgos.Raw, minBalance.Raw)
+		}
+	}
+
+	return appAccounts, boxes, nil
+}
+
+// sortGenesisBoxes orders the genesis boxes by application and name, so that the genesis is deterministic
+func sortGenesisBoxes(boxes []bookkeeping.GenesisBox) {
+	sort.Slice(boxes, func(i, j int) bool {
+		if boxes[i].App != boxes[j].App {
+			return boxes[i].App < boxes[j].App
+		}
+		return bytes.Compare(boxes[i].Name, boxes[j].Name) < 0
+	})
+}
+
+func allocateAsset(asset AssetData, proto config.ConsensusParams, lookup func(string) (basics.AccountData, error), records map[string]basics.AccountData) error {
+	params := asset.Params
+	if params.Decimals > proto.MaxAssetDecimals {
+		return fmt.Errorf("decimals %d exceed the maximum of %d", params.Decimals, proto.MaxAssetDecimals)
+	}
+	if len(params.UnitName) > proto.MaxAssetUnitNameBytes {
+		return fmt.Errorf("unit name is longer than %d bytes", proto.MaxAssetUnitNameBytes)
+	}
+	if len(params.AssetName) > proto.MaxAssetNameBytes {
+		return fmt.Errorf("asset name is longer than %d bytes", proto.MaxAssetNameBytes)
+	}
+	if len(params.URL) > proto.MaxAssetURLBytes {
+		return fmt.Errorf("url is longer than %d bytes", proto.MaxAssetURLBytes)
+	}
+
+	creator, err := lookup(asset.Creator)
+	if err != nil {
+		return err
+	}
+
+	remaining := params.Total
+	for _, holding := range asset.Holdings {
+		if holding.Wallet == asset.Creator {
+			return fmt.Errorf("creator '%s' holds the unallocated supply and cannot be listed as a holder", asset.Creator)
+		}
+		holder, err := lookup(holding.Wallet)
+		if err != nil {
+			return err
+		}
+		if _, ok := holder.Assets[asset.Index]; ok {
+			return fmt.Errorf("wallet '%s' holds the asset more than once", holding.Wallet)
+		}
+		if holding.Amount > remaining {
+			return fmt.Errorf("holdings exceed the total supply of %d", params.Total)
+		}
+		remaining -= holding.Amount
+		if proto.MaxAssetsPerAccount > 0 && len(holder.Assets) >= proto.MaxAssetsPerAccount {
+			return fmt.Errorf("wallet '%s' holds more than %d assets", holding.Wallet, proto.MaxAssetsPerAccount)
+		}
+		if holder.Assets == nil {
+			holder.Assets = make(map[basics.AssetIndex]basics.AssetHolding)
+		}
+		holder.Assets[asset.Index] = basics.AssetHolding{Amount: holding.Amount, Frozen: holding.Frozen}
+		records[holding.Wallet] = holder
+	}
+
+	if proto.MaxAssetsPerAccount > 0 && len(creator.Assets) >= proto.MaxAssetsPerAccount {
+		return fmt.Errorf("creator '%s' holds more than %d assets", asset.Creator, proto.MaxAssetsPerAccount)
+	}
+	if creator.AssetParams == nil {
+		creator.AssetParams = make(map[basics.AssetIndex]basics.AssetParams)
+	}
+	creator.AssetParams[asset.Index] = params
+	if creator.Assets == nil {
+		creator.Assets = make(map[basics.AssetIndex]basics.AssetHolding)
+	}
+	creator.Assets[asset.Index] = basics.AssetHolding{Amount: remaining}
+	records[asset.Creator] = creator
+	return nil
+}
+
+func allocateApplication(app ApplicationData, proto config.ConsensusParams, lookup func(string) (basics.AccountData, error), records map[string]basics.AccountData) (boxes []bookkeeping.GenesisBox, err error) {
+	if int(app.ExtraProgramPages) > proto.MaxExtraAppProgramPages {
+		return nil, fmt.Errorf("%d extra program pages exceed the maximum of %d", app.ExtraProgramPages, proto.MaxExtraAppProgramPages)
+	}
+	pages := 1 + int(app.ExtraProgramPages)
+	if len(app.ApprovalProgram) == 0 || len(app.ClearStateProgram) == 0 {
+		return nil, fmt.Errorf("approval and clear state programs are required")
+	}
+	if len(app.ApprovalProgram) > pages*proto.MaxAppProgramLen || len(app.ClearStateProgram) > pages*proto.MaxAppProgramLen {
+		return nil, fmt.Errorf("program is longer than %d bytes", pages*proto.MaxAppProgramLen)
+	}
+	if len(app.ApprovalProgram)+len(app.ClearStateProgram) > pages*proto.MaxAppTotalProgramLen {
+		return nil, fmt.Errorf("programs are longer than %d bytes in total", pages*proto.MaxAppTotalProgramLen)
+	}
+	if app.GlobalStateSchema.NumEntries() > proto.MaxGlobalSchemaEntries {
+		return nil, fmt.Errorf("global state schema exceeds %d entries", proto.MaxGlobalSchemaEntries)
+	}
+	if app.LocalStateSchema.NumEntries() > proto.MaxLocalSchemaEntries {
+		return nil, fmt.Errorf("local state schema exceeds %d entries", proto.MaxLocalSchemaEntries)
+	}
+
+	globalState, err := makeTealKeyValue(app.GlobalState, app.GlobalStateSchema, proto)
+	if err != nil {
+		return nil, fmt.Errorf("global state: %w", err)
+	}
+
+	creator, err := lookup(app.Creator)
+	if err != nil {
+		return nil, err
+	}
+	if proto.MaxAppsCreated > 0 && len(creator.AppParams) >= proto.MaxAppsCreated {
+		return nil, fmt.Errorf("creator '%s' creates more than %d applications", app.Creator, proto.MaxAppsCreated)
+	}
+	if creator.AppParams == nil {
+		creator.AppParams = make(map[basics.AppIndex]basics.AppParams)
+	}
+	creator.AppParams[app.Index] = basics.AppParams{
+		ApprovalProgram:   app.ApprovalProgram,
+		ClearStateProgram: app.ClearStateProgram,
+		GlobalState:       globalState,
+		StateSchemas: basics.StateSchemas{
+			LocalStateSchema:  app.LocalStateSchema,
+			GlobalStateSchema: app.GlobalStateSchema,
+		},
+		ExtraProgramPages: app.ExtraProgramPages,
+	}
+	creator.TotalAppSchema = creator.TotalAppSchema.AddSchema(app.GlobalStateSchema)
+	creator.TotalExtraAppPages += app.ExtraProgramPages
+	records[app.Creator] = creator
+
+	for _, local := range app.LocalStates {
+		localState, err := makeTealKeyValue(local.State, app.LocalStateSchema, proto)
+		if err != nil {
+			return nil, fmt.Errorf("local state of wallet '%s': %w", local.Wallet, err)
+		}
+		holder, err := lookup(local.Wallet)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := holder.AppLocalStates[app.Index]; ok {
+			return nil, fmt.Errorf("wallet '%s' is opted in more than once", local.Wallet)
+		}
+		if proto.MaxAppsOptedIn > 0 && len(holder.AppLocalStates) >= proto.MaxAppsOptedIn {
+			return nil, fmt.Errorf("wallet '%s' is opted into more than %d applications", local.Wallet, proto.MaxAppsOptedIn)
+		}
+		if holder.AppLocalStates == nil {
+			holder.AppLocalStates = make(map[basics.AppIndex]basics.AppLocalState)
+		}
+		holder.AppLocalStates[app.Index] = basics.AppLocalState{
+			Schema:   app.LocalStateSchema,
+			KeyValue: localState,
+		}
+		holder.TotalAppSchema = holder.TotalAppSchema.AddSchema(app.LocalStateSchema)
+		records[local.Wallet] = holder
+	}
+
+	if len(app.Boxes) > 0 && proto.MaxBoxSize == 0 {
+		return nil, fmt.Errorf("boxes are not supported by this protocol")
+	}
+	names := make(map[string]bool, len(app.Boxes))
+	for _, box := range app.Boxes {
+		if len(box.Name) == 0 || len(box.Name) > proto.MaxAppKeyLen {
+			return nil, fmt.Errorf("box name must be between 1 and %d bytes long", proto.MaxAppKeyLen)
+		}
+		if len(box.Value) == 0 || uint64(len(box.Value)) > proto.MaxBoxSize {
+			return nil, fmt.Errorf("box %q must be between 1 and %d bytes long", box.Name, proto.MaxBoxSize)
+		}
+		if names[string(box.Name)] {
+			return nil, fmt.Errorf("box %q is defined more than once", box.Name)
+		}
+		names[string(box.Name)] = true
+		boxes = append(boxes, bookkeeping.GenesisBox{App: app.Index, Name: box.Name, Value: box.Value})
+	}
+	return boxes, nil
+}
+
+// makeTealKeyValue converts a state specification into a TealKeyValue, making sure it fits the given schema
+func makeTealKeyValue(state []StateData, schema basics.StateSchema, proto config.ConsensusParams) (basics.TealKeyValue, error) {
+	if len(state) == 0 {
+		return nil, nil
+	}
+	kv := make(basics.TealKeyValue, len(state))
+	var counts basics.StateSchema
+	for _, sd := range state {
+		if len(sd.Key) > proto.MaxAppKeyLen {
+			return nil, fmt.Errorf("key %q is longer than %d bytes", sd.Key, proto.MaxAppKeyLen)
+		}
+		if _, ok := kv[string(sd.Key)]; ok {
+			return nil, fmt.Errorf("key %q is defined more than once", sd.Key)
+		}
+		if sd.Uint != nil {
+			if sd.Bytes != nil {
+				return nil, fmt.Errorf("key %q has both a uint and a byte slice value", sd.Key)
+			}
+			kv[string(sd.Key)] = basics.TealValue{Type: basics.TealUintType, Uint: *sd.Uint}
+			counts.NumUint++
+			continue
+		}
+		if len(sd.Bytes) > proto.MaxAppBytesValueLen {
+			return nil, fmt.Errorf("value of key %q is longer than %d bytes", sd.Key, proto.MaxAppBytesValueLen)
+		}
+		if len(sd.Key)+len(sd.Bytes) > proto.MaxAppSumKeyValueLens {
+			return nil, fmt.Errorf("key %q and its value are longer than %d bytes", sd.Key, proto.MaxAppSumKeyValueLens)
+		}
+		kv[string(sd.Key)] = basics.TealValue{Type: basics.TealBytesType, Bytes: string(sd.Bytes)}
+		counts.NumByteSlice++
+	}
+	if counts.NumUint > schema.NumUint || counts.NumByteSlice > schema.NumByteSlice {
+		return nil, fmt.Errorf("%d uints and %d byte slices do not fit the schema", counts.NumUint, counts.NumByteSlice)
+	}
+	return kv, nil
+}
@@ -0,0 +1,157 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package gen
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func makeCreatablesGenesisData() GenesisData {
+	counter := uint64(42)
+	gd := DefaultGenesis
+	gd.NetworkName = "creatables"
+	gd.ConsensusProtocol = protocol.ConsensusCurrentVersion
+	gd.LastPartKeyRound = 10
+	gd.Wallets = []WalletData{
+		{Name: "alice", Stake: 50},
+		{Name: "bob", Stake: 50},
+	}
+	gd.Assets = []AssetData{{
+		Index:    1000,
+		Creator:  "alice",
+		Params:   basics.AssetParams{Total: 1000, UnitName: "TOK", AssetName: "token"},
+		Holdings: []AssetHoldingData{{Wallet: "bob", Amount: 300, Frozen: true}},
+	}}
+	gd.Applications = []ApplicationData{{
+		Index:             1001,
+		Creator:           "alice",
+		ApprovalProgram:   []byte{0x08, 0x81, 0x01},
+		ClearStateProgram: []byte{0x08, 0x81, 0x01},
+		GlobalStateSchema: basics.StateSchema{NumUint: 1, NumByteSlice: 1},
+		LocalStateSchema:  basics.StateSchema{NumUint: 1},
+		GlobalState: []StateData{
+			{Key: []byte("counter"), Uint: &counter},
+			{Key: []byte("owner"), Bytes: []byte("alice")},
+		},
+		LocalStates: []LocalStateData{{Wallet: "bob", State: []StateData{{Key: []byte("count"), Uint: &counter}}}},
+		Boxes: []BoxData{
+			{Name: []byte("zeta"), Value: make([]byte, 100)},
+			{Name: []byte("alpha"), Value: []byte("first")},
+		},
+	}}
+	return gd
+}
+
+func TestGenesisCreatables(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	gd := makeCreatablesGenesisData()
+	outDir := t.TempDir()
+	err := GenerateGenesisFiles(gd, config.Consensus, outDir, nil)
+	require.NoError(t, err)
+
+	genesis, err := bookkeeping.LoadGenesisFromFile(filepath.Join(outDir, config.GenesisJSONFile))
+	require.NoError(t, err)
+
+	accounts := make(map[string]basics.AccountData)
+	var total uint64
+	for _, alloc := range genesis.Allocation {
+		accounts[alloc.Comment] = alloc.State
+		total += alloc.State.MicroAlgos.Raw
+	}
+	proto := config.Consensus[gd.ConsensusProtocol]
+	require.Equal(t, TotalMoney+proto.MinBalance+gd.RewardsPoolBalance, total)
+
+	alice, bob, app := accounts["alice"], accounts["bob"], accounts[appAccountName(1001)]
+	require.Equal(t, gd.Assets[0].Params, alice.AssetParams[1000])
+	require.Equal(t, basics.AssetHolding{Amount: 700}, alice.Assets[1000])
+	require.Equal(t, basics.AssetHolding{Amount: 300, Frozen: true}, bob.Assets[1000])
+
+	params := alice.AppParams[1001]
+	require.Equal(t, gd.Applications[0].ApprovalProgram, params.ApprovalProgram)
+	require.Equal(t, basics.TealValue{Type: basics.TealUintType, Uint: 42}, params.GlobalState["counter"])
+	require.Equal(t, basics.TealValue{Type: basics.TealBytesType, Bytes: "alice"}, params.GlobalState["owner"])
+	require.Equal(t, gd.Applications[0].GlobalStateSchema, alice.TotalAppSchema)
+	require.Equal(t, gd.Applications[0].LocalStateSchema, bob.TotalAppSchema)
+	require.Equal(t, basics.TealValue{Type: basics.TealUintType, Uint: 42}, bob.AppLocalStates[1001].KeyValue["count"])
+
+	require.Equal(t, uint64(2), app.TotalBoxes)
+	require.Equal(t, uint64(len("zeta")+100+len("alpha")+len("first")), app.TotalBoxBytes)
+	require.Equal(t, app.MinBalance(&proto), app.MicroAlgos)
+	require.Equal(t, TotalMoney/2-app.MicroAlgos.Raw, alice.MicroAlgos.Raw)
+
+	require.Len(t, genesis.Boxes, 2)
+	require.Equal(t, []byte("alpha"), genesis.Boxes[0].Name)
+	require.Equal(t, []byte("zeta"), genesis.Boxes[1].Name)
+
+	blk, err := genesis.Block()
+	require.NoError(t, err)
+	require.Equal(t, uint64(1001), blk.TxnCounter)
+}
+
+func TestGenesisCreatablesErrors(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	proto := config.Consensus[protocol.ConsensusCurrentVersion]
+	testcases := []struct {
+		name   string
+		modify func(gd *GenesisData)
+		err    string
+	}{
+		{"unknown creator", func(gd *GenesisData) { gd.Assets[0].Creator = "carol" }, "unknown wallet 'carol'"},
+		{"unknown holder", func(gd *GenesisData) { gd.Assets[0].Holdings[0].Wallet = "carol" }, "unknown wallet 'carol'"},
+		{"creator holding", func(gd *GenesisData) { gd.Assets[0].Holdings[0].Wallet = "alice" }, "cannot be listed as a holder"},
+		{"excess holdings", func(gd *GenesisData) { gd.Assets[0].Holdings[0].Amount = 1001 }, "exceed the total supply"},
+		{"zero index", func(gd *GenesisData) { gd.Assets[0].Index = 0 }, "must be non-zero"},
+		{"duplicate index", func(gd *GenesisData) { gd.Applications[0].Index = 1000 }, "used more than once"},
+		{"missing program", func(gd *GenesisData) { gd.Applications[0].ClearStateProgram = nil }, "programs are required"},
+		{"schema overflow", func(gd *GenesisData) { gd.Applications[0].GlobalStateSchema.NumUint = 0 }, "do not fit the schema"},
+		{"duplicate opt in", func(gd *GenesisData) {
+			gd.Applications[0].LocalStates = append(gd.Applications[0].LocalStates, gd.Applications[0].LocalStates[0])
+		}, "opted in more than once"},
+		{"large box", func(gd *GenesisData) { gd.Applications[0].Boxes[0].Value = make([]byte, proto.MaxBoxSize+1) }, "must be between 1 and"},
+		{"empty box", func(gd *GenesisData) { gd.Applications[0].Boxes[0].Value = nil }, "must be between 1 and"},
+		{"duplicate box", func(gd *GenesisData) { gd.Applications[0].Boxes[1].Name = []byte("zeta") }, "defined more than once"},
+		{"unfunded boxes", func(gd *GenesisData) { gd.Applications[0].Creator = "bob" }, "cannot fund"},
+		{"below min balance", func(gd *GenesisData) { gd.Applications[0].Boxes = nil }, "below its minimum balance"},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			gd := makeCreatablesGenesisData()
+			tc.modify(&gd)
+			records := map[string]basics.AccountData{
+				"alice": {MicroAlgos: basics.MicroAlgos{Raw: 10 * proto.MinBalance}},
+				"bob":   {MicroAlgos: basics.MicroAlgos{Raw: proto.MinBalance / 2}},
+			}
+			_, _, err := allocateCreatables(gd, proto, records, make(map[string]basics.Address))
+			require.ErrorContains(t, err, tc.err)
+		})
+	}
+}
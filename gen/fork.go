@@ -0,0 +1,120 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package gen
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/crypto/merklesignature"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// ForkState is a snapshot of the accounts and boxes of an existing network at some round. A private
+// network genesis that references it starts with the same accounts, assets, applications and boxes,
+// while all the online stake is held by the wallets of the private network. The whole snapshot is
+// written into the genesis, which the nodes keep in memory, so it should not hold more than about a
+// million accounts.
+type ForkState struct {
+	_struct struct{} `codec:",omitempty,omitemptyarray"`
+
+	// Round is the round of the source network at which the snapshot was taken
+	Round basics.Round `codec:"round"`
+	// RewardsLevel is the rewards level of the source network at that round. The pending rewards of the
+	// accounts are applied to their balances when the genesis is generated.
+	RewardsLevel uint64 `codec:"rewardslevel"`
+
+	Accounts map[basics.Address]basics.AccountData `codec:"accounts"`
+	Boxes    []bookkeeping.GenesisBox              `codec:"boxes"`
+}
+
+// SaveForkState writes the given fork state into a file
+func SaveForkState(filename string, fork ForkState) error {
+	f, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	w := bufio.NewWriter(f)
+	protocol.EncodeStream(w, &fork)
+	err = w.Flush()
+	if err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// LoadForkState loads a fork state from a file written by SaveForkState
+func LoadForkState(filename string) (fork ForkState, err error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return
+	}
+	defer f.Close()
+	err = protocol.DecodeStream(bufio.NewReader(f), &fork)
+	return
+}
+
+// allocateForkState adds the accounts of a fork state to the genesis records, which are keyed by name.
+// The forked accounts are taken offline so that the online stake is held by the private network's wallets,
+// and their pending rewards are paid out since the private network starts at rewards level zero.
+// The fee sink and rewards pool of the private network keep their forked state when their addresses
+// are part of the fork, as is the case when forking a network that uses the default ones.
+func allocateForkState(fork ForkState, proto config.ConsensusParams, records map[string]basics.AccountData, addrs map[string]basics.Address) (forkAccounts []genesisAllocation, err error) {
+	names := make(map[basics.Address]string, len(addrs))
+	for name, addr := range addrs {
+		names[addr] = name
+	}
+
+	for addr, ad := range fork.Accounts {
+		ad = ad.WithUpdatedRewards(proto, fork.RewardsLevel)
+		ad.RewardsBase = 0
+		if ad.Status == basics.Online {
+			ad.Status = basics.Offline
+		}
+		ad.VoteID = crypto.OneTimeSignatureVerifier{}
+		ad.SelectionID = crypto.VRFVerifier{}
+		ad.StateProofID = merklesignature.Commitment{}
+		ad.VoteFirstValid = 0
+		ad.VoteLastValid = 0
+		ad.VoteKeyDilution = 0
+
+		if name, ok := names[addr]; ok {
+			if name != "FeeSink" && name != "RewardsPool" {
+				return nil, fmt.Errorf("forked account %v collides with wallet '%s'", addr, name)
+			}
+			ad.Status = basics.NotParticipating
+			records[name] = ad
+			continue
+		}
+
+		name := addr.String()
+		records[name] = ad
+		addrs[name] = addr
+		forkAccounts = append(forkAccounts, genesisAllocation{Name: name, Stake: ad.MicroAlgos.Raw, Online: ad.Status})
+	}
+	sort.Slice(forkAccounts, func(i, j int) bool {
+		return forkAccounts[i].Name < forkAccounts[j].Name
+	})
+	return forkAccounts, nil
+}
@@ -0,0 +1,146 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package gen
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func makeTestForkState() (fork ForkState, online, creator basics.Address) {
+	online = basics.Address(crypto.Hash([]byte("online")))
+	creator = basics.Address(crypto.Hash([]byte("creator")))
+	const appIdx = basics.AppIndex(5)
+
+	fork.Round = 1000
+	fork.RewardsLevel = 10
+	fork.Accounts = map[basics.Address]basics.AccountData{
+		online: {
+			Status:          basics.Online,
+			MicroAlgos:      basics.MicroAlgos{Raw: 100e6},
+			RewardsBase:     4,
+			VoteID:          crypto.OneTimeSignatureVerifier{1},
+			SelectionID:     crypto.VRFVerifier{2},
+			VoteFirstValid:  1,
+			VoteLastValid:   2000,
+			VoteKeyDilution: 100,
+		},
+		creator: {
+			Status:      basics.Offline,
+			MicroAlgos:  basics.MicroAlgos{Raw: 10e6},
+			RewardsBase: 10,
+			AppParams: map[basics.AppIndex]basics.AppParams{
+				appIdx: {ApprovalProgram: []byte{0x08, 0x81, 0x01}, ClearStateProgram: []byte{0x08, 0x81, 0x01}},
+			},
+		},
+		appIdx.Address(): {
+			Status:        basics.Offline,
+			MicroAlgos:    basics.MicroAlgos{Raw: 1e6},
+			RewardsBase:   10,
+			TotalBoxes:    1,
+			TotalBoxBytes: 8,
+		},
+		defaultSinkAddr: {
+			Status:     basics.NotParticipating,
+			MicroAlgos: basics.MicroAlgos{Raw: 5e6},
+		},
+	}
+	fork.Boxes = []bookkeeping.GenesisBox{{App: appIdx, Name: []byte("box"), Value: []byte("value")}}
+	return
+}
+
+func TestForkStateFile(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	fork, _, _ := makeTestForkState()
+	filename := filepath.Join(t.TempDir(), "test.fork")
+	require.NoError(t, SaveForkState(filename, fork))
+
+	loaded, err := LoadForkState(filename)
+	require.NoError(t, err)
+	require.Equal(t, fork, loaded)
+}
+
+func TestGenesisFork(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	fork, online, creator := makeTestForkState()
+	outDir := t.TempDir()
+	forkFile := filepath.Join(outDir, "test.fork")
+	require.NoError(t, SaveForkState(forkFile, fork))
+
+	gd := DefaultGenesis
+	gd.NetworkName = "fork"
+	gd.ConsensusProtocol = protocol.ConsensusCurrentVersion
+	gd.LastPartKeyRound = 10
+	gd.Wallets = []WalletData{{Name: "node", Stake: 100, Online: true}}
+	gd.ForkState = forkFile
+
+	// the creatables of the fork cannot be allocated again
+	gd.Assets = []AssetData{{Index: 5, Creator: "node", Params: basics.AssetParams{Total: 1}}}
+	err := GenerateGenesisFiles(gd, config.Consensus, outDir, nil)
+	require.ErrorContains(t, err, "creatable index 5 is used more than once")
+
+	gd.Assets[0].Index = 6
+	err = GenerateGenesisFiles(gd, config.Consensus, outDir, nil)
+	require.NoError(t, err)
+
+	genesis, err := bookkeeping.LoadGenesisFromFile(filepath.Join(outDir, config.GenesisJSONFile))
+	require.NoError(t, err)
+	bals, err := genesis.Balances()
+	require.NoError(t, err)
+	proto := config.Consensus[gd.ConsensusProtocol]
+
+	// the forked accounts are offline, and their pending rewards were paid out
+	ad := bals.Balances[online]
+	require.Equal(t, basics.Offline, ad.Status)
+	require.Zero(t, ad.VoteID)
+	require.Zero(t, ad.SelectionID)
+	require.Zero(t, ad.VoteLastValid)
+	require.Zero(t, ad.RewardsBase)
+	require.Equal(t, uint64(100e6)+6*uint64(100e6)/proto.RewardUnit, ad.MicroAlgos.Raw)
+	require.Equal(t, fork.Accounts[creator].AppParams, bals.Balances[creator].AppParams)
+
+	// the fee sink keeps its forked state
+	require.Equal(t, fork.Accounts[defaultSinkAddr], bals.Balances[defaultSinkAddr])
+
+	// the network's own wallet holds all the online stake
+	var onlineCount int
+	for _, data := range bals.Balances {
+		if data.Status == basics.Online {
+			onlineCount++
+			require.Equal(t, TotalMoney, data.MicroAlgos.Raw)
+		}
+	}
+	require.Equal(t, 1, onlineCount)
+
+	require.Equal(t, fork.Boxes, genesis.Boxes)
+	blk, err := genesis.Block()
+	require.NoError(t, err)
+	require.Equal(t, uint64(6), blk.TxnCounter)
+}
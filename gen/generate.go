@@ -355,6 +355,31 @@ func generateGenesisFiles(protoVersion protocol.ConsensusVersion, protoParams co
 		MicroAlgos: basics.MicroAlgos{Raw: rewardsBalance},
 	}
 
+	var boxes []bookkeeping.GenesisBox
+	if genData.ForkState != "" {
+		fork, err := LoadForkState(genData.ForkState)
+		if err != nil {
+			return fmt.Errorf("couldn't load fork state '%s': %v", genData.ForkState, err)
+		}
+		if verbose {
+			fmt.Fprintf(verboseOut, "Forking %d accounts and %d boxes from round %d\n", len(fork.Accounts), len(fork.Boxes), fork.Round)
+		}
+		forkAccounts, err := allocateForkState(fork, protoParams, records, genesisAddrs)
+		if err != nil {
+			return err
+		}
+		allocation = append(allocation, forkAccounts...)
+		boxes = fork.Boxes
+	}
+
+	appAccounts, appBoxes, err := allocateCreatables(genData, protoParams, records, genesisAddrs)
+	if err != nil {
+		return err
+	}
+	allocation = append(allocation, appAccounts...)
+	boxes = append(boxes, appBoxes...)
+	sortGenesisBoxes(boxes)
+
 	// Add FeeSink and RewardsPool to allocation slice to be handled with other allocations.
 	sinkAcct := genesisAllocation{
 		Name: "FeeSink",
@@ -377,6 +402,7 @@ func generateGenesisFiles(protoVersion protocol.ConsensusVersion, protoParams co
 		RewardsPool: rewardsPool.String(),
 		Comment:     comment,
 		DevMode:     devmode,
+		Boxes:       boxes,
 	}
 
 	for _, wallet := range allocation {
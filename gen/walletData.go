@@ -53,6 +53,60 @@ type GenesisData struct {
 	RewardsPoolBalance uint64 // Values < `ConsensusParams.MinBalance` are adjusted to `ConsensusParams.MinBalance`
 	DevMode            bool
 	Comment            string
+	Assets             []AssetData       `json:",omitempty"`
+	Applications       []ApplicationData `json:",omitempty"`
+	ForkState          string            `json:",omitempty"` // Path of a ForkState file whose accounts and boxes are added to the genesis
+}
+
+// AssetData describes an asset created in the genesis block. The creator holds
+// whatever part of the total supply is not allocated to the listed holdings.
+type AssetData struct {
+	Index    basics.AssetIndex
+	Creator  string
+	Params   basics.AssetParams
+	Holdings []AssetHoldingData `json:",omitempty"`
+}
+
+// AssetHoldingData represents a wallet's initial holding of a genesis asset
+type AssetHoldingData struct {
+	Wallet string
+	Amount uint64
+	Frozen bool
+}
+
+// ApplicationData describes an application deployed in the genesis block,
+// along with its global state, the local states of opted-in wallets and its boxes.
+type ApplicationData struct {
+	Index             basics.AppIndex
+	Creator           string
+	ApprovalProgram   []byte
+	ClearStateProgram []byte
+	GlobalStateSchema basics.StateSchema
+	LocalStateSchema  basics.StateSchema
+	ExtraProgramPages uint32
+	GlobalState       []StateData      `json:",omitempty"`
+	LocalStates       []LocalStateData `json:",omitempty"`
+	Boxes             []BoxData        `json:",omitempty"`
+}
+
+// StateData represents a single key/value pair of an application's state.
+// The value is a uint when Uint is set, and a byte slice otherwise.
+type StateData struct {
+	Key   []byte
+	Uint  *uint64 `json:",omitempty"`
+	Bytes []byte  `json:",omitempty"`
+}
+
+// LocalStateData represents a wallet opted into a genesis application, and its initial local state
+type LocalStateData struct {
+	Wallet string
+	State  []StateData `json:",omitempty"`
+}
+
+// BoxData represents the name and initial content of a genesis application's box
+type BoxData struct {
+	Name  []byte
+	Value []byte
 }
 
 // LoadGenesisData loads a GenesisData structure from a json file
@@ -912,6 +912,18 @@ func (au *accountUpdates) onlineTotals(rnd basics.Round) (basics.MicroAlgos, err
 	return totals.Online.Money, nil
 }
 
+// totals returns the totals of all accounts at the end of round rnd.
+func (au *accountUpdates) totals(rnd basics.Round) (ledgercore.AccountTotals, error) {
+	au.accountsMu.RLock()
+	defer au.accountsMu.RUnlock()
+	offset, err := au.roundOffset(rnd)
+	if err != nil {
+		return ledgercore.AccountTotals{}, err
+	}
+
+	return au.roundTotals[offset], nil
+}
+
 // latestTotalsImpl returns the totals of all accounts for the most recent round, as well as the round number
 func (au *accountUpdates) latestTotalsImpl() (basics.Round, ledgercore.AccountTotals, error) {
 	offset := len(au.deltas)
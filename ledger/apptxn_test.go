@@ -20,6 +20,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -3755,3 +3756,59 @@ func TestAppCallAppDuringInit(t *testing.T) {
 		dl.txn(&callInInit, problem)
 	})
 }
+
+// TestBudgetReport ensures that a group that exceeds its pooled opcode budget
+// is rejected with the budget added and consumed by each of its app calls.
+func TestBudgetReport(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	genBalances, addrs, _ := ledgertesting.NewTestGenesis()
+	// Inner app calls start in v31
+	ledgertesting.TestConsensusRange(t, 31, 0, func(t *testing.T, ver int, cv protocol.ConsensusVersion) {
+		dl := NewDoubleLedger(t, genBalances, cv)
+		defer dl.Close()
+
+		proto := config.Consensus[cv]
+		gasup := dl.fundedApp(addrs[0], proto.MinBalance, main(""))
+		waster := dl.fundedApp(addrs[0], 1_000_000, main(`
+itxn_begin
+int appl;                 itxn_field TypeEnum
+txn Applications 1;       itxn_field ApplicationID
+itxn_submit
+`+strings.Repeat("global CurrentApplicationAddress; keccak256; pop;\n", 15)))
+
+		dummy := txntest.Txn{
+			Type:          "appl",
+			Sender:        addrs[0],
+			ApplicationID: gasup,
+		}
+		call := txntest.Txn{
+			Type:          "appl",
+			Sender:        addrs[0],
+			ApplicationID: waster,
+			ForeignApps:   []basics.AppIndex{gasup},
+		}
+		dl.txgroup("", &dummy, &call)
+
+		// without the dummy call, the group is short of budget
+		dl.txgroup(fmt.Sprintf("Group budget: txn[0] (app %d) added 700, consumed 1329; "+
+			"txn[0].itxn[0] (app %d) added 700, consumed 3", waster, gasup), &call)
+
+		// the budget of a dummy call is pooled before any call runs, so it can
+		// come last
+		dl.txgroup("", &call, &dummy)
+
+		// and it's reported even if the group fails before it runs
+		hog := dl.fundedApp(addrs[0], 1_000_000, main(`
+itxn_begin
+int appl;                 itxn_field TypeEnum
+txn Applications 1;       itxn_field ApplicationID
+itxn_submit
+`+strings.Repeat("global CurrentApplicationAddress; keccak256; pop;\n", 30)))
+		call.ApplicationID = hog
+		dl.txgroup(fmt.Sprintf("Group budget: txn[0] (app %d) added 700, consumed 1989; "+
+			"txn[0].itxn[0] (app %d) added 700, consumed 3; txn[1] (app %d) added 700, consumed 0",
+			hog, gasup, gasup), &call, &dummy)
+	})
+}
@@ -104,6 +104,10 @@ func (wl *wrappedLedger) GenesisAccounts() map[basics.Address]basics.AccountData
 	return wl.l.GenesisAccounts()
 }
 
+func (wl *wrappedLedger) GenesisBoxes() map[string][]byte {
+	return wl.l.GenesisBoxes()
+}
+
 func getInitState() (genesisInitState ledgercore.InitState) {
 	blk := bookkeeping.Block{}
 	blk.CurrentProtocol = protocol.ConsensusCurrentVersion
@@ -41,6 +41,12 @@ func (c *blockHeaderCache) initialize() {
 	c.lruCache.maxEntries = blockHeadersLRUCacheSize
 }
 
+// reset drops all the cached block headers.
+func (c *blockHeaderCache) reset() {
+	c.latestHeaderCache.reset()
+	c.lruCache.reset()
+}
+
 func (c *blockHeaderCache) get(round basics.Round) (blockHeader bookkeeping.BlockHeader, exists bool) {
 	// check latestHeaderCache first
 	blockHeader, exists = c.latestHeaderCache.get(round)
@@ -84,3 +90,10 @@ func (c *latestBlockHeaderCache) put(blockHeader bookkeeping.BlockHeader) {
 		c.blockHeaders[idx] = blockHeader
 	}
 }
+
+func (c *latestBlockHeaderCache) reset() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.blockHeaders = [latestHeaderCacheSize]bookkeeping.BlockHeader{}
+}
@@ -204,6 +204,74 @@ func TestBoxCreate(t *testing.T) {
 	})
 }
 
+// TestBoxResize tests MBR changes as boxes grow and shrink
+func TestBoxResize(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	genBalances, addrs, _ := ledgertesting.NewTestGenesis()
+	// box_resize is in vFuture
+	ledgertesting.TestConsensusRange(t, boxVersion+1, 0, func(t *testing.T, ver int, cv protocol.ConsensusVersion) {
+		dl := NewDoubleLedger(t, genBalances, cv)
+		defer dl.Close()
+
+		// enough for a size 24 box with 4 letter name
+		proto := config.Consensus[cv]
+		appIndex := dl.fundedApp(addrs[0], proto.MinBalance+boxFee(proto, 28), main(`
+			txn ApplicationArgs 0; byte "create"; ==; bz resize
+			txn ApplicationArgs 1; int 10; box_create; assert; b end
+		resize:
+			txn ApplicationArgs 0; byte "resize"; ==; bz splice
+			txn ApplicationArgs 1; txn ApplicationArgs 2; btoi; box_resize; b end
+		splice:
+			txn ApplicationArgs 1; int 0; int 0; txn ApplicationArgs 2; box_splice
+		`))
+
+		call := txntest.Txn{
+			Type:          "appl",
+			Sender:        addrs[0],
+			ApplicationID: appIndex,
+			Boxes:         []transactions.BoxRef{{Index: 0, Name: []byte("adam")}},
+		}
+
+		totals := func(boxes uint64, bytes uint64) {
+			t.Helper()
+			ad := lookup(t, dl.generator, appIndex.Address())
+			require.Equal(t, boxes, ad.TotalBoxes)
+			require.Equal(t, bytes, ad.TotalBoxBytes)
+		}
+
+		dl.txn(call.Args("resize", "adam", "\x18"), "no such box")
+		dl.txn(call.Args("create", "adam"))
+		totals(1, 14)
+
+		dl.txn(call.Args("resize", "adam", "\x18"))
+		totals(1, 28)
+		dl.txn(call.Args("resize", "adam", "\x19"), "below min")
+		totals(1, 28)
+
+		// splicing does not change the size
+		dl.beginBlock()
+		dl.txn(call.Args("splice", "adam", "hello"))
+		vb := dl.endBlock()
+		require.Len(t, vb.Delta().KvMods, 1)
+		for _, kvDelta := range vb.Delta().KvMods {
+			require.Equal(t, append([]byte("hello"), make([]byte, 19)...), kvDelta.Data)
+		}
+		totals(1, 28)
+
+		dl.beginBlock()
+		dl.txn(call.Args("resize", "adam", "\x02"))
+		vb = dl.endBlock()
+		for _, kvDelta := range vb.Delta().KvMods {
+			require.Equal(t, []byte("he"), kvDelta.Data)
+		}
+		totals(1, 6)
+		dl.txn(call.Args("resize", "adam", "\x00"))
+		totals(1, 4)
+	})
+}
+
 // TestBoxRecreate tests behavior when box_create is called for a box that already exists
 func TestBoxRecreate(t *testing.T) {
 	partitiontest.PartitionTest(t)
@@ -0,0 +1,386 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/crypto/merkletrie"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/ledger/store"
+	"github.com/algorand/go-algorand/protocol"
+)
+
+// maxCatchpointFileSectionSize is the maximal size of a single section of a catchpoint file.
+const maxCatchpointFileSectionSize = BalancesPerCatchpointFileChunk*(MaxEncodedBaseAccountDataSize+MaxEncodedKVDataSize) + ResourcesPerCatchpointFileChunk*MaxEncodedBaseResourceDataSize
+
+// ErrCatchpointProofEntryNotFound is returned when the requested entry is not part of the catchpoint.
+var ErrCatchpointProofEntryNotFound = errors.New("entry not found in catchpoint")
+
+// ErrCatchpointProofLabelMismatch is returned when the balances merkle trie rebuilt from a catchpoint file,
+// or the one provided with a proof, doesn't match the catchpoint label.
+var ErrCatchpointProofLabelMismatch = errors.New("balances merkle root doesn't match the catchpoint label")
+
+// ErrCatchpointProofValueMismatch is returned when the value provided with a proof doesn't hash to the proven trie element.
+var ErrCatchpointProofValueMismatch = errors.New("catchpoint proof value doesn't match its key")
+
+// CatchpointProof proves that a single entry of the balances merkle trie - an account, an account resource
+// or a key-value pair - was part of the ledger state committed to by a catchpoint label.
+type CatchpointProof struct {
+	// Catchpoint is the catchpoint label the proof is verified against.
+	Catchpoint string
+	// BalancesRound is the round of the ledger state captured by the catchpoint.
+	BalancesRound basics.Round
+	// BlockHeaderDigest, BalancesRoot and Totals are the catchpoint label components.
+	BlockHeaderDigest crypto.Digest
+	BalancesRoot      crypto.Digest
+	Totals            ledgercore.AccountTotals
+	// Address and CreatableIndex identify the proven account, or account resource when CreatableIndex is
+	// non-zero. KvKey identifies the proven key-value pair instead, when set.
+	Address        basics.Address
+	CreatableIndex basics.CreatableIndex
+	KvKey          string
+	// Key is the merkle trie element.
+	Key []byte
+	// Value is the encoded entry the key was derived from: the encoded base account data, the encoded
+	// resource data, or the key-value pair value.
+	Value []byte
+	// Proof is the merkle trie inclusion proof for Key.
+	Proof merkletrie.Proof
+}
+
+// Verify checks that the proof components match the catchpoint label, that the value hashes to the key, and that the key
+// is included in the balances trie.
+func (p *CatchpointProof) Verify() error {
+	round, labelHash, err := ledgercore.ParseCatchpointLabel(p.Catchpoint)
+	if err != nil {
+		return err
+	}
+	if ledgercore.MakeCatchpointLabel(round, p.BlockHeaderDigest, p.BalancesRoot, p.Totals).Hash() != labelHash {
+		return ErrCatchpointProofLabelMismatch
+	}
+	key, err := p.trieKey()
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(key, p.Key) {
+		return ErrCatchpointProofValueMismatch
+	}
+	found, err := merkletrie.VerifyProof(p.BalancesRoot, p.Key, &p.Proof)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return ErrCatchpointProofEntryNotFound
+	}
+	return nil
+}
+
+// trieKey hashes the proven entry into its balances trie element, the same way the catchpoint trie does.
+func (p *CatchpointProof) trieKey() ([]byte, error) {
+	if p.KvKey != "" {
+		return store.KvHashBuilderV6(p.KvKey, p.Value), nil
+	}
+	if p.CreatableIndex == 0 {
+		var accountData store.BaseAccountData
+		err := protocol.Decode(p.Value, &accountData)
+		if err != nil {
+			return nil, err
+		}
+		return store.AccountHashBuilderV6(p.Address, &accountData, p.Value), nil
+	}
+	var resourceData store.ResourcesData
+	err := protocol.Decode(p.Value, &resourceData)
+	if err != nil {
+		return nil, err
+	}
+	return store.ResourcesHashBuilderV6(&resourceData, p.Address, p.CreatableIndex, resourceData.UpdateRound, p.Value)
+}
+
+// catchpointProofTrie is a balances merkle trie rebuilt from a catchpoint file.
+type catchpointProofTrie struct {
+	round  basics.Round
+	header CatchpointFileHeader
+	trie   *merkletrie.Trie
+}
+
+// catchpointProofCache retains the most recently rebuilt catchpoint trie, since rebuilding it requires reading
+// the entire catchpoint file.
+type catchpointProofCache struct {
+	mu   deadlock.Mutex
+	last *catchpointProofTrie
+}
+
+// reset drops the cached trie.
+func (c *catchpointProofCache) reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.last = nil
+}
+
+// catchpointProofEntry identifies the trie entry being proven, and collects its key and value once found.
+type catchpointProofEntry struct {
+	addr         basics.Address
+	cidx         basics.CreatableIndex
+	kvKey        string
+	matchAccount func(balance *store.NormalizedAccountBalance) (key []byte, value []byte, err error)
+	matchKv      func(kv *encodedKVRecordV6) (key []byte, value []byte)
+	key          []byte
+	value        []byte
+}
+
+// CatchpointAccountProof returns a proof for the base account data of the given address, as captured by
+// the catchpoint file of the given round.
+func (l *Ledger) CatchpointAccountProof(round basics.Round, addr basics.Address) (CatchpointProof, error) {
+	entry := catchpointProofEntry{
+		addr: addr,
+		matchAccount: func(balance *store.NormalizedAccountBalance) ([]byte, []byte, error) {
+			if balance.Address != addr || balance.PartialBalance {
+				return nil, nil, nil
+			}
+			return balance.AccountHashes[0], balance.EncodedAccountData, nil
+		},
+	}
+	return l.catchpointProof(round, &entry)
+}
+
+// CatchpointResourceProof returns a proof for the asset or application resource data of the given address,
+// as captured by the catchpoint file of the given round.
+func (l *Ledger) CatchpointResourceProof(round basics.Round, addr basics.Address, cidx basics.CreatableIndex) (CatchpointProof, error) {
+	entry := catchpointProofEntry{
+		addr: addr,
+		cidx: cidx,
+		matchAccount: func(balance *store.NormalizedAccountBalance) ([]byte, []byte, error) {
+			if balance.Address != addr {
+				return nil, nil, nil
+			}
+			resData, ok := balance.Resources[cidx]
+			if !ok {
+				return nil, nil, nil
+			}
+			encoded := balance.EncodedResources[cidx]
+			key, err := store.ResourcesHashBuilderV6(&resData, addr, cidx, resData.UpdateRound, encoded)
+			return key, encoded, err
+		},
+	}
+	return l.catchpointProof(round, &entry)
+}
+
+// CatchpointKvProof returns a proof for the key-value pair with the given key, as captured by the catchpoint
+// file of the given round.
+func (l *Ledger) CatchpointKvProof(round basics.Round, key string) (CatchpointProof, error) {
+	entry := catchpointProofEntry{
+		kvKey: key,
+		matchKv: func(kv *encodedKVRecordV6) ([]byte, []byte) {
+			if string(kv.Key) != key {
+				return nil, nil
+			}
+			return store.KvHashBuilderV6(key, kv.Value), kv.Value
+		},
+	}
+	return l.catchpointProof(round, &entry)
+}
+
+func (l *Ledger) catchpointProof(round basics.Round, entry *catchpointProofEntry) (CatchpointProof, error) {
+	l.catchpointProofs.mu.Lock()
+	defer l.catchpointProofs.mu.Unlock()
+
+	var trie *merkletrie.Trie
+	cached := l.catchpointProofs.last
+	if cached == nil || cached.round != round {
+		var err error
+		trie, err = merkletrie.MakeTrie(nil, store.TrieMemoryConfig)
+		if err != nil {
+			return CatchpointProof{}, err
+		}
+		// drop the previous trie before rebuilding, so that we don't hold two of them in memory.
+		l.catchpointProofs.last = nil
+		cached = &catchpointProofTrie{round: round, trie: trie}
+	}
+
+	header, err := l.scanCatchpointFile(round, entry, trie)
+	if err != nil {
+		return CatchpointProof{}, err
+	}
+	if trie != nil {
+		root, err := trie.RootHash()
+		if err != nil {
+			return CatchpointProof{}, err
+		}
+		_, labelHash, err := ledgercore.ParseCatchpointLabel(header.Catchpoint)
+		if err != nil {
+			return CatchpointProof{}, err
+		}
+		if ledgercore.MakeCatchpointLabel(header.BlocksRound, header.BlockHeaderDigest, root, header.Totals).Hash() != labelHash {
+			return CatchpointProof{}, ErrCatchpointProofLabelMismatch
+		}
+		cached.header = header
+		l.catchpointProofs.last = cached
+	}
+	if entry.key == nil {
+		return CatchpointProof{}, ErrCatchpointProofEntryNotFound
+	}
+
+	proof, found, err := cached.trie.Prove(entry.key)
+	if err != nil {
+		return CatchpointProof{}, err
+	}
+	if !found {
+		// the entry was read from the very same catchpoint file the trie was built from.
+		return CatchpointProof{}, fmt.Errorf("catchpoint entry %x is missing from the rebuilt balances trie", entry.key)
+	}
+	root, err := cached.trie.RootHash()
+	if err != nil {
+		return CatchpointProof{}, err
+	}
+	return CatchpointProof{
+		Catchpoint:        cached.header.Catchpoint,
+		BalancesRound:     cached.header.BalancesRound,
+		BlockHeaderDigest: cached.header.BlockHeaderDigest,
+		BalancesRoot:      root,
+		Totals:            cached.header.Totals,
+		Address:           entry.addr,
+		CreatableIndex:    entry.cidx,
+		KvKey:             entry.kvKey,
+		Key:               entry.key,
+		Value:             entry.value,
+		Proof:             proof,
+	}, nil
+}
+
+// scanCatchpointFile reads the catchpoint file of the given round, looking for the requested entry. When a trie is
+// provided, all the catchpoint entries are added to it.
+func (l *Ledger) scanCatchpointFile(round basics.Round, entry *catchpointProofEntry, trie *merkletrie.Trie) (header CatchpointFileHeader, err error) {
+	stream, err := l.GetCatchpointStream(round)
+	if err != nil {
+		return CatchpointFileHeader{}, err
+	}
+	defer stream.Close()
+
+	gzipReader, err := gzip.NewReader(stream)
+	if err != nil {
+		return CatchpointFileHeader{}, err
+	}
+	defer gzipReader.Close()
+
+	trieElements := 0
+	addToTrie := func(key []byte) error {
+		if trie == nil {
+			return nil
+		}
+		added, err := trie.Add(key)
+		if err != nil {
+			return err
+		}
+		if !added {
+			return fmt.Errorf("catchpoint file for round %d contains a duplicate entry %x", round, key)
+		}
+		trieElements++
+		if trieElements%trieRebuildCommitFrequency == 0 {
+			_, err = trie.Evict(true)
+		}
+		return err
+	}
+
+	seenHeader := false
+	tarReader := tar.NewReader(gzipReader)
+	for {
+		tarHeader, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return CatchpointFileHeader{}, err
+		}
+		if tarHeader.Size > maxCatchpointFileSectionSize || tarHeader.Size < 1 {
+			return CatchpointFileHeader{}, fmt.Errorf("catchpoint file for round %d has a section %s with data size of %d", round, tarHeader.Name, tarHeader.Size)
+		}
+		sectionBytes := make([]byte, tarHeader.Size)
+		_, err = io.ReadFull(tarReader, sectionBytes)
+		if err != nil {
+			return CatchpointFileHeader{}, err
+		}
+
+		if tarHeader.Name == "content.msgpack" {
+			err = protocol.Decode(sectionBytes, &header)
+			if err != nil {
+				return CatchpointFileHeader{}, err
+			}
+			if header.Version != CatchpointFileVersionV6 {
+				return CatchpointFileHeader{}, fmt.Errorf("catchpoint file for round %d has an unsupported version %d", round, header.Version)
+			}
+			seenHeader = true
+			continue
+		}
+		if !strings.HasPrefix(tarHeader.Name, "balances.") || !strings.HasSuffix(tarHeader.Name, ".msgpack") {
+			continue
+		}
+		if !seenHeader {
+			return CatchpointFileHeader{}, fmt.Errorf("catchpoint file for round %d is missing its content section", round)
+		}
+
+		var chunk catchpointFileChunkV6
+		err = protocol.Decode(sectionBytes, &chunk)
+		if err != nil {
+			return CatchpointFileHeader{}, err
+		}
+		balances, err := prepareNormalizedBalancesV6(chunk.Balances, l.GenesisProto())
+		if err != nil {
+			return CatchpointFileHeader{}, err
+		}
+		for i := range balances {
+			for _, key := range balances[i].AccountHashes {
+				if err = addToTrie(key); err != nil {
+					return CatchpointFileHeader{}, err
+				}
+			}
+			if entry.matchAccount != nil && entry.key == nil {
+				entry.key, entry.value, err = entry.matchAccount(&balances[i])
+				if err != nil {
+					return CatchpointFileHeader{}, err
+				}
+			}
+		}
+		for i := range chunk.KVs {
+			key := store.KvHashBuilderV6(string(chunk.KVs[i].Key), chunk.KVs[i].Value)
+			if err = addToTrie(key); err != nil {
+				return CatchpointFileHeader{}, err
+			}
+			if entry.matchKv != nil && entry.key == nil {
+				entry.key, entry.value = entry.matchKv(&chunk.KVs[i])
+			}
+		}
+		if trie == nil && entry.key != nil {
+			// the trie is already cached, so there is no need to read the rest of the file.
+			break
+		}
+	}
+	if !seenHeader {
+		return CatchpointFileHeader{}, fmt.Errorf("catchpoint file for round %d is missing its content section", round)
+	}
+	return header, nil
+}
@@ -0,0 +1,152 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/crypto/merkletrie"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/data/txntest"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	"github.com/algorand/go-algorand/ledger/store"
+	ledgertesting "github.com/algorand/go-algorand/ledger/testing"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestCatchpointProofs(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	genBalances, addrs, _ := ledgertesting.NewTestGenesis()
+	dl := NewDoubleLedger(t, genBalances, protocol.ConsensusFuture)
+	defer dl.Close()
+
+	boxApp := dl.fundedApp(addrs[1], 1_000_000, boxAppSource)
+	makeBox := txntest.Txn{
+		Type:          "appl",
+		Sender:        addrs[2],
+		ApplicationID: boxApp,
+	}.Args("create", "xxx")
+	makeBox.Boxes = []transactions.BoxRef{{Index: 0, Name: []byte("xxx")}}
+	dl.fullBlock(makeBox)
+
+	// have the accounts committed to the database.
+	pay := txntest.Txn{
+		Type:     "pay",
+		Sender:   addrs[0],
+		Receiver: addrs[3],
+		Amount:   100000,
+	}
+	for i := 0; i < 40; i++ {
+		dl.fullBlock(pay.Noted(strconv.Itoa(i)))
+	}
+
+	tempDir := t.TempDir()
+	catchpointDataFilePath := filepath.Join(tempDir, t.Name()+".data")
+	unlabeledCatchpointFilePath := filepath.Join(tempDir, t.Name()+".catchpoint.tar.gz")
+	header := testWriteCatchpoint(t, dl.generator.trackerDB().Rdb, catchpointDataFilePath, unlabeledCatchpointFilePath, 0)
+
+	// calculate the balances root the way catchpoint catchup does, so that the catchpoint could be labeled correctly.
+	restored := testNewLedgerFromCatchpoint(t, dl.generator.trackerDB().Rdb, unlabeledCatchpointFilePath)
+	var balancesRoot crypto.Digest
+	err := restored.trackerDBs.Rdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		committer, err := store.MakeMerkleCommitter(tx, false)
+		if err != nil {
+			return err
+		}
+		trie, err := merkletrie.MakeTrie(committer, store.TrieMemoryConfig)
+		if err != nil {
+			return err
+		}
+		balancesRoot, err = trie.RootHash()
+		return err
+	})
+	require.NoError(t, err)
+	restored.Close()
+
+	header.Catchpoint = ledgercore.MakeCatchpointLabel(header.BlocksRound, header.BlockHeaderDigest, balancesRoot, header.Totals).String()
+	l := dl.generator
+	l.catchpoint.dbDirectory = tempDir
+	catchpointFilePath := filepath.Join(tempDir, store.CatchpointDirName, store.MakeCatchpointFilePath(header.BlocksRound))
+	require.NoError(t, os.MkdirAll(filepath.Dir(catchpointFilePath), 0700))
+	require.NoError(t, repackCatchpoint(context.Background(), header, 1<<20, catchpointDataFilePath, catchpointFilePath))
+
+	verify := func(proof CatchpointProof) {
+		require.Equal(t, header.Catchpoint, proof.Catchpoint)
+		require.Equal(t, header.BalancesRound, proof.BalancesRound)
+		require.Equal(t, balancesRoot, proof.BalancesRoot)
+		require.NoError(t, proof.Verify())
+
+		// a proof is bound to its key.
+		tampered := proof
+		tampered.Key = append([]byte{}, proof.Key...)
+		tampered.Key[len(tampered.Key)-1] ^= 1
+		require.ErrorIs(t, tampered.Verify(), ErrCatchpointProofValueMismatch)
+
+		// and to its value.
+		tampered = proof
+		tampered.Value = append([]byte{}, proof.Value...)
+		tampered.Value[len(tampered.Value)-1] ^= 1
+		require.Error(t, tampered.Verify())
+
+		// and to the catchpoint label.
+		tampered = proof
+		tampered.Totals.RewardsLevel++
+		require.ErrorIs(t, tampered.Verify(), ErrCatchpointProofLabelMismatch)
+	}
+
+	proof, err := l.CatchpointAccountProof(header.BlocksRound, addrs[0])
+	require.NoError(t, err)
+	verify(proof)
+	var accountData store.BaseAccountData
+	require.NoError(t, protocol.Decode(proof.Value, &accountData))
+	require.Equal(t, proof.Key, store.AccountHashBuilderV6(addrs[0], &accountData, proof.Value))
+
+	proof, err = l.CatchpointResourceProof(header.BlocksRound, addrs[1], basics.CreatableIndex(boxApp))
+	require.NoError(t, err)
+	verify(proof)
+	var resourceData store.ResourcesData
+	require.NoError(t, protocol.Decode(proof.Value, &resourceData))
+	require.True(t, resourceData.IsApp())
+
+	proof, err = l.CatchpointKvProof(header.BlocksRound, logic.MakeBoxKey(boxApp, "xxx"))
+	require.NoError(t, err)
+	verify(proof)
+	require.Equal(t, strings.Repeat("\x00", 24), string(proof.Value))
+	tampered := proof
+	tampered.Value = []byte(strings.Repeat("\x01", 24))
+	require.ErrorIs(t, tampered.Verify(), ErrCatchpointProofValueMismatch)
+
+	_, err = l.CatchpointAccountProof(header.BlocksRound, ledgertesting.RandomAddress())
+	require.ErrorIs(t, err, ErrCatchpointProofEntryNotFound)
+
+	_, err = l.CatchpointAccountProof(header.BlocksRound+1, addrs[0])
+	require.ErrorIs(t, err, ledgercore.ErrNoEntry{})
+}
@@ -22,6 +22,7 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"path/filepath"
 	"strings"
 	"sync"
 	"time"
@@ -91,6 +92,10 @@ type CatchpointCatchupAccessor interface {
 
 	// Ledger returns a narrow subset of Ledger methods needed by CatchpointCatchupAccessor clients
 	Ledger() (l CatchupAccessorClientLedger)
+
+	// CatchpointFileDownloadPath returns the path the catchpoint file of the given round is downloaded to, so that an
+	// interrupted download could be resumed.
+	CatchpointFileDownloadPath(round basics.Round) string
 }
 
 type stagingWriter interface {
@@ -1104,6 +1109,11 @@ func (c *catchpointCatchupAccessorImpl) Ledger() (l CatchupAccessorClientLedger)
 	return c.ledger
 }
 
+// CatchpointFileDownloadPath returns the path the catchpoint file of the given round is downloaded to
+func (c *catchpointCatchupAccessorImpl) CatchpointFileDownloadPath(round basics.Round) string {
+	return filepath.Join(filepath.Dir(c.ledger.dbPathPrefix), fmt.Sprintf("catchpoint.%d.download", round))
+}
+
 var ledgerResetstagingbalancesCount = metrics.NewCounter("ledger_catchup_resetstagingbalances_count", "calls")
 var ledgerResetstagingbalancesMicros = metrics.NewCounter("ledger_catchup_resetstagingbalances_micros", "µs spent")
 var ledgerProcessstagingcontentCount = metrics.NewCounter("ledger_catchup_processstagingcontent_count", "calls")
@@ -450,6 +450,42 @@ func MakeDebugBalances(l LedgerForCowBase, round basics.Round, proto protocol.Co
 	return cb
 }
 
+// ReplayBalances is a ledger for replaying the transactions of a block
+// outside of the block evaluation.
+type ReplayBalances interface {
+	apply.Balances
+
+	// Replay applies the transaction at gi in the group of ep as a
+	// top-level transaction of the block, and returns its ApplyData.
+	Replay(gi int, ep *logic.EvalParams) (transactions.ApplyData, error)
+
+	// CheckMinBalance checks the minimum balances of the accounts modified
+	// so far, as the block evaluator does after each transaction group.
+	CheckMinBalance() error
+
+	// Deltas returns the changes made by the replayed transactions. It
+	// must be called only once, after the last transaction.
+	Deltas() ledgercore.StateDelta
+}
+
+// MakeReplayBalances creates a ledger for replaying transactions of the block
+// with header hdr, after txnCounter transactions have been evaluated.
+func MakeReplayBalances(l LedgerForCowBase, hdr bookkeeping.BlockHeader, prevTimestamp int64, txnCounter uint64) ReplayBalances {
+	proto := config.Consensus[hdr.CurrentProtocol]
+	base := makeRoundCowBase(l, hdr.Round-1, txnCounter, basics.Round(0), proto)
+	// passing an empty AccountTotals here is fine since it's only being used by the top level cow state object.
+	cs := makeRoundCowState(base, hdr, proto, prevTimestamp, ledgercore.AccountTotals{}, 2)
+	eval := &BlockEvaluator{
+		proto: proto,
+		block: bookkeeping.Block{BlockHeader: hdr},
+		specials: transactions.SpecialAddresses{
+			FeeSink:     hdr.FeeSink,
+			RewardsPool: hdr.RewardsPool,
+		},
+	}
+	return &replayBalances{roundCowState: cs, eval: eval}
+}
+
 // StatefulEval runs application.  Execution happens in a child cow and all
 // modifications are merged into parent and the ApplyData in params[gi] is
 // filled if the program passes.
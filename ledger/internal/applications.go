@@ -266,6 +266,37 @@ func (cs *roundCowState) SetBox(appIdx basics.AppIndex, key string, value []byte
 	return cs.kvPut(fullKey, value)
 }
 
+// ResizeBox replaces the contents of an existing box with value, which may be a
+// different size. The box bytes of appAddr change by the difference in size.
+func (cs *roundCowState) ResizeBox(appIdx basics.AppIndex, key string, value []byte, appAddr basics.Address) error {
+	size := uint64(len(value))
+	if size > cs.proto.MaxBoxSize {
+		return fmt.Errorf("box size too large: %d, maximum is %d", size, cs.proto.MaxBoxSize)
+	}
+
+	fullKey := logic.MakeBoxKey(appIdx, key)
+	old, ok, err := cs.kvGet(fullKey)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return fmt.Errorf("box %s does not exist for %d", key, appIdx)
+	}
+
+	record, err := cs.Get(appAddr, false)
+	if err != nil {
+		return err
+	}
+	record.TotalBoxBytes = basics.SubSaturate(record.TotalBoxBytes, uint64(len(old)))
+	record.TotalBoxBytes = basics.AddSaturate(record.TotalBoxBytes, size)
+	err = cs.Put(appAddr, record)
+	if err != nil {
+		return err
+	}
+
+	return cs.kvPut(fullKey, value)
+}
+
 func (cs *roundCowState) DelBox(appIdx basics.AppIndex, key string, appAddr basics.Address) (bool, error) {
 	fullKey := logic.MakeBoxKey(appIdx, key)
 
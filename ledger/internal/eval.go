@@ -953,6 +953,11 @@ func (eval *BlockEvaluator) transactionGroup(txgroup []transactions.SignedTxnWit
 	defer cow.recycle()
 
 	evalParams := logic.NewEvalParams(txgroup, &eval.proto, &eval.specials)
+	if eval.generate {
+		// report how the app calls spent the group budget when the group is
+		// rejected from the pool, not when validating blocks
+		evalParams.RecordBudgetUses()
+	}
 
 	// Evaluate each transaction in the group
 	txibs = make([]transactions.SignedTxnInBlock, 0, len(txgroup))
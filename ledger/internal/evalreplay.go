@@ -0,0 +1,279 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package internal
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+)
+
+// replayRefs collects the parts of the state that a transaction group refers to.
+type replayRefs struct {
+	accounts map[basics.Address]bool
+	apps     map[basics.AppIndex]bool
+	assets   map[basics.AssetIndex]bool
+	boxes    map[string]bool
+}
+
+func (refs *replayRefs) addAccount(addr basics.Address) {
+	if !addr.IsZero() {
+		refs.accounts[addr] = true
+	}
+}
+
+func (refs *replayRefs) addApp(aidx basics.AppIndex) {
+	if aidx != 0 {
+		refs.apps[aidx] = true
+		refs.accounts[aidx.Address()] = true
+	}
+}
+
+func (refs *replayRefs) addAsset(aidx basics.AssetIndex) {
+	if aidx != 0 {
+		refs.assets[aidx] = true
+	}
+}
+
+// addTxn adds the references of a transaction and its inner transactions.
+func (refs *replayRefs) addTxn(txn *transactions.Transaction, ad *transactions.ApplyData) {
+	refs.addAccount(txn.Sender)
+	refs.addAccount(txn.RekeyTo)
+	refs.addAccount(txn.Receiver)
+	refs.addAccount(txn.CloseRemainderTo)
+	refs.addAccount(txn.AssetSender)
+	refs.addAccount(txn.AssetReceiver)
+	refs.addAccount(txn.AssetCloseTo)
+	refs.addAccount(txn.FreezeAccount)
+	refs.addAccount(txn.AssetParams.Manager)
+	refs.addAccount(txn.AssetParams.Reserve)
+	refs.addAccount(txn.AssetParams.Freeze)
+	refs.addAccount(txn.AssetParams.Clawback)
+	refs.addAsset(txn.XferAsset)
+	refs.addAsset(txn.ConfigAsset)
+	refs.addAsset(txn.FreezeAsset)
+	for _, addr := range txn.Accounts {
+		refs.addAccount(addr)
+	}
+	refs.addApp(txn.ApplicationID)
+	for _, aidx := range txn.ForeignApps {
+		refs.addApp(aidx)
+	}
+	for _, aidx := range txn.ForeignAssets {
+		refs.addAsset(aidx)
+	}
+	for _, br := range txn.Boxes {
+		aidx := txn.ApplicationID
+		if br.Index > 0 && br.Index <= uint64(len(txn.ForeignApps)) {
+			aidx = txn.ForeignApps[br.Index-1]
+		}
+		if aidx != 0 {
+			refs.boxes[logic.MakeBoxKey(aidx, string(br.Name))] = true
+		}
+	}
+	for i := range ad.EvalDelta.InnerTxns {
+		inner := &ad.EvalDelta.InnerTxns[i]
+		refs.addTxn(&inner.Txn, &inner.ApplyData)
+	}
+}
+
+// ProcessBlockForReplay evaluates the transaction groups of the block that
+// come before the group of the transaction txid, and returns the state that
+// group was evaluated against.
+func (eval *BlockEvaluator) ProcessBlockForReplay(block *bookkeeping.Block, txid transactions.Txid) (ledgercore.ReplayState, error) {
+	paysetgroups, err := block.DecodePaysetGroups()
+	if err != nil {
+		return ledgercore.ReplayState{}, fmt.Errorf("ProcessBlockForReplay() err: %w", err)
+	}
+
+	var group []transactions.SignedTxnWithAD
+	var groupIndex int
+	var earlier [][]transactions.SignedTxnWithAD
+	for i, txgroup := range paysetgroups {
+		for gi := range txgroup {
+			if txgroup[gi].Txn.ID() == txid {
+				group, groupIndex, earlier = txgroup, gi, paysetgroups[:i]
+				break
+			}
+		}
+		if group != nil {
+			break
+		}
+	}
+	if group == nil {
+		return ledgercore.ReplayState{}, ledgercore.ErrTxnNotInBlock{Txid: txid, Round: block.Round()}
+	}
+
+	for _, txgroup := range earlier {
+		err = eval.TransactionGroup(txgroup)
+		if err != nil {
+			return ledgercore.ReplayState{}, fmt.Errorf("ProcessBlockForReplay() err: %w", err)
+		}
+	}
+	// Move the changes the earlier groups made to application storage into
+	// the account data, where the lookups below read them. The evaluator
+	// evaluates nothing after this.
+	eval.state.deltas()
+
+	refs := replayRefs{
+		accounts: map[basics.Address]bool{block.FeeSink: true},
+		apps:     make(map[basics.AppIndex]bool),
+		assets:   make(map[basics.AssetIndex]bool),
+		boxes:    make(map[string]bool),
+	}
+	for i := range group {
+		refs.addTxn(&group[i].Txn, &group[i].ApplyData)
+	}
+	for aidx := range refs.apps {
+		creator, ok, err := eval.state.GetCreator(basics.CreatableIndex(aidx), basics.AppCreatable)
+		if err != nil {
+			return ledgercore.ReplayState{}, err
+		}
+		if ok {
+			refs.addAccount(creator)
+		}
+	}
+	for aidx := range refs.assets {
+		creator, ok, err := eval.state.GetCreator(basics.CreatableIndex(aidx), basics.AssetCreatable)
+		if err != nil {
+			return ledgercore.ReplayState{}, err
+		}
+		if ok {
+			refs.addAccount(creator)
+		}
+	}
+
+	state := ledgercore.ReplayState{
+		Header:        block.BlockHeader,
+		PrevTimestamp: eval.prevHeader.TimeStamp,
+		TxnCounter:    eval.state.Counter(),
+		Group:         group,
+		GroupIndex:    uint64(groupIndex),
+		Accounts:      make([]ledgercore.ReplayAccount, 0, len(refs.accounts)),
+		Boxes:         make(map[string][]byte),
+	}
+	for addr := range refs.accounts {
+		record, err := eval.replayRecord(addr, &refs)
+		if err != nil {
+			return ledgercore.ReplayState{}, err
+		}
+		state.Accounts = append(state.Accounts, record)
+	}
+	sort.Slice(state.Accounts, func(i, j int) bool {
+		return bytes.Compare(state.Accounts[i].Addr[:], state.Accounts[j].Addr[:]) < 0
+	})
+	for key := range refs.boxes {
+		value, ok, err := eval.state.kvGet(key)
+		if err != nil {
+			return ledgercore.ReplayState{}, err
+		}
+		if ok {
+			state.Boxes[key] = value
+		}
+	}
+	return state, nil
+}
+
+// replayRecord reads an account, with the referenced applications and assets
+// it holds, from the state of the evaluation.
+func (eval *BlockEvaluator) replayRecord(addr basics.Address, refs *replayRefs) (ledgercore.ReplayAccount, error) {
+	acct, err := eval.state.Get(addr, false)
+	if err != nil {
+		return ledgercore.ReplayAccount{}, err
+	}
+	record := ledgercore.ReplayAccount{Addr: addr, AccountData: acct}
+
+	for aidx := range refs.apps {
+		params, ok, err := eval.state.GetAppParams(addr, aidx)
+		if err != nil {
+			return ledgercore.ReplayAccount{}, err
+		}
+		if ok {
+			if record.AppParams == nil {
+				record.AppParams = make(map[basics.AppIndex]basics.AppParams)
+			}
+			record.AppParams[aidx] = params
+		}
+		local, ok, err := eval.state.GetAppLocalState(addr, aidx)
+		if err != nil {
+			return ledgercore.ReplayAccount{}, err
+		}
+		if ok {
+			if record.AppLocalStates == nil {
+				record.AppLocalStates = make(map[basics.AppIndex]basics.AppLocalState)
+			}
+			record.AppLocalStates[aidx] = local
+		}
+	}
+	for aidx := range refs.assets {
+		params, ok, err := eval.state.GetAssetParams(addr, aidx)
+		if err != nil {
+			return ledgercore.ReplayAccount{}, err
+		}
+		if ok {
+			if record.AssetParams == nil {
+				record.AssetParams = make(map[basics.AssetIndex]basics.AssetParams)
+			}
+			record.AssetParams[aidx] = params
+		}
+		holding, ok, err := eval.state.GetAssetHolding(addr, aidx)
+		if err != nil {
+			return ledgercore.ReplayAccount{}, err
+		}
+		if ok {
+			if record.Assets == nil {
+				record.Assets = make(map[basics.AssetIndex]basics.AssetHolding)
+			}
+			record.Assets[aidx] = holding
+		}
+	}
+	return record, nil
+}
+
+// replayBalances applies transactions the way a block evaluator that neither
+// validates nor generates the block does.
+type replayBalances struct {
+	*roundCowState
+	eval *BlockEvaluator
+}
+
+// Replay is part of ReplayBalances interface.
+func (rb *replayBalances) Replay(gi int, ep *logic.EvalParams) (transactions.ApplyData, error) {
+	txn := ep.TxnGroup[gi].Txn
+	ad, err := rb.eval.applyTransaction(txn, rb.roundCowState, ep, gi, rb.Counter())
+	if err != nil {
+		return transactions.ApplyData{}, err
+	}
+	rb.addTx(txn, txn.ID())
+	return ad, nil
+}
+
+// CheckMinBalance is part of ReplayBalances interface.
+func (rb *replayBalances) CheckMinBalance() error {
+	return rb.eval.checkMinBalance(rb.roundCowState)
+}
+
+// Deltas is part of ReplayBalances interface.
+func (rb *replayBalances) Deltas() ledgercore.StateDelta {
+	return rb.deltas()
+}
@@ -72,6 +72,8 @@ type Ledger struct {
 
 	genesisAccounts map[basics.Address]basics.AccountData
 
+	genesisBoxes map[string][]byte
+
 	genesisProto        config.ConsensusParams
 	genesisProtoVersion protocol.ConsensusVersion
 
@@ -89,9 +91,15 @@ type Ledger struct {
 
 	headerCache blockHeaderCache
 
+	// catchpointProofs retains the balances trie last rebuilt for producing catchpoint proofs
+	catchpointProofs catchpointProofCache
+
 	// verifiedTxnCache holds all the verified transactions state
 	verifiedTxnCache verify.VerifiedTransactionCache
 
+	// persistentTxnCache is the verifiedTxnCache when it is persisted across restarts, or nil otherwise
+	persistentTxnCache *verify.PersistentVerifiedTransactionCache
+
 	cfg config.Local
 
 	dbPathPrefix string
@@ -116,6 +124,7 @@ func OpenLedger(
 		archival:                       cfg.Archival,
 		genesisHash:                    genesisInitState.GenesisHash,
 		genesisAccounts:                genesisInitState.Accounts,
+		genesisBoxes:                   genesisInitState.Boxes,
 		genesisProto:                   config.Consensus[genesisInitState.Block.CurrentProtocol],
 		genesisProtoVersion:            genesisInitState.Block.CurrentProtocol,
 		synchronousMode:                db.SynchronousMode(cfg.LedgerSynchronousMode),
@@ -133,6 +142,17 @@ func OpenLedger(
 		}
 	}()
 
+	if cfg.EnablePersistentVerifiedTxnCache && !dbMem {
+		cache, cacheErr := verify.MakePersistentVerifiedTransactionCache(verifiedCacheSize, dbPathPrefix+".verifiedtxns.sqlite", log)
+		if cacheErr != nil {
+			log.Warnf("OpenLedger: unable to open the persistent verified transactions cache, using an in-memory one instead: %v", cacheErr)
+		} else {
+			l.verifiedTxnCache = cache
+			l.persistentTxnCache = cache
+			l.notifier.register([]BlockListener{cache})
+		}
+	}
+
 	l.trackerDBs, l.blockDBs, err = openLedgerDB(dbPathPrefix, dbMem)
 	if err != nil {
 		err = fmt.Errorf("OpenLedger.openLedgerDB %v", err)
@@ -174,13 +194,68 @@ func (l *Ledger) ReloadLedger() error {
 	return l.reloadLedger()
 }
 
+// Rollback reverts the ledger to the given round, removing all the blocks that follow it and reloading the
+// trackers state as of that round. The round is bounded by the state deltas the ledger keeps in memory, which start
+// at the round of the tracker database, unless replay is set. Then, when the tracker database has already been
+// committed beyond the given round, it is rebuilt by replaying all the blocks since genesis, which requires all of
+// them to still be available. Otherwise, Rollback returns a ledgercore.ErrRollbackBeyondDeltas.
+// Rollback doesn't synchronize with the block writers; the caller must make sure no blocks are being added concurrently.
+func (l *Ledger) Rollback(rnd basics.Round, replay bool) error {
+	latest := l.Latest()
+	if rnd > latest {
+		return fmt.Errorf("Rollback: round %d is beyond the latest round %d", rnd, latest)
+	}
+	if rnd == latest {
+		return nil
+	}
+
+	l.trackers.mu.RLock()
+	dbRound := l.trackers.dbRound
+	l.trackers.mu.RUnlock()
+	if rnd < dbRound && !replay {
+		return ledgercore.ErrRollbackBeyondDeltas{Round: rnd, Earliest: dbRound}
+	}
+
+	// make sure all the blocks were flushed to disk, so that the truncation below would cover all of them,
+	// and stop the syncer from writing any further blocks.
+	l.WaitForCommit(latest)
+	l.blockQ.close()
+
+	err := l.blockDBs.Wdb.Atomic(func(ctx context.Context, tx *sql.Tx) error {
+		if rnd < dbRound {
+			earliest, err := blockdb.BlockEarliest(tx)
+			if err != nil {
+				return err
+			}
+			if earliest > 0 {
+				return fmt.Errorf("Rollback: round %d precedes the tracker database round %d, and the blocks preceding round %d are no longer available", rnd, dbRound, earliest)
+			}
+		}
+		return blockdb.BlockForgetAfter(tx, rnd)
+	})
+	if err != nil {
+		// nothing was truncated, so resume the block queue where it was stopped.
+		blockQ, bqErr := bqInit(l)
+		if bqErr != nil {
+			l.log.Errorf("Rollback: unable to resume the block queue: %v", bqErr)
+			return err
+		}
+		l.blockQ = blockQ
+		return err
+	}
+
+	l.headerCache.reset()
+	l.catchpointProofs.reset()
+
+	return l.reloadLedger()
+}
+
 func (l *Ledger) reloadLedger() error {
 	// similar to the Close function, we want to start by closing the blockQ first. The
 	// blockQ is having a sync goroutine which indirectly calls other trackers. We want to eliminate that go-routine first,
-	// and follow up by taking the trackers lock.
+	// and follow up by taking the trackers lock. The closed blockQ keeps serving the persisted blocks until it's replaced below.
 	if l.blockQ != nil {
 		l.blockQ.close()
-		l.blockQ = nil
 	}
 
 	// take the trackers lock. This would ensure that no other goroutine is using the trackers.
@@ -392,6 +467,12 @@ func (l *Ledger) Close() {
 	// then, we shut down the trackers and their corresponding goroutines.
 	l.trackers.close()
 
+	// now that no more blocks are notified, persist the verified transactions cache.
+	if l.persistentTxnCache != nil {
+		l.persistentTxnCache.Close()
+		l.persistentTxnCache = nil
+	}
+
 	// last, we close the underlying database connections.
 	l.blockDBs.Close()
 	l.trackerDBs.Close()
@@ -730,6 +811,11 @@ func (l *Ledger) GenesisAccounts() map[basics.Address]basics.AccountData {
 	return l.genesisAccounts
 }
 
+// GenesisBoxes returns the initial boxes for this ledger, keyed by their key/value store keys.
+func (l *Ledger) GenesisBoxes() map[string][]byte {
+	return l.genesisBoxes
+}
+
 // BlockHdrCached returns the block header if available.
 // Expected availability range is [Latest - MaxTxnLife, Latest]
 // allowing (MaxTxnLife + 1) = 1001 rounds back loopback.
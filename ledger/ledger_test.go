@@ -24,6 +24,7 @@ import (
 	"fmt"
 	"math/rand"
 	"os"
+	"path/filepath"
 	"runtime"
 	"sort"
 	"testing"
@@ -40,6 +41,7 @@ import (
 	"github.com/algorand/go-algorand/data/transactions"
 	"github.com/algorand/go-algorand/data/transactions/logic"
 	"github.com/algorand/go-algorand/data/transactions/verify"
+	"github.com/algorand/go-algorand/data/txntest"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
 	"github.com/algorand/go-algorand/ledger/store"
 	ledgertesting "github.com/algorand/go-algorand/ledger/testing"
@@ -209,6 +211,107 @@ func TestLedgerBasic(t *testing.T) {
 	defer l.Close()
 }
 
+// TestLedgerGenesisCreatables checks that assets, applications and boxes allocated by the genesis
+// are indexed by the ledger as if they were created by transactions.
+func TestLedgerGenesisCreatables(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	genesisInitState, _ := ledgertesting.GenerateInitState(t, protocol.ConsensusCurrentVersion, 100)
+	var creator basics.Address
+	for addr, ad := range genesisInitState.Accounts {
+		if ad.Status == basics.Online {
+			creator = addr
+			break
+		}
+	}
+	const assetIdx, appIdx = basics.AssetIndex(1), basics.AppIndex(2)
+	ad := genesisInitState.Accounts[creator]
+	ad.AssetParams = map[basics.AssetIndex]basics.AssetParams{assetIdx: {Total: 100}}
+	ad.Assets = map[basics.AssetIndex]basics.AssetHolding{assetIdx: {Amount: 100}}
+	ad.AppParams = map[basics.AppIndex]basics.AppParams{appIdx: {ApprovalProgram: []byte{0x08, 0x81, 0x01}, ClearStateProgram: []byte{0x08, 0x81, 0x01}}}
+	genesisInitState.Accounts[creator] = ad
+	genesisInitState.Accounts[appIdx.Address()] = basics.AccountData{
+		MicroAlgos:    basics.MicroAlgos{Raw: 1000000},
+		TotalBoxes:    1,
+		TotalBoxBytes: 8,
+	}
+	genesisInitState.Boxes = map[string][]byte{
+		logic.MakeBoxKey(appIdx, "box"): []byte("value"),
+	}
+
+	const inMem = true
+	cfg := config.GetDefaultLocal()
+	l, err := OpenLedger(logging.TestingLog(t), t.Name(), inMem, genesisInitState, cfg)
+	require.NoError(t, err)
+	defer l.Close()
+
+	addr, ok, err := l.GetCreator(basics.CreatableIndex(assetIdx), basics.AssetCreatable)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, creator, addr)
+	addr, ok, err = l.GetCreator(basics.CreatableIndex(appIdx), basics.AppCreatable)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, creator, addr)
+
+	value, err := l.LookupKv(0, logic.MakeBoxKey(appIdx, "box"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("value"), value)
+	value, err = l.LookupKv(0, logic.MakeBoxKey(appIdx, "missing"))
+	require.NoError(t, err)
+	require.Nil(t, value)
+}
+
+func TestLedgerPersistentVerifiedTxnCache(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	genesisInitState, initKeys := ledgertesting.GenerateInitState(t, protocol.ConsensusCurrentVersion, 100)
+	const inMem = false
+	cfg := config.GetDefaultLocal()
+	cfg.EnablePersistentVerifiedTxnCache = true
+	dbPrefix := filepath.Join(t.TempDir(), t.Name())
+	l, err := OpenLedger(logging.TestingLog(t), dbPrefix, inMem, genesisInitState, cfg)
+	require.NoError(t, err)
+	require.NotNil(t, l.persistentTxnCache)
+	require.Equal(t, l.persistentTxnCache, l.VerifiedTransactionCache())
+
+	var sender basics.Address
+	for addr := range initKeys {
+		sender = addr
+		break
+	}
+	txn := transactions.Transaction{
+		Type: protocol.PaymentTx,
+		Header: transactions.Header{
+			Sender:      sender,
+			Fee:         basics.MicroAlgos{Raw: 1000},
+			FirstValid:  1,
+			LastValid:   100,
+			GenesisHash: genesisInitState.GenesisHash,
+		},
+		PaymentTxnFields: transactions.PaymentTxnFields{Receiver: sender},
+	}
+	txgroup := []transactions.SignedTxn{txn.Sign(initKeys[sender])}
+	hdr, err := l.BlockHdr(0)
+	require.NoError(t, err)
+	groupCtx, err := verify.PrepareGroupContext(txgroup, hdr, l)
+	require.NoError(t, err)
+	l.VerifiedTransactionCache().Add(txgroup, groupCtx)
+
+	// the verified transactions are persisted once a block is added
+	blk := makeNewEmptyBlock(t, l, t.Name(), genesisInitState.Accounts)
+	require.NoError(t, l.AddBlock(blk, agreement.Certificate{}))
+	l.WaitForCommit(blk.Round())
+	l.Close()
+
+	l, err = OpenLedger(logging.TestingLog(t), dbPrefix, inMem, genesisInitState, cfg)
+	require.NoError(t, err)
+	defer l.Close()
+	specAddrs := transactions.SpecialAddresses{FeeSink: hdr.FeeSink, RewardsPool: hdr.RewardsPool}
+	unverifiedGroups := l.VerifiedTransactionCache().GetUnverifiedTransactionGroups([][]transactions.SignedTxn{txgroup}, specAddrs, hdr.CurrentProtocol)
+	require.Empty(t, unverifiedGroups)
+}
+
 func TestLedgerBlockHeaders(t *testing.T) {
 	partitiontest.PartitionTest(t)
 
@@ -1513,6 +1616,64 @@ func TestLedgerReload(t *testing.T) {
 	}
 }
 
+func TestLedgerRollback(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	genBalances, addrs, _ := ledgertesting.NewTestGenesis()
+	l := newSimpleLedger(t, genBalances)
+	defer l.Close()
+
+	balances := map[basics.Round]uint64{0: micros(t, l, addrs[1])}
+	pay := func() {
+		eval := nextBlock(t, l)
+		txn(t, l, eval, &txntest.Txn{
+			Type:     "pay",
+			Sender:   addrs[0],
+			Receiver: addrs[1],
+			Amount:   1000,
+			Note:     []byte(fmt.Sprintf("%d", eval.Round())),
+		})
+		endBlock(t, l, eval)
+		balances[l.Latest()] = micros(t, l, addrs[1])
+	}
+	for i := 0; i < 40; i++ {
+		pay()
+	}
+	l.trackers.waitAccountsWriting()
+	require.Greater(t, l.trackers.dbRound, basics.Round(10))
+	require.Less(t, l.trackers.dbRound, l.Latest())
+
+	require.Error(t, l.Rollback(l.Latest()+1, false))
+
+	// roll back within the in-memory deltas.
+	rnd := l.Latest() - 1
+	require.NoError(t, l.Rollback(rnd, false))
+	require.Equal(t, rnd, l.Latest())
+	require.Equal(t, balances[rnd], micros(t, l, addrs[1]))
+	_, err := l.Block(rnd + 1)
+	require.Error(t, err)
+
+	// roll back beyond the tracker database round, only when replaying the blocks since genesis.
+	rnd = 5
+	latest := l.Latest()
+	var beyondErr ledgercore.ErrRollbackBeyondDeltas
+	require.ErrorAs(t, l.Rollback(rnd, false), &beyondErr)
+	require.Equal(t, ledgercore.ErrRollbackBeyondDeltas{Round: rnd, Earliest: l.trackers.dbRound}, beyondErr)
+	require.Equal(t, latest, l.Latest())
+	require.NoError(t, l.Rollback(rnd, true))
+	require.Equal(t, rnd, l.Latest())
+	require.Equal(t, balances[rnd], micros(t, l, addrs[1]))
+	hdr, err := l.BlockHdr(rnd)
+	require.NoError(t, err)
+	require.Equal(t, rnd, hdr.Round)
+
+	// the ledger keeps on going from the rolled back round, reproducing the same state.
+	expected := balances[rnd+1]
+	pay()
+	require.Equal(t, rnd+1, l.Latest())
+	require.Equal(t, expected, micros(t, l, addrs[1]))
+}
+
 // TestGetLastCatchpointLabel tests ledger.GetLastCatchpointLabel is returning the correct value.
 func TestGetLastCatchpointLabel(t *testing.T) {
 	partitiontest.PartitionTest(t)
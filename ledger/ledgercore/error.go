@@ -120,6 +120,18 @@ func (err ErrNonSequentialBlockEval) Error() string {
 	return fmt.Sprintf("block evaluation for round %d requires sequential evaluation while the latest round is %d", err.EvaluatorRound, err.LatestRound)
 }
 
+// ErrRollbackBeyondDeltas is returned when rolling the ledger back to a round before the state deltas it keeps in
+// memory, without replaying the blocks since genesis.
+type ErrRollbackBeyondDeltas struct {
+	Round    basics.Round // Round is the round to roll back to
+	Earliest basics.Round // Earliest is the earliest round the ledger could be rolled back to
+}
+
+// Error satisfies builtin interface `error`
+func (err ErrRollbackBeyondDeltas) Error() string {
+	return fmt.Sprintf("cannot roll back to round %d, before round %d, the earliest one with its state deltas in memory", err.Round, err.Earliest)
+}
+
 // TxGroupMalformedErrorReasonCode is a reason code for TxGroupMalformed
 //msgp:ignore TxGroupMalformedErrorReasonCode
 type TxGroupMalformedErrorReasonCode int
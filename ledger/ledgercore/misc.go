@@ -27,4 +27,6 @@ type InitState struct {
 	Block       bookkeeping.Block
 	Accounts    map[basics.Address]basics.AccountData
 	GenesisHash crypto.Digest
+	// Boxes are the initial key/value pairs of the applications' boxes
+	Boxes map[string][]byte
 }
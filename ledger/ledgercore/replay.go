@@ -0,0 +1,93 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledgercore
+
+import (
+	"fmt"
+
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/transactions"
+)
+
+// ReplayState is the state of the ledger that a transaction group of a block
+// was evaluated against, that is the state at the end of the previous round
+// with the changes of the earlier groups of the block. It holds only the parts
+// of the state that the group, including its inner transactions, refers to.
+type ReplayState struct {
+	// Header is the header of the block that contains the group.
+	Header bookkeeping.BlockHeader `codec:"hdr"`
+
+	// PrevTimestamp is the timestamp of the previous block.
+	PrevTimestamp int64 `codec:"prevts"`
+
+	// TxnCounter is the number of transactions, including inner
+	// transactions, that were evaluated before the group.
+	TxnCounter uint64 `codec:"tc"`
+
+	// Group is the transaction group with the ApplyData recorded in the block.
+	Group []transactions.SignedTxnWithAD `codec:"txns"`
+
+	// GroupIndex is the index of the requested transaction in Group.
+	GroupIndex uint64 `codec:"gi"`
+
+	// Accounts are the accounts the group refers to.
+	Accounts []ReplayAccount `codec:"accts"`
+
+	// Boxes are the contents of the existing boxes the group refers to,
+	// by their key in the ledger.
+	Boxes map[string][]byte `codec:"kvs"`
+}
+
+// ReplayAccount is an account of a ReplayState, with the applications and
+// assets of the group that it holds. AccountData keeps the totals of all the
+// resources of the account, so that minimum balances are computed as they
+// were during the evaluation.
+type ReplayAccount struct {
+	Addr        basics.Address `codec:"addr"`
+	AccountData AccountData    `codec:"ad"`
+
+	AppParams      map[basics.AppIndex]basics.AppParams      `codec:"appp"`
+	AppLocalStates map[basics.AppIndex]basics.AppLocalState  `codec:"appl"`
+	AssetParams    map[basics.AssetIndex]basics.AssetParams  `codec:"apar"`
+	Assets         map[basics.AssetIndex]basics.AssetHolding `codec:"asset"`
+}
+
+// ErrTxnNotInBlock is returned when a transaction to replay is not in the block.
+type ErrTxnNotInBlock struct {
+	Txid  transactions.Txid
+	Round basics.Round
+}
+
+// Error satisfies builtin interface `error`
+func (err ErrTxnNotInBlock) Error() string {
+	return fmt.Sprintf("transaction %s is not in block %d", err.Txid, err.Round)
+}
+
+// ErrReplayStateUnavailable is returned when the state a block was evaluated
+// against is no longer kept by the ledger, which only keeps the state deltas of
+// at least its last MaxAcctLookback rounds.
+type ErrReplayStateUnavailable struct {
+	Round           basics.Round
+	Oldest          basics.Round
+	MaxAcctLookback uint64
+}
+
+// Error satisfies builtin interface `error`
+func (err ErrReplayStateUnavailable) Error() string {
+	return fmt.Sprintf("block %d can not be replayed: the ledger only keeps the state deltas of at least its last MaxAcctLookback (%d) rounds, to replay blocks %d and later", err.Round, err.MaxAcctLookback, err.Oldest)
+}
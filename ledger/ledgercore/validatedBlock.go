@@ -50,6 +50,17 @@ func (vb ValidatedBlock) WithSeed(s committee.Seed) ValidatedBlock {
 	}
 }
 
+// WithTimestamp returns a copy of the ValidatedBlock with a modified timestamp.
+func (vb ValidatedBlock) WithTimestamp(ts int64) ValidatedBlock {
+	newblock := vb.blk
+	newblock.BlockHeader.TimeStamp = ts
+
+	return ValidatedBlock{
+		blk:   newblock,
+		delta: vb.delta,
+	}
+}
+
 // MakeValidatedBlock creates a validated block.
 func MakeValidatedBlock(blk bookkeeping.Block, delta StateDelta) ValidatedBlock {
 	return ValidatedBlock{
@@ -0,0 +1,115 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+)
+
+// OnlineStakeExpiryBucket aggregates the online accounts whose participation keys expire within a range of rounds.
+type OnlineStakeExpiryBucket struct {
+	// FirstRound and LastRound are the first and last rounds, inclusive, of the range.
+	FirstRound basics.Round
+	LastRound  basics.Round
+	// Accounts is the number of online accounts whose participation keys expire within the range.
+	Accounts uint64
+	// Stake is the total balance of these accounts, excluding their pending rewards.
+	Stake basics.MicroAlgos
+}
+
+// OnlineStakeHistory returns the total online stake at the end of each of the rounds in the range [first, last].
+// The online stake is only available for the rounds whose history is still retained by the online accounts tracker.
+func (l *Ledger) OnlineStakeHistory(first basics.Round, last basics.Round) ([]basics.MicroAlgos, error) {
+	if first > last {
+		return nil, fmt.Errorf("OnlineStakeHistory: first round %d is beyond the last round %d", first, last)
+	}
+	l.trackerMu.RLock()
+	defer l.trackerMu.RUnlock()
+	stakes := make([]basics.MicroAlgos, 0, last-first+1)
+	for rnd := first; rnd <= last; rnd++ {
+		stake, err := l.acctsOnline.onlineTotalsEx(rnd)
+		if err != nil {
+			return nil, err
+		}
+		stakes = append(stakes, stake)
+	}
+	return stakes, nil
+}
+
+// TopOnlineAccounts returns the top n online accounts at round rnd, sorted by their normalized balance, whose
+// participation keys are valid in that round. The second return value is the total online stake of the accounts
+// whose participation keys are valid in that round.
+func (l *Ledger) TopOnlineAccounts(rnd basics.Round, n uint64) ([]*ledgercore.OnlineAccount, basics.MicroAlgos, error) {
+	hdr, err := l.BlockHdr(rnd)
+	if err != nil {
+		return nil, basics.MicroAlgos{}, err
+	}
+	params := config.Consensus[hdr.CurrentProtocol]
+
+	l.trackerMu.RLock()
+	defer l.trackerMu.RUnlock()
+	return l.acctsOnline.TopOnlineAccounts(rnd, rnd, n, &params, hdr.RewardsLevel)
+}
+
+// OnlineStakeExpiry groups the online accounts at round rnd, whose participation keys are valid in that round, by
+// the round their participation keys expire. The buckets span bucketSize rounds each, starting at rnd, and only the
+// non-empty ones are returned, in ascending order.
+func (l *Ledger) OnlineStakeExpiry(rnd basics.Round, bucketSize basics.Round) ([]OnlineStakeExpiryBucket, error) {
+	if bucketSize == 0 {
+		return nil, fmt.Errorf("OnlineStakeExpiry: bucket size cannot be zero")
+	}
+	// there is no bound on the number of online accounts, so make sure all of them are retrieved.
+	accounts, _, err := l.TopOnlineAccounts(rnd, math.MaxUint32)
+	if err != nil {
+		return nil, err
+	}
+
+	buckets := make(map[basics.Round]*OnlineStakeExpiryBucket)
+	for _, acct := range accounts {
+		idx := (acct.VoteLastValid - rnd) / bucketSize
+		bucket, ok := buckets[idx]
+		if !ok {
+			first := rnd + idx*bucketSize
+			last := first + bucketSize - 1
+			if last < first {
+				// the last bucket is cut short by the largest round.
+				last = basics.Round(math.MaxUint64)
+			}
+			bucket = &OnlineStakeExpiryBucket{FirstRound: first, LastRound: last}
+			buckets[idx] = bucket
+		}
+		var ot basics.OverflowTracker
+		bucket.Accounts++
+		bucket.Stake = ot.AddA(bucket.Stake, acct.MicroAlgos)
+		if ot.Overflowed {
+			return nil, fmt.Errorf("OnlineStakeExpiry: overflow in the stake of the bucket starting at round %d", bucket.FirstRound)
+		}
+	}
+
+	result := make([]OnlineStakeExpiryBucket, 0, len(buckets))
+	for _, bucket := range buckets {
+		result = append(result, *bucket)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].FirstRound < result[j].FirstRound })
+	return result, nil
+}
@@ -0,0 +1,79 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/data/basics"
+	ledgertesting "github.com/algorand/go-algorand/ledger/testing"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestOnlineStakeAnalytics(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	genBalances, addrs, _ := ledgertesting.NewTestGenesis()
+	voteLastValid := []basics.Round{100, 150, 1000, 2}
+	for i, lastValid := range voteLastValid {
+		ad := genBalances.Balances[addrs[i]]
+		ad.Status = basics.Online
+		ad.MicroAlgos.Raw = uint64(i+1) * 1_000_000_000
+		ad.VoteFirstValid = 0
+		ad.VoteLastValid = lastValid
+		genBalances.Balances[addrs[i]] = ad
+	}
+	l := newSimpleLedger(t, genBalances)
+	defer l.Close()
+
+	for i := 0; i < 5; i++ {
+		endBlock(t, l, nextBlock(t, l))
+	}
+	rnd := l.Latest()
+
+	stakes, err := l.OnlineStakeHistory(0, rnd)
+	require.NoError(t, err)
+	require.Len(t, stakes, int(rnd)+1)
+	require.Equal(t, uint64(10_000_000_000), stakes[0].Raw)
+	for _, stake := range stakes[1:] {
+		require.GreaterOrEqual(t, stake.Raw, stakes[0].Raw)
+	}
+	_, err = l.OnlineStakeHistory(rnd, 0)
+	require.Error(t, err)
+	_, err = l.OnlineStakeHistory(0, rnd+1)
+	require.Error(t, err)
+
+	// the account whose participation key has already expired is excluded.
+	top, totalStake, err := l.TopOnlineAccounts(rnd, 2)
+	require.NoError(t, err)
+	require.Len(t, top, 2)
+	require.Equal(t, addrs[2], top[0].Address)
+	require.Equal(t, addrs[1], top[1].Address)
+	require.Less(t, totalStake.Raw, stakes[rnd].Raw)
+	require.GreaterOrEqual(t, totalStake.Raw, uint64(6_000_000_000))
+
+	buckets, err := l.OnlineStakeExpiry(rnd, 200)
+	require.NoError(t, err)
+	require.Equal(t, []OnlineStakeExpiryBucket{
+		{FirstRound: rnd, LastRound: rnd + 199, Accounts: 2, Stake: basics.MicroAlgos{Raw: 3_000_000_000}},
+		{FirstRound: rnd + 800, LastRound: rnd + 999, Accounts: 1, Stake: basics.MicroAlgos{Raw: 3_000_000_000}},
+	}, buckets)
+	_, err = l.OnlineStakeExpiry(rnd, 0)
+	require.Error(t, err)
+}
@@ -0,0 +1,179 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/ledger/internal"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+)
+
+// replayLedgerConnector presents the ledger as if round was its latest
+// round, so that the block after it can be evaluated again.
+type replayLedgerConnector struct {
+	*Ledger
+	round  basics.Round
+	totals ledgercore.AccountTotals
+}
+
+// LatestTotals is part of LedgerForEvaluator interface.
+func (l replayLedgerConnector) LatestTotals() (basics.Round, ledgercore.AccountTotals, error) {
+	return l.round, l.totals, nil
+}
+
+// ReplayState returns the state that the transaction group of txid in block
+// rnd was evaluated against, by evaluating the groups before it again on top
+// of the state of round rnd-1. The state of round rnd-1 is only rebuilt from
+// the state deltas that the ledger keeps in memory, which cover at least its
+// last MaxAcctLookback rounds, so older rounds can not be replayed, even on
+// archival nodes, and return ledgercore.ErrReplayStateUnavailable.
+func (l *Ledger) ReplayState(rnd basics.Round, txid transactions.Txid) (ledgercore.ReplayState, error) {
+	if rnd == 0 {
+		return ledgercore.ReplayState{}, internal.ErrRoundZero
+	}
+	blk, err := l.Block(rnd)
+	if err != nil {
+		return ledgercore.ReplayState{}, err
+	}
+
+	l.trackerMu.RLock()
+	totals, err := l.accts.totals(rnd - 1)
+	l.trackerMu.RUnlock()
+	if err != nil {
+		var roundOffsetError *RoundOffsetError
+		if errors.As(err, &roundOffsetError) {
+			return ledgercore.ReplayState{}, ledgercore.ErrReplayStateUnavailable{Round: rnd, Oldest: roundOffsetError.dbRound + 1, MaxAcctLookback: l.cfg.MaxAcctLookback}
+		}
+		return ledgercore.ReplayState{}, fmt.Errorf("state of round %d is not available: %w", rnd-1, err)
+	}
+
+	eval, err := internal.StartEvaluator(
+		replayLedgerConnector{Ledger: l, round: rnd - 1, totals: totals}, blk.BlockHeader,
+		internal.EvaluatorOptions{
+			PaysetHint: len(blk.Payset),
+			Generate:   false,
+			Validate:   false,
+		})
+	if err != nil {
+		return ledgercore.ReplayState{}, err
+	}
+	return eval.ProcessBlockForReplay(&blk, txid)
+}
+
+// ReplayBalances is a ledger for replaying the transactions of a block.
+type ReplayBalances = internal.ReplayBalances
+
+// MakeReplayBalances creates a ledger for replaying the transaction group of
+// a ReplayState. The ledger only knows the parts of the state that the group
+// refers to.
+func MakeReplayBalances(state *ledgercore.ReplayState) ReplayBalances {
+	l := &replayStateLedger{
+		state:    state,
+		accounts: make(map[basics.Address]*ledgercore.ReplayAccount, len(state.Accounts)),
+	}
+	for i := range state.Accounts {
+		l.accounts[state.Accounts[i].Addr] = &state.Accounts[i]
+	}
+	return internal.MakeReplayBalances(l, state.Header, state.PrevTimestamp, state.TxnCounter)
+}
+
+// replayStateLedger is a DebuggerLedger backed by a ReplayState.
+type replayStateLedger struct {
+	state    *ledgercore.ReplayState
+	accounts map[basics.Address]*ledgercore.ReplayAccount
+}
+
+func (l *replayStateLedger) BlockHdr(rnd basics.Round) (bookkeeping.BlockHeader, error) {
+	if rnd == l.state.Header.Round {
+		return l.state.Header, nil
+	}
+	return bookkeeping.BlockHeader{}, fmt.Errorf("header of round %d is not part of the replay state", rnd)
+}
+
+func (l *replayStateLedger) BlockHdrCached(rnd basics.Round) (bookkeeping.BlockHeader, error) {
+	return l.BlockHdr(rnd)
+}
+
+func (l *replayStateLedger) CheckDup(config.ConsensusParams, basics.Round, basics.Round, basics.Round, transactions.Txid, ledgercore.Txlease) error {
+	return nil
+}
+
+func (l *replayStateLedger) LookupWithoutRewards(rnd basics.Round, addr basics.Address) (ledgercore.AccountData, basics.Round, error) {
+	acct, ok := l.accounts[addr]
+	if !ok {
+		return ledgercore.AccountData{}, rnd, nil
+	}
+	return acct.AccountData, rnd, nil
+}
+
+func (l *replayStateLedger) LookupAsset(rnd basics.Round, addr basics.Address, aidx basics.AssetIndex) (ledgercore.AssetResource, error) {
+	var result ledgercore.AssetResource
+	acct, ok := l.accounts[addr]
+	if !ok {
+		return result, nil
+	}
+	if params, ok := acct.AssetParams[aidx]; ok {
+		result.AssetParams = &params
+	}
+	if holding, ok := acct.Assets[aidx]; ok {
+		result.AssetHolding = &holding
+	}
+	return result, nil
+}
+
+func (l *replayStateLedger) LookupApplication(rnd basics.Round, addr basics.Address, aidx basics.AppIndex) (ledgercore.AppResource, error) {
+	var result ledgercore.AppResource
+	acct, ok := l.accounts[addr]
+	if !ok {
+		return result, nil
+	}
+	if params, ok := acct.AppParams[aidx]; ok {
+		result.AppParams = &params
+	}
+	if local, ok := acct.AppLocalStates[aidx]; ok {
+		result.AppLocalState = &local
+	}
+	return result, nil
+}
+
+func (l *replayStateLedger) LookupKv(rnd basics.Round, key string) ([]byte, error) {
+	return l.state.Boxes[key], nil
+}
+
+func (l *replayStateLedger) GetCreatorForRound(rnd basics.Round, cidx basics.CreatableIndex, ctype basics.CreatableType) (basics.Address, bool, error) {
+	for addr, acct := range l.accounts {
+		switch ctype {
+		case basics.AssetCreatable:
+			if _, ok := acct.AssetParams[basics.AssetIndex(cidx)]; ok {
+				return addr, true, nil
+			}
+		case basics.AppCreatable:
+			if _, ok := acct.AppParams[basics.AppIndex(cidx)]; ok {
+				return addr, true, nil
+			}
+		default:
+			return basics.Address{}, false, fmt.Errorf("unknown creatable type %d", ctype)
+		}
+	}
+	return basics.Address{}, false, nil
+}
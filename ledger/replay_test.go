@@ -0,0 +1,156 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package ledger
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/transactions"
+	"github.com/algorand/go-algorand/data/transactions/logic"
+	"github.com/algorand/go-algorand/data/txntest"
+	"github.com/algorand/go-algorand/ledger/ledgercore"
+	ledgertesting "github.com/algorand/go-algorand/ledger/testing"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestReplayState(t *testing.T) {
+	partitiontest.PartitionTest(t)
+	t.Parallel()
+
+	genBalances, addrs, _ := ledgertesting.NewTestGenesis()
+	ledgertesting.TestConsensusRange(t, boxVersion, 0, func(t *testing.T, ver int, cv protocol.ConsensusVersion) {
+		dl := NewDoubleLedger(t, genBalances, cv)
+		defer dl.Close()
+
+		proto := config.Consensus[cv]
+		create := txntest.Txn{
+			Type:   "appl",
+			Sender: addrs[0],
+			ApprovalProgram: main(`
+byte "n"; byte "n"; app_global_get; int 1; +; app_global_put
+byte "b"; int 8; box_create; pop`),
+			GlobalStateSchema: basics.StateSchema{NumUint: 1},
+		}
+		appIndex := dl.fullBlock(&create).Block().Payset[0].ApplyData.ApplicationID
+		dl.txn(&txntest.Txn{
+			Type:     "pay",
+			Sender:   addrs[0],
+			Receiver: appIndex.Address(),
+			Amount:   proto.MinBalance + boxFee(proto, 9),
+		})
+
+		pay := txntest.Txn{
+			Type:     "pay",
+			Sender:   addrs[1],
+			Receiver: addrs[2],
+			Amount:   1000,
+		}
+		call := txntest.Txn{
+			Type:          "appl",
+			Sender:        addrs[0],
+			ApplicationID: appIndex,
+			Boxes:         []transactions.BoxRef{{Index: 0, Name: []byte("b")}},
+		}
+		newApp := txntest.Txn{
+			Type:   "appl",
+			Sender: addrs[1],
+		}
+		vb := dl.fullBlock(&pay, &call, call.Noted("again"), &newApp)
+		blk := vb.Block()
+		prev, err := dl.validator.BlockHdr(blk.Round() - 1)
+		require.NoError(t, err)
+
+		groups, err := blk.DecodePaysetGroups()
+		require.NoError(t, err)
+		require.Len(t, groups, 4)
+
+		first, err := dl.validator.ReplayState(blk.Round(), groups[1][0].Txn.ID())
+		require.NoError(t, err)
+		second, err := dl.validator.ReplayState(blk.Round(), groups[2][0].Txn.ID())
+		require.NoError(t, err)
+
+		require.Equal(t, blk.BlockHeader, second.Header)
+		require.Equal(t, prev.TimeStamp, second.PrevTimestamp)
+		require.Equal(t, prev.TxnCounter+1, first.TxnCounter)
+		require.Equal(t, prev.TxnCounter+2, second.TxnCounter)
+		require.Len(t, second.Group, 1)
+		require.Equal(t, groups[2][0].Txn.ID(), second.Group[0].Txn.ID())
+		require.Zero(t, second.GroupIndex)
+
+		record := func(state ledgercore.ReplayState, addr basics.Address) *ledgercore.ReplayAccount {
+			for i := range state.Accounts {
+				if state.Accounts[i].Addr == addr {
+					return &state.Accounts[i]
+				}
+			}
+			return nil
+		}
+		// the app call refers to its creator, and its account, but not to
+		// the receiver of the payment
+		require.NotNil(t, record(second, addrs[0]))
+		require.NotNil(t, record(second, appIndex.Address()))
+		require.Nil(t, record(second, addrs[2]))
+		require.EqualValues(t, 1, record(second, addrs[0]).AccountData.TotalAppParams)
+
+		// the second call sees the changes of the first
+		require.Empty(t, record(first, addrs[0]).AppParams[appIndex].GlobalState)
+		require.Equal(t, basics.TealKeyValue{"n": {Type: basics.TealUintType, Uint: 1}},
+			record(second, addrs[0]).AppParams[appIndex].GlobalState)
+		require.Empty(t, first.Boxes)
+		require.Equal(t, map[string][]byte{logic.MakeBoxKey(appIndex, "b"): make([]byte, 8)}, second.Boxes)
+
+		// replaying the groups gives the ApplyData of the block, including
+		// the ID of an app created after other transactions of the block
+		last, err := dl.validator.ReplayState(blk.Round(), groups[3][0].Txn.ID())
+		require.NoError(t, err)
+		require.NotZero(t, last.Group[0].ApplyData.ApplicationID)
+		for _, state := range []ledgercore.ReplayState{first, second, last} {
+			group := make([]transactions.SignedTxn, len(state.Group))
+			for i := range state.Group {
+				group[i] = state.Group[i].SignedTxn
+			}
+			specials := transactions.SpecialAddresses{FeeSink: state.Header.FeeSink, RewardsPool: state.Header.RewardsPool}
+			ep := logic.NewEvalParams(transactions.WrapSignedTxnsWithAD(group), &proto, &specials)
+			ba := MakeReplayBalances(&state)
+			ad, err := ba.Replay(0, ep)
+			require.NoError(t, err)
+			require.True(t, state.Group[0].ApplyData.Equal(ad), "%v != %v", state.Group[0].ApplyData, ad)
+		}
+
+		_, err = dl.validator.ReplayState(blk.Round(), transactions.Txid{})
+		require.ErrorAs(t, err, &ledgercore.ErrTxnNotInBlock{})
+
+		// once the state of the round before a block is committed, the block
+		// can't be replayed anymore
+		for i := uint64(0); i < proto.MaxBalLookback+10; i++ {
+			dl.fullBlock()
+		}
+		dl.validator.WaitForCommit(dl.validator.Latest())
+		dl.validator.trackers.waitAccountsWriting()
+		_, err = dl.validator.ReplayState(blk.Round(), groups[1][0].Txn.ID())
+		var unavailable ledgercore.ErrReplayStateUnavailable
+		require.ErrorAs(t, err, &unavailable)
+		require.Equal(t, dl.validator.cfg.MaxAcctLookback, unavailable.MaxAcctLookback)
+		require.Greater(t, unavailable.Oldest, blk.Round())
+		require.ErrorContains(t, err, "MaxAcctLookback")
+	})
+}
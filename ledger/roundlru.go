@@ -111,6 +111,13 @@ func (hlc *heapLRUCache) Put(r basics.Round, data interface{}) {
 	hlc.inc()
 }
 
+func (hlc *heapLRUCache) reset() {
+	hlc.lock.Lock()
+	defer hlc.lock.Unlock()
+	hlc.entries = lruHeap{}
+	hlc.nextUseIndex = 0
+}
+
 // MaxInt is the maximum int which might be int32 or int64
 const MaxInt = int((^uint(0)) >> 1)
 
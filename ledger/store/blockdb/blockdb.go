@@ -241,6 +241,21 @@ func BlockForgetBefore(tx *sql.Tx, rnd basics.Round) error {
 	return err
 }
 
+// BlockForgetAfter removes block entries with round numbers greater than the specified round
+func BlockForgetAfter(tx *sql.Tx, rnd basics.Round) error {
+	earliest, err := BlockEarliest(tx)
+	if err != nil {
+		return err
+	}
+
+	if rnd < earliest {
+		return fmt.Errorf("forgetting too much: rnd %d < earliest %d", rnd, earliest)
+	}
+
+	_, err = tx.Exec("DELETE FROM blocks WHERE rnd>?", rnd)
+	return err
+}
+
 // BlockStartCatchupStaging initializes catchup for catchpoint
 func BlockStartCatchupStaging(tx *sql.Tx, blk bookkeeping.Block) error {
 	// delete the old catchpointblocks table, if there is such.
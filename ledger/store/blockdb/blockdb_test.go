@@ -174,3 +174,43 @@ func TestBlockDBAppend(t *testing.T) {
 		checkBlockDB(t, tx, blocks)
 	}
 }
+
+func TestBlockDBForgetAfter(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	dbs, _ := storetesting.DbOpenTest(t, true)
+	storetesting.SetDbLogging(t, dbs)
+	defer dbs.Close()
+
+	tx, err := dbs.Wdb.Handle.Begin()
+	require.NoError(t, err)
+	defer tx.Rollback()
+
+	blocks := randomInitChain(protocol.ConsensusCurrentVersion, 10)
+
+	err = BlockInit(tx, blockChainBlocks(blocks))
+	require.NoError(t, err)
+	checkBlockDB(t, tx, blocks)
+
+	err = BlockForgetAfter(tx, 6)
+	require.NoError(t, err)
+	blocks = blocks[:7]
+	checkBlockDB(t, tx, blocks)
+
+	// forgetting after the latest block is a no-op.
+	err = BlockForgetAfter(tx, 20)
+	require.NoError(t, err)
+	checkBlockDB(t, tx, blocks)
+
+	// the new blocks are appended following the remaining ones.
+	blkent := randomBlock(basics.Round(len(blocks)))
+	err = BlockPut(tx, blkent.block, blkent.cert)
+	require.NoError(t, err)
+	blocks = append(blocks, blkent)
+	checkBlockDB(t, tx, blocks)
+
+	err = BlockForgetBefore(tx, 3)
+	require.NoError(t, err)
+	err = BlockForgetAfter(tx, 2)
+	require.Error(t, err)
+}
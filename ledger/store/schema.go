@@ -212,6 +212,20 @@ func accountsInit(tx *sql.Tx, initAccounts map[basics.Address]basics.AccountData
 
 			ad := ledgercore.ToAccountData(data)
 			totals.AddAccount(proto, ad, &ot)
+
+			// index the assets and applications created by the initial accounts.
+			for aidx := range data.AssetParams {
+				_, err = tx.Exec("INSERT INTO assetcreators (asset, creator, ctype) VALUES (?, ?, ?)", aidx, addr[:], basics.AssetCreatable)
+				if err != nil {
+					return true, err
+				}
+			}
+			for aidx := range data.AppParams {
+				_, err = tx.Exec("INSERT INTO assetcreators (asset, creator, ctype) VALUES (?, ?, ?)", aidx, addr[:], basics.AppCreatable)
+				if err != nil {
+					return true, err
+				}
+			}
 		}
 
 		if ot.Overflowed {
@@ -348,6 +362,17 @@ func accountsCreateBoxTable(ctx context.Context, tx *sql.Tx) error {
 	return nil
 }
 
+// accountsInitBoxes adds the initial boxes to the kvstore table.
+func accountsInitBoxes(ctx context.Context, tx *sql.Tx, initBoxes map[string][]byte) error {
+	for key, value := range initBoxes {
+		_, err := tx.ExecContext(ctx, "INSERT INTO kvstore (key, value) VALUES (?, ?)", []byte(key), value)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func accountsCreateTxTailTable(ctx context.Context, tx *sql.Tx) (err error) {
 	for _, stmt := range createTxTailTable {
 		_, err = tx.ExecContext(ctx, stmt)
@@ -39,6 +39,7 @@ import (
 // TrackerDBParams contains parameters for initializing trackerDB
 type TrackerDBParams struct {
 	InitAccounts      map[basics.Address]basics.AccountData
+	InitBoxes         map[string][]byte
 	InitProto         protocol.ConsensusVersion
 	GenesisHash       crypto.Digest
 	FromCatchpoint    bool
@@ -492,6 +493,13 @@ func (tu *trackerDBSchemaInitializer) upgradeDatabaseSchema7(ctx context.Context
 	if err != nil {
 		return fmt.Errorf("upgradeDatabaseSchema7 unable to create kvstore through createTables : %v", err)
 	}
+	if tu.newDatabase {
+		// the boxes of the genesis applications are added once the kvstore table is created.
+		err = accountsInitBoxes(ctx, tx, tu.InitBoxes)
+		if err != nil {
+			return fmt.Errorf("upgradeDatabaseSchema7 unable to initialize boxes : %v", err)
+		}
+	}
 	return tu.setVersion(ctx, tx, 8)
 }
 
@@ -146,6 +146,7 @@ type ledgerForTracker interface {
 	GenesisProto() config.ConsensusParams
 	GenesisProtoVersion() protocol.ConsensusVersion
 	GenesisAccounts() map[basics.Address]basics.AccountData
+	GenesisBoxes() map[string][]byte
 }
 
 type trackerRegistry struct {
@@ -77,6 +77,10 @@ type ClientConfig struct {
 
 	// BinDir may be "" and it will be guesed
 	BinDir string
+
+	// TLSCAFile is the file of the certificate authorities trusted, in
+	// addition to the system ones, when algod or kmd serve their API over TLS
+	TLSCAFile string
 }
 
 // ClientType represents the type of client you need
@@ -156,6 +160,7 @@ func (c *Client) init(config ClientConfig, clientType ClientType) error {
 		algodKmdPath, _ := filepath.Abs(filepath.Join(dataDir, DefaultKMDDataDir))
 		nc.SetKMDDataDir(algodKmdPath)
 	}
+	nc.SetTLSCAFile(config.TLSCAFile)
 	c.nc = nc
 
 	// Initialize default kmd start args
@@ -1065,6 +1070,15 @@ func (c *Client) GetParticipationKeyByID(id string) (resp model.ParticipationKey
 	return
 }
 
+// GetParticipationHistory returns the recent votes and proposals missed by a participation key
+func (c *Client) GetParticipationHistory(id string) (resp model.ParticipationHistoryResponse, err error) {
+	algod, err := c.ensureAlgodClient()
+	if err == nil {
+		return algod.GetParticipationHistory(id)
+	}
+	return
+}
+
 // ExportKey exports the private key of the passed account, assuming it's available
 func (c *Client) ExportKey(walletHandle []byte, password, account string) (resp kmdapi.APIV1POSTKeyExportResponse, err error) {
 	kmd, err := c.ensureKmdClient()
@@ -1134,6 +1148,94 @@ func (c *Client) Catchup(catchpointLabel string) error {
 	return nil
 }
 
+// CatchupFromFile start catching up to the give catchpoint label, loading the catchpoint file stored at the given path
+// on the node's filesystem.
+func (c *Client) CatchupFromFile(catchpointLabel string, catchpointFile string) error {
+	algod, err := c.ensureAlgodClient()
+	if err != nil {
+		return err
+	}
+	_, err = algod.CatchupFromFile(catchpointLabel, catchpointFile)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// GenerateDevModeBlocks generates the given number of blocks on a developer mode network, and returns the latest round
+func (c *Client) GenerateDevModeBlocks(count uint64) (uint64, error) {
+	algod, err := c.ensureAlgodClient()
+	if err != nil {
+		return 0, err
+	}
+	resp, err := algod.GenerateDevModeBlocks(count)
+	if err != nil {
+		return 0, err
+	}
+	return resp.Round, nil
+}
+
+// GetBlockTimeStampOffset returns the block timestamp offset of a developer mode network, in seconds
+func (c *Client) GetBlockTimeStampOffset() (uint64, error) {
+	algod, err := c.ensureAlgodClient()
+	if err != nil {
+		return 0, err
+	}
+	resp, err := algod.GetBlockTimeStampOffset()
+	if err != nil {
+		return 0, err
+	}
+	return resp.Offset, nil
+}
+
+// SetBlockTimeStampOffset sets the block timestamp offset of a developer mode network, in seconds
+func (c *Client) SetBlockTimeStampOffset(offset uint64) error {
+	algod, err := c.ensureAlgodClient()
+	if err != nil {
+		return err
+	}
+	return algod.SetBlockTimeStampOffset(offset)
+}
+
+// CreateDevModeSnapshot creates a snapshot of a developer mode network, and returns its identifier along with the round it captures
+func (c *Client) CreateDevModeSnapshot() (snapshotID uint64, round uint64, err error) {
+	algod, err := c.ensureAlgodClient()
+	if err != nil {
+		return 0, 0, err
+	}
+	resp, err := algod.CreateDevModeSnapshot()
+	if err != nil {
+		return 0, 0, err
+	}
+	return resp.SnapshotId, resp.Round, nil
+}
+
+// RevertDevModeSnapshot reverts a developer mode network to the given snapshot, and returns the round it was reverted to
+func (c *Client) RevertDevModeSnapshot(snapshotID uint64) (uint64, error) {
+	algod, err := c.ensureAlgodClient()
+	if err != nil {
+		return 0, err
+	}
+	resp, err := algod.RevertDevModeSnapshot(snapshotID)
+	if err != nil {
+		return 0, err
+	}
+	return resp.Round, nil
+}
+
+// RollbackLedger rolls the ledger of the node back to the given round, and returns the latest round
+func (c *Client) RollbackLedger(round uint64) (uint64, error) {
+	algod, err := c.ensureAlgodClient()
+	if err != nil {
+		return 0, err
+	}
+	resp, err := algod.RollbackLedger(round)
+	if err != nil {
+		return 0, err
+	}
+	return resp.Round, nil
+}
+
 const defaultAppIdx = 1380011588
 
 // MakeDryrunStateBytes function creates DryrunRequest data structure in serialized form according to the format
@@ -128,6 +128,28 @@ type PartKeyRegisteredEventDetails struct {
 	LastValid  uint64
 }
 
+// PartKeyExpiringEvent event
+const PartKeyExpiringEvent Event = "PartKeyExpiring"
+
+// PartKeyExpiringEventDetails contains details for the PartKeyExpiringEvent
+type PartKeyExpiringEventDetails struct {
+	Address         string
+	ParticipationID string
+	Round           uint64
+	LastValid       uint64
+}
+
+// PartKeyStoppedVotingEvent event
+const PartKeyStoppedVotingEvent Event = "PartKeyStoppedVoting"
+
+// PartKeyStoppedVotingEventDetails contains details for the PartKeyStoppedVotingEvent
+type PartKeyStoppedVotingEventDetails struct {
+	Address         string
+	ParticipationID string
+	Round           uint64
+	MissedVotes     uint64
+}
+
 // BlockProposedEvent event
 const BlockProposedEvent Event = "BlockProposed"
 
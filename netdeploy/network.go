@@ -82,6 +82,10 @@ func CreateNetworkFromTemplate(name, rootDir, templateFile, binDir string, impor
 	if n.cfg.Name == "" {
 		n.cfg.Name = template.Genesis.NetworkName
 	}
+	// the fork state of a forked network is relative to its template
+	if template.Genesis.ForkState != "" && !filepath.IsAbs(template.Genesis.ForkState) {
+		template.Genesis.ForkState = filepath.Join(filepath.Dir(templateFile), template.Genesis.ForkState)
+	}
 	if n.cfg.Name == "" {
 		return n, fmt.Errorf("unnamed network. Use the \"network\" flag or \"Genesis.NetworkName\" in the network template")
 	}
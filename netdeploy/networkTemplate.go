@@ -146,7 +146,7 @@ func (t NetworkTemplate) createNodeDirectories(targetFolder string, binDir strin
 
 		// Create any necessary config.json file for this node
 		nodeCfg := filepath.Join(nodeDir, config.ConfigFilename)
-		err = createConfigFile(cfg, nodeCfg, len(t.Nodes)-1, relaysCount) // minus 1 to avoid counting self
+		err = createConfigFile(cfg, nodeCfg, len(t.Nodes)-1, relaysCount, t.Genesis.DevMode) // minus 1 to avoid counting self
 		if err != nil {
 			return
 		}
@@ -239,7 +239,7 @@ func countRelayNodes(nodeCfgs []remote.NodeConfigGoal) (relayCount int) {
 	return
 }
 
-func createConfigFile(node remote.NodeConfigGoal, configFile string, numNodes int, relaysCount int) error {
+func createConfigFile(node remote.NodeConfigGoal, configFile string, numNodes int, relaysCount int, devMode bool) error {
 	cfg := config.GetDefaultLocal()
 	cfg.GossipFanout = numNodes
 	// Override default :8080 REST endpoint, and disable SRV lookup
@@ -262,5 +262,10 @@ func createConfigFile(node remote.NodeConfigGoal, configFile string, numNodes in
 	if node.DeadlockDetection != 0 {
 		cfg.DeadlockDetection = node.DeadlockDetection
 	}
+
+	if devMode {
+		// keep all the blocks, so that the node could be reverted to any of its snapshots.
+		cfg.Archival = true
+	}
 	return cfg.SaveToFile(configFile)
 }
@@ -0,0 +1,163 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package node
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+)
+
+// MaxDevModeBlocks is the maximal number of blocks that could be generated by a single GenerateDevModeBlocks call.
+const MaxDevModeBlocks = 10000
+
+// ErrDevModeDisabled is returned when a developer mode operation is requested from a node which doesn't operate in developer mode.
+var ErrDevModeDisabled = errors.New("the node doesn't operate in developer mode")
+
+// ErrDevModeSnapshotNotFound is returned when reverting to a snapshot which doesn't exist, or was discarded by an earlier revert.
+var ErrDevModeSnapshotNotFound = errors.New("developer mode snapshot not found")
+
+// ErrDevModeTimestampOverflow is returned when the block timestamp offset would take the timestamp of the next block
+// past the latest timestamp the ledger can evaluate blocks after.
+var ErrDevModeTimestampOverflow = errors.New("block timestamp offset overflows the block timestamps")
+
+// devModeSnapshot is a ledger state a developer mode node could be reverted to.
+type devModeSnapshot struct {
+	id              uint64
+	round           basics.Round
+	timestampOffset int64
+}
+
+// SetBlockTimeStampOffset sets the offset, in seconds, between the timestamps of consecutive blocks generated in
+// developer mode. A zero offset restores the default, where the block timestamps follow the wall clock.
+func (node *AlgorandFullNode) SetBlockTimeStampOffset(offset int64) error {
+	if !node.devMode {
+		return ErrDevModeDisabled
+	}
+	if offset < 0 {
+		return fmt.Errorf("block timestamp offset %d cannot be negative", offset)
+	}
+	node.mu.Lock()
+	defer node.mu.Unlock()
+	prev, err := node.ledger.BlockHdr(node.ledger.Latest())
+	if err != nil {
+		return err
+	}
+	if _, err := devModeTimestamp(prev, offset); err != nil {
+		return err
+	}
+	node.devModeTimestampOffset = offset
+	return nil
+}
+
+// devModeTimestamp returns the timestamp of the block after prev, with the given block timestamp offset. The
+// timestamps stay below math.MaxInt64 by the largest timestamp increment, so that the ledger could still evaluate
+// blocks after them.
+func devModeTimestamp(prev bookkeeping.BlockHeader, offset int64) (int64, error) {
+	limit := math.MaxInt64 - config.Consensus[prev.CurrentProtocol].MaxTimestampIncrement
+	if prev.TimeStamp > limit-offset {
+		return 0, fmt.Errorf("%w: %d + %d is past %d", ErrDevModeTimestampOverflow, prev.TimeStamp, offset, limit)
+	}
+	return prev.TimeStamp + offset, nil
+}
+
+// GetBlockTimeStampOffset returns the offset, in seconds, between the timestamps of consecutive blocks generated in
+// developer mode, or zero if the block timestamps follow the wall clock.
+func (node *AlgorandFullNode) GetBlockTimeStampOffset() (int64, error) {
+	if !node.devMode {
+		return 0, ErrDevModeDisabled
+	}
+	node.mu.Lock()
+	defer node.mu.Unlock()
+	return node.devModeTimestampOffset, nil
+}
+
+// GenerateDevModeBlocks generates the given number of blocks in developer mode, and returns the latest round.
+// The blocks are empty, unless there are pending transactions in the transaction pool.
+func (node *AlgorandFullNode) GenerateDevModeBlocks(count uint64) (basics.Round, error) {
+	if !node.devMode {
+		return 0, ErrDevModeDisabled
+	}
+	if count > MaxDevModeBlocks {
+		return 0, fmt.Errorf("cannot generate more than %d blocks at once", MaxDevModeBlocks)
+	}
+	node.mu.Lock()
+	defer node.mu.Unlock()
+	for i := uint64(0); i < count; i++ {
+		err := node.writeDevmodeBlock()
+		if err != nil {
+			return node.ledger.Latest(), err
+		}
+	}
+	return node.ledger.Latest(), nil
+}
+
+// CreateDevModeSnapshot records the current ledger state, so that the node could later be reverted to it.
+// It returns the snapshot identifier along with the round it captures.
+func (node *AlgorandFullNode) CreateDevModeSnapshot() (id uint64, round basics.Round, err error) {
+	if !node.devMode {
+		return 0, 0, ErrDevModeDisabled
+	}
+	node.mu.Lock()
+	defer node.mu.Unlock()
+	node.devModeLastSnapshotID++
+	snapshot := devModeSnapshot{
+		id:              node.devModeLastSnapshotID,
+		round:           node.ledger.Latest(),
+		timestampOffset: node.devModeTimestampOffset,
+	}
+	node.devModeSnapshots = append(node.devModeSnapshots, snapshot)
+	return snapshot.id, snapshot.round, nil
+}
+
+// RevertDevModeSnapshot reverts the ledger, along with the block timestamp offset, to the state recorded by the given
+// snapshot, and returns the round it was reverted to. The snapshot, and all the ones taken after it, are discarded.
+func (node *AlgorandFullNode) RevertDevModeSnapshot(id uint64) (basics.Round, error) {
+	if !node.devMode {
+		return 0, ErrDevModeDisabled
+	}
+	node.mu.Lock()
+	defer node.mu.Unlock()
+
+	idx := -1
+	for i, snapshot := range node.devModeSnapshots {
+		if snapshot.id == id {
+			idx = i
+			break
+		}
+	}
+	if idx < 0 {
+		return 0, ErrDevModeSnapshotNotFound
+	}
+	snapshot := node.devModeSnapshots[idx]
+
+	err := node.ledger.Rollback(snapshot.round, true)
+	if err != nil {
+		node.log.Warnf("unable to revert to developer mode snapshot %d at round %d: %v", snapshot.id, snapshot.round, err)
+		return node.ledger.Latest(), err
+	}
+	// the pending transactions were evaluated against the discarded state.
+	node.transactionPool.Reset()
+
+	node.devModeSnapshots = node.devModeSnapshots[:idx]
+	node.devModeTimestampOffset = snapshot.timestampOffset
+	return node.ledger.Latest(), nil
+}
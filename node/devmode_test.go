@@ -0,0 +1,211 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package node
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/config"
+	"github.com/algorand/go-algorand/crypto"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/data/bookkeeping"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/protocol"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func makeDevModeNode(t *testing.T, devMode bool) *AlgorandFullNode {
+	genesis := bookkeeping.Genesis{
+		SchemaID:    "go-test-node-genesis",
+		Proto:       protocol.ConsensusCurrentVersion,
+		Network:     config.Devtestnet,
+		FeeSink:     sinkAddr.String(),
+		RewardsPool: poolAddr.String(),
+		DevMode:     devMode,
+		Allocation: []bookkeeping.GenesisAllocation{
+			{
+				Address: poolAddr.String(),
+				State:   basics.AccountData{MicroAlgos: basics.MicroAlgos{Raw: 1000000000}},
+			},
+			{
+				Address: sinkAddr.String(),
+				State:   basics.AccountData{MicroAlgos: basics.MicroAlgos{Raw: 1000000000}},
+			},
+		},
+	}
+	cfg := config.GetDefaultLocal()
+	cfg.Archival = true
+	node, err := MakeFull(logging.TestingLog(t), t.TempDir(), cfg, []string{}, genesis)
+	require.NoError(t, err)
+	return node
+}
+
+func TestDevModeSandbox(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	node := makeDevModeNode(t, true)
+	node.Start()
+	defer node.Stop()
+
+	rnd, err := node.GenerateDevModeBlocks(3)
+	require.NoError(t, err)
+	require.Equal(t, basics.Round(3), rnd)
+	hdr, err := node.ledger.BlockHdr(rnd)
+	require.NoError(t, err)
+	timestamp := hdr.TimeStamp
+
+	// the timestamps of the following blocks are set by the offset.
+	require.Error(t, node.SetBlockTimeStampOffset(-1))
+	require.NoError(t, node.SetBlockTimeStampOffset(1000))
+	offset, err := node.GetBlockTimeStampOffset()
+	require.NoError(t, err)
+	require.Equal(t, int64(1000), offset)
+	rnd, err = node.GenerateDevModeBlocks(2)
+	require.NoError(t, err)
+	require.Equal(t, basics.Round(5), rnd)
+	hdr, err = node.ledger.BlockHdr(rnd)
+	require.NoError(t, err)
+	require.Equal(t, timestamp+2000, hdr.TimeStamp)
+	timestamp = hdr.TimeStamp
+
+	id, rnd, err := node.CreateDevModeSnapshot()
+	require.NoError(t, err)
+	require.Equal(t, basics.Round(5), rnd)
+	laterID, _, err := node.CreateDevModeSnapshot()
+	require.NoError(t, err)
+
+	require.NoError(t, node.SetBlockTimeStampOffset(0))
+	rnd, err = node.GenerateDevModeBlocks(4)
+	require.NoError(t, err)
+	require.Equal(t, basics.Round(9), rnd)
+
+	// reverting restores both the ledger and the timestamp offset.
+	rnd, err = node.RevertDevModeSnapshot(id)
+	require.NoError(t, err)
+	require.Equal(t, basics.Round(5), rnd)
+	require.Equal(t, basics.Round(5), node.ledger.Latest())
+	offset, err = node.GetBlockTimeStampOffset()
+	require.NoError(t, err)
+	require.Equal(t, int64(1000), offset)
+
+	rnd, err = node.GenerateDevModeBlocks(1)
+	require.NoError(t, err)
+	require.Equal(t, basics.Round(6), rnd)
+	hdr, err = node.ledger.BlockHdr(rnd)
+	require.NoError(t, err)
+	require.Equal(t, timestamp+1000, hdr.TimeStamp)
+
+	// the reverted snapshot, and the ones taken after it, are discarded.
+	_, err = node.RevertDevModeSnapshot(id)
+	require.ErrorIs(t, err, ErrDevModeSnapshotNotFound)
+	_, err = node.RevertDevModeSnapshot(laterID)
+	require.ErrorIs(t, err, ErrDevModeSnapshotNotFound)
+
+	// offsets can't take the block timestamps past the latest one the ledger
+	// can evaluate blocks after
+	require.ErrorIs(t, node.SetBlockTimeStampOffset(math.MaxInt64), ErrDevModeTimestampOverflow)
+	hdr, err = node.ledger.BlockHdr(node.ledger.Latest())
+	require.NoError(t, err)
+	limit := math.MaxInt64 - config.Consensus[hdr.CurrentProtocol].MaxTimestampIncrement
+	offset = (limit-hdr.TimeStamp)/2 + 1
+	require.NoError(t, node.SetBlockTimeStampOffset(offset))
+	rnd, err = node.GenerateDevModeBlocks(2)
+	require.ErrorIs(t, err, ErrDevModeTimestampOverflow)
+	require.Equal(t, hdr.Round+1, rnd)
+}
+
+func TestDevModeSandboxDisabled(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	node := makeDevModeNode(t, false)
+	defer node.ledger.Close()
+
+	_, err := node.GenerateDevModeBlocks(1)
+	require.ErrorIs(t, err, ErrDevModeDisabled)
+	require.ErrorIs(t, node.SetBlockTimeStampOffset(1), ErrDevModeDisabled)
+	_, err = node.GetBlockTimeStampOffset()
+	require.ErrorIs(t, err, ErrDevModeDisabled)
+	_, _, err = node.CreateDevModeSnapshot()
+	require.ErrorIs(t, err, ErrDevModeDisabled)
+	_, err = node.RevertDevModeSnapshot(1)
+	require.ErrorIs(t, err, ErrDevModeDisabled)
+}
+
+func TestRollbackLedger(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	node := makeDevModeNode(t, true)
+	node.Start()
+	defer node.Stop()
+
+	_, err := node.GenerateDevModeBlocks(5)
+	require.NoError(t, err)
+	earlierID, _, err := node.CreateDevModeSnapshot()
+	require.NoError(t, err)
+	_, err = node.GenerateDevModeBlocks(3)
+	require.NoError(t, err)
+	laterID, _, err := node.CreateDevModeSnapshot()
+	require.NoError(t, err)
+
+	_, err = node.RollbackLedger(10)
+	require.Error(t, err)
+	rnd, err := node.RollbackLedger(6)
+	require.NoError(t, err)
+	require.Equal(t, basics.Round(6), rnd)
+	require.Equal(t, basics.Round(6), node.ledger.Latest())
+
+	// the node keeps making blocks after the rollback.
+	rnd, err = node.GenerateDevModeBlocks(1)
+	require.NoError(t, err)
+	require.Equal(t, basics.Round(7), rnd)
+	rnd, err = node.RollbackLedger(6)
+	require.NoError(t, err)
+	require.Equal(t, basics.Round(6), rnd)
+
+	// the snapshots taken after the rollback round are discarded.
+	_, err = node.RevertDevModeSnapshot(laterID)
+	require.ErrorIs(t, err, ErrDevModeSnapshotNotFound)
+	rnd, err = node.RevertDevModeSnapshot(earlierID)
+	require.NoError(t, err)
+	require.Equal(t, basics.Round(5), rnd)
+
+	// public networks are never rolled back.
+	node.genesisID = "testnet-v1.0"
+	node.genesisHash, err = crypto.DigestFromString("JBR3KGFEWPEE5SAQ6IWU6EEBZMHXD4CZU6WCBXWGF57XBZIJHIRA")
+	require.NoError(t, err)
+	_, err = node.RollbackLedger(3)
+	require.ErrorIs(t, err, ErrRollbackPublicNetwork)
+	require.Equal(t, basics.Round(5), node.ledger.Latest())
+}
+
+func TestIsPublicGenesis(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	for id, hash := range publicGenesisHashes {
+		digest, err := crypto.DigestFromString(hash)
+		require.NoError(t, err)
+		require.True(t, isPublicGenesis(id, digest), id)
+		// a private network named after a public one is not public
+		require.False(t, isPublicGenesis(id, crypto.Hash([]byte(id))), id)
+	}
+	node := makeDevModeNode(t, true)
+	defer node.ledger.Close()
+	require.False(t, isPublicGenesis(node.genesisID, node.genesisHash))
+}
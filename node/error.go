@@ -17,9 +17,13 @@
 package node
 
 import (
+	"errors"
 	"fmt"
 )
 
+// ErrRollbackPublicNetwork is returned when rolling back the ledger of a node which operates on a public network.
+var ErrRollbackPublicNetwork = errors.New("rolling back the ledger is not allowed on public networks")
+
 // Catchpoint already in progress error
 
 // CatchpointAlreadyInProgressError indicates that the requested catchpoint is already running
@@ -128,6 +128,14 @@ type AlgorandFullNode struct {
 	genesisHash crypto.Digest
 	devMode     bool // is this node operates in a developer mode ? ( benign agreement, broadcasting transaction generates a new block )
 
+	// devModeTimestampOffset, when non-zero, sets the timestamp of each block generated in developer mode
+	// to the timestamp of its previous block plus the offset, in seconds. It's protected by mu.
+	devModeTimestampOffset int64
+	// devModeSnapshots are the ledger states a developer mode node could be reverted to, in the order
+	// they were taken. It's protected by mu.
+	devModeSnapshots      []devModeSnapshot
+	devModeLastSnapshotID uint64
+
 	log logging.Logger
 
 	// syncStatusMu used for locking lastRoundTimestamp and hasSyncedSinceStartup
@@ -142,6 +150,7 @@ type AlgorandFullNode struct {
 	catchupBlockAuth                   blockAuthenticatorImpl
 
 	oldKeyDeletionNotify        chan struct{}
+	participationMonitor        *participationMonitor
 	monitoringRoutinesWaitGroup sync.WaitGroup
 
 	tracer messagetracer.MessageTracer
@@ -302,6 +311,10 @@ func MakeFull(log logging.Logger, rootDir string, cfg config.Local, phonebookAdd
 	}
 
 	node.oldKeyDeletionNotify = make(chan struct{}, 1)
+	node.participationMonitor = makeParticipationMonitor(agreementLedger, node.accountManager, node.log, func() bool {
+		synchronizing, _ := node.catchupService.IsSynchronizing()
+		return synchronizing
+	})
 
 	catchpointCatchupState, err := node.ledger.GetCatchpointCatchupState(context.Background())
 	if err != nil {
@@ -396,10 +409,20 @@ func (node *AlgorandFullNode) startMonitoringRoutines() {
 	go node.txPoolGaugeThread(node.ctx.Done())
 	// Delete old participation keys
 	go node.oldKeyDeletionThread(node.ctx.Done())
+	// Monitor the participation keys, unless operating in developer mode where the node doesn't vote
+	if !node.devMode {
+		node.monitoringRoutinesWaitGroup.Add(1)
+		go node.participationMonitorThread(node.ctx.Done())
+	}
 
 	if node.config.EnableUsageLog {
 		go logging.UsageLogThread(node.ctx, node.log, 100*time.Millisecond, nil)
 	}
+
+	if node.config.EnableTxPoolPersistence {
+		node.monitoringRoutinesWaitGroup.Add(1)
+		go node.txPoolPersistenceThread(node.ctx.Done())
+	}
 }
 
 // waitMonitoringRoutines waits for all the monitoring routines to exit. Note that
@@ -474,6 +497,21 @@ func (node *AlgorandFullNode) writeDevmodeBlock() (err error) {
 		return
 	}
 
+	if node.devModeTimestampOffset != 0 {
+		var prev bookkeeping.BlockHeader
+		prev, err = node.ledger.BlockHdr(vb.Block().Round() - 1)
+		if err != nil {
+			return
+		}
+		var timestamp int64
+		timestamp, err = devModeTimestamp(prev, node.devModeTimestampOffset)
+		if err != nil {
+			return
+		}
+		timestamped := vb.WithTimestamp(timestamp)
+		vb = &timestamped
+	}
+
 	// add the newly generated block to the ledger
 	err = node.ledger.AddValidatedBlock(*vb, agreement.Certificate{})
 	return err
@@ -626,38 +664,123 @@ func (node *AlgorandFullNode) GetPendingTransaction(txID transactions.Txid) (res
 		// Keep looking in the ledger.
 	}
 
+	// Search from newest to oldest round up to the max life of a transaction.
+	minRound, maxRound := node.txnLifeRounds()
+	for r := maxRound; r >= minRound; r-- {
+		tx, found, err := node.ledger.LookupTxid(txID, r)
+		if err != nil || !found {
+			continue
+		}
+		return TxnWithStatus{
+			Txn:            tx.SignedTxn,
+			ConfirmedRound: r,
+			ApplyData:      tx.ApplyData,
+		}, true
+	}
+
+	// Return whatever we found in the pool (if anything).
+	return
+}
+
+// txnLifeRounds returns the range of recent rounds that a transaction which isn't expired yet could have been committed in.
+func (node *AlgorandFullNode) txnLifeRounds() (minRound basics.Round, maxRound basics.Round) {
 	var maxLife basics.Round
 	latest := node.ledger.Latest()
 	proto, err := node.ledger.ConsensusParams(latest)
 	if err == nil {
 		maxLife = basics.Round(proto.MaxTxnLife)
 	} else {
-		node.log.Errorf("node.GetPendingTransaction: cannot get consensus params for latest round %v", latest)
+		node.log.Errorf("node.txnLifeRounds: cannot get consensus params for latest round %v", latest)
 	}
 
-	// Search from newest to oldest round up to the max life of a transaction.
-	maxRound := latest
-	minRound := maxRound.SubSaturate(maxLife)
+	maxRound = latest
+	minRound = maxRound.SubSaturate(maxLife)
 
 	// Since we're using uint64, if the minRound is 0, we need to check for an underflow.
 	if minRound == 0 {
 		minRound++
 	}
+	return
+}
 
-	for r := maxRound; r >= minRound; r-- {
-		tx, found, err := node.ledger.LookupTxid(txID, r)
-		if err != nil || !found {
+// getCommittedTransactions looks for the given transactions in the recent ledger blocks, reading each of the blocks once.
+func (node *AlgorandFullNode) getCommittedTransactions(txids []transactions.Txid) map[transactions.Txid]TxnWithStatus {
+	wanted := make(map[transactions.Txid]bool, len(txids))
+	for _, txid := range txids {
+		wanted[txid] = true
+	}
+	committed := make(map[transactions.Txid]TxnWithStatus)
+
+	minRound, maxRound := node.txnLifeRounds()
+	for r := maxRound; r >= minRound && len(committed) < len(wanted); r-- {
+		blk, err := node.ledger.Block(r)
+		if err != nil {
 			continue
 		}
-		return TxnWithStatus{
-			Txn:            tx.SignedTxn,
-			ConfirmedRound: r,
-			ApplyData:      tx.ApplyData,
-		}, true
+		payset, err := blk.DecodePaysetFlat()
+		if err != nil {
+			continue
+		}
+		for _, tx := range payset {
+			txid := tx.ID()
+			if !wanted[txid] {
+				continue
+			}
+			if _, found := committed[txid]; found {
+				continue
+			}
+			committed[txid] = TxnWithStatus{
+				Txn:            tx.SignedTxn,
+				ConfirmedRound: r,
+				ApplyData:      tx.ApplyData,
+			}
+		}
 	}
+	return committed
+}
 
-	// Return whatever we found in the pool (if anything).
-	return
+// WaitForTxnEvents waits until any of the given transactions, or any of the transactions of the given address that
+// are in the txpool, is committed or removed from the txpool. It returns the events that occurred, or no events if
+// the context is done first. The given transactions that were already committed, or removed from the txpool, are
+// reported right away.
+func (node *AlgorandFullNode) WaitForTxnEvents(ctx context.Context, txids []transactions.Txid, address *basics.Address) []pools.TxnEvent {
+	// Subscribe before looking the transactions up, so that no event is missed in between.
+	sub := node.transactionPool.SubscribeTxnEvents(txids, address)
+	defer node.transactionPool.UnsubscribeTxnEvents(sub)
+
+	// As in GetPendingTransaction, the txpool is checked before the ledger, so that a transaction committed in between
+	// is not missed.
+	removed := make(map[transactions.Txid]pools.TxnEvent)
+	for _, txid := range txids {
+		tx, txErr, found := node.transactionPool.Lookup(txid)
+		if found && txErr != "" {
+			removed[txid] = pools.TxnEvent{Txid: txid, Txn: tx, PoolError: txErr}
+		}
+	}
+	committed := node.getCommittedTransactions(txids)
+
+	var events []pools.TxnEvent
+	for _, txid := range txids {
+		if txn, found := committed[txid]; found {
+			events = append(events, pools.TxnEvent{
+				Txid:           txid,
+				Txn:            txn.Txn,
+				ConfirmedRound: txn.ConfirmedRound,
+			})
+		} else if event, found := removed[txid]; found {
+			events = append(events, event)
+		}
+	}
+	if len(events) > 0 {
+		return events
+	}
+
+	select {
+	case <-ctx.Done():
+		return nil
+	case <-sub.Ready():
+		return sub.Events()
+	}
 }
 
 // Status returns a StatusReport structure reporting our status as Active and with our ledger's LastRound
@@ -783,6 +906,15 @@ func (node *AlgorandFullNode) GetParticipationKey(partKeyID account.Participatio
 	return rval, nil
 }
 
+// GetParticipationHistory returns the recent votes and proposals missed by the given participation key.
+func (node *AlgorandFullNode) GetParticipationHistory(partKeyID account.ParticipationID) (ParticipationHistory, error) {
+	record := node.accountManager.Registry().Get(partKeyID)
+	if record.IsZero() {
+		return ParticipationHistory{}, account.ErrParticipationIDNotFound
+	}
+	return node.participationMonitor.history(partKeyID, record.Account), nil
+}
+
 // RemoveParticipationKey given a participation id, remove the records from the node
 func (node *AlgorandFullNode) RemoveParticipationKey(partKeyID account.ParticipationID) error {
 
@@ -1016,6 +1148,42 @@ func (node *AlgorandFullNode) txPoolGaugeThread(done <-chan struct{}) {
 	}
 }
 
+// txPoolPersistenceThread restores the transaction groups saved by a previous run of the node into the
+// transaction pool, and then saves the pending transaction groups periodically, and once more when done.
+func (node *AlgorandFullNode) txPoolPersistenceThread(done <-chan struct{}) {
+	defer node.monitoringRoutinesWaitGroup.Done()
+	filename := filepath.Join(node.rootDir, node.genesisID, config.TxPoolFilename)
+
+	remembered, total, err := node.transactionPool.RestorePending(filename)
+	if err != nil {
+		node.log.Warnf("Unable to restore the transaction pool from %s: %v", filename, err)
+	} else if total > 0 {
+		node.log.Infof("Restored %d out of %d transaction groups into the transaction pool from %s", remembered, total, filename)
+	}
+
+	save := func() {
+		err := node.transactionPool.SavePending(filename)
+		if err != nil {
+			node.log.Warnf("Unable to save the transaction pool to %s: %v", filename, err)
+		}
+	}
+	interval := time.Duration(node.config.TxPoolPersistenceIntervalSeconds) * time.Second
+	if interval <= 0 {
+		interval = time.Minute
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			save()
+		case <-done:
+			save()
+			return
+		}
+	}
+}
+
 // IsArchival returns true the node is an archival node, false otherwise
 func (node *AlgorandFullNode) IsArchival() bool {
 	return node.config.Archival
@@ -1036,6 +1204,15 @@ func (node *AlgorandFullNode) OnNewBlock(block bookkeeping.Block, delta ledgerco
 	case node.oldKeyDeletionNotify <- struct{}{}:
 	default:
 	}
+
+	// Wake up participationMonitorThread(), non-blocking.
+	node.participationMonitor.wake()
+}
+
+// participationMonitorThread keeps track of the votes and proposals missed by the participation keys.
+func (node *AlgorandFullNode) participationMonitorThread(done <-chan struct{}) {
+	defer node.monitoringRoutinesWaitGroup.Done()
+	node.participationMonitor.run(done)
 }
 
 // oldKeyDeletionThread keeps deleting old participation keys.
@@ -1121,6 +1298,24 @@ func (node *AlgorandFullNode) GetTransactionByID(txid transactions.Txid, rnd bas
 // StartCatchup starts the catchpoint mode and attempt to get to the provided catchpoint
 // this function is intended to be called externally via the REST api interface.
 func (node *AlgorandFullNode) StartCatchup(catchpoint string) error {
+	return node.startCatchup(catchpoint, "")
+}
+
+// StartCatchupFromFile starts the catchpoint mode and attempt to get to the provided catchpoint, loading the ledger
+// from the catchpoint file stored at the given path on the node's filesystem rather than downloading it.
+// this function is intended to be called externally via the REST api interface.
+func (node *AlgorandFullNode) StartCatchupFromFile(catchpoint string, catchpointFile string) error {
+	stat, err := os.Stat(catchpointFile)
+	if err != nil {
+		return fmt.Errorf("unable to use catchpoint file %s : %v", catchpointFile, err)
+	}
+	if stat.IsDir() {
+		return fmt.Errorf("unable to use catchpoint file %s : not a regular file", catchpointFile)
+	}
+	return node.startCatchup(catchpoint, catchpointFile)
+}
+
+func (node *AlgorandFullNode) startCatchup(catchpoint string, catchpointFile string) error {
 	node.mu.Lock()
 	defer node.mu.Unlock()
 	if node.indexer != nil {
@@ -1136,7 +1331,7 @@ func (node *AlgorandFullNode) StartCatchup(catchpoint string) error {
 	}
 	var err error
 	accessor := ledger.MakeCatchpointCatchupAccessor(node.ledger.Ledger, node.log)
-	node.catchpointCatchupService, err = catchup.MakeNewCatchpointCatchupService(catchpoint, node, node.log, node.net, accessor, node.config)
+	node.catchpointCatchupService, err = catchup.MakeNewCatchpointCatchupService(catchpoint, catchpointFile, node, node.log, node.net, accessor, node.config)
 	if err != nil {
 		node.log.Warnf("unable to create catchpoint catchup service : %v", err)
 		return err
@@ -1162,6 +1357,74 @@ func (node *AlgorandFullNode) AbortCatchup(catchpoint string) error {
 	return nil
 }
 
+// publicGenesisHashes are the genesis hashes of the publicly-available networks by their genesis ID. Their state should
+// never be tampered with locally.
+var publicGenesisHashes = map[string]string{
+	"mainnet-v1.0": "YBQ4JWH4DW655UWXMBF6IVUOH5WQIGMHVQ333ZFWEC22WOJERLPQ",
+	"testnet-v1.0": "JBR3KGFEWPEE5SAQ6IWU6EEBZMHXD4CZU6WCBXWGF57XBZIJHIRA",
+	"betanet-v1.0": "TBMBVTC7W24RJNNUZCF7LWZD2NMESGZEQSMPG5XQD7JY4O7JKVWQ",
+	"alphanet-v1":  "HLZUIMHPR5GU6EYOYPF4KQNIC75EJKPCTLEA3GW7DGOC2MX7EY5A",
+}
+
+// isPublicGenesis returns true if the genesis is the one of a publicly-available network.
+func isPublicGenesis(genesisID string, genesisHash crypto.Digest) bool {
+	hash, ok := publicGenesisHashes[genesisID]
+	return ok && hash == genesisHash.String()
+}
+
+// RollbackLedger rolls the ledger back to the given round, discarding all the blocks that follow it, and returns the
+// latest round. It's refused on the public networks; on other networks, all the nodes need to be rolled back to the same
+// round, as otherwise the discarded blocks would be fetched again from their peers. The round is bounded by the state
+// deltas the ledger keeps in memory, except in developer mode, where the ledger is replayed from genesis if needed, as
+// for reverting to a snapshot. Agreement, the catchup service and the services feeding them are stopped during the
+// rollback, so that they don't add blocks to the ledger meanwhile.
+// this function is intended to be called externally via the REST api interface.
+func (node *AlgorandFullNode) RollbackLedger(rnd basics.Round) (basics.Round, error) {
+	if isPublicGenesis(node.genesisID, node.genesisHash) {
+		return node.ledger.Latest(), ErrRollbackPublicNetwork
+	}
+	node.mu.Lock()
+	defer node.mu.Unlock()
+	if node.catchpointCatchupService != nil {
+		return node.ledger.Latest(), fmt.Errorf("unable to roll back the ledger while catching up using a catchpoint")
+	}
+
+	// the services register their message handlers again once restarted.
+	node.net.ClearHandlers()
+	node.txHandler.Stop()
+	node.agreementService.Shutdown()
+	node.catchupService.Stop()
+	node.txPoolSyncerService.Stop()
+	node.blockService.Stop()
+	node.ledgerService.Stop()
+	defer func() {
+		node.catchupService.Start()
+		node.agreementService.Start()
+		node.txPoolSyncerService.Start(node.catchupService.InitialSyncDone)
+		node.blockService.Start()
+		node.ledgerService.Start()
+		node.txHandler.Start()
+	}()
+
+	err := node.ledger.Rollback(rnd, node.devMode)
+	if err != nil {
+		node.log.Warnf("unable to roll back the ledger to round %d: %v", rnd, err)
+		return node.ledger.Latest(), err
+	}
+	// the pending transactions were evaluated against the discarded state.
+	node.transactionPool.Reset()
+
+	// the developer mode snapshots taken after the given round can no longer be reverted to.
+	for i, snapshot := range node.devModeSnapshots {
+		if snapshot.round > rnd {
+			node.devModeSnapshots = node.devModeSnapshots[:i]
+			break
+		}
+	}
+	node.log.Infof("rolled back the ledger to round %d", rnd)
+	return node.ledger.Latest(), nil
+}
+
 // SetCatchpointCatchupMode change the node's operational mode from catchpoint catchup mode and back, it returns a
 // channel which contains the updated node context. This function need to work asynchronously so that the caller could
 // detect and handle the use case where the node is being shut down while we're switching to/from catchup mode without
@@ -1375,6 +1638,7 @@ func (node *AlgorandFullNode) VotingKeys(votingRound, keysRound basics.Round) []
 // Record forwards participation record calls to the participation registry.
 func (node *AlgorandFullNode) Record(account basics.Address, round basics.Round, participationType account.ParticipationAction) {
 	node.accountManager.Record(account, round, participationType)
+	node.participationMonitor.record(account, round, participationType)
 }
 
 // IsParticipating implements network.NodeInfo
@@ -0,0 +1,389 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package node
+
+import (
+	"github.com/algorand/go-deadlock"
+
+	"github.com/algorand/go-algorand/agreement"
+	"github.com/algorand/go-algorand/data"
+	"github.com/algorand/go-algorand/data/account"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/logging/telemetryspec"
+	"github.com/algorand/go-algorand/util/metrics"
+)
+
+const (
+	// participationHistoryRounds is the number of recent rounds for which the participation history of each key is kept.
+	participationHistoryRounds = 1000
+
+	// participationMonitorLag is the number of rounds the participation monitor lags behind the ledger, so that the
+	// votes and proposals made during a round are all recorded by the time the round is evaluated.
+	participationMonitorLag = 2
+
+	// participationStoppedVotingThreshold is the number of consecutive votes a key needs to miss for it to be
+	// considered as having stopped voting.
+	participationStoppedVotingThreshold = 10
+
+	// participationKeyExpiryWarningRounds is the number of rounds, roughly a week, before a registered key
+	// expires at which a warning is raised.
+	participationKeyExpiryWarningRounds = 180000
+)
+
+var participationExpectedVotes = metrics.MakeCounter(metrics.ParticipationExpectedVotes)
+var participationMissedVotes = metrics.MakeCounter(metrics.ParticipationMissedVotes)
+var participationExpectedProposals = metrics.MakeCounter(metrics.ParticipationExpectedProposals)
+var participationMissedProposals = metrics.MakeCounter(metrics.ParticipationMissedProposals)
+
+// ParticipationHistory summarizes the recent participation of a participation key, comparing the votes and
+// proposals it was selected to make with the ones it actually made.
+type ParticipationHistory struct {
+	ParticipationID account.ParticipationID
+	Address         basics.Address
+
+	// FirstRound and LastRound are the first and last rounds, inclusive, covered by the history. Both are zero
+	// when no round was evaluated for the key yet.
+	FirstRound basics.Round
+	LastRound  basics.Round
+
+	ExpectedVotes     uint64
+	MissedVotes       uint64
+	ExpectedProposals uint64
+	MissedProposals   uint64
+
+	// ConsecutiveMissedVotes is the number of votes missed since the last vote made using the key.
+	ConsecutiveMissedVotes uint64
+
+	MissedVoteRounds     []basics.Round
+	MissedProposalRounds []basics.Round
+}
+
+// participationRound is the expected and actual participation of a key during a single round.
+type participationRound struct {
+	round            basics.Round
+	expectedVote     bool
+	voted            bool
+	expectedProposal bool
+	proposed         bool
+}
+
+// participationKeyHistory is the participation history of a single key.
+type participationKeyHistory struct {
+	address    basics.Address
+	firstRound basics.Round
+	lastRound  basics.Round
+
+	// rounds are the rounds in which the key was expected to participate, in ascending order.
+	rounds []participationRound
+
+	consecutiveMissedVotes uint64
+	stoppedVotingReported  bool
+}
+
+// participationActions is a bitmask of the participation actions made by an account during a round.
+type participationActions uint8
+
+const (
+	participationVoted participationActions = 1 << iota
+	participationProposed
+)
+
+// participationMonitor keeps a rolling history of the votes and proposals missed by each of the node's
+// participation keys. It reports them through metrics, and raises telemetry events when a key stops voting or
+// is about to expire.
+type participationMonitor struct {
+	mu deadlock.Mutex
+
+	ledger         agreement.LedgerReader
+	accountManager *data.AccountManager
+	log            logging.Logger
+
+	// isSynchronizing reports whether the node is catching up, during which it doesn't participate.
+	isSynchronizing func() bool
+
+	// actions are the votes and proposals made by each account during the rounds which weren't evaluated yet.
+	actions map[basics.Address]map[basics.Round]participationActions
+	// histories are the participation histories of the keys, synchronized by mu.
+	histories map[account.ParticipationID]*participationKeyHistory
+	// expiryReported are the keys whose upcoming expiry was already reported.
+	expiryReported map[account.ParticipationID]bool
+	// lastEvaluated is the latest round evaluated by the monitor, once started.
+	lastEvaluated basics.Round
+	started       bool
+
+	notify chan struct{}
+}
+
+func makeParticipationMonitor(ledger agreement.LedgerReader, accountManager *data.AccountManager, log logging.Logger, isSynchronizing func() bool) *participationMonitor {
+	return &participationMonitor{
+		ledger:          ledger,
+		accountManager:  accountManager,
+		log:             log,
+		isSynchronizing: isSynchronizing,
+		actions:         make(map[basics.Address]map[basics.Round]participationActions),
+		histories:       make(map[account.ParticipationID]*participationKeyHistory),
+		expiryReported:  make(map[account.ParticipationID]bool),
+		notify:          make(chan struct{}, 1),
+	}
+}
+
+// record records a vote or a proposal made by the given account.
+func (pm *participationMonitor) record(addr basics.Address, rnd basics.Round, action account.ParticipationAction) {
+	var act participationActions
+	switch action {
+	case account.Vote:
+		act = participationVoted
+	case account.BlockProposal:
+		act = participationProposed
+	default:
+		return
+	}
+
+	pm.mu.Lock()
+	defer pm.mu.Unlock()
+	if rnd <= pm.lastEvaluated {
+		return
+	}
+	rounds, ok := pm.actions[addr]
+	if !ok {
+		rounds = make(map[basics.Round]participationActions)
+		pm.actions[addr] = rounds
+	}
+	rounds[rnd] |= act
+}
+
+// wake notifies the monitor that a new block was written to the ledger, without blocking.
+func (pm *participationMonitor) wake() {
+	select {
+	case pm.notify <- struct{}{}:
+	default:
+	}
+}
+
+// run evaluates the rounds added to the ledger until done is closed.
+func (pm *participationMonitor) run(done <-chan struct{}) {
+	for {
+		select {
+		case <-done:
+			return
+		case <-pm.notify:
+		}
+		pm.evaluate(pm.ledger.NextRound() - 1)
+	}
+}
+
+// evaluate evaluates the participation of the keys in the rounds that follow the last evaluated round, up to the
+// given latest round, less the monitor lag.
+func (pm *participationMonitor) evaluate(latest basics.Round) {
+	if latest < participationMonitorLag {
+		return
+	}
+	target := latest - participationMonitorLag
+
+	pm.mu.Lock()
+	if !pm.started || latest < pm.lastEvaluated {
+		// the monitor just started, or the ledger was rolled back; either way, the actions made during the
+		// rounds up to the latest one weren't recorded, so the monitoring starts from the following round.
+		pm.started = true
+		pm.lastEvaluated = latest
+		pm.mu.Unlock()
+		return
+	}
+	first := pm.lastEvaluated + 1
+	if target >= participationHistoryRounds && first < target-participationHistoryRounds+1 {
+		first = target - participationHistoryRounds + 1
+	}
+	pm.mu.Unlock()
+
+	for rnd := first; rnd <= target; rnd++ {
+		pm.evaluateRound(rnd)
+	}
+	pm.checkExpiry(target)
+}
+
+// evaluateRound compares the expected participation of each key during the given round with the actual one.
+func (pm *participationMonitor) evaluateRound(rnd basics.Round) {
+	synchronizing := pm.isSynchronizing()
+	type expectation struct {
+		part     account.ParticipationRecordForRound
+		proposer bool
+		voter    bool
+	}
+	var expected []expectation
+	if !synchronizing {
+		for _, part := range pm.accountManager.Keys(rnd) {
+			if part.VRF == nil {
+				continue
+			}
+			proposer, voter, err := agreement.ExpectedParticipation(pm.ledger, part.Account, part.VRF, rnd)
+			if err != nil {
+				pm.log.Warnf("participationMonitor: unable to evaluate the participation of %v in round %d: %v", part.Account, rnd, err)
+				continue
+			}
+			expected = append(expected, expectation{part: part, proposer: proposer, voter: voter})
+		}
+	}
+
+	pm.mu.Lock()
+	defer pm.mu.Unlock()
+	pm.lastEvaluated = rnd
+	made := make(map[basics.Address]participationActions, len(pm.actions))
+	for addr, rounds := range pm.actions {
+		made[addr] = rounds[rnd]
+		for r := range rounds {
+			if r <= rnd {
+				delete(rounds, r)
+			}
+		}
+		if len(rounds) == 0 {
+			delete(pm.actions, addr)
+		}
+	}
+	if synchronizing {
+		// the node doesn't participate while catching up, so there is nothing to compare with.
+		return
+	}
+
+	for _, e := range expected {
+		act := made[e.part.Account]
+		pr := participationRound{
+			round:            rnd,
+			expectedVote:     e.voter,
+			voted:            act&participationVoted != 0,
+			expectedProposal: e.proposer,
+			proposed:         act&participationProposed != 0,
+		}
+		pm.update(e.part.ParticipationID, e.part.Account, pr)
+	}
+}
+
+// update adds the participation of a key during a single round to its history.
+func (pm *participationMonitor) update(id account.ParticipationID, addr basics.Address, pr participationRound) {
+	history, ok := pm.histories[id]
+	if !ok {
+		history = &participationKeyHistory{address: addr, firstRound: pr.round}
+		pm.histories[id] = history
+	}
+	history.lastRound = pr.round
+	if pr.round >= participationHistoryRounds && history.firstRound < pr.round-participationHistoryRounds+1 {
+		history.firstRound = pr.round - participationHistoryRounds + 1
+	}
+	for len(history.rounds) > 0 && history.rounds[0].round < history.firstRound {
+		history.rounds = history.rounds[1:]
+	}
+	if !pr.expectedVote && !pr.expectedProposal {
+		return
+	}
+	history.rounds = append(history.rounds, pr)
+
+	labels := map[string]string{"address": addr.String()}
+	if pr.expectedProposal {
+		participationExpectedProposals.Inc(labels)
+		if !pr.proposed {
+			participationMissedProposals.Inc(labels)
+		}
+	}
+	if !pr.expectedVote {
+		return
+	}
+	participationExpectedVotes.Inc(labels)
+	if pr.voted {
+		history.consecutiveMissedVotes = 0
+		history.stoppedVotingReported = false
+		return
+	}
+	participationMissedVotes.Inc(labels)
+	history.consecutiveMissedVotes++
+	if history.consecutiveMissedVotes >= participationStoppedVotingThreshold && !history.stoppedVotingReported {
+		history.stoppedVotingReported = true
+		pm.log.Warnf("participation key %s of %v missed the last %d votes it was selected to make, as of round %d", id, addr, history.consecutiveMissedVotes, pr.round)
+		pm.log.EventWithDetails(telemetryspec.Accounts, telemetryspec.PartKeyStoppedVotingEvent, telemetryspec.PartKeyStoppedVotingEventDetails{
+			Address:         addr.String(),
+			ParticipationID: id.String(),
+			Round:           uint64(pr.round),
+			MissedVotes:     history.consecutiveMissedVotes,
+		})
+	}
+}
+
+// checkExpiry reports the registered keys which are about to expire, and forgets the keys which were removed.
+func (pm *participationMonitor) checkExpiry(rnd basics.Round) {
+	parts := pm.accountManager.Registry().GetAll()
+
+	pm.mu.Lock()
+	defer pm.mu.Unlock()
+	registered := make(map[account.ParticipationID]bool, len(parts))
+	for _, part := range parts {
+		registered[part.ParticipationID] = true
+		if part.EffectiveLast == 0 || part.EffectiveLast < rnd || part.EffectiveLast-rnd > participationKeyExpiryWarningRounds {
+			continue
+		}
+		if pm.expiryReported[part.ParticipationID] {
+			continue
+		}
+		pm.expiryReported[part.ParticipationID] = true
+		pm.log.Warnf("participation key %s of %v expires at round %d", part.ParticipationID, part.Account, part.EffectiveLast)
+		pm.log.EventWithDetails(telemetryspec.Accounts, telemetryspec.PartKeyExpiringEvent, telemetryspec.PartKeyExpiringEventDetails{
+			Address:         part.Account.String(),
+			ParticipationID: part.ParticipationID.String(),
+			Round:           uint64(rnd),
+			LastValid:       uint64(part.EffectiveLast),
+		})
+	}
+	for id := range pm.histories {
+		if !registered[id] {
+			delete(pm.histories, id)
+		}
+	}
+	for id := range pm.expiryReported {
+		if !registered[id] {
+			delete(pm.expiryReported, id)
+		}
+	}
+}
+
+// history returns the participation history of the given key.
+func (pm *participationMonitor) history(id account.ParticipationID, addr basics.Address) ParticipationHistory {
+	pm.mu.Lock()
+	defer pm.mu.Unlock()
+	res := ParticipationHistory{ParticipationID: id, Address: addr}
+	history, ok := pm.histories[id]
+	if !ok {
+		return res
+	}
+	res.FirstRound = history.firstRound
+	res.LastRound = history.lastRound
+	res.ConsecutiveMissedVotes = history.consecutiveMissedVotes
+	for _, pr := range history.rounds {
+		if pr.expectedVote {
+			res.ExpectedVotes++
+			if !pr.voted {
+				res.MissedVotes++
+				res.MissedVoteRounds = append(res.MissedVoteRounds, pr.round)
+			}
+		}
+		if pr.expectedProposal {
+			res.ExpectedProposals++
+			if !pr.proposed {
+				res.MissedProposals++
+				res.MissedProposalRounds = append(res.MissedProposalRounds, pr.round)
+			}
+		}
+	}
+	return res
+}
@@ -0,0 +1,120 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package node
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/data/account"
+	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/logging"
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestParticipationMonitorHistory(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	pm := makeParticipationMonitor(nil, nil, logging.TestingLog(t), func() bool { return false })
+	id := account.ParticipationID{1}
+	addr := basics.Address{2}
+
+	// the key votes in the first rounds, then stops voting.
+	for rnd := basics.Round(1); rnd <= 20; rnd++ {
+		pm.update(id, addr, participationRound{
+			round:            rnd,
+			expectedVote:     true,
+			voted:            rnd <= 5,
+			expectedProposal: rnd%4 == 0,
+			proposed:         rnd == 4,
+		})
+	}
+	// rounds in which the key isn't selected are covered, but not counted.
+	pm.update(id, addr, participationRound{round: 21})
+
+	history := pm.history(id, addr)
+	require.Equal(t, id, history.ParticipationID)
+	require.Equal(t, addr, history.Address)
+	require.Equal(t, basics.Round(1), history.FirstRound)
+	require.Equal(t, basics.Round(21), history.LastRound)
+	require.Equal(t, uint64(20), history.ExpectedVotes)
+	require.Equal(t, uint64(15), history.MissedVotes)
+	require.Equal(t, uint64(15), history.ConsecutiveMissedVotes)
+	require.Equal(t, uint64(5), history.ExpectedProposals)
+	require.Equal(t, uint64(4), history.MissedProposals)
+	require.Equal(t, []basics.Round{8, 12, 16, 20}, history.MissedProposalRounds)
+	require.Len(t, history.MissedVoteRounds, 15)
+	require.Equal(t, basics.Round(6), history.MissedVoteRounds[0])
+	require.True(t, pm.histories[id].stoppedVotingReported)
+
+	// voting again resets the consecutive missed votes.
+	pm.update(id, addr, participationRound{round: 22, expectedVote: true, voted: true})
+	history = pm.history(id, addr)
+	require.Equal(t, uint64(0), history.ConsecutiveMissedVotes)
+	require.False(t, pm.histories[id].stoppedVotingReported)
+
+	// only the most recent rounds are kept.
+	last := basics.Round(participationHistoryRounds + 10)
+	pm.update(id, addr, participationRound{round: last, expectedVote: true, voted: true})
+	history = pm.history(id, addr)
+	require.Equal(t, last-participationHistoryRounds+1, history.FirstRound)
+	require.Equal(t, last, history.LastRound)
+	require.Equal(t, uint64(12), history.ExpectedVotes)
+	require.Equal(t, uint64(10), history.MissedVotes)
+	require.Equal(t, []basics.Round{12, 16, 20}, history.MissedProposalRounds)
+
+	// a key which wasn't evaluated yet has an empty history.
+	other := pm.history(account.ParticipationID{3}, addr)
+	require.Equal(t, basics.Round(0), other.LastRound)
+	require.Zero(t, other.ExpectedVotes)
+}
+
+func TestParticipationMonitorRecord(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	// while synchronizing, the rounds are evaluated without looking up the keys.
+	pm := makeParticipationMonitor(nil, nil, logging.TestingLog(t), func() bool { return true })
+	addr := basics.Address{1}
+
+	// the first evaluation only starts the monitoring.
+	pm.evaluate(10)
+	require.True(t, pm.started)
+	require.Equal(t, basics.Round(10), pm.lastEvaluated)
+
+	// actions made in rounds which were already evaluated are ignored.
+	pm.record(addr, 10, account.Vote)
+	require.Empty(t, pm.actions)
+
+	pm.record(addr, 11, account.Vote)
+	pm.record(addr, 11, account.BlockProposal)
+	pm.record(addr, 13, account.Vote)
+	require.Equal(t, participationVoted|participationProposed, pm.actions[addr][11])
+
+	pm.evaluateRound(11)
+	require.Equal(t, basics.Round(11), pm.lastEvaluated)
+	require.Len(t, pm.actions[addr], 1)
+	require.Equal(t, participationVoted, pm.actions[addr][13])
+	require.Empty(t, pm.histories)
+
+	pm.evaluateRound(13)
+	require.Empty(t, pm.actions)
+
+	// a rollback restarts the monitoring from the new latest round.
+	pm.evaluate(5)
+	require.Equal(t, basics.Round(5), pm.lastEvaluated)
+}
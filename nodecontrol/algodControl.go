@@ -17,6 +17,7 @@
 package nodecontrol
 
 import (
+	"crypto/tls"
 	"fmt"
 	"net/url"
 	"os"
@@ -30,6 +31,7 @@ import (
 	"github.com/algorand/go-algorand/data/bookkeeping"
 	"github.com/algorand/go-algorand/protocol"
 	"github.com/algorand/go-algorand/util"
+	"github.com/algorand/go-algorand/util/tlsutil"
 	"github.com/algorand/go-algorand/util/tokens"
 )
 
@@ -76,6 +78,20 @@ func (nc NodeController) AlgodClient() (algodClient client.RestClient, err error
 
 	// Build the client from the URL and API token
 	algodClient = client.MakeRestClient(algodURL, algodAPIToken)
+
+	// Trust the node's certificate if it serves its API over TLS
+	certFile, err := nc.restTLSCertFile()
+	if err != nil {
+		return
+	}
+	if certFile != "" {
+		var tlsConfig *tls.Config
+		tlsConfig, err = tlsutil.MakeClientConfig(trustedCertFiles(nc.tlsCAFile, certFile)...)
+		if err != nil {
+			return
+		}
+		algodClient.SetTLSConfig(tlsConfig)
+	}
 	return
 }
 
@@ -85,9 +101,30 @@ func (nc NodeController) ServerURL() (url.URL, error) {
 	if err != nil {
 		return url.URL{}, err
 	}
+	certFile, err := nc.restTLSCertFile()
+	if err != nil {
+		return url.URL{}, err
+	}
+	if certFile != "" {
+		return url.URL{Scheme: "https", Host: addr}, nil
+	}
 	return url.URL{Scheme: "http", Host: addr}, nil
 }
 
+// restTLSCertFile returns the certificate the node serves its REST API with,
+// or an empty string if it serves it over plain HTTP.
+func (nc NodeController) restTLSCertFile() (string, error) {
+	if len(nc.algodDataDir) == 0 {
+		return "", nil
+	}
+	cfg, err := config.LoadConfigFromDisk(nc.algodDataDir)
+	if err != nil && !os.IsNotExist(err) {
+		return "", err
+	}
+	certFile, _, _ := cfg.RestTLSFiles(nc.algodDataDir)
+	return certFile, nil
+}
+
 // GetHostAddress retrieves the REST address for the node from its algod.net file.
 func (nc NodeController) GetHostAddress() (string, error) {
 	// For now, we want the old behavior to 'just work';
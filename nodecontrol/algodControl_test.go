@@ -18,8 +18,11 @@ package nodecontrol
 
 import (
 	"errors"
+	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/test/partitiontest"
 	"github.com/stretchr/testify/require"
 )
@@ -41,3 +44,27 @@ func TestStopAlgodErrorInvalidDirectory(t *testing.T) {
 	var e *MissingDataDirError
 	require.True(t, errors.As(err, &e))
 }
+
+func TestServerURLScheme(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	dataDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dataDir, "algod.net"), []byte("127.0.0.1:8080\n"), 0644))
+	nodeController := MakeNodeController("", dataDir)
+
+	serverURL, err := nodeController.ServerURL()
+	require.NoError(t, err)
+	require.Equal(t, "http://127.0.0.1:8080", serverURL.String())
+
+	cfg := config.GetDefaultLocal()
+	cfg.RestTLSCertFile = "algod.crt"
+	cfg.RestTLSKeyFile = "algod.key"
+	require.NoError(t, cfg.SaveToDisk(dataDir))
+	serverURL, err = nodeController.ServerURL()
+	require.NoError(t, err)
+	require.Equal(t, "https://127.0.0.1:8080", serverURL.String())
+
+	// the client fails to build if the certificate can't be trusted
+	_, err = nodeController.AlgodClient()
+	require.Error(t, err)
+}
@@ -17,6 +17,7 @@
 package nodecontrol
 
 import (
+	"crypto/tls"
 	"errors"
 	"fmt"
 	"os"
@@ -28,10 +29,12 @@ import (
 
 	"github.com/algorand/go-algorand/cmd/kmd/codes"
 	"github.com/algorand/go-algorand/daemon/kmd/client"
+	"github.com/algorand/go-algorand/daemon/kmd/config"
 	"github.com/algorand/go-algorand/daemon/kmd/lib/kmdapi"
 	"github.com/algorand/go-algorand/daemon/kmd/server"
 	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-algorand/util"
+	"github.com/algorand/go-algorand/util/tlsutil"
 	"github.com/algorand/go-algorand/util/tokens"
 )
 
@@ -52,6 +55,7 @@ type KMDController struct {
 	kmd        string // path to binary
 	kmdDataDir string
 	kmdPIDPath string
+	tlsCAFile  string
 }
 
 // MakeKMDController initializes a KMDController
@@ -73,6 +77,12 @@ func (kc *KMDController) SetKMDDataDir(kmdDataDir string) {
 	kc.kmdPIDPath = filepath.Join(kmdDataDir, server.PIDFilename)
 }
 
+// SetTLSCAFile sets the file of the certificate authorities the clients trust,
+// in addition to the system ones, when the API is served over TLS.
+func (kc *KMDController) SetTLSCAFile(caFile string) {
+	kc.tlsCAFile = caFile
+}
+
 // KMDClient reads an APIToken and netFile from the kmd dataDir, and then
 // builds a KMDClient for the running kmd process
 func (kc KMDController) KMDClient() (kmdClient client.KMDClient, err error) {
@@ -91,9 +101,37 @@ func (kc KMDController) KMDClient() (kmdClient client.KMDClient, err error) {
 
 	// Build the client
 	kmdClient, err = client.MakeKMDClient(sockPath, apiToken)
+	if err != nil {
+		return
+	}
+
+	// Connect over TLS if kmd serves its API with a certificate
+	kmdCfg, err := config.LoadKMDConfig(kc.kmdDataDir)
+	if err != nil {
+		return
+	}
+	certFile, _ := kmdCfg.TLSFiles()
+	if certFile != "" {
+		var tlsConfig *tls.Config
+		tlsConfig, err = tlsutil.MakeClientConfig(trustedCertFiles(kc.tlsCAFile, certFile)...)
+		if err != nil {
+			return
+		}
+		kmdClient.SetTLSConfig(tlsConfig)
+	}
 	return
 }
 
+// trustedCertFiles returns the certificate files a client trusts: the
+// certificate authorities it was given, if any, and the server's own
+// certificate, which works for self-signed certificates.
+func trustedCertFiles(caFile string, serverCertFile string) []string {
+	if caFile == "" {
+		return []string{serverCertFile}
+	}
+	return []string{caFile, serverCertFile}
+}
+
 func (kc KMDController) buildKMDCommand(args KMDStartArgs) *exec.Cmd {
 	var startArgs []string
 	startArgs = append(startArgs, "-d")
@@ -18,6 +18,7 @@ package rpcs
 
 import (
 	"compress/gzip"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -32,6 +33,7 @@ import (
 	"github.com/algorand/go-algorand/config"
 	"github.com/algorand/go-algorand/data"
 	"github.com/algorand/go-algorand/data/basics"
+	"github.com/algorand/go-algorand/ledger"
 	"github.com/algorand/go-algorand/ledger/ledgercore"
 	"github.com/algorand/go-algorand/logging"
 	"github.com/algorand/go-algorand/network"
@@ -207,6 +209,11 @@ func (ls *LedgerService) ServeHTTP(response http.ResponseWriter, request *http.R
 	requestedCompressedResponse := strings.Contains(request.Header.Get("Accept-Encoding"), "gzip")
 	if requestedCompressedResponse {
 		response.Header().Set("Content-Encoding", "gzip")
+		response.Header().Set("Accept-Ranges", "bytes")
+		if rangeHeader := request.Header.Get("Range"); rangeHeader != "" {
+			ls.serveCatchpointRange(response, cs, rangeHeader, round)
+			return
+		}
 		written, err := io.Copy(response, cs)
 		if err != nil {
 			logging.Base().Infof("LedgerService.ServeHTTP : unable to write compressed catchpoint file for round %d, written bytes %d : %v", round, written, err)
@@ -226,3 +233,95 @@ func (ls *LedgerService) ServeHTTP(response http.ResponseWriter, request *http.R
 		logging.Base().Infof("LedgerService.ServeHTTP : unable to write decompressed catchpoint file for round %d, written bytes %d : %v", round, written, err)
 	}
 }
+
+// serveCatchpointRange writes the requested byte range of the compressed catchpoint file, allowing the catchpoint file
+// to be downloaded in segments. A range header which can't be parsed is ignored, and the whole file is written.
+func (ls *LedgerService) serveCatchpointRange(response http.ResponseWriter, cs ledger.ReadCloseSizer, rangeHeader string, round uint64) {
+	size, err := cs.Size()
+	if err != nil || size <= 0 {
+		logging.Base().Warnf("LedgerService.ServeHTTP : failed to retrieve the size of catchpoint %d %v", round, err)
+		response.WriteHeader(http.StatusInternalServerError)
+		response.Write([]byte(fmt.Sprintf("catchpoint file for round %d size could not be retrieved due to internal error : %v", round, err)))
+		return
+	}
+	first, last, err := parseByteRange(rangeHeader, size)
+	switch err {
+	case nil:
+	case errUnsatisfiableByteRange:
+		response.Header().Set("Content-Range", fmt.Sprintf("bytes */%d", size))
+		response.WriteHeader(http.StatusRequestedRangeNotSatisfiable)
+		return
+	default:
+		written, err := io.Copy(response, cs)
+		if err != nil {
+			logging.Base().Infof("LedgerService.ServeHTTP : unable to write compressed catchpoint file for round %d, written bytes %d : %v", round, written, err)
+		}
+		return
+	}
+	if _, err = io.CopyN(io.Discard, cs, first); err != nil {
+		logging.Base().Warnf("LedgerService.ServeHTTP : failed to seek catchpoint %d to offset %d %v", round, first, err)
+		response.WriteHeader(http.StatusInternalServerError)
+		response.Write([]byte(fmt.Sprintf("catchpoint file for round %d could not be read due to internal error : %v", round, err)))
+		return
+	}
+	response.Header().Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", first, last, size))
+	response.Header().Set("Content-Length", strconv.FormatInt(last-first+1, 10))
+	response.WriteHeader(http.StatusPartialContent)
+	written, err := io.CopyN(response, cs, last-first+1)
+	if err != nil {
+		logging.Base().Infof("LedgerService.ServeHTTP : unable to write compressed catchpoint file range %d-%d for round %d, written bytes %d : %v", first, last, round, written, err)
+	}
+}
+
+// errUnsatisfiableByteRange is returned by parseByteRange when the range doesn't overlap the content.
+var errUnsatisfiableByteRange = errors.New("byte range not satisfiable")
+
+// parseByteRange parses a Range header holding a single byte range, such as "bytes=100-199", "bytes=100-" or
+// "bytes=-100", and returns the first and last offsets of the range within a content of the given size, inclusive.
+func parseByteRange(rangeHeader string, size int64) (first int64, last int64, err error) {
+	const prefix = "bytes="
+	if !strings.HasPrefix(rangeHeader, prefix) {
+		return 0, 0, fmt.Errorf("unsupported range unit in '%s'", rangeHeader)
+	}
+	spec := strings.TrimSpace(rangeHeader[len(prefix):])
+	if strings.Contains(spec, ",") {
+		return 0, 0, fmt.Errorf("multiple byte ranges aren't supported '%s'", rangeHeader)
+	}
+	bounds := strings.SplitN(spec, "-", 2)
+	if len(bounds) != 2 {
+		return 0, 0, fmt.Errorf("invalid byte range '%s'", rangeHeader)
+	}
+	firstStr, lastStr := strings.TrimSpace(bounds[0]), strings.TrimSpace(bounds[1])
+	if firstStr == "" {
+		// a suffix range, holding the last bytes of the content.
+		suffix, err := strconv.ParseInt(lastStr, 10, 64)
+		if err != nil || suffix < 0 {
+			return 0, 0, fmt.Errorf("invalid byte range '%s'", rangeHeader)
+		}
+		if suffix == 0 {
+			return 0, 0, errUnsatisfiableByteRange
+		}
+		if suffix > size {
+			suffix = size
+		}
+		return size - suffix, size - 1, nil
+	}
+	first, err = strconv.ParseInt(firstStr, 10, 64)
+	if err != nil || first < 0 {
+		return 0, 0, fmt.Errorf("invalid byte range '%s'", rangeHeader)
+	}
+	last = size - 1
+	if lastStr != "" {
+		last, err = strconv.ParseInt(lastStr, 10, 64)
+		if err != nil || last < first {
+			return 0, 0, fmt.Errorf("invalid byte range '%s'", rangeHeader)
+		}
+		if last >= size {
+			last = size - 1
+		}
+	}
+	if first >= size {
+		return 0, 0, errUnsatisfiableByteRange
+	}
+	return first, last, nil
+}
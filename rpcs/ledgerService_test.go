@@ -0,0 +1,104 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package rpcs
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+func TestParseByteRange(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	testCases := []struct {
+		header string
+		first  int64
+		last   int64
+		err    error
+	}{
+		{"bytes=0-99", 0, 99, nil},
+		{"bytes=100-", 100, 999, nil},
+		{"bytes=900-2000", 900, 999, nil},
+		{"bytes=-100", 900, 999, nil},
+		{"bytes=-5000", 0, 999, nil},
+		{"bytes=999-999", 999, 999, nil},
+		{"bytes=1000-", 0, 0, errUnsatisfiableByteRange},
+		{"bytes=-0", 0, 0, errUnsatisfiableByteRange},
+	}
+	for _, testCase := range testCases {
+		first, last, err := parseByteRange(testCase.header, 1000)
+		require.Equal(t, testCase.err, err, testCase.header)
+		require.Equal(t, testCase.first, first, testCase.header)
+		require.Equal(t, testCase.last, last, testCase.header)
+	}
+
+	for _, header := range []string{"items=0-1", "bytes=0-1,5-6", "bytes=5", "bytes=6-5", "bytes=a-", "bytes=-a", "bytes=-"} {
+		_, _, err := parseByteRange(header, 1000)
+		require.Error(t, err, header)
+		require.NotEqual(t, errUnsatisfiableByteRange, err, header)
+	}
+}
+
+type testReadCloseSizer struct {
+	io.Reader
+	size int64
+}
+
+func (r *testReadCloseSizer) Close() error {
+	return nil
+}
+
+func (r *testReadCloseSizer) Size() (int64, error) {
+	return r.size, nil
+}
+
+func TestServeCatchpointRange(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	content := make([]byte, 1000)
+	for i := range content {
+		content[i] = byte(i)
+	}
+	serve := func(rangeHeader string) *httptest.ResponseRecorder {
+		ls := &LedgerService{}
+		response := httptest.NewRecorder()
+		ls.serveCatchpointRange(response, &testReadCloseSizer{Reader: bytes.NewReader(content), size: int64(len(content))}, rangeHeader, 1)
+		return response
+	}
+
+	response := serve("bytes=100-199")
+	require.Equal(t, http.StatusPartialContent, response.Code)
+	require.Equal(t, "bytes 100-199/1000", response.Header().Get("Content-Range"))
+	require.Equal(t, "100", response.Header().Get("Content-Length"))
+	require.Equal(t, content[100:200], response.Body.Bytes())
+
+	response = serve("bytes=1000-")
+	require.Equal(t, http.StatusRequestedRangeNotSatisfiable, response.Code)
+	require.Equal(t, "bytes */1000", response.Header().Get("Content-Range"))
+
+	// a range which can't be parsed is ignored.
+	response = serve("bytes=0-1,5-6")
+	require.Equal(t, http.StatusOK, response.Code)
+	require.Equal(t, content, response.Body.Bytes())
+}
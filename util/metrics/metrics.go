@@ -126,4 +126,13 @@ var (
 	TransactionGroupTxSyncRemember = MetricName{Name: "algod_transaction_group_txsync_remember", Description: "Number of transaction groups remembered via txsync"}
 	// TransactionGroupTxSyncAlreadyCommitted "Number of duplicate or error transaction groups received via txsync"
 	TransactionGroupTxSyncAlreadyCommitted = MetricName{Name: "algod_transaction_group_txsync_err_or_committed", Description: "Number of duplicate or error transaction groups received via txsync"}
+
+	// ParticipationExpectedVotes "Number of votes the participating accounts were selected to make"
+	ParticipationExpectedVotes = MetricName{Name: "algod_participation_expected_votes", Description: "Number of votes the participating accounts were selected to make"}
+	// ParticipationMissedVotes "Number of votes the participating accounts were selected to make, but did not make"
+	ParticipationMissedVotes = MetricName{Name: "algod_participation_missed_votes", Description: "Number of votes the participating accounts were selected to make, but did not make"}
+	// ParticipationExpectedProposals "Number of blocks the participating accounts were selected to propose"
+	ParticipationExpectedProposals = MetricName{Name: "algod_participation_expected_proposals", Description: "Number of blocks the participating accounts were selected to propose"}
+	// ParticipationMissedProposals "Number of blocks the participating accounts were selected to propose, but did not propose"
+	ParticipationMissedProposals = MetricName{Name: "algod_participation_missed_proposals", Description: "Number of blocks the participating accounts were selected to propose, but did not propose"}
 )
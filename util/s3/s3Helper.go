@@ -17,6 +17,7 @@
 package s3
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
@@ -50,6 +51,9 @@ const (
 	uploadAction   = "upload"
 )
 
+// ErrObjectNotFound is returned when the requested object doesn't exist in the bucket
+var ErrObjectNotFound = errors.New("object not found")
+
 // Helper encapsulates the s3 session state for interactive with our default S3 bucket with appropriate credentials
 type Helper struct {
 	session *session.Session
@@ -100,6 +104,39 @@ func MakeS3SessionForDownloadWithBucket(awsBucket string) (helper Helper, err er
 	return makeS3Session(creds, awsBucket)
 }
 
+// MakeS3SessionForDownloadWithEndpoint download from a bucket hosted at the given endpoint, which can be an
+// S3-compatible service. The default S3 endpoint and region are used when they are empty, and anonymous credentials
+// are used when no AWS credentials are available.
+func MakeS3SessionForDownloadWithEndpoint(awsBucket string, endpoint string, region string) (helper Helper, err error) {
+	err = validateS3Bucket(awsBucket)
+	if err != nil {
+		return
+	}
+	creds := credentials.AnonymousCredentials
+	awsID, awsKey := getAWSCredentials()
+	if awsID != "" && awsKey != "" {
+		creds = credentials.NewStaticCredentials(awsID, awsKey, "")
+	}
+	if region == "" {
+		region = getS3Region()
+	}
+	cfg := &aws.Config{Region: aws.String(region), Credentials: creds}
+	if endpoint != "" {
+		// S3-compatible services don't necessarily support virtual-hosted buckets.
+		cfg.Endpoint = aws.String(endpoint)
+		cfg.S3ForcePathStyle = aws.Bool(true)
+	}
+	sess, err := session.NewSession(cfg)
+	if err != nil {
+		return
+	}
+	helper = Helper{
+		session: sess,
+		bucket:  awsBucket,
+	}
+	return
+}
+
 // UploadFileStream sends file as stream to s3
 func (helper *Helper) UploadFileStream(targetFile string, reader io.Reader) error {
 	uploader := s3manager.NewUploader(helper.session)
@@ -220,6 +257,31 @@ func (helper *Helper) DownloadFile(name string, writer io.WriterAt) error {
 	return err
 }
 
+// GetObject returns the content of the specified object, which is expected to be at most maxBytes long.
+// ErrObjectNotFound is returned if the object doesn't exist.
+func (helper *Helper) GetObject(ctx context.Context, name string, maxBytes int64) ([]byte, error) {
+	svc := s3.New(helper.session)
+	result, err := svc.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: &helper.bucket,
+		Key:    aws.String(name),
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == s3.ErrCodeNoSuchKey {
+			return nil, ErrObjectNotFound
+		}
+		return nil, err
+	}
+	defer result.Body.Close()
+	data, err := io.ReadAll(io.LimitReader(result.Body, maxBytes+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > maxBytes {
+		return nil, fmt.Errorf("object %s in bucket %s exceeds the maximal size of %d bytes", name, helper.bucket, maxBytes)
+	}
+	return data, nil
+}
+
 // UploadChannelFiles uploads the provided set of package files in a batch
 func (helper *Helper) UploadChannelFiles(channel string, files []string) error {
 	subFolder := filepath.Join("channel", channel)
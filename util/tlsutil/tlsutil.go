@@ -0,0 +1,134 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+// Package tlsutil provides the TLS configurations of the REST servers and of their clients.
+package tlsutil
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+
+	"github.com/algorand/go-deadlock"
+)
+
+// minTLSVersion is the oldest TLS version the servers and clients accept.
+const minTLSVersion = tls.VersionTLS12
+
+// CertificateReloader serves the certificate of a TLS server, and optionally the certificate authorities its
+// clients' certificates are verified against, which can be reloaded from their files while the server is running.
+type CertificateReloader struct {
+	certFile     string
+	keyFile      string
+	clientCAFile string
+
+	mu        deadlock.RWMutex
+	cert      *tls.Certificate
+	clientCAs *x509.CertPool
+}
+
+// MakeCertificateReloader loads the certificate and key of a TLS server from the given files. If clientCAFile isn't
+// empty, the clients may present a certificate, which is then verified against the certificate authorities of that file.
+func MakeCertificateReloader(certFile, keyFile, clientCAFile string) (*CertificateReloader, error) {
+	reloader := &CertificateReloader{
+		certFile:     certFile,
+		keyFile:      keyFile,
+		clientCAFile: clientCAFile,
+	}
+	err := reloader.Reload()
+	if err != nil {
+		return nil, err
+	}
+	return reloader, nil
+}
+
+// Reload loads the files again. The connections established from then on use the new certificates, while the
+// previous ones are kept if any of the files can't be loaded.
+func (r *CertificateReloader) Reload() error {
+	cert, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		return fmt.Errorf("unable to load the TLS certificate %s and key %s: %w", r.certFile, r.keyFile, err)
+	}
+	var clientCAs *x509.CertPool
+	if r.clientCAFile != "" {
+		clientCAs = x509.NewCertPool()
+		err = appendCertsFromFile(clientCAs, r.clientCAFile)
+		if err != nil {
+			return err
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cert = &cert
+	r.clientCAs = clientCAs
+	return nil
+}
+
+// ServerConfig returns the TLS configuration of the server, which uses the certificates loaded last.
+func (r *CertificateReloader) ServerConfig() *tls.Config {
+	return &tls.Config{
+		MinVersion:         minTLSVersion,
+		GetConfigForClient: r.getConfigForClient,
+	}
+}
+
+func (r *CertificateReloader) getConfigForClient(*tls.ClientHelloInfo) (*tls.Config, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	config := &tls.Config{
+		MinVersion:   minTLSVersion,
+		Certificates: []tls.Certificate{*r.cert},
+	}
+	if r.clientCAs != nil {
+		// Only some of the requests require a client certificate, which is checked once they are routed.
+		config.ClientCAs = r.clientCAs
+		config.ClientAuth = tls.VerifyClientCertIfGiven
+	}
+	return config, nil
+}
+
+// MakeClientConfig returns the TLS configuration of a client which trusts the certificates of the given files, in
+// addition to the certificate authorities of the system. The files may hold certificate authorities as well as the
+// self-signed certificates of servers.
+func MakeClientConfig(trustedCertFiles ...string) (*tls.Config, error) {
+	rootCAs, err := x509.SystemCertPool()
+	if err != nil {
+		rootCAs = x509.NewCertPool()
+	}
+	for _, certFile := range trustedCertFiles {
+		err = appendCertsFromFile(rootCAs, certFile)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &tls.Config{
+		MinVersion: minTLSVersion,
+		RootCAs:    rootCAs,
+	}, nil
+}
+
+func appendCertsFromFile(pool *x509.CertPool, certFile string) error {
+	pem, err := os.ReadFile(certFile)
+	if err != nil {
+		return fmt.Errorf("unable to read the certificates of %s: %w", certFile, err)
+	}
+	if !pool.AppendCertsFromPEM(pem) {
+		return fmt.Errorf("no PEM encoded certificate found in %s", certFile)
+	}
+	return nil
+}
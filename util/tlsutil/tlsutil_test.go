@@ -0,0 +1,144 @@
+// Copyright (C) 2019-2022 Algorand, Inc.
+// This file is part of go-algorand
+//
+// go-algorand is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as
+// published by the Free Software Foundation, either version 3 of the
+// License, or (at your option) any later version.
+//
+// go-algorand is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with go-algorand.  If not, see <https://www.gnu.org/licenses/>.
+
+package tlsutil
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io"
+	"math/big"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/algorand/go-algorand/test/partitiontest"
+)
+
+// writeSelfSignedCert writes a self-signed certificate valid for localhost, and its key, into the given directory.
+func writeSelfSignedCert(t *testing.T, dir string, name string) (certFile string, keyFile string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		IPAddresses:           []net.IP{net.ParseIP("127.0.0.1")},
+		DNSNames:              []string{"localhost"},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, &template, &template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	certFile = filepath.Join(dir, name+".crt")
+	keyFile = filepath.Join(dir, name+".key")
+	require.NoError(t, os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600))
+	require.NoError(t, os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0600))
+	return
+}
+
+func TestCertificateReloader(t *testing.T) {
+	partitiontest.PartitionTest(t)
+
+	dir := t.TempDir()
+	certFile, keyFile := writeSelfSignedCert(t, dir, "server")
+	clientCertFile, clientKeyFile := writeSelfSignedCert(t, dir, "client")
+
+	reloader, err := MakeCertificateReloader(certFile, keyFile, clientCertFile)
+	require.NoError(t, err)
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if len(r.TLS.VerifiedChains) > 0 {
+			w.Write([]byte(r.TLS.VerifiedChains[0][0].Subject.CommonName))
+		}
+	}))
+	server.TLS = reloader.ServerConfig()
+	server.StartTLS()
+	defer server.Close()
+
+	get := func(clientConfig *tls.Config) (string, error) {
+		client := http.Client{Transport: &http.Transport{TLSClientConfig: clientConfig}}
+		resp, err := client.Get(server.URL)
+		if err != nil {
+			return "", err
+		}
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		return string(body), err
+	}
+
+	// the server certificate is not trusted by default
+	clientConfig, err := MakeClientConfig()
+	require.NoError(t, err)
+	_, err = get(clientConfig)
+	require.Error(t, err)
+
+	clientConfig, err = MakeClientConfig(certFile)
+	require.NoError(t, err)
+	body, err := get(clientConfig)
+	require.NoError(t, err)
+	require.Empty(t, body)
+
+	// the client certificate is verified if given
+	clientCert, err := tls.LoadX509KeyPair(clientCertFile, clientKeyFile)
+	require.NoError(t, err)
+	clientConfig.Certificates = []tls.Certificate{clientCert}
+	body, err = get(clientConfig)
+	require.NoError(t, err)
+	require.Equal(t, "client", body)
+
+	// the previous certificate is kept if the new one can't be loaded
+	require.NoError(t, os.WriteFile(keyFile, []byte("not a key"), 0600))
+	require.Error(t, reloader.Reload())
+	body, err = get(clientConfig)
+	require.NoError(t, err)
+	require.Equal(t, "client", body)
+
+	// the new certificate is used once reloaded
+	newCertFile, newKeyFile := writeSelfSignedCert(t, t.TempDir(), "server")
+	require.NoError(t, os.Rename(newCertFile, certFile))
+	require.NoError(t, os.Rename(newKeyFile, keyFile))
+	require.NoError(t, reloader.Reload())
+	_, err = get(clientConfig)
+	require.Error(t, err)
+	clientConfig, err = MakeClientConfig(certFile)
+	require.NoError(t, err)
+	body, err = get(clientConfig)
+	require.NoError(t, err)
+	require.Empty(t, body)
+
+	_, err = MakeClientConfig(filepath.Join(dir, "missing.crt"))
+	require.Error(t, err)
+	_, err = MakeCertificateReloader(certFile, filepath.Join(dir, "missing.key"), "")
+	require.Error(t, err)
+}
@@ -17,10 +17,12 @@
 package tokens
 
 import (
+	"bufio"
 	"crypto/rand"
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/algorand/go-algorand/util"
 )
@@ -33,8 +35,30 @@ const (
 	AlgodTokenFilename      = "algod.token"
 	AlgodAdminTokenFilename = "algod.admin.token"
 	KmdTokenFilename        = "kmd.token"
+
+	// AlgodScopedTokensFilename is the optional file listing additional algod API tokens along with their scopes,
+	// one "<scope> <token>" pair per line.
+	AlgodScopedTokensFilename = "algod.scoped.tokens"
+)
+
+// TokenScope is the set of API requests a scoped API token is allowed to make
+type TokenScope string
+
+const (
+	// ReadOnlyScope allows the requests that don't modify the state of the node
+	ReadOnlyScope TokenScope = "read"
+	// SubmitScope allows all the requests of the public API, like the algod.token
+	SubmitScope TokenScope = "submit"
+	// AdminScope allows all the requests, like the algod.admin.token
+	AdminScope TokenScope = "admin"
 )
 
+// ScopedToken is an API token read from the scoped tokens file
+type ScopedToken struct {
+	Token string
+	Scope TokenScope
+}
+
 func tokenFilepath(dataDir, tokenFilename string) string {
 	return filepath.Join(dataDir, tokenFilename)
 }
@@ -121,3 +145,41 @@ func ValidateOrGenerateAPIToken(dataDir, tokenFilename string) (apiToken string,
 
 	return
 }
+
+// GetScopedAPITokens reads and validates the scoped API tokens file. Empty lines, and lines starting with '#', are
+// ignored. A missing file is not an error, as there are no scoped tokens then.
+func GetScopedAPITokens(dataDir, tokensFilename string) ([]ScopedToken, error) {
+	f, err := os.Open(tokenFilepath(dataDir, tokensFilename))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer f.Close()
+
+	var scopedTokens []ScopedToken
+	scanner := bufio.NewScanner(f)
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("%s:%d: expected a scope and a token", tokensFilename, lineNumber)
+		}
+		scope := TokenScope(fields[0])
+		switch scope {
+		case ReadOnlyScope, SubmitScope, AdminScope:
+		default:
+			return nil, fmt.Errorf("%s:%d: unknown scope '%s'", tokensFilename, lineNumber, scope)
+		}
+		err = ValidateAPIToken(fields[1])
+		if err != nil {
+			return nil, fmt.Errorf("%s:%d: %v", tokensFilename, lineNumber, err)
+		}
+		scopedTokens = append(scopedTokens, ScopedToken{Token: fields[1], Scope: scope})
+	}
+	return scopedTokens, scanner.Err()
+}